@@ -23,16 +23,24 @@ package galio
 
 import (
 	"context"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"net/http"
+	"strings"
 
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"galiosight.ai/galio-sdk-go/components"
 	"galiosight.ai/galio-sdk-go/configs"
+	otlpmetrics "galiosight.ai/galio-sdk-go/exporters/otlp/metrics"
 	"galiosight.ai/galio-sdk-go/exporters/otlp/traces"
 	"galiosight.ai/galio-sdk-go/helper"
 	"galiosight.ai/galio-sdk-go/lib/otelzap"
 	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/processors/omp/profiles"
 )
 
 var (
@@ -84,6 +92,23 @@ func NewMetricsProcessor(metricsCfg *configs.Metrics) (components.MetricsProcess
 	return helper.GetMetricsProcessor(metricsCfg)
 }
 
+// NewMetricsProcessorWithPrometheusHandler 创建一个 MetricsProcessor，同时返回 Prometheus 拉取数据的 http.Handler。
+// 将 handler 注册到业务的 http 服务上，Prometheus 即可直接拉取伽利略聚合后的指标。
+// 与 NewMetricsProcessor 一样，此方法开销较大，只需要调用一次。
+func NewMetricsProcessorWithPrometheusHandler(metricsCfg *configs.Metrics) (
+	components.MetricsProcessor, http.Handler, error,
+) {
+	return helper.GetMetricsProcessorWithPrometheusHandler(metricsCfg)
+}
+
+// NewMultiTargetMetricsProcessor 创建一个多目标 MetricsProcessor，网关等代理多个逻辑服务上报监控时使用。
+// 通过 ForResource/ForTarget 获取各目标的处理器上报，各目标共用聚合分片及上报链路，导出时使用各自的 resource。
+// 每个目标的点数预算、最多代理的目标数通过 processor.multi_target 配置。
+// 与 NewMetricsProcessor 一样，此方法开销较大，只需要调用一次。
+func NewMultiTargetMetricsProcessor(metricsCfg *configs.Metrics) (components.MultiTargetMetricsProcessor, error) {
+	return helper.GetMultiTargetMetricsProcessor(metricsCfg)
+}
+
 // ClientMetrics 主调指标数据上报。
 // 此方法是线程安全的。
 func ClientMetrics(clientMetrics *model.ClientMetrics) {
@@ -108,6 +133,38 @@ func CustomMetrics(customMetrics *model.CustomMetrics) {
 	defaultMetricsProcessor.ProcessCustomMetrics(customMetrics)
 }
 
+// SetMetricsSpanContext 将 ctx 中的 span 上下文关联到主被调监控，聚合时为耗时分布的分桶生成 exemplar。
+// 采样（含后置采样命中）的 span 会优先作为 exemplar，ctx 中没有 span 时不做任何处理。
+// 需要在 ClientMetrics/ServerMetrics 上报之前调用。
+func SetMetricsSpanContext(ctx context.Context, metrics model.SpanContextSetter) {
+	span := SpanFromContext(ctx)
+	sc := span.SpanContext()
+	if !sc.IsValid() {
+		return
+	}
+	traceID, spanID := sc.TraceID(), sc.SpanID()
+	metrics.SetSpanContext(traceID[:], spanID[:], span.IsSampled())
+}
+
+// NewMetricsSpanProcessor 创建一个将 client/server span 转换成主被调监控的 SpanProcessor，
+// 数据上报到默认的指标处理器，ret_code_as_exception 跟随 target 的 OCP 配置。
+// 在创建 TracesExporter 之前通过 traces.WithSetupOptions(traces.WithMetricsSpanProcessor(p)) 注册，
+// 该 TracerProvider 会记录未采样的 span（只用于统计，不上报），监控不受采样率影响。
+// 注册后不需要再调用 ClientMetrics/ServerMetrics 上报同一个 RPC，否则会重复统计。
+func NewMetricsSpanProcessor(
+	target string, opts ...traces.MetricsSpanProcessorOption,
+) *traces.MetricsSpanProcessor {
+	opts = append([]traces.MetricsSpanProcessorOption{traces.WithOCPTarget(target)}, opts...)
+	return traces.NewMetricsSpanProcessor(GetDefaultMetricsProcessor, opts...)
+}
+
+// NewMetricsBridgeReader 创建一个将 OpenTelemetry 指标转换成自定义监控的 Reader，
+// 数据上报到默认的指标处理器，通过 sdkmetric.NewMeterProvider(sdkmetric.WithReader(r)) 注册。
+// 依赖库使用 OpenTelemetry metric API 埋点时，可以与自定义监控共用同一套聚合、过载保护及上报链路。
+func NewMetricsBridgeReader(opts ...otlpmetrics.BridgeOption) sdkmetric.Reader {
+	return otlpmetrics.NewBridgeReader(GetDefaultMetricsProcessor, opts...)
+}
+
 // NewTracesExporter 创建一个 TracesExporter。
 // 通常情况下，此方法只需要调用一次，创建出对象后可以进行重用。
 // 此方法是线程安全的。
@@ -164,10 +221,62 @@ func NewLogsExporter(cfg *configs.Logs) (components.LogsExporter, error) {
 // 通常情况下，此方法只需要调用一次，创建出对象后可以进行重用。
 // 注意，此方法开销是非常大的，内部会创建多个协程，所以不能频繁创建。
 // 此方法是线程安全的。
+// 开启异常检测且未设置 cfg.AnomalyHandler 时，检测到的异常通过 ReportEvent 及自定义监控上报。
 func NewProfilesProcessor(cfg *configs.Profiles) (components.ProfilesProcessor, error) {
+	if cfg.AnomalyHandler == nil {
+		c := *cfg // 不修改调用方的配置
+		c.AnomalyHandler = reportProfileAnomaly
+		cfg = &c
+	}
 	return helper.GetProfilesProcessor(cfg)
 }
 
+// profileAnomalyMonitorName profile 异常检测的自定义监控项名。
+const profileAnomalyMonitorName = "GalileoProfileAnomaly"
+
+// reportProfileAnomaly 上报 profile 异常：事件包含完整调用栈，
+// 自定义监控按 profile 类型、栈顶函数及调用栈哈希上报增长值，避免完整调用栈作为标签导致标签值过长。
+func reportProfileAnomaly(a *model.ProfileAnomaly) {
+	name := "goroutine_leak"
+	if a.Type == model.HeapProfile {
+		name = "heap_growth"
+	}
+	frame, hash := stackLabels(a.Stack)
+	ReportEvent(
+		fmt.Sprintf("%s grew %d in %d periods, current %d\n%s", a.Type, a.Growth, a.Periods, a.Current, a.Stack),
+		"galileo", "profiles", name,
+		zap.String("profile.type", string(a.Type)),
+		zap.String("profile.stack", a.Stack),
+		zap.String("profile.stack_hash", hash),
+		zap.Int64("profile.growth", a.Growth),
+		zap.Int64("profile.current", a.Current),
+	)
+	customMetrics := model.GetCustomMetrics(3, 2)
+	defer model.PutCustomMetrics(customMetrics)
+	customMetrics.MonitorName = profileAnomalyMonitorName
+	customMetrics.CustomLabels[0].Name = "type"
+	customMetrics.CustomLabels[0].Value = string(a.Type)
+	customMetrics.CustomLabels[1].Name = "frame"
+	customMetrics.CustomLabels[1].Value = frame
+	customMetrics.CustomLabels[2].Name = "stack"
+	customMetrics.CustomLabels[2].Value = hash
+	customMetrics.Metrics[0].Name = "growth"
+	customMetrics.Metrics[0].Aggregation = model.Aggregation_AGGREGATION_SET
+	customMetrics.Metrics[0].Value = float64(a.Growth)
+	customMetrics.Metrics[1].Name = "current"
+	customMetrics.Metrics[1].Aggregation = model.Aggregation_AGGREGATION_SET
+	customMetrics.Metrics[1].Value = float64(a.Current)
+	CustomMetrics(customMetrics)
+}
+
+// stackLabels 返回调用栈的栈顶函数及 8 位十六进制哈希，哈希与事件中的 profile.stack_hash 一致，用于关联完整调用栈。
+func stackLabels(stack string) (frame, hash string) {
+	frame, _, _ = strings.Cut(stack, "\n")
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(stack))
+	return frame, fmt.Sprintf("%08x", h.Sum32())
+}
+
 // SetDefaultProfilesProcessor 设置默认的性能数据处理器。
 // 此函数通常在插件初始化时调用。
 // 此函数不是线程安全的。
@@ -183,6 +292,20 @@ func GetDefaultProfilesProcessor() components.ProfilesProcessor {
 	return defaultProfilesProcessor
 }
 
+// CaptureProfiles 通过默认的 ProfilesProcessor 按需采集一次 profile，阻塞到采集结束。
+// 采集结果带上 capture_id 标签导出，不影响周期采集，同一时间只允许一个按需采集。
+// 此方法是线程安全的。
+func CaptureProfiles(ctx context.Context, req *model.ProfileCapture) (*model.ProfilesBatch, error) {
+	return defaultProfilesProcessor.Capture(ctx, req)
+}
+
+// NewProfileCaptureHandler 创建按需采集 profile 的本地 HTTP 管理接口，使用默认的 ProfilesProcessor。
+// 通过 query 参数 id、types、seconds、rate 指定采集请求，如：?types=cpu,goroutine&seconds=10&rate=1000。
+// 此接口会消耗较多资源，建议只注册到内网的管理端口。
+func NewProfileCaptureHandler() http.Handler {
+	return profiles.NewCaptureHandler(GetDefaultProfilesProcessor)
+}
+
 // NewLogger 创建一个 LogsExporter。
 // 通常情况下，此方法只需要调用一次，创建出对象后可以进行重用。
 // 此方法是线程安全的。
@@ -190,6 +313,18 @@ func NewLogger(cfg *configs.Logs, options ...zap.Option) (*zap.Logger, error) {
 	return otelzap.NewLogger(cfg, options...)
 }
 
+// NewSlogLogger 创建一个 log/slog 日志对象，与 NewLogger 共用日志上报链路及采样策略。
+// slog.*Context 传入的 ctx 中的 span 会自动关联到日志，进程退出前可以调用 SlogHandler.Sync 上报队列中的日志。
+// 通常情况下，此方法只需要调用一次，创建出对象后可以进行重用。
+// 此方法是线程安全的。
+func NewSlogLogger(cfg *configs.Logs) (*slog.Logger, error) {
+	h, err := otelzap.NewSlogHandler(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return slog.New(h), nil
+}
+
 // GetLogger 获取日志对象
 func GetLogger() *zap.Logger {
 	return defaultLogger
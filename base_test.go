@@ -39,6 +39,7 @@ import (
 	"galiosight.ai/galio-sdk-go/exporters/otlp/traces"
 	"galiosight.ai/galio-sdk-go/exporters/otlp/traces/tracestate"
 	"galiosight.ai/galio-sdk-go/lib/logs"
+	"galiosight.ai/galio-sdk-go/lib/otelzap"
 	"galiosight.ai/galio-sdk-go/model"
 	"galiosight.ai/galio-sdk-go/testdata"
 	"galiosight.ai/galio-sdk-go/version"
@@ -165,6 +166,14 @@ func TestReportEvent(t *testing.T) {
 	time.Sleep(time.Second * 1)
 }
 
+func TestStackLabels(t *testing.T) {
+	frame, hash := stackLabels("main.leak\nmain.main")
+	assert.Equal(t, "main.leak", frame)
+	assert.Len(t, hash, 8)
+	_, other := stackLabels("main.leak\nmain.run")
+	assert.NotEqual(t, hash, other)
+}
+
 func TestSpanFromContext(t *testing.T) {
 	a := assert.New(t)
 	ts := SpanFromContext(context.Background()).TraceState()
@@ -181,6 +190,17 @@ func initLog(t *testing.T) {
 	SetLogger(logger)
 }
 
+func TestNewSlogLogger(t *testing.T) {
+	resource := defaultResource()
+	conf := logsconfig.NewConfig(&resource)
+	conf.Processor.Level = "debug"
+	logger, err := NewSlogLogger(conf)
+	assert.Nil(t, err)
+	assert.NotNil(t, logger)
+	logger.InfoContext(context.Background(), "galileo SDK slog", "foo", "bar")
+	assert.Nil(t, logger.Handler().(*otelzap.SlogHandler).Sync())
+}
+
 func initEventLog(t *testing.T) {
 	resource := defaultResource()
 	conf := logsconfig.NewConfig(&resource)
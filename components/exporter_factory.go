@@ -40,6 +40,13 @@ type MetricsExporter interface {
 	UpdateConfig(cfg *configs.Metrics)
 }
 
+// MultiTargetMetricsExporter 多目标监控导出器，监控导出器可以选择实现。
+// 多目标监控处理器将代理目标的数据分批组装成 MultiTargetMetrics 导出，导出器未实现时按目标逐个调用 Export。
+type MultiTargetMetricsExporter interface {
+	// ExportMultiTarget 导出多个目标的监控，每个目标的 NormalLabels 不同。
+	ExportMultiTarget(metrics *model.MultiTargetMetrics)
+}
+
 // TracesExporter 追踪导出器。
 type TracesExporter interface {
 	trace.Tracer
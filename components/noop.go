@@ -23,6 +23,7 @@ import (
 
 	"galiosight.ai/galio-sdk-go/configs"
 	"galiosight.ai/galio-sdk-go/configs/ocp"
+	"galiosight.ai/galio-sdk-go/errs"
 	"galiosight.ai/galio-sdk-go/model"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -108,3 +109,8 @@ func (n NoopProfilersProcessor) Shutdown() {
 // UpdateConfig 更新配置。
 func (n NoopProfilersProcessor) UpdateConfig(cfg *configs.Profiles) {
 }
+
+// Capture 按需采集，未开启性能数据采集时返回错误。
+func (n NoopProfilersProcessor) Capture(ctx context.Context, req *model.ProfileCapture) (*model.ProfilesBatch, error) {
+	return nil, errs.ErrProfilesDisabled
+}
@@ -19,6 +19,8 @@
 package components
 
 import (
+	"context"
+
 	"galiosight.ai/galio-sdk-go/configs"
 	"galiosight.ai/galio-sdk-go/configs/ocp"
 	"galiosight.ai/galio-sdk-go/errs"
@@ -42,6 +44,31 @@ type MetricsProcessor interface {
 	UpdateConfig(cfg *configs.Metrics)
 }
 
+// TargetMetricsProcessor 多目标监控处理器中代理目标的处理器，只用于上报数据，配置跟随所属的多目标监控处理器。
+type TargetMetricsProcessor interface {
+	// GetStats 获取自监控统计数据。
+	GetStats() *model.SelfMonitorStats
+	// ProcessClientMetrics 处理主调监控。
+	ProcessClientMetrics(clientMetrics *model.ClientMetrics)
+	// ProcessServerMetrics 处理被调监控。
+	ProcessServerMetrics(serverMetrics *model.ServerMetrics)
+	// ProcessNormalMetric 处理属性监控。
+	ProcessNormalMetric(normalMetric *model.NormalMetric)
+	// ProcessCustomMetrics 处理用户自定义监控。
+	ProcessCustomMetrics(customMetrics *model.CustomMetrics)
+}
+
+// MultiTargetMetricsProcessor 多目标监控处理器，网关等代理多个逻辑服务上报监控时使用。
+// 各目标共用聚合分片及上报链路，按目标分别聚合，导出时每个目标使用各自的 NormalLabels。
+type MultiTargetMetricsProcessor interface {
+	MetricsProcessor
+	// ForResource 返回代理 resource 对应目标的监控处理器，返回值可以缓存复用，也可以每次调用时获取。
+	// 目标数超出上限时，返回的处理器注册前丢弃数据，其他目标过期腾出目标数后恢复上报。
+	ForResource(resource *model.Resource) TargetMetricsProcessor
+	// ForTarget 与 ForResource 相同，只替换处理器自身 resource 中的 target。
+	ForTarget(target string) TargetMetricsProcessor
+}
+
 // TracesProcessor 追踪处理器。
 type TracesProcessor interface {
 	// Watcher 为了观察 ocp 的配置更新
@@ -65,6 +92,8 @@ type ProfilesProcessor interface {
 	Start()
 	// UpdateConfig 更新配置。
 	UpdateConfig(cfg *configs.Profiles)
+	// Capture 按需采集一次 profile，不影响周期采集，采集结果带上 capture_id 标签导出并返回。
+	Capture(ctx context.Context, req *model.ProfileCapture) (*model.ProfilesBatch, error)
 	Shutdown()
 }
 
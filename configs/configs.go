@@ -32,6 +32,7 @@ type Metrics struct {
 	Log                *logs.Wrapper          `yaml:"-"`            // 日志 wrapper。
 	HistogramBuckets   map[string]*Bucket     `yaml:"-"`            // 间接配置，从 pb 中的配置构造而成，方便使用。
 	ignoreLabels       atomic.Value           `yaml:"-"`            // *IgnoreLabels，从 pb 中的配置构造而成，方便使用。
+	labelRules         atomic.Value           `yaml:"-"`            // *LabelRules，从 pb 中的配置编译而成，方便使用。
 	SecondGranularitys *SecondGranularitys    `yaml:"-"`            // 秒级监控配置，间接配置，从 pb 中的配置构造而成，方便使用。
 	Resource           model.Resource         `yaml:"resource"`     // 资源信息。
 	Processor          model.MetricsProcessor `yaml:"processor"`    // 处理器配置。
@@ -73,6 +74,9 @@ type Logs struct {
 	SchemaURL   string
 	// 用于数据上报身份认证
 	APIKey string
+	// RecordCore 为 true 时 otelzap 直接将 zap field 转换成 OTLP LogRecord 属性，保留 int、bool 等字段类型；
+	// 默认为 false，使用 JSON 编码，属性值均为字符串。
+	RecordCore bool `yaml:"-"`
 }
 
 // Profiles 性能上报配置
@@ -88,4 +92,6 @@ type Profiles struct {
 	Stats       *model.SelfMonitorStats `yaml:"Stats"` // 自监控状态对象
 	// 用于数据上报身份认证
 	APIKey string
+	// AnomalyHandler 处理 profile 异常检测结果，为空时只打印日志。
+	AnomalyHandler func(*model.ProfileAnomaly) `yaml:"-"`
 }
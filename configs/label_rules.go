@@ -0,0 +1,312 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configs
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"galiosight.ai/galio-sdk-go/lib/hash/fnv64a"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// DefaultOtherValue 允许列表之外的值默认替换成的值。
+const DefaultOtherValue = "other"
+
+// LabelRules 标签改写规则，从 pb 中的配置编译而成，方便使用。
+type LabelRules struct {
+	rules []*labelRule
+}
+
+// labelKey 标签名，同时保存主被调标签的枚举，避免每次转换。
+type labelKey struct {
+	name     string
+	rpcLabel model.RPCLabels_FieldName
+	isRPC    bool // 是否是合法的主被调标签名。
+}
+
+// labelMatcher 编译后的标签匹配器。
+type labelMatcher struct {
+	key   labelKey
+	regex *regexp.Regexp
+}
+
+// labelRule 编译后的标签改写规则。
+type labelRule struct {
+	monitorName string
+	action      model.LabelRuleAction
+	key         labelKey
+	regex       *regexp.Regexp // 为空时不限制标签值。
+	replacement string
+	allowValues map[string]struct{}
+	otherValue  string
+	buckets     uint64
+	matchers    []labelMatcher // DROP 动作的匹配条件。
+}
+
+// labelSet 统一主被调标签和自定义标签的读写。
+type labelSet struct {
+	rpc    *model.RPCLabels
+	custom []model.Label
+}
+
+// ConvLabelRules 编译标签改写规则，非法的规则打印日志后忽略。
+func (m *Metrics) ConvLabelRules() {
+	lr := &LabelRules{}
+	for i := range m.Processor.LabelRules {
+		rule, err := compileLabelRule(&m.Processor.LabelRules[i])
+		if err != nil {
+			if m.Log != nil {
+				m.Log.Errorf("[galileo]ConvLabelRules|rule=%+v|err=%v\n", m.Processor.LabelRules[i], err)
+			}
+			continue
+		}
+		lr.rules = append(lr.rules, rule)
+	}
+	m.labelRules.Store(lr)
+}
+
+// GetLabelRules 从原子变量中获取 *LabelRules，确保并发安全。
+func (m *Metrics) GetLabelRules() *LabelRules {
+	lr, ok := m.labelRules.Load().(*LabelRules)
+	if !ok || lr == nil { // 未编译过规则时，不做改写。
+		return &LabelRules{}
+	}
+	return lr
+}
+
+// RewriteClientLabels 按规则改写主调监控的维度，返回 false 表示丢弃该条数据。
+func (r *LabelRules) RewriteClientLabels(c *model.ClientMetrics) bool {
+	if len(r.rules) == 0 {
+		return true
+	}
+	return r.apply(model.RPCClient, &labelSet{rpc: &c.RpcLabels})
+}
+
+// RewriteServerLabels 按规则改写被调监控的维度，返回 false 表示丢弃该条数据。
+func (r *LabelRules) RewriteServerLabels(s *model.ServerMetrics) bool {
+	if len(r.rules) == 0 {
+		return true
+	}
+	return r.apply(model.RPCServer, &labelSet{rpc: &s.RpcLabels})
+}
+
+// RewriteCustomLabels 按规则改写自定义监控的维度，返回 false 表示丢弃该条数据。
+func (r *LabelRules) RewriteCustomLabels(c *model.CustomMetrics) bool {
+	if len(r.rules) == 0 {
+		return true
+	}
+	return r.apply(c.MonitorName, &labelSet{custom: c.CustomLabels})
+}
+
+func (r *LabelRules) apply(monitorName string, s *labelSet) bool {
+	for _, rule := range r.rules {
+		if rule.monitorName != "" && rule.monitorName != monitorName {
+			continue
+		}
+		if !rule.apply(s) {
+			return false
+		}
+	}
+	return true
+}
+
+// apply 执行规则，返回 false 表示丢弃。屏蔽掉的标签值不再改写。
+func (r *labelRule) apply(s *labelSet) bool {
+	if r.action == model.LabelRuleAction_LABEL_RULE_ACTION_DROP {
+		return !r.matchAll(s)
+	}
+	i := s.index(r.key)
+	if i < 0 {
+		return true
+	}
+	v := s.value(i)
+	if v == IgnoredValue {
+		return true
+	}
+	switch r.action {
+	case model.LabelRuleAction_LABEL_RULE_ACTION_REPLACE:
+		if idx := r.regex.FindStringSubmatchIndex(v); idx != nil {
+			s.setValue(i, string(r.regex.ExpandString(nil, r.replacement, v, idx)))
+		}
+	case model.LabelRuleAction_LABEL_RULE_ACTION_ALLOW_LIST:
+		if _, ok := r.allowValues[v]; !ok && r.matchValue(v) {
+			s.setValue(i, r.otherValue)
+		}
+	case model.LabelRuleAction_LABEL_RULE_ACTION_HASH:
+		if r.matchValue(v) {
+			s.setValue(i, strconv.FormatUint(fnv64a.Add(fnv64a.New(), v)%r.buckets, 10))
+		}
+	}
+	return true
+}
+
+func (r *labelRule) matchValue(v string) bool {
+	return r.regex == nil || r.regex.MatchString(v)
+}
+
+// matchAll 所有匹配条件都满足时返回 true，标签不存在时按空串匹配。
+func (r *labelRule) matchAll(s *labelSet) bool {
+	for _, m := range r.matchers {
+		v := ""
+		if i := s.index(m.key); i >= 0 {
+			v = s.value(i)
+		}
+		if !m.regex.MatchString(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *labelSet) index(k labelKey) int {
+	if s.rpc != nil {
+		if !k.isRPC {
+			return -1
+		}
+		for i := range s.rpc.Fields {
+			if s.rpc.Fields[i].Name == k.rpcLabel {
+				return i
+			}
+		}
+		return -1
+	}
+	for i := range s.custom {
+		if s.custom[i].Name == k.name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *labelSet) value(i int) string {
+	if s.rpc != nil {
+		return s.rpc.Fields[i].Value
+	}
+	return s.custom[i].Value
+}
+
+func (s *labelSet) setValue(i int, v string) {
+	if s.rpc != nil {
+		s.rpc.Fields[i].Value = v
+		return
+	}
+	s.custom[i].Value = v
+}
+
+// compileLabelRule 校验并编译一条规则。
+func compileLabelRule(cfg *model.LabelRule) (*labelRule, error) {
+	switch cfg.MonitorName {
+	case model.GoRuntime, model.Process:
+		return nil, fmt.Errorf("monitor %s has no labels", cfg.MonitorName)
+	}
+	r := &labelRule{
+		monitorName: cfg.MonitorName,
+		action:      cfg.Action,
+		replacement: cfg.Replacement,
+	}
+	var err error
+	if cfg.LabelName != "" {
+		if r.key, err = newLabelKey(cfg.MonitorName, cfg.LabelName); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Regex != "" {
+		if r.regex, err = compileAnchored(cfg.Regex); err != nil {
+			return nil, err
+		}
+	}
+	switch cfg.Action {
+	case model.LabelRuleAction_LABEL_RULE_ACTION_REPLACE:
+		if cfg.LabelName == "" || r.regex == nil {
+			return nil, errors.New("replace rule requires label_name and regex")
+		}
+	case model.LabelRuleAction_LABEL_RULE_ACTION_ALLOW_LIST:
+		if cfg.LabelName == "" {
+			return nil, errors.New("allow list rule requires label_name")
+		}
+		r.allowValues = make(map[string]struct{}, len(cfg.AllowValues))
+		for _, v := range cfg.AllowValues {
+			r.allowValues[v] = struct{}{}
+		}
+		r.otherValue = cfg.OtherValue
+		if r.otherValue == "" {
+			r.otherValue = DefaultOtherValue
+		}
+	case model.LabelRuleAction_LABEL_RULE_ACTION_HASH:
+		if cfg.LabelName == "" || cfg.Buckets <= 0 {
+			return nil, errors.New("hash rule requires label_name and positive buckets")
+		}
+		r.buckets = uint64(cfg.Buckets)
+	case model.LabelRuleAction_LABEL_RULE_ACTION_DROP:
+		if r.matchers, err = compileDropMatchers(cfg); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid action %v", cfg.Action)
+	}
+	return r, nil
+}
+
+// compileDropMatchers label_name、regex 作为一个匹配条件，和 selectors 一起编译。
+func compileDropMatchers(cfg *model.LabelRule) ([]labelMatcher, error) {
+	selectors := cfg.Selectors
+	if cfg.LabelName != "" {
+		selectors = append([]model.LabelMatcher{{Name: cfg.LabelName, Regex: cfg.Regex}}, selectors...)
+	}
+	if len(selectors) == 0 {
+		return nil, errors.New("drop rule requires label_name or selectors")
+	}
+	matchers := make([]labelMatcher, 0, len(selectors))
+	for i := range selectors {
+		key, err := newLabelKey(cfg.MonitorName, selectors[i].Name)
+		if err != nil {
+			return nil, err
+		}
+		regex, err := compileAnchored(selectors[i].Regex)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, labelMatcher{key: key, regex: regex})
+	}
+	return matchers, nil
+}
+
+// newLabelKey 主被调监控项的标签名必须是合法的主被调标签，未指定监控项时两种标签都可能命中。
+func newLabelKey(monitorName, labelName string) (labelKey, error) {
+	k := labelKey{name: labelName}
+	rpcLabel, err := convRPCLabel(labelName)
+	if err == nil {
+		k.rpcLabel, k.isRPC = rpcLabel, true
+	}
+	switch monitorName {
+	case model.RPCClient, model.RPCServer:
+		if !k.isRPC {
+			return k, fmt.Errorf("invalid rpc label %s", labelName)
+		}
+	}
+	return k, nil
+}
+
+// compileAnchored 编译完整匹配的正则。
+func compileAnchored(expr string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + expr + ")$")
+}
@@ -0,0 +1,238 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configs
+
+import (
+	"testing"
+
+	"galiosight.ai/galio-sdk-go/lib/logs"
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCustom(labels ...string) *model.CustomMetrics {
+	c := &model.CustomMetrics{MonitorName: "m1"}
+	for i := 0; i+1 < len(labels); i += 2 {
+		c.CustomLabels = append(c.CustomLabels, model.Label{Name: labels[i], Value: labels[i+1]})
+	}
+	return c
+}
+
+func TestLabelRules_RewriteCustomLabels(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []model.LabelRule
+		input *model.CustomMetrics
+		keep  bool
+		want  []model.Label
+	}{
+		{
+			"empty",
+			nil,
+			newCustom("path", "/a/1"),
+			true,
+			[]model.Label{{Name: "path", Value: "/a/1"}},
+		},
+		{
+			"replace",
+			[]model.LabelRule{
+				{
+					Action:      model.LabelRuleAction_LABEL_RULE_ACTION_REPLACE,
+					LabelName:   "path",
+					Regex:       `/user/(\w+)/\d+`,
+					Replacement: "/user/$1/:id",
+				},
+			},
+			newCustom("path", "/user/info/123", "code", "0"),
+			true,
+			[]model.Label{{Name: "path", Value: "/user/info/:id"}, {Name: "code", Value: "0"}},
+		},
+		{
+			"replace not full match",
+			[]model.LabelRule{
+				{
+					Action:      model.LabelRuleAction_LABEL_RULE_ACTION_REPLACE,
+					LabelName:   "path",
+					Regex:       `\d+`,
+					Replacement: "id",
+				},
+			},
+			newCustom("path", "/123"),
+			true,
+			[]model.Label{{Name: "path", Value: "/123"}},
+		},
+		{
+			"allow list",
+			[]model.LabelRule{
+				{
+					Action:      model.LabelRuleAction_LABEL_RULE_ACTION_ALLOW_LIST,
+					LabelName:   "region",
+					AllowValues: []string{"sz", "sh"},
+				},
+			},
+			newCustom("region", "bj"),
+			true,
+			[]model.Label{{Name: "region", Value: DefaultOtherValue}},
+		},
+		{
+			"allow list keep allowed and ignored",
+			[]model.LabelRule{
+				{
+					Action:      model.LabelRuleAction_LABEL_RULE_ACTION_ALLOW_LIST,
+					LabelName:   "region",
+					AllowValues: []string{"sz"},
+					OtherValue:  "others",
+				},
+				{
+					Action:      model.LabelRuleAction_LABEL_RULE_ACTION_ALLOW_LIST,
+					LabelName:   "zone",
+					AllowValues: []string{"z1"},
+				},
+			},
+			newCustom("region", "sz", "zone", IgnoredValue),
+			true,
+			[]model.Label{{Name: "region", Value: "sz"}, {Name: "zone", Value: IgnoredValue}},
+		},
+		{
+			"hash",
+			[]model.LabelRule{
+				{
+					Action:    model.LabelRuleAction_LABEL_RULE_ACTION_HASH,
+					LabelName: "uid",
+					Buckets:   1,
+				},
+			},
+			newCustom("uid", "10086"),
+			true,
+			[]model.Label{{Name: "uid", Value: "0"}},
+		},
+		{
+			"drop",
+			[]model.LabelRule{
+				{
+					Action:    model.LabelRuleAction_LABEL_RULE_ACTION_DROP,
+					LabelName: "path",
+					Regex:     "/health.*",
+					Selectors: []model.LabelMatcher{{Name: "code", Regex: "0"}},
+				},
+			},
+			newCustom("path", "/healthz", "code", "0"),
+			false,
+			nil,
+		},
+		{
+			"drop selector not match",
+			[]model.LabelRule{
+				{
+					Action:    model.LabelRuleAction_LABEL_RULE_ACTION_DROP,
+					Selectors: []model.LabelMatcher{{Name: "path", Regex: "/health.*"}, {Name: "code", Regex: "0"}},
+				},
+			},
+			newCustom("path", "/healthz", "code", "1"),
+			true,
+			[]model.Label{{Name: "path", Value: "/healthz"}, {Name: "code", Value: "1"}},
+		},
+		{
+			"other monitor",
+			[]model.LabelRule{
+				{
+					MonitorName: "m2",
+					Action:      model.LabelRuleAction_LABEL_RULE_ACTION_DROP,
+					Selectors:   []model.LabelMatcher{{Name: "path", Regex: ".*"}},
+				},
+			},
+			newCustom("path", "/a"),
+			true,
+			[]model.Label{{Name: "path", Value: "/a"}},
+		},
+		{
+			"invalid rules ignored",
+			[]model.LabelRule{
+				{Action: model.LabelRuleAction_LABEL_RULE_ACTION_REPLACE, LabelName: "path", Regex: "("},
+				{Action: model.LabelRuleAction_LABEL_RULE_ACTION_HASH, LabelName: "path"},
+				{Action: model.LabelRuleAction_LABEL_RULE_ACTION_DROP},
+				{Action: model.LabelRuleAction_LABEL_RULE_ACTION_INVALID, LabelName: "path"},
+			},
+			newCustom("path", "/a"),
+			true,
+			[]model.Label{{Name: "path", Value: "/a"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				m := &Metrics{Log: logs.DefaultWrapper()}
+				m.Processor.LabelRules = tt.rules
+				m.ConvLabelRules()
+				keep := m.GetLabelRules().RewriteCustomLabels(tt.input)
+				assert.Equal(t, tt.keep, keep)
+				if keep {
+					assert.Equal(t, tt.want, tt.input.CustomLabels)
+				}
+			},
+		)
+	}
+}
+
+func TestLabelRules_RewriteRPCLabels(t *testing.T) {
+	m := &Metrics{Log: logs.DefaultWrapper()}
+	m.Processor.LabelRules = []model.LabelRule{
+		{
+			MonitorName: model.RPCClient,
+			Action:      model.LabelRuleAction_LABEL_RULE_ACTION_HASH,
+			LabelName:   "callee_method",
+			Buckets:     4,
+		},
+		{
+			MonitorName: model.RPCServer,
+			Action:      model.LabelRuleAction_LABEL_RULE_ACTION_DROP,
+			Selectors:   []model.LabelMatcher{{Name: "callee_method", Regex: "Ping"}},
+		},
+		{
+			MonitorName: model.RPCClient,
+			Action:      model.LabelRuleAction_LABEL_RULE_ACTION_ALLOW_LIST,
+			LabelName:   "not_rpc_label",
+		},
+	}
+	m.ConvLabelRules()
+	require.Len(t, m.GetLabelRules().rules, 2, "非法的主被调标签名被忽略")
+
+	c := &model.ClientMetrics{}
+	c.RpcLabels.Fields = []model.RPCLabels_Field{
+		{Name: model.RPCLabels_callee_method, Value: "GetUser"},
+		{Name: model.RPCLabels_caller_ip, Value: "127.0.0.1"},
+	}
+	assert.True(t, m.GetLabelRules().RewriteClientLabels(c))
+	assert.Contains(t, []string{"0", "1", "2", "3"}, c.RpcLabels.Fields[0].Value)
+	assert.Equal(t, "127.0.0.1", c.RpcLabels.Fields[1].Value)
+
+	s := &model.ServerMetrics{}
+	s.RpcLabels.Fields = []model.RPCLabels_Field{{Name: model.RPCLabels_callee_method, Value: "Ping"}}
+	assert.False(t, m.GetLabelRules().RewriteServerLabels(s))
+	s.RpcLabels.Fields[0].Value = "Pong"
+	assert.True(t, m.GetLabelRules().RewriteServerLabels(s))
+
+	// 热更新后规则生效。
+	m.Processor.LabelRules = nil
+	m.ConvLabelRules()
+	s.RpcLabels.Fields[0].Value = "Ping"
+	assert.True(t, m.GetLabelRules().RewriteServerLabels(s))
+	assert.True(t, (&Metrics{}).GetLabelRules().RewriteServerLabels(s), "未编译规则时不改写")
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ocp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"galiosight.ai/galio-sdk-go/errs"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// envSetter 使用环境变量的值修改配置。
+type envSetter func(cfg *GalileoConfig, v string) error
+
+// envSetters 支持覆盖的 GALILEO_* 环境变量，优先级高于配置文件。
+var envSetters = []struct {
+	name string
+	set  envSetter
+}{
+	{"GALILEO_VERBOSE", func(cfg *GalileoConfig, v string) error { cfg.Verbose = v; return nil }},
+	{"GALILEO_API_KEY", func(cfg *GalileoConfig, v string) error { cfg.APIKey = v; return nil }},
+	{"GALILEO_TENANT_ID", func(cfg *GalileoConfig, v string) error { cfg.Config.TenantId = v; return nil }},
+	{"GALILEO_ACCESS_POINT", setAccessPoint},
+	{"GALILEO_METRICS_ENABLE", boolSetter(func(cfg *GalileoConfig) *bool { return &cfg.Config.MetricsConfig.Enable })},
+	{"GALILEO_TRACES_ENABLE", boolSetter(func(cfg *GalileoConfig) *bool { return &cfg.Config.TracesConfig.Enable })},
+	{"GALILEO_LOGS_ENABLE", boolSetter(func(cfg *GalileoConfig) *bool { return &cfg.Config.LogsConfig.Enable })},
+	{"GALILEO_PROFILES_ENABLE", boolSetter(func(cfg *GalileoConfig) *bool { return &cfg.Config.ProfilesConfig.Enable })},
+	{"GALILEO_METRICS_COLLECTOR_ADDR", func(cfg *GalileoConfig, v string) error {
+		cfg.Config.MetricsConfig.Exporter.Collector.Addr = v
+		return nil
+	}},
+	{"GALILEO_TRACES_COLLECTOR_ADDR", func(cfg *GalileoConfig, v string) error {
+		cfg.Config.TracesConfig.Exporter.Collector.Addr = v
+		return nil
+	}},
+	{"GALILEO_LOGS_COLLECTOR_ADDR", func(cfg *GalileoConfig, v string) error {
+		cfg.Config.LogsConfig.Exporter.Collector.Addr = v
+		return nil
+	}},
+	{"GALILEO_PROFILES_COLLECTOR_ADDR", func(cfg *GalileoConfig, v string) error {
+		cfg.Config.ProfilesConfig.Exporter.Collector.Addr = v
+		return nil
+	}},
+	{"GALILEO_TRACES_SAMPLER_FRACTION", func(cfg *GalileoConfig, v string) error {
+		f, err := strconv.ParseFloat(v, 64)
+		cfg.Config.TracesConfig.Processor.Sampler.Fraction = f
+		return err
+	}},
+	{"GALILEO_LOGS_LEVEL", func(cfg *GalileoConfig, v string) error { cfg.Config.LogsConfig.Processor.Level = v; return nil }},
+}
+
+// overlayEnv 使用 GALILEO_* 环境变量覆盖配置，lookup 一般是 os.LookupEnv。
+func overlayEnv(cfg *GalileoConfig, lookup func(key string) (string, bool)) error {
+	for _, e := range envSetters {
+		v, ok := lookup(e.name)
+		if !ok {
+			continue
+		}
+		if err := e.set(cfg, strings.TrimSpace(v)); err != nil {
+			return fmt.Errorf("%w: env %s=%q: %v", errs.ErrConfigInvalid, e.name, v, err)
+		}
+	}
+	return nil
+}
+
+func boolSetter(field func(cfg *GalileoConfig) *bool) envSetter {
+	return func(cfg *GalileoConfig, v string) error {
+		b, err := strconv.ParseBool(v)
+		*field(cfg) = b
+		return err
+	}
+}
+
+// setAccessPoint 接入点支持枚举名（如 ACCESS_POINT_CN_PRIVATE）或枚举值。
+func setAccessPoint(cfg *GalileoConfig, v string) error {
+	if n, ok := model.AccessPoint_value[v]; ok {
+		cfg.Config.AccessPoint = model.AccessPoint(n)
+		return nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	cfg.Config.AccessPoint = model.AccessPoint(n)
+	return err
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ocp
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/errs"
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/gogo/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// FileDecoder 本地文件配置来源，用于没有 ocp 服务的隔离环境。
+// 文件内容是 GetConfigResponse，扩展名为 .json 时按 JSON 解析，否则按 YAML 解析，
+// 文件中没有的字段保持原值（一般是默认配置），解析后使用 GALILEO_* 环境变量覆盖（见 overlayEnv），校验通过才生效。
+type FileDecoder struct {
+	path    string
+	modTime time.Time  // 最近一次成功读取时文件的修改时间。
+	size    int64      // 最近一次成功读取时文件的大小。
+	mu      sync.Mutex // 保护 modTime、size。
+}
+
+// NewFileDecoder 创建本地文件配置来源，配合 WithFileSource 使用可以热更新。
+func NewFileDecoder(path string) *FileDecoder {
+	return &FileDecoder{path: path}
+}
+
+// Decode 读取文件并覆盖环境变量，校验不通过时 to 不会被修改。
+func (f *FileDecoder) Decode(to *GalileoConfig) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return fmt.Errorf("stat: %w", err)
+	}
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return fmt.Errorf("read: %w", err)
+	}
+	cfg := *to
+	cfg.Config = *proto.Clone(&to.Config).(*model.GetConfigResponse) // 解析失败时不能修改 to 的切片。
+	if err = unmarshalConfig(f.path, data, &cfg.Config); err != nil {
+		return fmt.Errorf("unmarshal %s: %w", f.path, err)
+	}
+	if err = overlayEnv(&cfg, os.LookupEnv); err != nil {
+		return err
+	}
+	if err = validateConfig(&cfg); err != nil {
+		return err
+	}
+	*to = cfg
+	f.modTime, f.size = info.ModTime(), info.Size()
+	return nil
+}
+
+// changed 文件自最近一次成功读取后是否有变化，文件不存在时认为没有变化，保持原配置。
+func (f *FileDecoder) changed() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return false
+	}
+	return !info.ModTime().Equal(f.modTime) || info.Size() != f.size
+}
+
+func unmarshalConfig(path string, data []byte, to *model.GetConfigResponse) error {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return json.Unmarshal(data, to)
+	}
+	return yaml.Unmarshal(data, to)
+}
+
+// validateConfig 校验配置，避免错误的本地配置生效。
+func validateConfig(cfg *GalileoConfig) error {
+	c := &cfg.Config
+	if _, ok := model.AccessPoint_name[int32(c.AccessPoint)]; !ok {
+		return fmt.Errorf("%w: access_point=%d", errs.ErrConfigInvalid, c.AccessPoint)
+	}
+	sampler := &c.TracesConfig.Processor.Sampler
+	if sampler.Fraction < 0 || sampler.Fraction > 1 {
+		return fmt.Errorf("%w: traces sampler fraction=%v", errs.ErrConfigInvalid, sampler.Fraction)
+	}
+	if sampler.ErrorFraction < 0 || sampler.ErrorFraction > 1 {
+		return fmt.Errorf("%w: traces sampler error_fraction=%v", errs.ErrConfigInvalid, sampler.ErrorFraction)
+	}
+	if c.MetricsConfig.Processor.WindowSeconds < 0 {
+		return fmt.Errorf(
+			"%w: metrics processor window_seconds=%d", errs.ErrConfigInvalid, c.MetricsConfig.Processor.WindowSeconds,
+		)
+	}
+	for _, b := range c.MetricsConfig.Processor.HistogramBuckets {
+		if !sort.Float64sAreSorted(b.Buckets) {
+			return fmt.Errorf("%w: histogram bucket %s not sorted", errs.ErrConfigInvalid, b.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Tencent Galileo Authors
+
+package ocp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/errs"
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	require.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestFileDecoder_Decode(t *testing.T) {
+	dir := t.TempDir()
+	yamlPath := filepath.Join(dir, "galileo.yaml")
+	writeFile(t, yamlPath, `
+tenant_id: yaml
+metrics_config:
+  processor:
+    window_seconds: 30
+traces_config:
+  processor:
+    sampler:
+      fraction: 0.5
+`)
+	to := &GalileoConfig{Config: *DefaultConfig("")}
+	require.Nil(t, NewFileDecoder(yamlPath).Decode(to))
+	assert.Equal(t, "yaml", to.Config.TenantId)
+	assert.Equal(t, int32(30), to.Config.MetricsConfig.Processor.WindowSeconds)
+	assert.Equal(t, 0.5, to.Config.TracesConfig.Processor.Sampler.Fraction)
+	// 文件中没有的字段保持默认配置。
+	assert.Equal(t, DefaultConfig("").MetricsConfig.Processor.HistogramBuckets, to.Config.MetricsConfig.Processor.HistogramBuckets)
+
+	jsonPath := filepath.Join(dir, "galileo.json")
+	writeFile(t, jsonPath, `{"tenant_id":"json","logs_config":{"processor":{"level":"warn"}}}`)
+	to = &GalileoConfig{Config: *DefaultConfig("")}
+	require.Nil(t, NewFileDecoder(jsonPath).Decode(to))
+	assert.Equal(t, "json", to.Config.TenantId)
+	assert.Equal(t, "warn", to.Config.LogsConfig.Processor.Level)
+
+	err := NewFileDecoder(filepath.Join(dir, "not_exist.yaml")).Decode(to)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestFileDecoder_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "采样率超出范围", content: "traces_config: {processor: {sampler: {fraction: 2}}}"},
+		{name: "错误采样率超出范围", content: "traces_config: {processor: {sampler: {error_fraction: -1}}}"},
+		{name: "窗口为负数", content: "metrics_config: {processor: {window_seconds: -1}}"},
+		{name: "接入点不存在", content: "access_point: 100"},
+		{
+			name:    "分桶无序",
+			content: "metrics_config: {processor: {histogram_buckets: [{name: a, buckets: [2, 1]}]}}",
+		},
+	}
+	path := filepath.Join(t.TempDir(), "galileo.yaml")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeFile(t, path, tt.content)
+			to := &GalileoConfig{Config: *DefaultConfig("")}
+			err := NewFileDecoder(path).Decode(to)
+			assert.ErrorIs(t, err, errs.ErrConfigInvalid)
+			// 校验失败时不修改原配置。
+			assert.Equal(t, &GalileoConfig{Config: *DefaultConfig("")}, to)
+		})
+	}
+
+	writeFile(t, path, "metrics_config: [")
+	to := &GalileoConfig{Config: *DefaultConfig("")}
+	assert.NotNil(t, NewFileDecoder(path).Decode(to))
+	assert.Equal(t, &GalileoConfig{Config: *DefaultConfig("")}, to)
+}
+
+func TestOverlayEnv(t *testing.T) {
+	env := map[string]string{
+		"GALILEO_VERBOSE":                 "debug",
+		"GALILEO_API_KEY":                 "key",
+		"GALILEO_TENANT_ID":               "tenant",
+		"GALILEO_ACCESS_POINT":            "ACCESS_POINT_CN_PRIVATE",
+		"GALILEO_METRICS_ENABLE":          "false",
+		"GALILEO_TRACES_ENABLE":           " true ",
+		"GALILEO_TRACES_COLLECTOR_ADDR":   "127.0.0.1:4317",
+		"GALILEO_TRACES_SAMPLER_FRACTION": "0.1",
+		"GALILEO_LOGS_LEVEL":              "info",
+	}
+	lookup := func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+	cfg := &GalileoConfig{Config: *DefaultConfig("")}
+	require.Nil(t, overlayEnv(cfg, lookup))
+	assert.Equal(t, "debug", cfg.Verbose)
+	assert.Equal(t, "key", cfg.APIKey)
+	assert.Equal(t, "tenant", cfg.Config.TenantId)
+	assert.Equal(t, model.AccessPoint_ACCESS_POINT_CN_PRIVATE, cfg.Config.AccessPoint)
+	assert.False(t, cfg.Config.MetricsConfig.Enable)
+	assert.True(t, cfg.Config.TracesConfig.Enable)
+	assert.Equal(t, "127.0.0.1:4317", cfg.Config.TracesConfig.Exporter.Collector.Addr)
+	assert.Equal(t, 0.1, cfg.Config.TracesConfig.Processor.Sampler.Fraction)
+	assert.Equal(t, "info", cfg.Config.LogsConfig.Processor.Level)
+
+	env = map[string]string{"GALILEO_ACCESS_POINT": "1"}
+	require.Nil(t, overlayEnv(cfg, lookup))
+	assert.Equal(t, model.AccessPoint(1), cfg.Config.AccessPoint)
+
+	env = map[string]string{"GALILEO_LOGS_ENABLE": "yes"}
+	assert.ErrorIs(t, overlayEnv(cfg, lookup), errs.ErrConfigInvalid)
+}
+
+func TestUpdater_FileSource(t *testing.T) {
+	t.Setenv("GALILEO_LOGS_LEVEL", "error")
+	t.Setenv("GALILEO_API_KEY", "key1")
+	path := filepath.Join(t.TempDir(), "galileo.yaml")
+	writeFile(t, path, "metrics_config: {processor: {window_seconds: 30}}")
+	resource := model.NewResource(
+		"Galileo-Dial", "galileo", "SDK", "FileSourceService", model.Production, "formal", "", "", "", "",
+	)
+	require.Nil(t, RegisterResource(resource, WithFileSource(path), WithDuration(time.Hour)))
+	defer func() { _ = UnregisterResource(resource.Target) }()
+	updater := GetUpdater(resource.Target)
+	assert.Equal(t, "", updater.config.OcpAddr)
+	assert.Equal(t, int32(30), updater.GetConfig().Config.MetricsConfig.Processor.WindowSeconds)
+	assert.Equal(t, "error", updater.GetConfig().Config.LogsConfig.Processor.Level)
+	assert.Equal(t, "key1", updater.GetConfig().APIKey)
+
+	watcher := &mockWatcher{}
+	AddWatcher(resource.Target, watcher)
+	// 文件没有变化，不更新。
+	assert.False(t, updater.Update())
+
+	// 文件变化后，热更新并通知观察者，环境变量同样覆盖 verbose 和 api_key。
+	t.Setenv("GALILEO_VERBOSE", "debug")
+	t.Setenv("GALILEO_API_KEY", "key2")
+	writeFile(t, path, "metrics_config: {processor: {window_seconds: 60}}\n")
+	assert.True(t, updater.Update())
+	assert.Equal(t, int32(60), watcher.config.Config.MetricsConfig.Processor.WindowSeconds)
+	assert.Equal(t, "error", watcher.config.Config.LogsConfig.Processor.Level)
+	assert.Equal(t, "debug", watcher.config.Verbose)
+	assert.Equal(t, "key2", watcher.config.APIKey)
+
+	// 配置非法时保持原配置。
+	writeFile(t, path, "metrics_config: {processor: {window_seconds: -60}}\n")
+	assert.False(t, updater.Update())
+	assert.Equal(t, int32(60), updater.GetConfig().Config.MetricsConfig.Processor.WindowSeconds)
+}
@@ -95,8 +95,10 @@ type Updater struct {
 	// 所有的观察者
 	watchers []Watcher
 	decoder  decoder
-	ctx      context.Context
-	cancel   context.CancelFunc
+	// 本地文件配置来源，不为空时不访问 ocp 服务，定时检查文件变化进行热更新。
+	file   *FileDecoder
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // Watcher 观察者，观察配置变化后，使用配置更新自己的状态。
@@ -125,7 +127,9 @@ func newUpdater(resource *model.Resource, opts ...updaterOption) *Updater {
 		opt(updater)
 	}
 	if updater.decoder != nil {
-		_ = updater.decoder.Decode(&updater.config)
+		if err := updater.decoder.Decode(&updater.config); err != nil {
+			selflog.Errorf("decode local config err: %v, resource: %v", err, *resource)
+		}
 		fixResource(&updater.config)
 	}
 	updater.config.local = updater.config.Config
@@ -137,7 +141,7 @@ func newUpdater(resource *model.Resource, opts ...updaterOption) *Updater {
 
 // updateByTick 此方法在初始化时会被调用，有一个线程会执行在执行
 func (u *Updater) updateByTick() {
-	if u.config.OcpAddr == "" {
+	if u.config.OcpAddr == "" && u.file == nil {
 		return
 	}
 	tick := time.Tick(u.duration)
@@ -157,7 +161,11 @@ func (u *Updater) Close() {
 }
 
 // Update 从 ocp 更新配置。通常由 Updater 内部的每分钟定时任务自动调用，当需要立即更新时，可以主动调用此方法。
+// 使用 WithFileSource 时，从本地文件更新配置。
 func (u *Updater) Update() bool {
+	if u.file != nil {
+		return u.updateFromFile()
+	}
 	config, err := GetOcpConfig(
 		u.config.OcpAddr, &u.config.Resource, Local(&u.config.local), WithApiKey(u.config.APIKey),
 	)
@@ -179,6 +187,45 @@ func (u *Updater) Update() bool {
 		fixResource(wrap)
 		config = &wrap.Config
 	}
+	return u.apply(config)
+}
+
+// updateFromFile 从本地文件更新配置，文件没有变化时不重新读取。
+func (u *Updater) updateFromFile() bool {
+	if !u.file.changed() {
+		return false
+	}
+	u.rwMutex.RLock()
+	wrap := &GalileoConfig{
+		Resource: u.config.Resource,
+		Config:   *DefaultConfig(u.config.Resource.TenantId),
+		Verbose:  u.config.Verbose,
+		APIKey:   u.config.APIKey,
+	}
+	u.rwMutex.RUnlock()
+	if err := u.file.Decode(wrap); err != nil {
+		selflog.Errorf("err: %v, resource: %v", err, u.config.Resource)
+		return false
+	}
+	fixResource(wrap)
+	u.setOverlay(wrap)
+	return u.apply(&wrap.Config)
+}
+
+// setOverlay 更新环境变量覆盖的、不属于 GetConfigResponse 的配置，保证重新加载文件时和首次加载一致。
+func (u *Updater) setOverlay(wrap *GalileoConfig) {
+	u.rwMutex.Lock()
+	verboseChanged := u.config.Verbose != wrap.Verbose
+	u.config.Verbose = wrap.Verbose
+	u.config.APIKey = wrap.APIKey
+	u.rwMutex.Unlock()
+	if verboseChanged {
+		selflog.SetLogLevel(wrap.Verbose)
+	}
+}
+
+// apply 配置有变化时更新配置，并通知所有的观察者。
+func (u *Updater) apply(config *model.GetConfigResponse) bool {
 	oldYaml := u.getConfigYAML()
 	newYaml := toYAML(config)
 	if oldYaml == newYaml {
@@ -292,6 +339,16 @@ func WithLocalDecoder(local decoder) updaterOption {
 	}
 }
 
+// WithFileSource 使用本地文件代替 ocp 服务作为配置来源，不再访问 ocp 服务。
+// 文件格式见 FileDecoder，按 WithDuration 设置的时间间隔检查文件变化，有变化时重新读取并通知观察者。
+func WithFileSource(path string) updaterOption {
+	return func(u *Updater) {
+		u.file = NewFileDecoder(path)
+		u.decoder = u.file
+		u.config.OcpAddr = ""
+	}
+}
+
 // WithVerbose 设置调试级别
 func WithVerbose(verbose string) updaterOption {
 	return func(u *Updater) {
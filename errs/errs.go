@@ -61,6 +61,14 @@ var (
 	ErrTargetNotExist = errors.New("target not exist")
 	// ErrTimeout 超时
 	ErrTimeout = errors.New("timeout")
+	// ErrConfigInvalid 配置校验不通过
+	ErrConfigInvalid = errors.New("config invalid")
+	// ErrProfilesDisabled 未开启性能数据采集
+	ErrProfilesDisabled = errors.New("profiles disabled")
+	// ErrProfileCaptureBusy 已有按需采集在进行中
+	ErrProfileCaptureBusy = errors.New("profile capture in progress")
+	// ErrMultiTargetUnsupported 监控处理器不支持多目标
+	ErrMultiTargetUnsupported = errors.New("metrics processor does not support multi target")
 )
 
 // otlp logs exporter 错误码汇总。
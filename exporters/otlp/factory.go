@@ -22,6 +22,7 @@ package otlp
 import (
 	"galiosight.ai/galio-sdk-go/components"
 	"galiosight.ai/galio-sdk-go/exporters/otlp/logs"
+	"galiosight.ai/galio-sdk-go/exporters/otlp/metrics"
 	"galiosight.ai/galio-sdk-go/exporters/otlp/traces"
 	"galiosight.ai/galio-sdk-go/protocols"
 )
@@ -36,5 +37,6 @@ func NewFactory() components.ExporterFactory {
 		protocol,
 		components.WithCreateTracesExporter(traces.NewExporter),
 		components.WithCreateLogsExporter(logs.NewExporter),
+		components.WithCreateMetricsExporter(metrics.NewExporter),
 	)
 }
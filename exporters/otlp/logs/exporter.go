@@ -76,22 +76,30 @@ func NewExporter(baseCfg *configs.Logs) (components.LogsExporter, error) {
 				model.APIKeyHeaderKey: baseCfg.APIKey,
 			},
 		),
-		withHTTPEnabled(true), // 默认使用 http 协议，因为 ias 域名不支持 grpc
+		// 只有 data_transmission 为 gRPC 时使用 grpc，未配置或 HTTP 时默认使用 http 协议，因为 ias 域名不支持 grpc
+		withHTTPEnabled(
+			baseCfg.Exporter.Collector.DataTransmission != model.DataTransmission_DATA_TRANSMISSION_gRPC,
+		),
+		withHTTPConfig(baseCfg.Exporter.Http),
 	)
-	e.log = baseCfg.Log
-	e.stats = baseCfg.Stats
 	if err != nil {
-		e.log.Errorf(
+		baseCfg.Log.Errorf(
 			"[galileo]new otlp logs exporter|err=%v, baseCfg=%+v", err, baseCfg,
 		)
-		e.stats.LogsStats.InitErrorTotal.Inc()
+		baseCfg.Stats.LogsStats.InitErrorTotal.Inc()
+		return nil, err
 	}
-	return e, err
+	e.log = baseCfg.Log
+	e.stats = baseCfg.Stats
+	return e, nil
 }
 
 // newExporter constructs a new Exporter and starts it.
 func newExporter(opts ...grpcOption) (*exporter, error) {
-	e := newUnstartedExporter(opts...)
+	e, err := newUnstartedExporter(opts...)
+	if err != nil {
+		return nil, err
+	}
 	if err := e.start(); err != nil {
 		return nil, err
 	}
@@ -99,14 +107,20 @@ func newExporter(opts ...grpcOption) (*exporter, error) {
 }
 
 // newUnstartedExporter constructs a new Exporter and does not start it.
-func newUnstartedExporter(opts ...grpcOption) *exporter {
+func newUnstartedExporter(opts ...grpcOption) (*exporter, error) {
 	e := &exporter{}
 	e.grpcOptions = newGRPCOptions(opts...)
 	if len(e.grpcOptions.headers) > 0 {
 		e.grpcMetaDatas = metadata.New(e.grpcOptions.headers)
 	}
-	e.httpLogClient = newLogClient(e.grpcOptions.addr, e.grpcOptions.headers)
-	return e
+	if e.grpcOptions.httpEnabled {
+		client, err := newLogClient(e.grpcOptions.addr, e.grpcOptions.headers, e.grpcOptions.httpConfig)
+		if err != nil {
+			return nil, err
+		}
+		e.httpLogClient = client
+	}
+	return e, nil
 }
 
 // start 导出器启动。
@@ -135,8 +149,11 @@ func (e *exporter) start() error {
 	return err
 }
 
-// connect grpc 连接。
+// connect grpc 连接，使用 http 协议时不需要建立 grpc 连接。
 func (e *exporter) connect() error {
+	if e.grpcOptions.httpEnabled {
+		return nil
+	}
 	cc, err := e.dialToCollector()
 	if err != nil {
 		return err
@@ -306,6 +323,9 @@ func (e *exporter) Shutdown(ctx context.Context) error {
 		// Clean things up before checking this error.
 		err = cc.Close()
 	}
+	if e.httpLogClient != nil {
+		e.httpLogClient.close()
+	}
 
 	// At this point we can change the state variable started
 	e.mu.Lock()
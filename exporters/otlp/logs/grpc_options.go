@@ -23,6 +23,8 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+
+	"galiosight.ai/galio-sdk-go/model"
 )
 
 type grpcOptions struct {
@@ -35,6 +37,7 @@ type grpcOptions struct {
 	reconnectionPeriod time.Duration                    // grpc 客户端保活重连间隔。
 	dialInsecure       bool                             // grpc 跳过对服务器证书的验证。
 	httpEnabled        bool                             // 是否使用 http 协议
+	httpConfig         model.LogsHTTP                   // http 协议上报配置。
 }
 
 type grpcOption func(*grpcOptions)
@@ -120,13 +123,20 @@ func withGRPCHeaders(headers map[string]string) grpcOption {
 	}
 }
 
-// withHTTPEnabled will send the provided headers with gRPC requests
+// withHTTPEnabled 是否使用 OTLP/HTTP 协议上报，否则使用 gRPC。
 func withHTTPEnabled(enable bool) grpcOption {
 	return func(o *grpcOptions) {
 		o.httpEnabled = enable
 	}
 }
 
+// withHTTPConfig 设置 OTLP/HTTP 上报的编码、压缩、代理及 TLS 配置。
+func withHTTPConfig(cfg model.LogsHTTP) grpcOption {
+	return func(o *grpcOptions) {
+		o.httpConfig = cfg
+	}
+}
+
 // withTLSCredentials allows the connection to use TLS credentials
 // when talking to the server. It takes in grpc.TransportCredentials instead
 // of say a Certificate file or a tls.Certificate, because the retrieving
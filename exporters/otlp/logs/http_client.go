@@ -22,26 +22,138 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
-	"errors"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
 	"time"
 
 	collectorlogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+const (
+	// encodingProtobuf OTLP/HTTP protobuf 编码。
+	encodingProtobuf = "protobuf"
+	// encodingJSON OTLP/HTTP JSON 编码。
+	encodingJSON = "json"
+	// compressionGzip gzip 压缩。
+	compressionGzip = "gzip"
+	// compressionNone 不压缩。
+	compressionNone = "none"
+
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeJSON     = "application/json"
+
+	// defaultHTTPTimeout 默认上报超时。
+	defaultHTTPTimeout = 5 * time.Second
+	// maxResponseBodySize 读取响应体的最大长度，避免异常响应占用过多内存。
+	maxResponseBodySize = 64 * 1024
+	// maxErrorBodySize 错误信息中保留的响应体长度。
+	maxErrorBodySize = 256
 )
 
+// logClient OTLP/HTTP 日志上报客户端。
 type logClient struct {
-	url     string
-	headers map[string]string
+	url         string
+	headers     map[string]string
+	encoding    string
+	compression string
+	client      *http.Client
 }
 
-func newLogClient(addr string, headers map[string]string) *logClient {
+// newLogClient 根据 OTLP/HTTP 配置创建日志上报客户端。
+// 代理及 TLS 配置错误时返回错误，底层连接在多次上报间复用。
+func newLogClient(addr string, headers map[string]string, cfg model.LogsHTTP) (*logClient, error) {
+	cfg = fixHTTPConfig(cfg)
+	transport, err := newHTTPTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
 	return &logClient{
-		url:     fmt.Sprintf("%s/v1/logs", GetFullDomain(addr)),
-		headers: headers,
+		url:         fmt.Sprintf("%s/v1/logs", GetFullDomain(addr)),
+		headers:     headers,
+		encoding:    cfg.Encoding,
+		compression: cfg.Compression,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   time.Duration(cfg.TimeoutMs) * time.Millisecond,
+		},
+	}, nil
+}
+
+// fixHTTPConfig 使用默认值填充未配置的字段。
+func fixHTTPConfig(cfg model.LogsHTTP) model.LogsHTTP {
+	cfg.Encoding = strings.ToLower(cfg.Encoding)
+	if cfg.Encoding != encodingJSON {
+		cfg.Encoding = encodingProtobuf
+	}
+	cfg.Compression = strings.ToLower(cfg.Compression)
+	if cfg.Compression != compressionNone {
+		cfg.Compression = compressionGzip
+	}
+	if cfg.TimeoutMs <= 0 {
+		cfg.TimeoutMs = int32(defaultHTTPTimeout / time.Millisecond)
 	}
+	return cfg
+}
+
+// newHTTPTransport 创建带代理及 TLS 配置的 http.Transport。
+func newHTTPTransport(cfg model.LogsHTTP) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// 未配置代理地址时，沿用 DefaultTransport 的 http.ProxyFromEnvironment。
+	if cfg.ProxyUrl != "" {
+		proxy, err := url.Parse(cfg.ProxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("parse proxy_url %q: %w", cfg.ProxyUrl, err)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+	tlsConfig, err := newTLSConfig(cfg.Tls)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+	return transport, nil
+}
+
+// newTLSConfig 根据 ClientTLS 创建 tls.Config，未配置时返回 nil，使用默认配置。
+func newTLSConfig(cfg model.ClientTLS) (*tls.Config, error) {
+	if cfg == (model.ClientTLS{}) {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         cfg.ServerName,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, // #nosec G402 由用户显式配置，仅用于调试
+	}
+	if cfg.CaFile != "" {
+		pem, err := os.ReadFile(cfg.CaFile)
+		if err != nil {
+			return nil, fmt.Errorf("read ca_file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in ca_file %q", cfg.CaFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load cert_file/key_file: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
 }
 
 // GetFullDomain 返回给定地址的完整域名。
@@ -57,52 +169,110 @@ func GetFullDomain(addr string) string {
 	return protocol + addr
 }
 
+// Export 上报日志，按配置的编码及压缩方式发送 OTLP/HTTP 请求。
 func (l *logClient) Export(
 	ctx context.Context, in *collectorlogpb.ExportLogsServiceRequest,
 ) (*collectorlogpb.ExportLogsServiceResponse, error) {
-	buf, err := proto.Marshal(in)
+	body, err := l.marshal(in)
 	if err != nil {
 		return nil, err
 	}
-	// 压缩数据
-	var b bytes.Buffer
-	zw := gzip.NewWriter(&b)
-	if _, err = zw.Write(buf); err != nil {
-		return nil, err
-	}
-	if err = zw.Close(); err != nil {
-		return nil, err
+	if l.compression == compressionGzip {
+		if body, err = gzipBytes(body); err != nil {
+			return nil, err
+		}
 	}
 
 	// 设置 HTTP 请求
 	req, err := http.NewRequestWithContext(
-		ctx, "POST", l.url, &b,
+		ctx, http.MethodPost, l.url, bytes.NewReader(body),
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	// 设置 HTTP 头部
-	req.Header.Set("Content-Type", "application/x-protobuf")
-	req.Header.Set("Content-Encoding", "gzip") // 设置压缩头部
+	req.Header.Set("Content-Type", l.contentType())
+	if l.compression == compressionGzip {
+		req.Header.Set("Content-Encoding", compressionGzip) // 设置压缩头部
+	}
 	for k, v := range l.headers {
 		req.Header.Set(k, v)
 	}
-	// 创建 HTTP 客户端
-	client := &http.Client{
-		Timeout: time.Second * 5, // 超时时间 5 秒，且不允许用户配置
-	}
 
 	// 发送 HTTP 请求
-	resp, err := client.Do(req)
+	resp, err := l.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	if err != nil {
+		return nil, err
+	}
 
 	// 检查响应状态
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(resp.Status)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		if len(respBody) > maxErrorBodySize {
+			respBody = respBody[:maxErrorBodySize]
+		}
+		return nil, fmt.Errorf("otlp http logs export: status=%s, body=%s", resp.Status, respBody)
+	}
+	return l.unmarshalResponse(resp.Header.Get("Content-Type"), respBody), nil
+}
+
+// marshal 按配置的编码方式序列化请求。
+func (l *logClient) marshal(in *collectorlogpb.ExportLogsServiceRequest) ([]byte, error) {
+	if l.encoding == encodingJSON {
+		return marshalOTLPJSON(in)
+	}
+	return proto.Marshal(in)
+}
+
+// contentType 返回请求的 Content-Type。
+func (l *logClient) contentType() string {
+	if l.encoding == encodingJSON {
+		return contentTypeJSON
+	}
+	return contentTypeProtobuf
+}
+
+// unmarshalResponse 解析响应体，获取 partial_success 信息。
+// 部分 collector 返回空响应体或非 OTLP 格式，解析失败时返回空响应，不当作上报失败。
+func (l *logClient) unmarshalResponse(contentType string, body []byte) *collectorlogpb.ExportLogsServiceResponse {
+	rsp := &collectorlogpb.ExportLogsServiceResponse{}
+	if len(body) == 0 {
+		return rsp
+	}
+	var err error
+	switch {
+	case strings.HasPrefix(contentType, contentTypeJSON):
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, rsp)
+	case strings.HasPrefix(contentType, contentTypeProtobuf):
+		err = proto.Unmarshal(body, rsp)
+	default:
+		return rsp
+	}
+	if err != nil {
+		return &collectorlogpb.ExportLogsServiceResponse{}
+	}
+	return rsp
+}
+
+// close 关闭空闲连接。
+func (l *logClient) close() {
+	l.client.CloseIdleConnections()
+}
+
+// gzipBytes gzip 压缩数据。
+func gzipBytes(data []byte) ([]byte, error) {
+	var b bytes.Buffer
+	zw := gzip.NewWriter(&b)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
 	}
-	return &collectorlogpb.ExportLogsServiceResponse{}, nil
+	return b.Bytes(), nil
 }
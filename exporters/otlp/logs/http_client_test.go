@@ -22,14 +22,22 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/pem"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"galiosight.ai/galio-sdk-go/configs"
+	"galiosight.ai/galio-sdk-go/lib/logs"
 	"galiosight.ai/galio-sdk-go/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	collectorlogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
 	common "go.opentelemetry.io/proto/otlp/common/v1"
 	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
@@ -168,7 +176,8 @@ func TestLogClientExportWithResourceLogs(t *testing.T) {
 		t.Run(
 			url, func(t *testing.T) {
 				// 创建 logClient 实例
-				client := newLogClient(url, map[string]string{model.TenantHeaderKey: "galileo"})
+				client, err := newLogClient(url, map[string]string{model.TenantHeaderKey: "galileo"}, model.LogsHTTP{})
+				assert.NoError(t, err)
 				resp, err := client.Export(context.Background(), req)
 				// 检查结果
 				assert.NoError(t, err)
@@ -177,3 +186,233 @@ func TestLogClientExportWithResourceLogs(t *testing.T) {
 		)
 	}
 }
+
+func testLogsRequest(body string) *collectorlogpb.ExportLogsServiceRequest {
+	return &collectorlogpb.ExportLogsServiceRequest{
+		ResourceLogs: []*logpb.ResourceLogs{
+			{
+				ScopeLogs: []*logpb.ScopeLogs{
+					{
+						LogRecords: []*logpb.LogRecord{
+							{
+								SeverityText: "error",
+								Body:         &common.AnyValue{Value: &common.AnyValue_StringValue{StringValue: body}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// readLogsBody 按请求头解压上报的日志。
+func readLogsBody(t *testing.T, r *http.Request) []byte {
+	var reader io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(r.Body)
+		require.NoError(t, err)
+		defer zr.Close()
+		reader = zr
+	}
+	buf, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return buf
+}
+
+func TestLogClientEncoding(t *testing.T) {
+	tests := []struct {
+		name            string
+		cfg             model.LogsHTTP
+		contentType     string
+		contentEncoding string
+	}{
+		{"default", model.LogsHTTP{}, "application/x-protobuf", "gzip"},
+		{"json", model.LogsHTTP{Encoding: "JSON"}, "application/json", "gzip"},
+		{"json without compression", model.LogsHTTP{Encoding: "json", Compression: "none"}, "application/json", ""},
+		{"protobuf without compression", model.LogsHTTP{Compression: "none"}, "application/x-protobuf", ""},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var got []byte
+				server := httptest.NewServer(
+					http.HandlerFunc(
+						func(w http.ResponseWriter, r *http.Request) {
+							assert.Equal(t, "/v1/logs", r.URL.Path)
+							assert.Equal(t, tt.contentType, r.Header.Get("Content-Type"))
+							assert.Equal(t, tt.contentEncoding, r.Header.Get("Content-Encoding"))
+							assert.Equal(t, "galileo", r.Header.Get(model.TenantHeaderKey))
+							assert.Equal(t, "key", r.Header.Get(model.APIKeyHeaderKey))
+							got = readLogsBody(t, r)
+							w.Header().Set("Content-Type", "application/json")
+							_, _ = w.Write([]byte(`{"partialSuccess":{"rejectedLogRecords":"1","errorMessage":"bad"}}`))
+						},
+					),
+				)
+				defer server.Close()
+				client, err := newLogClient(
+					server.URL,
+					map[string]string{model.TenantHeaderKey: "galileo", model.APIKeyHeaderKey: "key"},
+					tt.cfg,
+				)
+				require.NoError(t, err)
+				want := testLogsRequest(tt.name)
+				rsp, err := client.Export(context.Background(), want)
+				require.NoError(t, err)
+				if tt.contentType == "application/json" {
+					assert.Equal(
+						t,
+						`{"resourceLogs":[{"scopeLogs":[{"logRecords":[{"severityText":"error","body":{"stringValue":"`+
+							tt.name+`"}}]}]}]}`,
+						string(got),
+					)
+				} else {
+					req := &collectorlogpb.ExportLogsServiceRequest{}
+					require.NoError(t, proto.Unmarshal(got, req))
+					assert.True(t, proto.Equal(want, req))
+				}
+				assert.Equal(t, int64(1), rsp.GetPartialSuccess().GetRejectedLogRecords())
+			},
+		)
+	}
+}
+
+func TestLogClientErrorStatus(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("invalid api key"))
+			},
+		),
+	)
+	defer server.Close()
+	client, err := newLogClient(server.URL, nil, model.LogsHTTP{})
+	require.NoError(t, err)
+	_, err = client.Export(context.Background(), testLogsRequest("forbidden"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+	assert.Contains(t, err.Error(), "invalid api key")
+}
+
+func TestLogClientProxy(t *testing.T) {
+	var proxied bool
+	proxy := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				// 代理收到的是绝对地址。
+				proxied = r.URL.Host == "collector.example.com"
+				assert.Equal(t, "/v1/logs", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+			},
+		),
+	)
+	defer proxy.Close()
+	client, err := newLogClient(
+		"http://collector.example.com", nil, model.LogsHTTP{ProxyUrl: proxy.URL},
+	)
+	require.NoError(t, err)
+	_, err = client.Export(context.Background(), testLogsRequest("proxy"))
+	require.NoError(t, err)
+	assert.True(t, proxied)
+
+	_, err = newLogClient("collector.example.com", nil, model.LogsHTTP{ProxyUrl: "://bad"})
+	assert.Error(t, err)
+}
+
+func TestLogClientTLS(t *testing.T) {
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+		),
+	)
+	defer server.Close()
+
+	// 未信任服务端证书时失败。
+	client, err := newLogClient(server.URL, nil, model.LogsHTTP{})
+	require.NoError(t, err)
+	_, err = client.Export(context.Background(), testLogsRequest("untrusted"))
+	assert.Error(t, err)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, certPEM, 0600))
+	client, err = newLogClient(server.URL, nil, model.LogsHTTP{Tls: model.ClientTLS{CaFile: caFile}})
+	require.NoError(t, err)
+	_, err = client.Export(context.Background(), testLogsRequest("trusted"))
+	assert.NoError(t, err)
+
+	client, err = newLogClient(server.URL, nil, model.LogsHTTP{Tls: model.ClientTLS{InsecureSkipVerify: true}})
+	require.NoError(t, err)
+	_, err = client.Export(context.Background(), testLogsRequest("insecure"))
+	assert.NoError(t, err)
+
+	_, err = newLogClient(server.URL, nil, model.LogsHTTP{Tls: model.ClientTLS{CaFile: caFile + ".missing"}})
+	assert.Error(t, err)
+	_, err = newLogClient(server.URL, nil, model.LogsHTTP{Tls: model.ClientTLS{CertFile: caFile}})
+	assert.Error(t, err)
+}
+
+func TestNewExporterDataTransmission(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&count, 1)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "tenant", r.Header.Get(model.TenantHeaderKey))
+				assert.Equal(t, "a.b.c", r.Header.Get(model.TargetHeaderKey))
+				w.WriteHeader(http.StatusOK)
+			},
+		),
+	)
+	defer server.Close()
+	cfg := &configs.Logs{
+		Log:      logs.NopWrapper(),
+		Resource: model.Resource{TenantId: "tenant", Target: "a.b.c"},
+		Exporter: model.LogsExporter{
+			Collector: model.Collector{
+				Addr:             server.URL,
+				DataTransmission: model.DataTransmission_DATA_TRANSMISSION_HTTP,
+			},
+			Http: model.LogsHTTP{Encoding: "json"},
+		},
+		Stats: &model.SelfMonitorStats{},
+	}
+	e, err := NewExporter(cfg)
+	require.NoError(t, err)
+	exp := e.(*exporter)
+	assert.True(t, exp.grpcOptions.httpEnabled)
+	assert.Nil(t, exp.grpcClientConn)
+	require.NoError(t, e.ExportLogs(context.Background(), testLogsRequest("http").ResourceLogs))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+	require.NoError(t, e.Shutdown(context.Background()))
+
+	// 未配置 data_transmission 时默认使用 http
+	cfg.Exporter.Collector.DataTransmission = model.DataTransmission_DATA_TRANSMISSION_INVALID
+	e, err = NewExporter(cfg)
+	require.NoError(t, err)
+	exp = e.(*exporter)
+	assert.True(t, exp.grpcOptions.httpEnabled)
+	require.NoError(t, e.ExportLogs(context.Background(), testLogsRequest("http").ResourceLogs))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+	require.NoError(t, e.Shutdown(context.Background()))
+
+	cfg.Exporter.Collector.Addr = "127.0.0.1:4317"
+	cfg.Exporter.Collector.DataTransmission = model.DataTransmission_DATA_TRANSMISSION_gRPC
+	e, err = NewExporter(cfg)
+	require.NoError(t, err)
+	exp = e.(*exporter)
+	assert.False(t, exp.grpcOptions.httpEnabled)
+	assert.Nil(t, exp.httpLogClient)
+	require.NoError(t, e.Shutdown(context.Background()))
+
+	cfg.Exporter.Collector.DataTransmission = model.DataTransmission_DATA_TRANSMISSION_HTTP
+	cfg.Exporter.Http.ProxyUrl = "://bad"
+	e, err = NewExporter(cfg)
+	assert.Error(t, err)
+	assert.Nil(t, e)
+}
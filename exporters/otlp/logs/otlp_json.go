@@ -0,0 +1,148 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// otlpHexBytesFields OTLP/JSON 中使用十六进制编码的 bytes 字段，其他 bytes 字段使用 base64 编码。
+var otlpHexBytesFields = map[protoreflect.Name]bool{
+	"trace_id":       true,
+	"span_id":        true,
+	"parent_span_id": true,
+}
+
+// marshalOTLPJSON 按 OTLP/JSON 规范序列化，与 protojson 的区别：
+// trace_id、span_id 使用十六进制字符串而不是 base64，枚举使用整数而不是名称。
+// 参考：https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+func marshalOTLPJSON(m proto.Message) ([]byte, error) {
+	return appendOTLPMessage(make([]byte, 0, 1024), m.ProtoReflect())
+}
+
+// appendOTLPMessage 按字段定义顺序序列化已设置的字段，字段名使用 lowerCamelCase。
+func appendOTLPMessage(b []byte, m protoreflect.Message) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	fields := m.Descriptor().Fields()
+	first := true
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !m.Has(fd) {
+			continue
+		}
+		if !first {
+			b = append(b, ',')
+		}
+		first = false
+		b = strconv.AppendQuote(b, fd.JSONName())
+		b = append(b, ':')
+		if b, err = appendOTLPField(b, fd, m.Get(fd)); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, '}'), nil
+}
+
+func appendOTLPField(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	if !fd.IsList() {
+		return appendOTLPValue(b, fd, v)
+	}
+	var err error
+	list := v.List()
+	b = append(b, '[')
+	for i := 0; i < list.Len(); i++ {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		if b, err = appendOTLPValue(b, fd, list.Get(i)); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, ']'), nil
+}
+
+// appendOTLPValue 64 位整数使用字符串，与 protojson 一致。
+func appendOTLPValue(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return appendOTLPMessage(b, v.Message())
+	case protoreflect.EnumKind:
+		return strconv.AppendInt(b, int64(v.Enum()), 10), nil
+	case protoreflect.BoolKind:
+		return strconv.AppendBool(b, v.Bool()), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return strconv.AppendInt(b, v.Int(), 10), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return strconv.AppendUint(b, v.Uint(), 10), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		b = append(b, '"')
+		return append(strconv.AppendInt(b, v.Int(), 10), '"'), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		b = append(b, '"')
+		return append(strconv.AppendUint(b, v.Uint(), 10), '"'), nil
+	case protoreflect.FloatKind:
+		return appendOTLPFloat(b, v.Float(), 32), nil
+	case protoreflect.DoubleKind:
+		return appendOTLPFloat(b, v.Float(), 64), nil
+	case protoreflect.StringKind:
+		return appendJSONString(b, v.String())
+	case protoreflect.BytesKind:
+		b = append(b, '"')
+		if otlpHexBytesFields[fd.Name()] {
+			b = append(b, hex.EncodeToString(v.Bytes())...)
+		} else {
+			b = append(b, base64.StdEncoding.EncodeToString(v.Bytes())...)
+		}
+		return append(b, '"'), nil
+	}
+	return b, nil
+}
+
+// appendJSONString 序列化字符串，不转义 HTML 字符，便于阅读。
+func appendJSONString(b []byte, s string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return nil, err
+	}
+	return append(b, bytes.TrimSuffix(buf.Bytes(), []byte{'\n'})...), nil
+}
+
+// appendOTLPFloat NaN、Infinity 使用字符串，与 protojson 一致。
+func appendOTLPFloat(b []byte, f float64, bitSize int) []byte {
+	switch {
+	case math.IsNaN(f):
+		return append(b, `"NaN"`...)
+	case math.IsInf(f, 1):
+		return append(b, `"Infinity"`...)
+	case math.IsInf(f, -1):
+		return append(b, `"-Infinity"`...)
+	}
+	return strconv.AppendFloat(b, f, 'g', -1, bitSize)
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectorlogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	common "go.opentelemetry.io/proto/otlp/common/v1"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+func TestMarshalOTLPJSON(t *testing.T) {
+	req := &collectorlogpb.ExportLogsServiceRequest{
+		ResourceLogs: []*logpb.ResourceLogs{
+			{
+				Resource: &resourcepb.Resource{
+					Attributes: []*common.KeyValue{
+						{Key: "service.name", Value: &common.AnyValue{Value: &common.AnyValue_StringValue{StringValue: "a<b"}}},
+					},
+				},
+				ScopeLogs: []*logpb.ScopeLogs{
+					{
+						Scope: &common.InstrumentationScope{Name: "otelzap"},
+						LogRecords: []*logpb.LogRecord{
+							{
+								TimeUnixNano:   1700000000000000001,
+								SeverityNumber: logpb.SeverityNumber_SEVERITY_NUMBER_ERROR,
+								SeverityText:   "error",
+								Body:           &common.AnyValue{Value: &common.AnyValue_StringValue{StringValue: "msg"}},
+								Attributes: []*common.KeyValue{
+									{Key: "int", Value: &common.AnyValue{Value: &common.AnyValue_IntValue{IntValue: -3}}},
+									{Key: "double", Value: &common.AnyValue{Value: &common.AnyValue_DoubleValue{DoubleValue: 1.5}}},
+									{Key: "nan", Value: &common.AnyValue{Value: &common.AnyValue_DoubleValue{DoubleValue: math.NaN()}}},
+									{Key: "bool", Value: &common.AnyValue{Value: &common.AnyValue_BoolValue{BoolValue: false}}},
+									{Key: "bytes", Value: &common.AnyValue{Value: &common.AnyValue_BytesValue{BytesValue: []byte{1, 2}}}},
+								},
+								Flags:   1,
+								TraceId: []byte{0x5b, 0x8e, 0xff, 0xf7, 0x98, 0x03, 0x81, 0x03, 0xd2, 0x69, 0xb6, 0x33, 0x81, 0x3f, 0xc6, 0x0c},
+								SpanId:  []byte{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x74},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	got, err := marshalOTLPJSON(req)
+	require.NoError(t, err)
+	// trace_id、span_id 为十六进制，枚举为整数，64 位整数为字符串
+	assert.Equal(
+		t,
+		`{"resourceLogs":[{"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"a<b"}}]},`+
+			`"scopeLogs":[{"scope":{"name":"otelzap"},"logRecords":[{"timeUnixNano":"1700000000000000001",`+
+			`"severityNumber":17,"severityText":"error","body":{"stringValue":"msg"},"attributes":[`+
+			`{"key":"int","value":{"intValue":"-3"}},{"key":"double","value":{"doubleValue":1.5}},`+
+			`{"key":"nan","value":{"doubleValue":"NaN"}},{"key":"bool","value":{"boolValue":false}},`+
+			`{"key":"bytes","value":{"bytesValue":"AQI="}}],"flags":1,`+
+			`"traceId":"5b8efff798038103d269b633813fc60c","spanId":"eee19b7ec3c1b174"}]}]}]}`,
+		string(got),
+	)
+}
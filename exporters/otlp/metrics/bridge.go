@@ -0,0 +1,393 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"galiosight.ai/galio-sdk-go/components"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+var _ sdkmetric.Exporter = (*BridgeExporter)(nil)
+
+// errBridgeShutdown 导出器已关闭。
+var errBridgeShutdown = errors.New("otel metrics bridge exporter is shutdown")
+
+// defaultBridgeInterval 默认的采集间隔，比 OMP 聚合窗口小，避免一个窗口内没有数据。
+const defaultBridgeInterval = 5 * time.Second
+
+// BridgeExporter 将 OpenTelemetry 指标转换成自定义监控 model.CustomMetrics，
+// 上报到 MetricsProcessor，与自定义监控共用分桶、标签忽略、采样、秒级聚合、时间线预算及过载保护等处理逻辑。
+// 指标名为 OpenTelemetry 指标名，监控项名默认为 Meter 名（instrumentation scope），
+// 开启 convert_name（默认开启）时由 MetricsProcessor 按 CustomName 规范转换成 custom_$type_$group_$name_$usage。
+//
+// 转换规则：
+//   - Counter、ObservableCounter：差值，int64 转成 AGGREGATION_COUNTER，float64 转成 AGGREGATION_SUM。
+//   - UpDownCounter、ObservableUpDownCounter：累计值，转成 AGGREGATION_SET。
+//   - Gauge、ObservableGauge：转成 AGGREGATION_SET。
+//   - Histogram：差值，转成 AGGREGATION_HISTOGRAM，按 OpenTelemetry 分桶批量回放，
+//     每个分桶的观测值取分桶中值（参考 min、max 修正）确定所在分桶，分桶以 MetricsProcessor 的 histogram_buckets 为准，
+//     观测值总和及次数保持原始值。
+//
+// 直方图回放的开销与分桶数成正比，与观测次数无关。
+type BridgeExporter struct {
+	processor   func() components.MetricsProcessor
+	monitorName string
+	mu          sync.Mutex
+	shutdown    bool
+}
+
+// BridgeOption BridgeExporter、NewBridgeReader 选项。
+type BridgeOption func(*bridgeOptions)
+
+type bridgeOptions struct {
+	monitorName string
+	interval    time.Duration
+}
+
+// WithBridgeMonitorName 设置监控项名，为空时使用 Meter 名。
+func WithBridgeMonitorName(name string) BridgeOption {
+	return func(o *bridgeOptions) {
+		o.monitorName = name
+	}
+}
+
+// WithBridgeInterval 设置 NewBridgeReader 的采集间隔，默认 5 秒。
+func WithBridgeInterval(d time.Duration) BridgeOption {
+	return func(o *bridgeOptions) {
+		o.interval = d
+	}
+}
+
+func newBridgeOptions(opts []BridgeOption) bridgeOptions {
+	o := bridgeOptions{interval: defaultBridgeInterval}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.interval <= 0 {
+		o.interval = defaultBridgeInterval
+	}
+	return o
+}
+
+// NewBridgeExporter 创建 BridgeExporter，processor 每次导出时调用，以便使用最新设置的默认监控处理器。
+func NewBridgeExporter(processor func() components.MetricsProcessor, opts ...BridgeOption) *BridgeExporter {
+	o := newBridgeOptions(opts)
+	return &BridgeExporter{
+		processor:   processor,
+		monitorName: o.monitorName,
+	}
+}
+
+// NewBridgeReader 创建定时采集 OpenTelemetry 指标并上报到 MetricsProcessor 的 Reader，
+// 通过 sdkmetric.WithReader 注册到 MeterProvider。
+func NewBridgeReader(processor func() components.MetricsProcessor, opts ...BridgeOption) sdkmetric.Reader {
+	o := newBridgeOptions(opts)
+	return sdkmetric.NewPeriodicReader(
+		NewBridgeExporter(processor, opts...),
+		sdkmetric.WithInterval(o.interval),
+	)
+}
+
+// Temporality 单调累加的 Counter、Histogram 使用差值，与 OMP 窗口聚合一致；
+// UpDownCounter 使用累计值，作为瞬时值上报。
+func (e *BridgeExporter) Temporality(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+	switch kind {
+	case sdkmetric.InstrumentKindUpDownCounter, sdkmetric.InstrumentKindObservableUpDownCounter:
+		return metricdata.CumulativeTemporality
+	default:
+		return metricdata.DeltaTemporality
+	}
+}
+
+// Aggregation 使用 OpenTelemetry 默认的聚合方式，直方图支持 instrument 建议的分桶。
+func (e *BridgeExporter) Aggregation(kind sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(kind)
+}
+
+// Export 转换并上报到 MetricsProcessor，数据同步处理完毕，不会保留 rm 的引用。
+func (e *BridgeExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	e.mu.Lock()
+	shutdown := e.shutdown
+	e.mu.Unlock()
+	if shutdown {
+		return errBridgeShutdown
+	}
+	p := e.processor()
+	if p == nil {
+		return nil
+	}
+	for i := range rm.ScopeMetrics {
+		sm := &rm.ScopeMetrics[i]
+		monitorName := e.monitorName
+		if monitorName == "" {
+			monitorName = model.ToValidName(sm.Scope.Name)
+		}
+		for j := range sm.Metrics {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			w := &bridgeWriter{
+				processor:   p,
+				monitorName: monitorName,
+				name:        model.ToValidName(sm.Metrics[j].Name),
+			}
+			w.write(sm.Metrics[j].Data)
+		}
+	}
+	return nil
+}
+
+// ForceFlush 数据在 Export 中同步上报，不需要刷新。
+func (e *BridgeExporter) ForceFlush(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// Shutdown 关闭导出器，关闭后 Export 返回错误。
+func (e *BridgeExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	e.shutdown = true
+	e.mu.Unlock()
+	return ctx.Err()
+}
+
+// bridgeWriter 将一个 OpenTelemetry 指标的数据点写入 MetricsProcessor。
+type bridgeWriter struct {
+	processor   components.MetricsProcessor
+	monitorName string
+	name        string
+}
+
+func (w *bridgeWriter) write(data metricdata.Aggregation) {
+	switch d := data.(type) {
+	case metricdata.Sum[int64]:
+		aggregation := sumAggregation(d.IsMonotonic, d.Temporality, model.Aggregation_AGGREGATION_COUNTER)
+		for _, dp := range d.DataPoints {
+			w.process(dp.Attributes, aggregation, float64(dp.Value))
+		}
+	case metricdata.Sum[float64]:
+		aggregation := sumAggregation(d.IsMonotonic, d.Temporality, model.Aggregation_AGGREGATION_SUM)
+		for _, dp := range d.DataPoints {
+			w.process(dp.Attributes, aggregation, dp.Value)
+		}
+	case metricdata.Gauge[int64]:
+		for _, dp := range d.DataPoints {
+			w.process(dp.Attributes, model.Aggregation_AGGREGATION_SET, float64(dp.Value))
+		}
+	case metricdata.Gauge[float64]:
+		for _, dp := range d.DataPoints {
+			w.process(dp.Attributes, model.Aggregation_AGGREGATION_SET, dp.Value)
+		}
+	case metricdata.Histogram[int64]:
+		for _, dp := range d.DataPoints {
+			w.replayHistogram(dp.Attributes, dp.Bounds, dp.BucketCounts, float64(dp.Sum), dp.Count,
+				extrema(dp.Min), extrema(dp.Max))
+		}
+	case metricdata.Histogram[float64]:
+		for _, dp := range d.DataPoints {
+			w.replayHistogram(dp.Attributes, dp.Bounds, dp.BucketCounts, dp.Sum, dp.Count,
+				extrema(dp.Min), extrema(dp.Max))
+		}
+	case metricdata.ExponentialHistogram[int64]:
+		for _, dp := range d.DataPoints {
+			w.replayExponential(dp.Attributes, &expoPoint{
+				scale: dp.Scale, zeroCount: dp.ZeroCount, positive: dp.PositiveBucket, negative: dp.NegativeBucket,
+				sum: float64(dp.Sum), count: dp.Count, minV: extrema(dp.Min), maxV: extrema(dp.Max),
+			})
+		}
+	case metricdata.ExponentialHistogram[float64]:
+		for _, dp := range d.DataPoints {
+			w.replayExponential(dp.Attributes, &expoPoint{
+				scale: dp.Scale, zeroCount: dp.ZeroCount, positive: dp.PositiveBucket, negative: dp.NegativeBucket,
+				sum: dp.Sum, count: dp.Count, minV: extrema(dp.Min), maxV: extrema(dp.Max),
+			})
+		}
+	}
+}
+
+// sumAggregation 单调差值上报为 counter/sum，其他（UpDownCounter 累计值）上报为瞬时值。
+func sumAggregation(monotonic bool, temporality metricdata.Temporality, delta model.Aggregation) model.Aggregation {
+	if monotonic && temporality == metricdata.DeltaTemporality {
+		return delta
+	}
+	return model.Aggregation_AGGREGATION_SET
+}
+
+// process 上报一个数据点。每次都重新构造 CustomMetrics，
+// 因为 MetricsProcessor 会原地修改标签（标签忽略）及指标名（CustomName 转换）。
+func (w *bridgeWriter) process(attrs attribute.Set, aggregation model.Aggregation, value float64) {
+	w.processMetric(attrs, model.Metric{Name: w.name, Value: value, Aggregation: aggregation})
+}
+
+// processMetric 上报一个数据点 metric。
+func (w *bridgeWriter) processMetric(attrs attribute.Set, metric model.Metric) {
+	c := model.GetCustomMetrics(attrs.Len(), 1)
+	defer model.PutCustomMetrics(c)
+	c.MonitorName = w.monitorName
+	iter := attrs.Iter()
+	for i := 0; iter.Next(); i++ {
+		kv := iter.Attribute()
+		c.CustomLabels[i] = model.Label{Name: string(kv.Key), Value: kv.Value.Emit()}
+	}
+	c.Metrics[0] = metric
+	w.processor.ProcessCustomMetrics(c)
+}
+
+// bound 可选的最小、最大值。
+type bound struct {
+	value   float64
+	defined bool
+}
+
+func extrema[N int64 | float64](e metricdata.Extrema[N]) bound {
+	v, ok := e.Value()
+	return bound{value: float64(v), defined: ok}
+}
+
+// replayHistogram 按分桶回放直方图，分桶 i 的范围是 (bounds[i-1], bounds[i]]。
+func (w *bridgeWriter) replayHistogram(
+	attrs attribute.Set, bounds []float64, counts []uint64, sum float64, count uint64, minV, maxV bound,
+) {
+	r := histogramReplay{w: w, attrs: attrs, rest: sum}
+	defer r.flush()
+	if count == 1 { // 只有一个观测值时，sum 就是原始值。
+		r.add(sum, 1)
+		return
+	}
+	for i, n := range counts {
+		if n == 0 {
+			continue
+		}
+		lower, upper := math.Inf(-1), math.Inf(1)
+		if i > 0 {
+			lower = bounds[i-1]
+		}
+		if i < len(bounds) {
+			upper = bounds[i]
+		}
+		r.add(representative(lower, upper, minV, maxV), n)
+	}
+}
+
+// expoPoint 指数直方图数据点。
+type expoPoint struct {
+	scale     int32
+	zeroCount uint64
+	positive  metricdata.ExponentialBucket
+	negative  metricdata.ExponentialBucket
+	sum       float64
+	count     uint64
+	minV      bound
+	maxV      bound
+}
+
+// replayExponential 按分桶回放指数直方图，正数分桶 k 的范围是 (base^k, base^(k+1)]，负数分桶对称。
+func (w *bridgeWriter) replayExponential(attrs attribute.Set, p *expoPoint) {
+	r := histogramReplay{w: w, attrs: attrs, rest: p.sum}
+	defer r.flush()
+	if p.count == 1 {
+		r.add(p.sum, 1)
+		return
+	}
+	base := math.Exp2(math.Exp2(-float64(p.scale)))
+	if p.zeroCount > 0 {
+		r.add(0, p.zeroCount)
+	}
+	for i, n := range p.positive.Counts {
+		if n == 0 {
+			continue
+		}
+		k := float64(p.positive.Offset) + float64(i)
+		r.add(representative(math.Pow(base, k), math.Pow(base, k+1), p.minV, p.maxV), n)
+	}
+	for i, n := range p.negative.Counts {
+		if n == 0 {
+			continue
+		}
+		k := float64(p.negative.Offset) + float64(i)
+		r.add(representative(-math.Pow(base, k+1), -math.Pow(base, k), p.minV, p.maxV), n)
+	}
+}
+
+// histogramReplay 按分桶批量回放直方图，每个分桶一次 ProcessCustomMetrics。
+// 分桶的观测值总和按代表值估算，最后一个分桶取原始总和的余量，保证总和与原始值一致。
+type histogramReplay struct {
+	w       *bridgeWriter
+	attrs   attribute.Set
+	rest    float64 // 原始总和减去已回放的部分。
+	pending float64 // 待回放分桶的代表值。
+	n       uint64  // 待回放分桶的观测次数。
+}
+
+// add 添加 n 次观测值 v，回放上一个分桶。
+func (r *histogramReplay) add(v float64, n uint64) {
+	if r.n > 0 {
+		sum := r.pending * float64(r.n)
+		r.replay(sum)
+		r.rest -= sum
+	}
+	r.pending, r.n = v, n
+}
+
+// flush 回放最后一个分桶，观测值总和取余量。
+func (r *histogramReplay) flush() {
+	if r.n > 0 {
+		r.replay(r.rest)
+	}
+	r.n = 0
+}
+
+func (r *histogramReplay) replay(sum float64) {
+	r.w.processMetric(r.attrs, model.Metric{
+		Name:        r.w.name,
+		Value:       r.pending,
+		Aggregation: model.Aggregation_AGGREGATION_HISTOGRAM,
+		Count:       r.n,
+		Sum:         sum,
+	})
+}
+
+// representative 分桶 (lower, upper] 的代表值，取中值，并用 min、max 收窄无穷边界。
+func representative(lower, upper float64, minV, maxV bound) float64 {
+	if minV.defined && minV.value > lower {
+		lower = minV.value
+	}
+	if maxV.defined && maxV.value < upper {
+		upper = maxV.value
+	}
+	switch {
+	case math.IsInf(lower, -1) && math.IsInf(upper, 1):
+		return 0
+	case math.IsInf(lower, -1):
+		return upper
+	case math.IsInf(upper, 1):
+		return lower
+	default:
+		return lower + (upper-lower)/2
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"galiosight.ai/galio-sdk-go/components"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// bridgeRecord 记录的一个自定义监控数据点。
+type bridgeRecord struct {
+	monitor     string
+	name        string
+	labels      map[string]string
+	value       float64
+	aggregation model.Aggregation
+	count       uint64
+	sum         float64
+}
+
+// recordCustomProcessor 记录上报的自定义监控。
+type recordCustomProcessor struct {
+	components.NoopMetricsProcessor
+	mu      sync.Mutex
+	records []bridgeRecord
+}
+
+func (r *recordCustomProcessor) ProcessCustomMetrics(c *model.CustomMetrics) {
+	labels := map[string]string{}
+	for _, l := range c.CustomLabels {
+		labels[l.Name] = l.Value
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, m := range c.Metrics {
+		r.records = append(r.records, bridgeRecord{
+			monitor: c.MonitorName, name: m.Name, labels: labels, value: m.Value, aggregation: m.Aggregation,
+			count: m.Count, sum: m.Sum,
+		})
+	}
+	// 模拟 MetricsProcessor 原地修改指标名。
+	c.Metrics[0].Name = "converted"
+}
+
+func (r *recordCustomProcessor) take(name string) []bridgeRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var got, rest []bridgeRecord
+	for _, rec := range r.records {
+		if rec.name == name {
+			got = append(got, rec)
+		} else {
+			rest = append(rest, rec)
+		}
+	}
+	r.records = rest
+	return got
+}
+
+func newBridgeProvider(p components.MetricsProcessor, opts ...BridgeOption) (*sdkmetric.MeterProvider, *BridgeExporter) {
+	exporter := NewBridgeExporter(func() components.MetricsProcessor { return p }, opts...)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)))
+	return provider, exporter
+}
+
+func TestBridgeExporterCounterAndGauge(t *testing.T) {
+	p := &recordCustomProcessor{}
+	provider, _ := newBridgeProvider(p)
+	defer provider.Shutdown(context.Background())
+	meter := provider.Meter("github.com/foo/bar")
+	ctx := context.Background()
+
+	requests, err := meter.Int64Counter("http.server.requests")
+	require.NoError(t, err)
+	bytes, err := meter.Float64Counter("bytes")
+	require.NoError(t, err)
+	active, err := meter.Int64UpDownCounter("active")
+	require.NoError(t, err)
+	_, err = meter.Float64ObservableGauge("temperature", otelmetric.WithFloat64Callback(
+		func(_ context.Context, o otelmetric.Float64Observer) error {
+			o.Observe(36.5, otelmetric.WithAttributes(attribute.String("room", "a")))
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	attrs := otelmetric.WithAttributes(attribute.String("method", "GET"), attribute.Int("code", 200))
+	requests.Add(ctx, 3, attrs)
+	bytes.Add(ctx, 1.5)
+	active.Add(ctx, 5)
+	active.Add(ctx, -2)
+	require.NoError(t, provider.ForceFlush(ctx))
+
+	got := p.take("http_server_requests")
+	require.Len(t, got, 1)
+	assert.Equal(t, "github_com_foo_bar", got[0].monitor)
+	assert.Equal(t, model.Aggregation_AGGREGATION_COUNTER, got[0].aggregation)
+	assert.Equal(t, 3.0, got[0].value)
+	assert.Equal(t, map[string]string{"method": "GET", "code": "200"}, got[0].labels)
+
+	got = p.take("bytes")
+	require.Len(t, got, 1)
+	assert.Equal(t, model.Aggregation_AGGREGATION_SUM, got[0].aggregation)
+	assert.Equal(t, 1.5, got[0].value)
+
+	got = p.take("active")
+	require.Len(t, got, 1)
+	assert.Equal(t, model.Aggregation_AGGREGATION_SET, got[0].aggregation)
+	assert.Equal(t, 3.0, got[0].value)
+
+	got = p.take("temperature")
+	require.Len(t, got, 1)
+	assert.Equal(t, model.Aggregation_AGGREGATION_SET, got[0].aggregation)
+	assert.Equal(t, 36.5, got[0].value)
+	assert.Equal(t, map[string]string{"room": "a"}, got[0].labels)
+
+	// counter 上报差值，UpDownCounter 上报累计值。
+	requests.Add(ctx, 2, attrs)
+	active.Add(ctx, 1)
+	require.NoError(t, provider.ForceFlush(ctx))
+	got = p.take("http_server_requests")
+	require.Len(t, got, 1)
+	assert.Equal(t, 2.0, got[0].value)
+	got = p.take("active")
+	require.Len(t, got, 1)
+	assert.Equal(t, 4.0, got[0].value)
+}
+
+func TestBridgeExporterHistogram(t *testing.T) {
+	p := &recordCustomProcessor{}
+	provider, _ := newBridgeProvider(p, WithBridgeMonitorName("中文监控项"))
+	defer provider.Shutdown(context.Background())
+	meter := provider.Meter("scope")
+	ctx := context.Background()
+
+	h, err := meter.Float64Histogram("latency", otelmetric.WithExplicitBucketBoundaries(1, 2, 5))
+	require.NoError(t, err)
+	h.Record(ctx, 0.3)
+	h.Record(ctx, 0.7)
+	h.Record(ctx, 3)
+	h.Record(ctx, 8)
+	require.NoError(t, provider.ForceFlush(ctx))
+
+	got := p.take("latency")
+	require.Len(t, got, 3, "每个非空分桶回放一次")
+	values := make([]float64, 0, len(got))
+	var count uint64
+	var sum float64
+	for _, rec := range got {
+		assert.Equal(t, "中文监控项", rec.monitor)
+		assert.Equal(t, model.Aggregation_AGGREGATION_HISTOGRAM, rec.aggregation)
+		for n := rec.count; n > 0; n-- {
+			values = append(values, rec.value)
+		}
+		count += rec.count
+		sum += rec.sum
+	}
+	sort.Float64s(values)
+	// (-inf,1] 使用 min 收窄为 (0.3,1]，(5,+inf) 使用 max 收窄为 (5,8]。
+	assert.InDeltaSlice(t, []float64{0.65, 0.65, 3.5, 6.5}, values, 1e-9)
+	assert.Equal(t, uint64(4), count)
+	assert.InDelta(t, 12.0, sum, 1e-9, "总和保持原始值")
+
+	// 只有一个观测值时，上报原始值。
+	h.Record(ctx, 1.7)
+	require.NoError(t, provider.ForceFlush(ctx))
+	got = p.take("latency")
+	require.Len(t, got, 1)
+	assert.Equal(t, 1.7, got[0].value)
+	assert.Equal(t, uint64(1), got[0].count)
+	assert.Equal(t, 1.7, got[0].sum)
+
+	// 没有观测值时不上报。
+	require.NoError(t, provider.ForceFlush(ctx))
+	assert.Empty(t, p.take("latency"))
+}
+
+func TestBridgeExporterExponentialHistogram(t *testing.T) {
+	p := &recordCustomProcessor{}
+	exporter := NewBridgeExporter(func() components.MetricsProcessor { return p })
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)),
+		sdkmetric.WithView(sdkmetric.NewView(
+			sdkmetric.Instrument{Name: "size"},
+			sdkmetric.Stream{Aggregation: sdkmetric.AggregationBase2ExponentialHistogram{MaxSize: 160, MaxScale: 0}},
+		)),
+	)
+	defer provider.Shutdown(context.Background())
+	h, err := provider.Meter("scope").Int64Histogram("size")
+	require.NoError(t, err)
+	ctx := context.Background()
+	for _, v := range []int64{0, 3, 3, 100} {
+		h.Record(ctx, v)
+	}
+	require.NoError(t, provider.ForceFlush(ctx))
+	got := p.take("size")
+	require.Len(t, got, 3)
+	values := make([]float64, 0, len(got))
+	var sum float64
+	for _, rec := range got {
+		for n := rec.count; n > 0; n-- {
+			values = append(values, rec.value)
+		}
+		sum += rec.sum
+	}
+	sort.Float64s(values)
+	// scale 0 时分桶为 (2^k, 2^(k+1)]：3 落在 (2,4]，100 落在 (64,128] 并被 max 收窄为 (64,100]。
+	assert.InDeltaSlice(t, []float64{0, 3, 3, 82}, values, 1e-9)
+	assert.InDelta(t, 106.0, sum, 1e-9, "总和保持原始值")
+}
+
+func TestBridgeExporterShutdown(t *testing.T) {
+	var p components.MetricsProcessor
+	exporter := NewBridgeExporter(func() components.MetricsProcessor { return p })
+	ctx := context.Background()
+	assert.Nil(t, exporter.Export(ctx, nil), "processor 为空时不上报")
+	require.NoError(t, exporter.ForceFlush(ctx))
+	require.NoError(t, exporter.Shutdown(ctx))
+	assert.Error(t, exporter.Export(ctx, nil))
+}
+
+func TestNewBridgeReader(t *testing.T) {
+	p := &recordCustomProcessor{}
+	reader := NewBridgeReader(func() components.MetricsProcessor { return p }, WithBridgeInterval(-1))
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer provider.Shutdown(context.Background())
+	c, err := provider.Meter("scope").Int64Counter("count")
+	require.NoError(t, err)
+	ctx := context.Background()
+	c.Add(ctx, 1)
+	require.NoError(t, provider.ForceFlush(ctx))
+	assert.Len(t, p.take("count"), 1)
+}
+
+func TestRepresentative(t *testing.T) {
+	none := bound{}
+	assert.Equal(t, 1.5, representative(1, 2, none, none))
+	assert.Equal(t, 2.0, representative(math.Inf(-1), 2, none, none))
+	assert.Equal(t, 1.0, representative(1, math.Inf(1), none, none))
+	assert.Equal(t, 0.0, representative(math.Inf(-1), math.Inf(1), none, none))
+	assert.Equal(t, 1.5, representative(math.Inf(-1), math.Inf(1), bound{1, true}, bound{2, true}))
+}
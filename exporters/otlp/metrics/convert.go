@@ -0,0 +1,356 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+
+	"galiosight.ai/galio-sdk-go/lib/strings"
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/version"
+)
+
+// scope 导出的 OTLP 指标使用的 instrumentation scope。
+var scope = instrumentation.Scope{Name: "galiosight.ai/galio-sdk-go", Version: version.Number}
+
+// metricKind OTLP 指标类型，同名不同类型的指标分开存放，避免类型断言失败。
+type metricKind int
+
+const (
+	kindSum metricKind = iota
+	kindCounter
+	kindGauge
+	kindSummary
+	kindHistogram
+	kindExponentialHistogram
+)
+
+type metricKey struct {
+	name string
+	kind metricKind
+}
+
+// converter 将 otp 指标转换成 OTLP ResourceMetrics。
+// OMP 处理器默认每个窗口导出的是窗口内的增量数据，Sum 及 Histogram 使用 Delta 时间性；
+// 累计模式下 Sum 及 Histogram 使用 Cumulative 时间性，开始时间为时间线开始累计的时间，指数直方图仍然是增量。
+// 非并发安全，每次转换创建一个新的对象。
+type converter struct {
+	temporality metricdata.Temporality
+	start       time.Time // 窗口开始时间。
+	seriesStart time.Time // 当前时间线的开始时间，累计模式下为时间线开始累计的时间。
+	end         time.Time
+	index       map[metricKey]int
+	metrics     []metricdata.Metrics
+}
+
+// toResourceMetrics 将一个窗口的 otp 指标转换成 OTLP ResourceMetrics。
+// window 是 OMP 处理器的聚合窗口，用于计算数据点的开始时间。
+func toResourceMetrics(res *resource.Resource, m *model.Metrics, window time.Duration) *metricdata.ResourceMetrics {
+	end := time.UnixMilli(m.TimestampMs)
+	c := &converter{
+		temporality: metricdata.DeltaTemporality,
+		start:       end.Add(-window),
+		end:         end,
+		index:       make(map[metricKey]int),
+	}
+	if m.Temporality == model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE {
+		c.temporality = metricdata.CumulativeTemporality
+	}
+	for _, cm := range m.ClientMetrics {
+		c.setSeriesStart(cm.StartTimestampMs)
+		attrs := rpcAttributes(cm.RpcLabels)
+		c.addCounter("rpc_client_started_total", attrs, float64(cm.RpcClientStartedTotal))
+		c.addCounter("rpc_client_handled_total", attrs, float64(cm.RpcClientHandledTotal))
+		c.addHistogram("rpc_client_handled_seconds", attrs, cm.RpcClientHandledSeconds)
+	}
+	for _, sm := range m.ServerMetrics {
+		c.setSeriesStart(sm.StartTimestampMs)
+		attrs := rpcAttributes(sm.RpcLabels)
+		c.addCounter("rpc_server_started_total", attrs, float64(sm.RpcServerStartedTotal))
+		c.addCounter("rpc_server_handled_total", attrs, float64(sm.RpcServerHandledTotal))
+		c.addHistogram("rpc_server_handled_seconds", attrs, sm.RpcServerHandledSeconds)
+	}
+	for _, nm := range m.NormalMetrics {
+		c.setSeriesStart(nm.StartTimestampMs)
+		c.addMetric(nm.Metric, *attribute.EmptySet())
+	}
+	for _, cm := range m.CustomMetrics {
+		c.setSeriesStart(cm.StartTimestampMs)
+		attrs := customAttributes(cm.CustomLabels)
+		for _, metric := range cm.Metrics {
+			c.addMetric(metric, attrs)
+		}
+	}
+	return &metricdata.ResourceMetrics{
+		Resource:     res,
+		ScopeMetrics: []metricdata.ScopeMetrics{{Scope: scope, Metrics: c.metrics}},
+	}
+}
+
+// setSeriesStart 设置当前时间线的开始时间，未设置（增量模式）时使用窗口开始时间。
+func (c *converter) setSeriesStart(startMs int64) {
+	if startMs == 0 {
+		c.seriesStart = c.start
+		return
+	}
+	c.seriesStart = time.UnixMilli(startMs)
+}
+
+// rpcAttributes RPC 标签转换成属性，属性名即标签枚举名。
+func rpcAttributes(labels *model.RPCLabels) attribute.Set {
+	if labels == nil {
+		return *attribute.EmptySet()
+	}
+	kvs := make([]attribute.KeyValue, 0, len(labels.Fields))
+	for _, f := range labels.Fields {
+		kvs = append(kvs, attribute.String(f.Name.String(), f.Value))
+	}
+	return attribute.NewSet(kvs...)
+}
+
+// customAttributes 自定义标签转换成属性。
+func customAttributes(labels []*model.Label) attribute.Set {
+	kvs := make([]attribute.KeyValue, 0, len(labels))
+	for _, l := range labels {
+		kvs = append(kvs, attribute.String(l.Name, l.Value))
+	}
+	return attribute.NewSet(kvs...)
+}
+
+// addMetric 根据聚合方式转换单个 otp 指标：
+// counter 转换成单调递增的 Sum，sum 转换成非单调的 Sum，set/max/min 转换成 Gauge，
+// avg 转换成只有 count 和 sum 的 Summary，summary 转换成带分位值的 Summary，
+// histogram 转换成显式分桶的 Histogram，指数直方图转换成 ExponentialHistogram。
+func (c *converter) addMetric(m *model.MetricOTP, attrs attribute.Set) {
+	if m == nil {
+		return
+	}
+	switch m.Aggregation {
+	case model.Aggregation_AGGREGATION_COUNTER, model.Aggregation_AGGREGATION_PROMETHEUS_COUNTER:
+		c.addCounter(m.Name, attrs, m.GetValue())
+	case model.Aggregation_AGGREGATION_SUM:
+		c.addSum(m.Name, attrs, m.GetValue())
+	case model.Aggregation_AGGREGATION_AVG:
+		if avg := m.GetAvg(); avg != nil {
+			c.addSummary(m.Name, attrs, avg)
+			return
+		}
+		c.addGauge(m.Name, attrs, m.GetValue())
+	case model.Aggregation_AGGREGATION_HISTOGRAM, model.Aggregation_AGGREGATION_PROMETHEUS_HISTOGRAM:
+		c.addHistogram(m.Name, attrs, m.GetHistogram())
+	case model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM:
+		c.addExponentialHistogram(m.Name, attrs, m.GetExponentialHistogram())
+	case model.Aggregation_AGGREGATION_SUMMARY:
+		c.addQuantiles(m.Name, attrs, m.GetSummary())
+	default:
+		c.addGauge(m.Name, attrs, m.GetValue())
+	}
+}
+
+// metric 获取同名同类型的指标，不存在则创建。
+func (c *converter) metric(name string, kind metricKind) *metricdata.Metrics {
+	key := metricKey{name: name, kind: kind}
+	if i, ok := c.index[key]; ok {
+		return &c.metrics[i]
+	}
+	m := metricdata.Metrics{Name: name}
+	switch kind {
+	case kindCounter:
+		m.Data = metricdata.Sum[float64]{Temporality: c.temporality, IsMonotonic: true}
+	case kindSum:
+		m.Data = metricdata.Sum[float64]{Temporality: c.temporality}
+	case kindGauge:
+		m.Data = metricdata.Gauge[float64]{}
+	case kindSummary:
+		m.Data = metricdata.Summary{}
+	case kindHistogram:
+		m.Data = metricdata.Histogram[float64]{Temporality: c.temporality}
+	case kindExponentialHistogram:
+		m.Data = metricdata.ExponentialHistogram[float64]{Temporality: metricdata.DeltaTemporality}
+	}
+	c.index[key] = len(c.metrics)
+	c.metrics = append(c.metrics, m)
+	return &c.metrics[len(c.metrics)-1]
+}
+
+func (c *converter) addCounter(name string, attrs attribute.Set, value float64) {
+	c.appendSum(c.metric(name, kindCounter), attrs, value)
+}
+
+func (c *converter) addSum(name string, attrs attribute.Set, value float64) {
+	c.appendSum(c.metric(name, kindSum), attrs, value)
+}
+
+func (c *converter) appendSum(m *metricdata.Metrics, attrs attribute.Set, value float64) {
+	data := m.Data.(metricdata.Sum[float64])
+	data.DataPoints = append(data.DataPoints, metricdata.DataPoint[float64]{
+		Attributes: attrs, StartTime: c.seriesStart, Time: c.end, Value: value,
+	})
+	m.Data = data
+}
+
+func (c *converter) addGauge(name string, attrs attribute.Set, value float64) {
+	m := c.metric(name, kindGauge)
+	data := m.Data.(metricdata.Gauge[float64])
+	data.DataPoints = append(data.DataPoints, metricdata.DataPoint[float64]{
+		Attributes: attrs, StartTime: c.start, Time: c.end, Value: value,
+	})
+	m.Data = data
+}
+
+func (c *converter) addSummary(name string, attrs attribute.Set, avg *model.Avg) {
+	m := c.metric(name, kindSummary)
+	data := m.Data.(metricdata.Summary)
+	data.DataPoints = append(data.DataPoints, metricdata.SummaryDataPoint{
+		Attributes: attrs, StartTime: c.start, Time: c.end, Count: uint64(avg.Count), Sum: avg.Sum,
+	})
+	m.Data = data
+}
+
+func (c *converter) addQuantiles(name string, attrs attribute.Set, s *model.Summary) {
+	if s == nil {
+		return
+	}
+	m := c.metric(name, kindSummary)
+	data := m.Data.(metricdata.Summary)
+	quantiles := make([]metricdata.QuantileValue, len(s.Quantiles))
+	for i, q := range s.Quantiles {
+		quantiles[i] = metricdata.QuantileValue{Quantile: q.Quantile, Value: q.Value}
+	}
+	data.DataPoints = append(data.DataPoints, metricdata.SummaryDataPoint{
+		Attributes:     attrs,
+		StartTime:      c.start,
+		Time:           c.end,
+		Count:          uint64(s.Count),
+		Sum:            s.Sum,
+		QuantileValues: quantiles,
+	})
+	m.Data = data
+}
+
+func (c *converter) addHistogram(name string, attrs attribute.Set, h *model.Histogram) {
+	if h == nil {
+		return
+	}
+	m := c.metric(name, kindHistogram)
+	data := m.Data.(metricdata.Histogram[float64])
+	bounds, counts := explicitBuckets(h.Buckets)
+	data.DataPoints = append(data.DataPoints, metricdata.HistogramDataPoint[float64]{
+		Attributes:   attrs,
+		StartTime:    c.seriesStart,
+		Time:         c.end,
+		Count:        uint64(h.Count),
+		Sum:          h.Sum,
+		Bounds:       bounds,
+		BucketCounts: counts,
+		Exemplars:    exemplars(h.Buckets),
+	})
+	m.Data = data
+}
+
+func (c *converter) addExponentialHistogram(name string, attrs attribute.Set, h *model.ExponentialHistogram) {
+	if h == nil {
+		return
+	}
+	m := c.metric(name, kindExponentialHistogram)
+	data := m.Data.(metricdata.ExponentialHistogram[float64])
+	data.DataPoints = append(data.DataPoints, metricdata.ExponentialHistogramDataPoint[float64]{
+		Attributes:     attrs,
+		StartTime:      c.start,
+		Time:           c.end,
+		Count:          uint64(h.Count),
+		Sum:            h.Sum,
+		Scale:          h.Scale,
+		ZeroCount:      uint64(h.ZeroCount),
+		PositiveBucket: exponentialBucket(h.Positive),
+		NegativeBucket: exponentialBucket(h.Negative),
+	})
+	m.Data = data
+}
+
+// exponentialBucket 指数直方图分桶转换成 OTLP 分桶。
+func exponentialBucket(b *model.ExponentialBuckets) metricdata.ExponentialBucket {
+	if b == nil {
+		return metricdata.ExponentialBucket{}
+	}
+	counts := make([]uint64, len(b.BucketCounts))
+	for i, c := range b.BucketCounts {
+		counts[i] = uint64(c)
+	}
+	return metricdata.ExponentialBucket{Offset: b.Offset, Counts: counts}
+}
+
+// exemplars 收集各个分桶的 exemplar。
+func exemplars(buckets []*model.Bucket) []metricdata.Exemplar[float64] {
+	var out []metricdata.Exemplar[float64]
+	for _, b := range buckets {
+		e := b.Exemplar
+		if e == nil {
+			continue
+		}
+		out = append(out, metricdata.Exemplar[float64]{
+			Time:    time.UnixMilli(e.TimestampMs),
+			Value:   e.Value,
+			SpanID:  e.SpanId,
+			TraceID: e.TraceId,
+		})
+	}
+	return out
+}
+
+// explicitBuckets 将 vmrange 分桶转换成 OTLP 显式分桶。
+// otp 只上报非 0 的分桶，每个分桶的上界作为 OTLP 的一个边界，上界为 +Inf 的分桶计入最后的溢出桶。
+// vmrange 分桶是左闭右开区间，OTLP 是左开右闭区间，只有恰好落在边界上的数据会有差异，可以忽略。
+func explicitBuckets(buckets []*model.Bucket) ([]float64, []uint64) {
+	ends := make([]float64, 0, len(buckets))
+	parsed := make([]float64, len(buckets))
+	for i, b := range buckets {
+		_, end, err := strings.ParseVMRange(b.Range)
+		if err != nil {
+			end = math.NaN()
+		}
+		parsed[i] = end
+		if !math.IsNaN(end) && !math.IsInf(end, 0) {
+			ends = append(ends, end)
+		}
+	}
+	sort.Float64s(ends)
+	bounds := ends[:0]
+	for i, e := range ends {
+		if i == 0 || e != ends[i-1] {
+			bounds = append(bounds, e)
+		}
+	}
+	counts := make([]uint64, len(bounds)+1)
+	for i, b := range buckets {
+		end := parsed[i]
+		if math.IsNaN(end) || math.IsInf(end, -1) {
+			continue
+		}
+		counts[sort.SearchFloat64s(bounds, end)] += uint64(b.Count)
+	}
+	return bounds, counts
+}
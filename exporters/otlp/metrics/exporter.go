@@ -0,0 +1,173 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+
+	"galiosight.ai/galio-sdk-go/components"
+	"galiosight.ai/galio-sdk-go/configs"
+	expres "galiosight.ai/galio-sdk-go/internal/resource"
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/semconv"
+)
+
+// defaultURLPath OTLP/HTTP 指标上报的默认路径。
+const defaultURLPath = "/v1/metrics"
+
+// otlpExporter 将 OMP 处理器聚合后的 otp 指标转换成 OTLP 格式，上报到 OpenTelemetry collector。
+// 用于没有部署伽利略 collector，只有原生 OpenTelemetry collector 的场景。
+type otlpExporter struct {
+	cfg   *configs.Metrics
+	res   *resource.Resource
+	out   chan *model.Metrics
+	stats *model.SelfMonitorStats
+
+	mu       sync.RWMutex
+	addr     string
+	exporter *otlpmetrichttp.Exporter
+}
+
+var _ components.MetricsExporter = (*otlpExporter)(nil)
+
+// NewExporter 根据配置创建 OTLP 指标导出器。
+// collector 地址可以是 host:port，也可以是完整的 url，未指定路径时使用 /v1/metrics。
+func NewExporter(cfg *configs.Metrics) (components.MetricsExporter, error) {
+	cfg = mergeDefaultCfg(cfg)
+	exporter, err := newOTLPExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	e := &otlpExporter{
+		cfg:      cfg,
+		res:      expres.GenResource(cfg.SchemaURL, &cfg.Resource, expres.SchemaTypeMetric),
+		out:      make(chan *model.Metrics, cfg.Exporter.BufferSize),
+		stats:    cfg.Stats,
+		addr:     cfg.Exporter.Collector.Addr,
+		exporter: exporter,
+	}
+	go e.worker()
+	return e, nil
+}
+
+// mergeDefaultCfg 如果 cfg 参数未配置，使用默认配置填充。
+func mergeDefaultCfg(cfg *configs.Metrics) *configs.Metrics {
+	if cfg.Stats == nil {
+		cfg.Stats = &model.SelfMonitorStats{}
+	}
+	if cfg.SchemaURL == "" {
+		cfg.SchemaURL = semconv.SchemaURL
+	}
+	if cfg.Exporter.BufferSize <= 0 {
+		cfg.Exporter.BufferSize = 1000
+	}
+	if cfg.Exporter.WindowSeconds < 1 {
+		cfg.Exporter.WindowSeconds = 1
+	}
+	if cfg.Exporter.TimeoutMs <= 0 {
+		cfg.Exporter.TimeoutMs = 1000
+	}
+	return cfg
+}
+
+// newOTLPExporter 创建 otlpmetrichttp 导出器。
+func newOTLPExporter(cfg *configs.Metrics) (*otlpmetrichttp.Exporter, error) {
+	opts := []otlpmetrichttp.Option{
+		otlpmetrichttp.WithTimeout(time.Duration(cfg.Exporter.TimeoutMs) * time.Millisecond),
+		otlpmetrichttp.WithHeaders(
+			map[string]string{
+				model.TenantHeaderKey: cfg.Resource.TenantId,
+				model.TargetHeaderKey: cfg.Resource.Target,
+				model.APIKeyHeaderKey: cfg.APIKey,
+			},
+		),
+	}
+	addr := cfg.Exporter.Collector.Addr
+	if strings.Contains(addr, "://") {
+		opts = append(opts, otlpmetrichttp.WithEndpointURL(addr))
+		if path := addr[strings.Index(addr, "://")+3:]; !strings.Contains(path, "/") {
+			opts = append(opts, otlpmetrichttp.WithURLPath(defaultURLPath))
+		}
+	} else {
+		opts = append(opts, otlpmetrichttp.WithEndpoint(addr), otlpmetrichttp.WithInsecure())
+	}
+	return otlpmetrichttp.New(context.Background(), opts...)
+}
+
+// GetStats 返回自监控统计数据。
+// 注意返回的是指针，调用方只能读取结果，不能修改它。
+func (e *otlpExporter) GetStats() *model.SelfMonitorStats {
+	return e.stats
+}
+
+// UpdateConfig 更新配置，collector 地址变化时重新创建 otlpmetrichttp 导出器。
+func (e *otlpExporter) UpdateConfig(cfg *configs.Metrics) {
+	addr := cfg.Exporter.Collector.Addr
+	e.mu.RLock()
+	changed := addr != e.addr
+	e.mu.RUnlock()
+	if !changed {
+		return
+	}
+	exporter, err := newOTLPExporter(mergeDefaultCfg(cfg))
+	if err != nil {
+		e.cfg.Log.Errorf("[galileo]otlpExporter.UpdateConfig|addr=%v,err=%v", addr, err)
+		return
+	}
+	e.mu.Lock()
+	old := e.exporter
+	e.addr = addr
+	e.exporter = exporter
+	e.mu.Unlock()
+	_ = old.Shutdown(context.Background())
+}
+
+// Export 将数据放到 chan 中，异步转换并上报。
+// 该函数是并发安全的，chan 满的话，会阻塞住。
+func (e *otlpExporter) Export(metrics *model.Metrics) {
+	e.out <- metrics
+}
+
+// worker 按顺序转换并上报 chan 中的数据。
+// OTLP 单个请求中的数据点没有数量限制，一个窗口的数据在一个请求中发送，不需要分页。
+func (e *otlpExporter) worker() {
+	window := time.Duration(e.cfg.Exporter.WindowSeconds) * time.Second
+	for metrics := range e.out {
+		rm := toResourceMetrics(e.res, metrics, window)
+		e.mu.RLock()
+		exporter := e.exporter
+		e.mu.RUnlock()
+		err := exporter.Export(context.Background(), rm)
+		rows := int64(len(metrics.ClientMetrics) + len(metrics.ServerMetrics) + len(metrics.NormalMetrics) +
+			len(metrics.CustomMetrics))
+		e.stats.MetricsStats.ReportHandledTotal.Inc()
+		e.stats.MetricsStats.ReportHandledRowsTotal.Add(rows)
+		if err != nil {
+			e.cfg.Log.Errorf("[galileo]otlpExporter.worker|err=%v", err)
+			e.stats.MetricsStats.ReportErrorTotal.Inc()
+			e.stats.MetricsStats.ReportErrorRowsTotal.Add(rows)
+		}
+	}
+}
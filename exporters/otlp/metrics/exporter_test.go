@@ -0,0 +1,232 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/protobuf/proto"
+
+	"galiosight.ai/galio-sdk-go/configs"
+	"galiosight.ai/galio-sdk-go/lib/logs"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+func testOTPMetrics() *model.Metrics {
+	rpcLabels := &model.RPCLabels{Fields: []model.RPCLabels_Field{
+		{Name: model.RPCLabels_caller_service, Value: "a"},
+		{Name: model.RPCLabels_callee_service, Value: "b"},
+	}}
+	histogram := &model.Histogram{
+		Sum:   1.5,
+		Count: 4,
+		Buckets: []*model.Bucket{
+			{Range: "1.000e-01...2.000e-01", Count: 1},
+			{Range: "5.000e-01...1.000e+00", Count: 2, Exemplar: &model.Exemplar{
+				Value: 0.8, TimestampMs: 1, TraceId: make([]byte, 16), SpanId: make([]byte, 8), Sampled: true,
+			}},
+			{Range: "1.000e+00...+Inf", Count: 1},
+		},
+	}
+	return &model.Metrics{
+		TimestampMs: time.Now().UnixMilli(),
+		ClientMetrics: []*model.ClientMetricsOTP{{
+			RpcClientStartedTotal:   4,
+			RpcClientHandledTotal:   4,
+			RpcClientHandledSeconds: histogram,
+			RpcLabels:               rpcLabels,
+		}},
+		ServerMetrics: []*model.ServerMetricsOTP{{
+			RpcServerStartedTotal: 2,
+			RpcServerHandledTotal: 2,
+			RpcLabels:             rpcLabels,
+		}},
+		NormalMetrics: []*model.NormalMetricOTP{
+			{Metric: &model.MetricOTP{
+				Name: "cpu", V: model.NewOTPValue(0.5), Aggregation: model.Aggregation_AGGREGATION_SET,
+			}},
+			{Metric: &model.MetricOTP{
+				Name: "mem_max", V: model.NewOTPValue(100), Aggregation: model.Aggregation_AGGREGATION_MAX,
+			}},
+		},
+		CustomMetrics: []*model.CustomMetricsOTP{{
+			Metrics: []*model.MetricOTP{
+				{Name: "req_total", V: model.NewOTPValue(3), Aggregation: model.Aggregation_AGGREGATION_COUNTER},
+				{Name: "bytes_sum", V: model.NewOTPValue(7), Aggregation: model.Aggregation_AGGREGATION_SUM},
+				{Name: "cost_avg", V: model.NewOTPAvg(6, 3), Aggregation: model.Aggregation_AGGREGATION_AVG},
+				{
+					Name: "cost_exp",
+					V: model.NewOTPExponentialHistogram(&model.ExponentialHistogram{
+						Sum: 3.5, Count: 4, Scale: 1, ZeroCount: 1,
+						Positive: &model.ExponentialBuckets{Offset: -1, BucketCounts: []int64{2, 0, 1}},
+					}),
+					Aggregation: model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM,
+				},
+				{
+					Name:        "size_summary",
+					V:           model.NewOTPSummary(30, 3, []float64{0.5, 0.99}, []float64{10, 19.8}),
+					Aggregation: model.Aggregation_AGGREGATION_SUMMARY,
+				},
+			},
+			CustomLabels: []*model.Label{{Name: "_group", Value: "g"}},
+			MonitorName:  "monitor",
+		}},
+	}
+}
+
+func Test_toResourceMetrics(t *testing.T) {
+	m := testOTPMetrics()
+	rm := toResourceMetrics(nil, m, 10*time.Second)
+	require.Len(t, rm.ScopeMetrics, 1)
+	byName := map[string]metricdata.Aggregation{}
+	for _, metric := range rm.ScopeMetrics[0].Metrics {
+		byName[metric.Name] = metric.Data
+	}
+	assert.Len(t, byName, 12)
+
+	started := byName["rpc_client_started_total"].(metricdata.Sum[float64])
+	assert.True(t, started.IsMonotonic)
+	assert.Equal(t, metricdata.DeltaTemporality, started.Temporality)
+	require.Len(t, started.DataPoints, 1)
+	assert.Equal(t, 4.0, started.DataPoints[0].Value)
+	assert.Equal(t, 10*time.Second, started.DataPoints[0].Time.Sub(started.DataPoints[0].StartTime))
+	v, ok := started.DataPoints[0].Attributes.Value("caller_service")
+	assert.True(t, ok)
+	assert.Equal(t, "a", v.AsString())
+
+	seconds := byName["rpc_client_handled_seconds"].(metricdata.Histogram[float64])
+	require.Len(t, seconds.DataPoints, 1)
+	assert.Equal(t, []float64{0.2, 1}, seconds.DataPoints[0].Bounds)
+	assert.Equal(t, []uint64{1, 2, 1}, seconds.DataPoints[0].BucketCounts)
+	assert.Equal(t, uint64(4), seconds.DataPoints[0].Count)
+	require.Len(t, seconds.DataPoints[0].Exemplars, 1)
+	assert.Equal(t, 0.8, seconds.DataPoints[0].Exemplars[0].Value)
+	assert.Len(t, seconds.DataPoints[0].Exemplars[0].TraceID, 16)
+	// 服务端没有耗时分布，不导出。
+	_, ok = byName["rpc_server_handled_seconds"]
+	assert.False(t, ok)
+
+	assert.Equal(t, 0.5, byName["cpu"].(metricdata.Gauge[float64]).DataPoints[0].Value)
+	assert.Equal(t, 100.0, byName["mem_max"].(metricdata.Gauge[float64]).DataPoints[0].Value)
+	assert.True(t, byName["req_total"].(metricdata.Sum[float64]).IsMonotonic)
+	assert.False(t, byName["bytes_sum"].(metricdata.Sum[float64]).IsMonotonic)
+	avg := byName["cost_avg"].(metricdata.Summary).DataPoints[0]
+	assert.Equal(t, uint64(3), avg.Count)
+	assert.Equal(t, 6.0, avg.Sum)
+	v, _ = avg.Attributes.Value("_group")
+	assert.Equal(t, "g", v.AsString())
+	exp := byName["cost_exp"].(metricdata.ExponentialHistogram[float64])
+	assert.Equal(t, metricdata.DeltaTemporality, exp.Temporality)
+	require.Len(t, exp.DataPoints, 1)
+	assert.Equal(t, int32(1), exp.DataPoints[0].Scale)
+	assert.Equal(t, uint64(4), exp.DataPoints[0].Count)
+	assert.Equal(t, uint64(1), exp.DataPoints[0].ZeroCount)
+	assert.Equal(t, int32(-1), exp.DataPoints[0].PositiveBucket.Offset)
+	assert.Equal(t, []uint64{2, 0, 1}, exp.DataPoints[0].PositiveBucket.Counts)
+	assert.Empty(t, exp.DataPoints[0].NegativeBucket.Counts)
+	summary := byName["size_summary"].(metricdata.Summary).DataPoints[0]
+	assert.Equal(t, uint64(3), summary.Count)
+	assert.Equal(t, []metricdata.QuantileValue{{Quantile: 0.5, Value: 10}, {Quantile: 0.99, Value: 19.8}},
+		summary.QuantileValues)
+}
+
+func Test_toResourceMetrics_Cumulative(t *testing.T) {
+	m := testOTPMetrics()
+	m.Temporality = model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE
+	start := time.UnixMilli(m.TimestampMs).Add(-time.Hour)
+	m.ClientMetrics[0].StartTimestampMs = start.UnixMilli()
+	rm := toResourceMetrics(nil, m, 10*time.Second)
+	byName := map[string]metricdata.Aggregation{}
+	for _, metric := range rm.ScopeMetrics[0].Metrics {
+		byName[metric.Name] = metric.Data
+	}
+	started := byName["rpc_client_started_total"].(metricdata.Sum[float64])
+	assert.Equal(t, metricdata.CumulativeTemporality, started.Temporality)
+	assert.True(t, start.Equal(started.DataPoints[0].StartTime))
+	seconds := byName["rpc_client_handled_seconds"].(metricdata.Histogram[float64])
+	assert.Equal(t, metricdata.CumulativeTemporality, seconds.Temporality)
+	assert.True(t, start.Equal(seconds.DataPoints[0].StartTime))
+	// 未设置开始时间的时间线使用窗口开始时间。
+	req := byName["req_total"].(metricdata.Sum[float64])
+	assert.Equal(t, metricdata.CumulativeTemporality, req.Temporality)
+	assert.Equal(t, 10*time.Second, req.DataPoints[0].Time.Sub(req.DataPoints[0].StartTime))
+	// 指数直方图不累计。
+	assert.Equal(t, metricdata.DeltaTemporality, byName["cost_exp"].(metricdata.ExponentialHistogram[float64]).Temporality)
+}
+
+func Test_explicitBuckets(t *testing.T) {
+	bounds, counts := explicitBuckets([]*model.Bucket{
+		{Range: "1.000e+00...+Inf", Count: 1},
+		{Range: "-Inf...0.000e+00", Count: 5},
+		{Range: "bad", Count: 9},
+		{Range: "1.000e-01...2.000e-01", Count: 2},
+	})
+	assert.Equal(t, []float64{0, 0.2}, bounds)
+	assert.Equal(t, []uint64{5, 2, 1}, counts)
+
+	bounds, counts = explicitBuckets(nil)
+	assert.Empty(t, bounds)
+	assert.Equal(t, []uint64{0}, counts)
+}
+
+func TestOTLPExporter_Export(t *testing.T) {
+	received := make(chan *colmetricpb.ExportMetricsServiceRequest, 1)
+	var path, tenant string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, tenant = r.URL.Path, r.Header.Get(model.TenantHeaderKey)
+		body, _ := io.ReadAll(r.Body)
+		req := &colmetricpb.ExportMetricsServiceRequest{}
+		_ = proto.Unmarshal(body, req)
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		received <- req
+	}))
+	defer srv.Close()
+
+	cfg := &configs.Metrics{Log: logs.DefaultWrapper()}
+	cfg.Resource.TenantId = "tenant"
+	cfg.Resource.Target = "PCG-123.demo.server"
+	cfg.Exporter.Collector.Addr = srv.URL
+	e, err := NewExporter(cfg)
+	require.Nil(t, err)
+	e.Export(testOTPMetrics())
+
+	select {
+	case req := <-received:
+		assert.Equal(t, defaultURLPath, path)
+		assert.Equal(t, "tenant", tenant)
+		require.Len(t, req.ResourceMetrics, 1)
+		assert.NotEmpty(t, req.ResourceMetrics[0].Resource.Attributes)
+		require.Len(t, req.ResourceMetrics[0].ScopeMetrics, 1)
+		assert.Len(t, req.ResourceMetrics[0].ScopeMetrics[0].Metrics, 12)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no request received")
+	}
+	assert.Eventually(t, func() bool {
+		return e.GetStats().MetricsStats.ReportHandledTotal.Load() == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int64(0), e.GetStats().MetricsStats.ReportErrorTotal.Load())
+}
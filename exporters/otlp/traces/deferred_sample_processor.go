@@ -139,6 +139,7 @@ func randSample(fraction float64) bool {
 type DeferredSampleProcessor struct {
 	next            sdktrace.SpanProcessor
 	deferredSampler DeferredSampler
+	tail            *TailSampler // 尾部采样，未命中后置采样的 span 交由其缓冲
 }
 
 // NewDeferredSampleProcessor 创建一个延迟采样 processor
@@ -161,6 +162,10 @@ func (p *DeferredSampleProcessor) OnStart(parent context.Context, s sdktrace.Rea
 // OnEnd is called when span is finished. It is called synchronously and
 // hence not block.
 func (p *DeferredSampleProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if isMetricsOnly(s) {
+		// 采样器丢弃的 span，只用于主被调监控
+		return
+	}
 	if p.deferredSampler == nil {
 		// keep
 		p.next.OnEnd(s)
@@ -168,10 +173,18 @@ func (p *DeferredSampleProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
 	}
 	strategy := p.deferredSampler.ShouldSample(s)
 	if strategy >= tracestate.StrategyMatch {
+		if p.tail != nil && !s.SpanContext().IsSampled() {
+			// 后置采样命中，同一 trace 片段中已缓冲的 span 一并上报
+			p.tail.release(s.SpanContext().TraceID(), p.next)
+		}
 		// keep
 		p.next.OnEnd(s)
 		return
 	}
+	if p.tail != nil {
+		p.tail.offer(s, p.next)
+		return
+	}
 	// drop
 }
 
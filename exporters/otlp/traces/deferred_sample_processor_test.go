@@ -5,6 +5,7 @@ package traces
 
 import (
 	"context"
+	"runtime/pprof"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 
 	traceconf "galiosight.ai/galio-sdk-go/configs/traces"
 	"galiosight.ai/galio-sdk-go/exporters/otlp/traces/tracestate"
+	"galiosight.ai/galio-sdk-go/model"
 )
 
 type suited struct {
@@ -134,6 +136,20 @@ func (s *suited) TestShouldSample() {
 func (s *suited) TestProfileSpan() {
 	nctx, sp := s.profile.Start(s.ctx, "profile-span")
 	s.True(trace.SpanFromContext(nctx) == sp)
+	endpoint, _ := pprof.Label(nctx, model.ProfileLabelEndpoint)
+	s.Equal("profile-span", endpoint)
+	spanID, _ := pprof.Label(nctx, model.ProfileLabelSpanID)
+	s.Equal(sp.SpanContext().SpanID().String(), spanID)
+
+	// 子 span 沿用根 span 的接口名
+	cctx, child := s.profile.Start(nctx, "child-span")
+	endpoint, _ = pprof.Label(cctx, model.ProfileLabelEndpoint)
+	s.Equal("profile-span", endpoint)
+	child.SetStatus(codes.Error, "")
+	child.End()
+	s.Contains(child.(Span).Attributes(), profileLinkKey.Bool(true))
+	s.True(child.(Span).IsSampled())
+	sp.End()
 }
 
 func TestDefer(t *testing.T) {
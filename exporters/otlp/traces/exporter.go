@@ -41,6 +41,7 @@ type exporter struct {
 	trace.Tracer
 	sampler       *adaptiveSampler
 	deferred      DeferredSampler
+	tail          *TailSampler
 	enableProfile bool // 是否开启 span 与 profile 关联
 }
 
@@ -70,6 +71,7 @@ func (e *exporter) UpdateConfig(cfg *configs.Traces) {
 	e.enableProfile = cfg.Processor.EnableProfile
 	e.sampler.UpdateConfig(updateSamplerOption(&cfg.Processor)...)
 	e.deferred.UpdateConfig(updateDeferredConfig(&cfg.Processor))
+	e.tail.UpdateConfig(updateTailConfig(&cfg.Processor))
 }
 
 // Start 创建一个 span 和包含这个 span 的 context
@@ -77,16 +79,21 @@ func (e *exporter) Start(
 	ctx context.Context,
 	spanName string, opts ...trace.SpanStartOption,
 ) (context.Context, trace.Span) {
+	parent := ctx
 	ctx, span := e.Tracer.Start(ctx, spanName, opts...)
 	ds := NewSpan(span)
-	if span.SpanContext().IsSampled() && e.enableProfile {
+	// 未采样但记录的 span 可能被后置采样命中，同样需要 pprof label
+	if span.IsRecording() && e.enableProfile {
 		s := &profileSpan{
 			Span:      ds,
 			originCtx: ctx,
+			deferred:  e.deferred,
 		}
-		var labels []string
+		endpoint := profileEndpoint(parent, spanName)
+		ctx = context.WithValue(ctx, profileEndpointKey{}, endpoint)
+		labels := []string{model.ProfileLabelEndpoint, endpoint}
 		if span.SpanContext().HasSpanID() {
-			labels = append(labels, "span_id", span.SpanContext().SpanID().String())
+			labels = append(labels, model.ProfileLabelSpanID, span.SpanContext().SpanID().String())
 		}
 		if spanName != "" {
 			labels = append(labels, "span_name", spanName)
@@ -134,14 +141,32 @@ func updateDeferredConfig(tracesProcessor *model.TracesProcessor) *DeferredSampl
 	}
 }
 
+func updateTailConfig(tracesProcessor *model.TracesProcessor) *TailSampleConfig {
+	tail := &tracesProcessor.TailSampler
+	cfg := &TailSampleConfig{
+		// 尾部采样依赖后置采样，未开启时 span 不会被记录
+		Enabled:      tail.Enable && tracesProcessor.EnableDeferredSample,
+		DecisionWait: time.Duration(tail.DecisionWaitMs) * time.Millisecond,
+		MaxSpans:     int(tail.MaxSpans),
+		MaxBytes:     tail.MaxBytes,
+		Attributes:   tail.Attributes,
+	}
+	if tail.SampleDyeing && tracesProcessor.Sampler.EnableDyeing {
+		cfg.Dyeing = tracesProcessor.Sampler.Dyeing
+	}
+	return cfg
+}
+
 // NewExporter 构建 galileo trace exporter
 func NewExporter(cfg *configs.Traces) (components.TracesExporter, error) {
 	sampler := NewAdaptiveSampler(updateSamplerOption(&cfg.Processor)...)
 	deferredSampler := NewWorkflowDefer(NewDeferredSampler(updateDeferredConfig(&cfg.Processor)))
+	tailSampler := NewTailSampler(updateTailConfig(&cfg.Processor))
 	tp, err := NewTracerProvider(
 		cfg.Exporter.Collector.Addr,
 		WithSampler(sampler),
 		WithDeferredSampler(deferredSampler),
+		WithTailSampler(tailSampler),
 		WithGRPCDialOption(grpc.WithChainUnaryInterceptor(recovery())),
 		WithModel(cfg.SchemaURL, &cfg.Resource),
 		WithBatchSpanProcessorOption(
@@ -168,6 +193,7 @@ func NewExporter(cfg *configs.Traces) (components.TracesExporter, error) {
 		Tracer:   tp.Tracer(""),
 		sampler:  sampler,
 		deferred: deferredSampler,
+		tail:     tailSampler,
 	}
 	ep.UpdateConfig(cfg)
 	tpw := &tracerProviderWrapper{
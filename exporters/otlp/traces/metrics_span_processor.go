@@ -0,0 +1,261 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/atomic"
+
+	"galiosight.ai/galio-sdk-go/components"
+	"galiosight.ai/galio-sdk-go/configs/ocp"
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/semconv"
+	semconv1 "galiosight.ai/galio-sdk-go/semconv/v1.0.0"
+)
+
+var _ sdktrace.SpanProcessor = (*MetricsSpanProcessor)(nil)
+
+// rpcLabelKeys span 属性到主被调监控标签的映射，同时兼容 v1.0.0 的 trpc 属性。
+var rpcLabelKeys = map[attribute.Key]model.RPCLabels_FieldName{
+	semconv.RPCCallerServiceKey:   model.RPCLabels_caller_service,
+	semconv.RPCCallerMethodKey:    model.RPCLabels_caller_method,
+	semconv.RPCCallerSetKey:       model.RPCLabels_caller_con_setid,
+	semconv.RPCCallerIPKey:        model.RPCLabels_caller_ip,
+	semconv.RPCCallerContainerKey: model.RPCLabels_caller_container,
+	semconv.RPCCallerGroupKey:     model.RPCLabels_caller_group,
+	semconv.RPCCallerServerKey:    model.RPCLabels_caller_server,
+	semconv.RPCCalleeServiceKey:   model.RPCLabels_callee_service,
+	semconv.RPCCalleeMethodKey:    model.RPCLabels_callee_method,
+	semconv.RPCCalleeSetKey:       model.RPCLabels_callee_con_setid,
+	semconv.RPCCalleeIPKey:        model.RPCLabels_callee_ip,
+	semconv.RPCCalleeContainerKey: model.RPCLabels_callee_container,
+	semconv.RPCCalleeServerKey:    model.RPCLabels_callee_server,
+	semconv.RPCErrorCodeKey:       model.RPCLabels_code,
+	semconv.RPCUserExt1Key:        model.RPCLabels_user_ext1,
+	semconv.RPCUserExt2Key:        model.RPCLabels_user_ext2,
+	semconv.RPCUserExt3Key:        model.RPCLabels_user_ext3,
+	semconv.RPCCanaryKey:          model.RPCLabels_canary,
+	semconv.RPCFlowTagKey:         model.RPCLabels_flow_tag,
+
+	semconv1.TrpcCallerServiceKey: model.RPCLabels_caller_service,
+	semconv1.TrpcCallerMethodKey:  model.RPCLabels_caller_method,
+	semconv1.TrpcCallerServerKey:  model.RPCLabels_caller_server,
+	semconv1.TrpcCalleeServiceKey: model.RPCLabels_callee_service,
+	semconv1.TrpcCalleeMethodKey:  model.RPCLabels_callee_method,
+	semconv1.TrpcCalleeServerKey:  model.RPCLabels_callee_server,
+	semconv1.TrpcStatusCodeKey:    model.RPCLabels_code,
+}
+
+// metricsOnlyKey 标记只为主被调监控记录的 span，DeferredSampleProcessor 直接丢弃，不参与后置采样及上报。
+var metricsOnlyKey = attribute.Key("galileo.metrics_only")
+
+// recordUnsampledSampler 将被包装采样器不采样的 span 改为 RecordOnly，使 MetricsSpanProcessor 能统计到所有 span。
+type recordUnsampledSampler struct {
+	sdktrace.Sampler
+}
+
+// RecordUnsampled 包装 TracerProvider 的采样器，sampler 不采样的 span 改为 RecordOnly（只用于统计，不上报），
+// 与 MetricsSpanProcessor 注册到同一个 TracerProvider，监控数据不受 trace 采样率影响。
+// 只影响使用该采样器的 TracerProvider，sampler 可以是任意采样器。
+func RecordUnsampled(sampler sdktrace.Sampler) sdktrace.Sampler {
+	return recordUnsampledSampler{Sampler: sampler}
+}
+
+// ShouldSample 不采样时改为 RecordOnly，并标记为只用于主被调监控。
+func (r recordUnsampledSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	res := r.Sampler.ShouldSample(p)
+	if res.Decision == sdktrace.Drop {
+		res.Decision = sdktrace.RecordOnly
+		res.Attributes = append(res.Attributes, metricsOnlyKey.Bool(true))
+	}
+	return res
+}
+
+// Description 返回采样器描述。
+func (r recordUnsampledSampler) Description() string {
+	return "RecordUnsampled{" + r.Sampler.Description() + "}"
+}
+
+// isMetricsOnly 是否是只为主被调监控记录的 span。
+func isMetricsOnly(s sdktrace.ReadOnlySpan) bool {
+	if s.SpanContext().IsSampled() {
+		return false
+	}
+	for _, attr := range s.Attributes() {
+		if attr.Key == metricsOnlyKey {
+			return true
+		}
+	}
+	return false
+}
+
+// MetricsSpanProcessor 将结束的 client/server span 转换成主被调监控（请求量、错误、耗时），
+// 上报到 MetricsProcessor，避免同一个 RPC 既埋点监控又埋点 trace。
+// 通过 WithMetricsSpanProcessor 注册时，该 TracerProvider 的采样器对不采样的 span 也返回 RecordOnly
+// （只用于统计，不上报），在 OnEnd 中统计所有 span，因此监控数据不受 trace 采样率影响；
+// 自行创建 TracerProvider 时，使用 RecordUnsampled 包装采样器达到同样效果，否则只统计采样的 span。
+type MetricsSpanProcessor struct {
+	processor          func() components.MetricsProcessor
+	target             string
+	retCodeAsException atomic.Bool
+}
+
+// MetricsSpanProcessorOption MetricsSpanProcessor 选项
+type MetricsSpanProcessorOption func(*MetricsSpanProcessor)
+
+// WithRetCodeAsException 返回码是否当成异常，与监控处理器的 ret_code_as_exception 一致
+func WithRetCodeAsException(enabled bool) MetricsSpanProcessorOption {
+	return func(p *MetricsSpanProcessor) {
+		p.retCodeAsException.Store(enabled)
+	}
+}
+
+// WithOCPTarget 观察 target 的 OCP 配置，ret_code_as_exception 使用 processor.ret_code_as_exception，
+// 覆盖 WithRetCodeAsException 的设置。target 需要已经通过 ocp.RegisterResource 注册。
+func WithOCPTarget(target string) MetricsSpanProcessorOption {
+	return func(p *MetricsSpanProcessor) {
+		p.target = target
+	}
+}
+
+// NewMetricsSpanProcessor 创建 MetricsSpanProcessor，processor 每次上报时调用，
+// 以便使用最新设置的默认监控处理器。
+func NewMetricsSpanProcessor(
+	processor func() components.MetricsProcessor,
+	opts ...MetricsSpanProcessorOption,
+) *MetricsSpanProcessor {
+	p := &MetricsSpanProcessor{processor: processor}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.target != "" {
+		if u := ocp.GetUpdater(p.target); u != nil {
+			p.Watch(u.GetConfig())
+		}
+		ocp.AddWatcher(p.target, p)
+	}
+	return p
+}
+
+// Watch 观察配置，更新 ret_code_as_exception
+func (p *MetricsSpanProcessor) Watch(readOnlyConfig *ocp.GalileoConfig) {
+	p.retCodeAsException.Store(readOnlyConfig.Config.MetricsConfig.Processor.RetCodeAsException)
+}
+
+// OnStart is called when a span is started. It is called synchronously
+// and should not block.
+func (p *MetricsSpanProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) {
+}
+
+// OnEnd is called when span is finished. It is called synchronously and
+// hence not block.
+func (p *MetricsSpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	switch s.SpanKind() {
+	case trace.SpanKindClient:
+		c := model.GetClientMetrics(0)
+		defer model.PutClientMetrics(c)
+		c.RpcLabels.Fields = p.rpcLabels(c.RpcLabels.Fields[:0], s)
+		c.Metrics[model.ClientMetricStartedTotalPoint].Value = 1
+		c.Metrics[model.ClientMetricHandledTotalPoint].Value = 1
+		c.Metrics[model.ClientMetricHandledSecondsPoint].Value = s.EndTime().Sub(s.StartTime()).Seconds()
+		setExemplar(c, s.SpanContext())
+		p.processor().ProcessClientMetrics(c)
+	case trace.SpanKindServer:
+		sm := model.GetServerMetrics(0)
+		defer model.PutServerMetrics(sm)
+		sm.RpcLabels.Fields = p.rpcLabels(sm.RpcLabels.Fields[:0], s)
+		sm.Metrics[model.ServerMetricStartedTotalPoint].Value = 1
+		sm.Metrics[model.ServerMetricHandledTotalPoint].Value = 1
+		sm.Metrics[model.ServerMetricHandledSecondsPoint].Value = s.EndTime().Sub(s.StartTime()).Seconds()
+		setExemplar(sm, s.SpanContext())
+		p.processor().ProcessServerMetrics(sm)
+	}
+}
+
+// rpcLabels 将 span 属性转换成主被调标签，本端的 ip、容器等由监控处理器从 resource 填充。
+func (p *MetricsSpanProcessor) rpcLabels(
+	fields []model.RPCLabels_Field, s sdktrace.ReadOnlySpan,
+) []model.RPCLabels_Field {
+	var code, codeType string
+	for _, attr := range s.Attributes() {
+		if attr.Key == semconv.RPCErrorCodeTypeKey || attr.Key == semconv1.CodeTypeKey {
+			codeType = attr.Value.Emit()
+			continue
+		}
+		name, ok := rpcLabelKeys[attr.Key]
+		if !ok {
+			continue
+		}
+		value := attr.Value.Emit()
+		if name == model.RPCLabels_code {
+			code = value
+			continue
+		}
+		fields = append(fields, model.RPCLabels_Field{Name: name, Value: value})
+	}
+	if codeType == "" {
+		codeType = p.codeType(s.Status(), code)
+	}
+	return append(
+		fields,
+		model.RPCLabels_Field{Name: model.RPCLabels_code, Value: code},
+		model.RPCLabels_Field{Name: model.RPCLabels_code_type, Value: codeType},
+	)
+}
+
+// codeType 根据 span 状态判断成功、异常、超时。
+// span 状态为 Error 时是异常（描述包含 timeout 时为超时），
+// 状态非 Error 但返回码非 0 时，按 ret_code_as_exception 判断是否异常。
+func (p *MetricsSpanProcessor) codeType(status sdktrace.Status, code string) string {
+	if status.Code == codes.Error {
+		if strings.Contains(strings.ToLower(status.Description), semconv1.CodeTypeTimeoutValue) {
+			return semconv1.CodeTypeTimeoutValue
+		}
+		return semconv1.CodeTypeExceptionValue
+	}
+	if code != "" && code != "0" && p.retCodeAsException.Load() {
+		return semconv1.CodeTypeExceptionValue
+	}
+	return semconv1.CodeTypeSuccessValue
+}
+
+func setExemplar(m model.SpanContextSetter, sc trace.SpanContext) {
+	if !sc.IsValid() {
+		return
+	}
+	traceID, spanID := sc.TraceID(), sc.SpanID()
+	m.SetSpanContext(traceID[:], spanID[:], sc.IsSampled())
+}
+
+// Shutdown is called when the SDK shuts down. Any cleanup or release of
+// resources held by the processor should be done in this call.
+func (p *MetricsSpanProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+// ForceFlush 监控数据由 MetricsProcessor 聚合上报，无需处理。
+func (p *MetricsSpanProcessor) ForceFlush(context.Context) error {
+	return nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"galiosight.ai/galio-sdk-go/components"
+	"galiosight.ai/galio-sdk-go/configs/ocp"
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/semconv"
+)
+
+// recordMetricsProcessor 记录主被调监控的标签及耗时
+type recordMetricsProcessor struct {
+	components.NoopMetricsProcessor
+	client  []map[model.RPCLabels_FieldName]string
+	server  []map[model.RPCLabels_FieldName]string
+	seconds []float64
+	sampled []bool
+}
+
+func (r *recordMetricsProcessor) ProcessClientMetrics(c *model.ClientMetrics) {
+	r.client = append(r.client, labelMap(c.RpcLabels))
+	r.seconds = append(r.seconds, c.Metrics[model.ClientMetricHandledSecondsPoint].Value)
+	r.sampled = append(r.sampled, c.SpanContext.Sampled)
+}
+
+func (r *recordMetricsProcessor) ProcessServerMetrics(s *model.ServerMetrics) {
+	r.server = append(r.server, labelMap(s.RpcLabels))
+	r.seconds = append(r.seconds, s.Metrics[model.ServerMetricHandledSecondsPoint].Value)
+	r.sampled = append(r.sampled, s.SpanContext.Sampled)
+}
+
+func labelMap(labels model.RPCLabels) map[model.RPCLabels_FieldName]string {
+	m := map[model.RPCLabels_FieldName]string{}
+	for _, f := range labels.Fields {
+		m[f.Name] = f.Value
+	}
+	return m
+}
+
+func rpcSnapshot(kind trace.SpanKind, status codes.Code, attrs ...attribute.KeyValue) sdktrace.ReadOnlySpan {
+	start := time.Now()
+	return tracetest.SpanStub{
+		Name:     "rpc",
+		SpanKind: kind,
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{1},
+		}),
+		StartTime:  start,
+		EndTime:    start.Add(200 * time.Millisecond),
+		Status:     sdktrace.Status{Code: status, Description: "client timeout"},
+		Attributes: attrs,
+	}.Snapshot()
+}
+
+func TestMetricsSpanProcessor_OnEnd(t *testing.T) {
+	r := &recordMetricsProcessor{}
+	p := NewMetricsSpanProcessor(func() components.MetricsProcessor { return r })
+	defer func() { _ = p.Shutdown(context.Background()) }()
+
+	p.OnEnd(rpcSnapshot(
+		trace.SpanKindClient, codes.Unset,
+		semconv.RPCCalleeServiceKey.String("greeter"),
+		semconv.RPCCalleeMethodKey.String("SayHello"),
+		semconv.RPCErrorCodeKey.Int(101),
+		attribute.String("other", "ignored"),
+	))
+	p.OnEnd(rpcSnapshot(trace.SpanKindServer, codes.Error, semconv.RPCCallerServiceKey.String("gateway")))
+	p.OnEnd(rpcSnapshot(trace.SpanKindInternal, codes.Unset))
+
+	require.Len(t, r.client, 1)
+	require.Len(t, r.server, 1)
+	assert.Equal(t, map[model.RPCLabels_FieldName]string{
+		model.RPCLabels_callee_service: "greeter",
+		model.RPCLabels_callee_method:  "SayHello",
+		model.RPCLabels_code:           "101",
+		model.RPCLabels_code_type:      "success",
+	}, r.client[0])
+	assert.Equal(t, map[model.RPCLabels_FieldName]string{
+		model.RPCLabels_caller_service: "gateway",
+		model.RPCLabels_code:           "",
+		model.RPCLabels_code_type:      "timeout",
+	}, r.server[0])
+	assert.InDelta(t, 0.2, r.seconds[0], 1e-9)
+	// 未采样的 span 也会统计
+	assert.Equal(t, []bool{false, false}, r.sampled)
+}
+
+func TestMetricsSpanProcessor_RetCodeAsException(t *testing.T) {
+	r := &recordMetricsProcessor{}
+	p := NewMetricsSpanProcessor(func() components.MetricsProcessor { return r }, WithRetCodeAsException(true))
+	defer func() { _ = p.Shutdown(context.Background()) }()
+	p.OnEnd(rpcSnapshot(trace.SpanKindClient, codes.Unset, semconv.RPCErrorCodeKey.String("101")))
+	p.OnEnd(rpcSnapshot(trace.SpanKindClient, codes.Unset, semconv.RPCErrorCodeKey.String("0")))
+	// 属性中指定的 code_type 优先
+	p.OnEnd(rpcSnapshot(
+		trace.SpanKindClient, codes.Unset,
+		semconv.RPCErrorCodeKey.String("101"), semconv.RPCErrorCodeTypeKey.String("success"),
+	))
+
+	cfg := &ocp.GalileoConfig{}
+	p.Watch(cfg)
+	p.OnEnd(rpcSnapshot(trace.SpanKindClient, codes.Unset, semconv.RPCErrorCodeKey.String("101")))
+
+	var got []string
+	for _, labels := range r.client {
+		got = append(got, labels[model.RPCLabels_code_type])
+	}
+	assert.Equal(t, []string{"exception", "success", "success", "success"}, got)
+}
+
+func TestMetricsSpanProcessor_Unsampled(t *testing.T) {
+	r := &recordMetricsProcessor{}
+	p := NewMetricsSpanProcessor(func() components.MetricsProcessor { return r })
+	exported := tracetest.NewSpanRecorder()
+	tp, err := NewTracerProvider(
+		"127.0.0.1:0",
+		WithSampler(NewAdaptiveSampler(WithFraction(0), WithEnableMinSample(false))),
+		WithDeferredSampler(NewDeferredSampler(&DeferredSampleConfig{})),
+		WithMetricsSpanProcessor(p),
+	)
+	require.Nil(t, err)
+	defer func() { _ = tp.(*sdktrace.TracerProvider).Shutdown(context.Background()) }()
+	// 同一进程中没有注册 MetricsSpanProcessor 的 TracerProvider 不受影响
+	other := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(NewAdaptiveSampler(WithFraction(0), WithEnableMinSample(false))),
+		sdktrace.WithSpanProcessor(NewDeferredSampleProcessor(exported, NewDeferredSampler(&DeferredSampleConfig{}))),
+	)
+	defer func() { _ = other.Shutdown(context.Background()) }()
+
+	// 采样率为 0 且未开启后置采样，span 不采样，但仍会统计监控
+	_, span := tp.Tracer("test").Start(context.Background(), "rpc", trace.WithSpanKind(trace.SpanKindClient))
+	assert.False(t, span.SpanContext().IsSampled())
+	assert.True(t, span.IsRecording())
+	span.End()
+	require.Len(t, r.client, 1)
+	assert.Equal(t, []bool{false}, r.sampled)
+
+	_, span = other.Tracer("test").Start(context.Background(), "rpc", trace.WithSpanKind(trace.SpanKindClient))
+	assert.False(t, span.IsRecording())
+	span.End()
+	assert.Len(t, r.client, 1)
+	assert.Empty(t, exported.Ended())
+}
+
+func TestRecordUnsampled(t *testing.T) {
+	r := &recordMetricsProcessor{}
+	exported := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(RecordUnsampled(sdktrace.NeverSample())),
+		sdktrace.WithSpanProcessor(NewDeferredSampleProcessor(exported, nil)),
+		sdktrace.WithSpanProcessor(NewMetricsSpanProcessor(func() components.MetricsProcessor { return r })),
+	)
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	// 非伽利略采样器同样生效，只为监控记录的 span 不会上报
+	_, span := tp.Tracer("test").Start(context.Background(), "rpc", trace.WithSpanKind(trace.SpanKindServer))
+	assert.True(t, span.IsRecording())
+	span.End()
+	assert.Len(t, r.server, 1)
+	assert.Empty(t, exported.Ended())
+	assert.Equal(t, "RecordUnsampled{AlwaysOffSampler}", RecordUnsampled(sdktrace.NeverSample()).Description())
+}
+
+func TestMetricsSpanProcessor_OCPTarget(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "galileo.yaml")
+	writeConfig := func(content string) {
+		require.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	writeConfig("metrics_config: {processor: {ret_code_as_exception: true}}")
+	resource := model.NewResource(
+		"Galileo-Dial", "galileo", "MetricsSpan", "MetricsSpanProcessor", model.Production, "formal", "", "", "", "",
+	)
+	require.Nil(t, ocp.RegisterResource(resource, ocp.WithFileSource(path), ocp.WithDuration(time.Hour)))
+	defer func() { _ = ocp.UnregisterResource(resource.Target) }()
+
+	r := &recordMetricsProcessor{}
+	p := NewMetricsSpanProcessor(func() components.MetricsProcessor { return r }, WithOCPTarget(resource.Target))
+	p.OnEnd(rpcSnapshot(trace.SpanKindClient, codes.Unset, semconv.RPCErrorCodeKey.String("101")))
+
+	// OCP 配置更新后，按新的 ret_code_as_exception 判断
+	writeConfig("metrics_config: {processor: {ret_code_as_exception: false}}\n")
+	require.True(t, ocp.GetUpdater(resource.Target).Update())
+	p.OnEnd(rpcSnapshot(trace.SpanKindClient, codes.Unset, semconv.RPCErrorCodeKey.String("101")))
+
+	require.Len(t, r.client, 2)
+	assert.Equal(t, "exception", r.client[0][model.RPCLabels_code_type])
+	assert.Equal(t, "success", r.client[1][model.RPCLabels_code_type])
+}
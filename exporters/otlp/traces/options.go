@@ -34,3 +34,15 @@ func TracerProviderOptions() []sdk.TracerProviderOption {
 func WithTracerProviderOptions(opts ...sdk.TracerProviderOption) {
 	extraTraceProviderOptions = append(extraTraceProviderOptions, opts...)
 }
+
+var extraSetupOptions []SetupOption
+
+// SetupOptions returns the extra setup options.
+func SetupOptions() []SetupOption {
+	return extraSetupOptions
+}
+
+// WithSetupOptions sets the extra setup options applied by NewTracerProvider.
+func WithSetupOptions(opts ...SetupOption) {
+	extraSetupOptions = append(extraSetupOptions, opts...)
+}
@@ -22,24 +22,50 @@ import (
 	"context"
 	"runtime/pprof"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
+// profileLinkKey 标记 span 关联了 CPU profile，可以按 span id 查看火焰图
+var profileLinkKey = attribute.Key("galileo.profile")
+
+// profileEndpointKey context 中保存进程内根 span 的名称，子 span 的 pprof label 沿用
+type profileEndpointKey struct{}
+
 type profileSpan struct {
 	Span
 	// span 的 context，用于恢复 goroutine 的 pprof label
 	originCtx context.Context
+	deferred  DeferredSampler
 }
 
 // End 结束 span 并将当前 goroutine 的 pprof label 还原回开启该 span 之前
 func (s *profileSpan) End(options ...trace.SpanEndOption) {
+	if !s.SpanContext().IsSampled() && s.deferred != nil {
+		// 提前执行后置采样，命中时才能在 span 上记录 profile 关联
+		s.deferred.DeferSample(s)
+	}
+	if s.IsSampled() {
+		s.SetAttributes(profileLinkKey.Bool(true))
+	}
 	s.Span.End(options...)
 	// 将 goroutine 的 pprof label 设置回未加 span id 的状态
 	pprof.SetGoroutineLabels(s.originCtx)
 }
 
+// profileEndpoint 返回进程内根 span 的名称，parent 不存在或者来自上游时，当前 span 即根 span
+func profileEndpoint(parent context.Context, spanName string) string {
+	if psc := trace.SpanContextFromContext(parent); psc.IsValid() && !psc.IsRemote() {
+		if endpoint, ok := parent.Value(profileEndpointKey{}).(string); ok {
+			return endpoint
+		}
+	}
+	return spanName
+}
+
 func addPProfLabels(ctx context.Context, labels []string) context.Context {
 	pprofCtx := pprof.WithLabels(ctx, pprof.Labels(labels...))
 	pprof.SetGoroutineLabels(pprofCtx)
-	return ctx
+	// 返回带 label 的 context，子 span 结束时恢复为父 span 的 label
+	return pprofCtx
 }
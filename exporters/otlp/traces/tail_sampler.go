@@ -0,0 +1,293 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"galiosight.ai/galio-sdk-go/exporters/otlp/traces/internal"
+	"galiosight.ai/galio-sdk-go/exporters/otlp/traces/tracestate"
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/self/metric"
+	"galiosight.ai/galio-sdk-go/semconv"
+)
+
+// Defaults for TailSampleConfig.
+const (
+	// DefaultTailDecisionWait 默认尾部采样缓冲时间
+	DefaultTailDecisionWait = 10 * time.Second
+	// DefaultTailMaxSpans 默认尾部采样最大缓冲 span 数
+	DefaultTailMaxSpans = 10000
+	// DefaultTailMaxBytes 默认尾部采样最大缓冲内存
+	DefaultTailMaxBytes = 16 * 1024 * 1024
+)
+
+// spanOverhead 估算 span 内存时，每个 span、event、link 的固定开销。
+const spanOverhead = 256
+
+// TailSampleConfig 尾部采样配置
+type TailSampleConfig struct {
+	Enabled      bool          // 是否启用，只在开启后置采样时生效
+	DecisionWait time.Duration // 每个 trace 片段的最大缓冲时间
+	MaxSpans     int           // 最大缓冲 span 数
+	MaxBytes     int64         // 最大缓冲内存（估算值）
+	Dyeing       []model.Dyeing
+	Attributes   []model.Dyeing // 命中任意属性规则，整个 trace 片段采样
+}
+
+// TailSampler 尾部采样，按 trace id 缓冲未采样的 span，
+// 同一 trace 片段中任意 span 命中错误、慢操作（后置采样）、染色或属性规则时，上报整个片段。
+type TailSampler struct {
+	mu     sync.Mutex
+	cfg    *TailSampleConfig
+	traces map[trace.TraceID]*tailTrace
+	order  *list.List // 按 trace 首个 span 到达的先后排序，用于过期和淘汰
+	spans  int
+	bytes  int64
+
+	dyeing     dyeingSampler
+	attributes dyeingSampler
+	now        func() time.Time
+}
+
+type tailTrace struct {
+	id      trace.TraceID
+	spans   []sdktrace.ReadOnlySpan
+	bytes   int64
+	sampled bool // 已命中规则，后续结束的 span 直接上报
+	expire  time.Time
+	elem    *list.Element
+}
+
+// NewTailSampler 创建尾部采样
+func NewTailSampler(cfg *TailSampleConfig) *TailSampler {
+	t := &TailSampler{
+		traces: map[trace.TraceID]*tailTrace{},
+		order:  list.New(),
+		now:    time.Now,
+	}
+	t.UpdateConfig(cfg)
+	return t
+}
+
+// UpdateConfig 更新配置，关闭时清空缓冲。
+func (t *TailSampler) UpdateConfig(cfg *TailSampleConfig) {
+	if cfg.DecisionWait <= 0 {
+		cfg.DecisionWait = DefaultTailDecisionWait
+	}
+	if cfg.MaxSpans <= 0 {
+		cfg.MaxSpans = DefaultTailMaxSpans
+	}
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = DefaultTailMaxBytes
+	}
+	t.dyeing.UpdateConfig(cfg.Enabled, cfg.Dyeing)
+	t.attributes.UpdateConfig(cfg.Enabled, cfg.Attributes)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cfg = cfg
+	if !cfg.Enabled {
+		t.traces = map[trace.TraceID]*tailTrace{}
+		t.order.Init()
+		t.spans = 0
+		t.bytes = 0
+	}
+	t.evict()
+	t.updateStats()
+}
+
+// offer 处理后置采样未命中的 span，命中染色或属性规则时上报整个片段，否则缓冲。
+func (t *TailSampler) offer(s sdktrace.ReadOnlySpan, next sdktrace.SpanProcessor) {
+	matched := t.match(s)
+	t.mu.Lock()
+	if !t.cfg.Enabled {
+		t.mu.Unlock()
+		return
+	}
+	t.expire()
+	tt := t.traces[s.SpanContext().TraceID()]
+	if tt != nil && tt.sampled {
+		t.mu.Unlock()
+		t.export([]sdktrace.ReadOnlySpan{s}, next)
+		return
+	}
+	if matched {
+		spans := append(t.decide(s.SpanContext().TraceID(), tt), s)
+		t.updateStats()
+		t.mu.Unlock()
+		t.export(spans, next)
+		return
+	}
+	if tt == nil {
+		tt = t.add(s.SpanContext().TraceID())
+	}
+	size := estimateSpanSize(s)
+	tt.spans = append(tt.spans, s)
+	tt.bytes += size
+	t.spans++
+	t.bytes += size
+	t.evict()
+	t.updateStats()
+	t.mu.Unlock()
+}
+
+// release 同一 trace 中有未前置采样的 span 命中后置采样，上报已缓冲的 span。
+func (t *TailSampler) release(id trace.TraceID, next sdktrace.SpanProcessor) {
+	t.mu.Lock()
+	if !t.cfg.Enabled {
+		t.mu.Unlock()
+		return
+	}
+	t.expire()
+	spans := t.decide(id, t.traces[id])
+	t.updateStats()
+	t.mu.Unlock()
+	t.export(spans, next)
+}
+
+// match 是否命中染色或属性规则
+func (t *TailSampler) match(s sdktrace.ReadOnlySpan) bool {
+	p := &sdktrace.SamplingParameters{Attributes: s.Attributes()}
+	return t.dyeing.ShouldSample(p) || t.attributes.ShouldSample(p)
+}
+
+// decide 标记 trace 已采样，返回并清空已缓冲的 span，需要持有锁。
+func (t *TailSampler) decide(id trace.TraceID, tt *tailTrace) []sdktrace.ReadOnlySpan {
+	if tt == nil {
+		tt = t.add(id)
+	}
+	spans := tt.spans
+	t.spans -= len(tt.spans)
+	t.bytes -= tt.bytes
+	tt.spans = nil
+	tt.bytes = 0
+	tt.sampled = true
+	return spans
+}
+
+func (t *TailSampler) add(id trace.TraceID) *tailTrace {
+	tt := &tailTrace{id: id, expire: t.now().Add(t.cfg.DecisionWait)}
+	tt.elem = t.order.PushBack(tt)
+	t.traces[id] = tt
+	return tt
+}
+
+func (t *TailSampler) remove(tt *tailTrace) {
+	t.order.Remove(tt.elem)
+	delete(t.traces, tt.id)
+	t.spans -= len(tt.spans)
+	t.bytes -= tt.bytes
+}
+
+// expire 丢弃超过缓冲时间的 trace，需要持有锁。
+func (t *TailSampler) expire() {
+	now := t.now()
+	for e := t.order.Front(); e != nil; e = t.order.Front() {
+		tt := e.Value.(*tailTrace)
+		if now.Before(tt.expire) {
+			return
+		}
+		t.remove(tt)
+		metric.GetSelfMonitor().Stats.TracesStats.TailExpireCounter.Add(int64(len(tt.spans)))
+	}
+}
+
+// evict 超出缓冲上限时，从最早的 trace 开始淘汰，需要持有锁。
+func (t *TailSampler) evict() {
+	for t.spans > t.cfg.MaxSpans || t.bytes > t.cfg.MaxBytes {
+		e := t.order.Front()
+		if e == nil {
+			return
+		}
+		tt := e.Value.(*tailTrace)
+		t.remove(tt)
+		metric.GetSelfMonitor().Stats.TracesStats.TailEvictCounter.Add(int64(len(tt.spans)))
+	}
+}
+
+func (t *TailSampler) updateStats() {
+	stats := &metric.GetSelfMonitor().Stats.TracesStats
+	stats.TailBufferSpans.Store(int64(t.spans))
+	stats.TailBufferBytes.Store(t.bytes)
+}
+
+// export 标记尾部采样并交给下一个 processor
+func (t *TailSampler) export(spans []sdktrace.ReadOnlySpan, next sdktrace.SpanProcessor) {
+	for _, s := range spans {
+		next.OnEnd(newTailSpan(s))
+	}
+	metric.GetSelfMonitor().Stats.TracesStats.TailSampledCounter.Add(int64(len(spans)))
+}
+
+// tailSpan 覆盖 GalileoStateKey，用于 UI 显示尾部采样结果
+type tailSpan struct {
+	sdktrace.ReadOnlySpan
+	attrs []attribute.KeyValue
+}
+
+func newTailSpan(s sdktrace.ReadOnlySpan) sdktrace.ReadOnlySpan {
+	state := s.SpanContext().TraceState()
+	parsed, _ := tracestate.Parse(state.Get(galileoVendor))
+	parsed.Sample.SampledStrategy = tracestate.StrategyTail
+	ts := internal.Convert(&state).Insert(galileoVendor, parsed.String())
+	kv := semconv.GalileoStateKey.String(ts.String())
+
+	attrs := make([]attribute.KeyValue, 0, len(s.Attributes())+1)
+	for _, a := range s.Attributes() {
+		if a.Key != semconv.GalileoStateKey {
+			attrs = append(attrs, a)
+		}
+	}
+	return &tailSpan{ReadOnlySpan: s, attrs: append(attrs, kv)}
+}
+
+// Attributes 返回覆盖后的属性
+func (s *tailSpan) Attributes() []attribute.KeyValue {
+	return s.attrs
+}
+
+// estimateSpanSize 估算 span 占用的内存
+func estimateSpanSize(s sdktrace.ReadOnlySpan) int64 {
+	size := spanOverhead + len(s.Name()) + attributesSize(s.Attributes())
+	for _, e := range s.Events() {
+		size += spanOverhead + len(e.Name) + attributesSize(e.Attributes)
+	}
+	for _, l := range s.Links() {
+		size += spanOverhead + attributesSize(l.Attributes)
+	}
+	return int64(size)
+}
+
+func attributesSize(attrs []attribute.KeyValue) int {
+	size := 0
+	for _, a := range attrs {
+		size += len(a.Key) + 16 // 16 为非字符串值的固定开销
+		if a.Value.Type() == attribute.STRING {
+			size += len(a.Value.AsString())
+		}
+	}
+	return size
+}
@@ -0,0 +1,208 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"galiosight.ai/galio-sdk-go/exporters/otlp/traces/tracestate"
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/self/metric"
+	"galiosight.ai/galio-sdk-go/semconv"
+)
+
+// recordProcessor 记录所有 OnEnd 的 span，不区分是否采样
+type recordProcessor struct {
+	mu    sync.Mutex
+	spans []sdktrace.ReadOnlySpan
+}
+
+func (r *recordProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+func (r *recordProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = append(r.spans, s)
+}
+
+func (r *recordProcessor) Shutdown(context.Context) error { return nil }
+
+func (r *recordProcessor) ForceFlush(context.Context) error { return nil }
+
+func (r *recordProcessor) names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var names []string
+	for _, s := range r.spans {
+		names = append(names, s.Name())
+	}
+	return names
+}
+
+func newTailProcessor(cfg *TailSampleConfig) (*DeferredSampleProcessor, *recordProcessor) {
+	next := &recordProcessor{}
+	p := NewDeferredSampleProcessor(next, NewDeferredSampler(&DeferredSampleConfig{
+		Enabled:       true,
+		SampleError:   true,
+		ErrorFraction: 1,
+	}))
+	p.tail = NewTailSampler(cfg)
+	return p, next
+}
+
+func tailSnapshot(traceID byte, name string, attrs ...attribute.KeyValue) sdktrace.ReadOnlySpan {
+	return tracetest.SpanStub{
+		Name: name,
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{traceID},
+			SpanID:  trace.SpanID{traceID, byte(len(name))},
+		}),
+		StartTime:  time.Now(),
+		EndTime:    time.Now(),
+		Attributes: attrs,
+	}.Snapshot()
+}
+
+func TestTailSampler_Release(t *testing.T) {
+	p, next := newTailProcessor(&TailSampleConfig{Enabled: true})
+	p.OnEnd(tailSnapshot(1, "child"))
+	p.OnEnd(tailSnapshot(2, "other"))
+	assert.Empty(t, next.names())
+
+	stub := tracetest.SpanStubFromReadOnlySpan(tailSnapshot(1, "error"))
+	stub.Status = sdktrace.Status{Code: codes.Error}
+	p.OnEnd(stub.Snapshot())
+	assert.Equal(t, []string{"child", "error"}, next.names())
+
+	// 已命中的 trace，后续结束的 span 直接上报
+	p.OnEnd(tailSnapshot(1, "parent"))
+	assert.Equal(t, []string{"child", "error", "parent"}, next.names())
+
+	// 尾部采样上报的 span 标记为 tail
+	for _, i := range []int{0, 2} {
+		var state string
+		for _, a := range next.spans[i].Attributes() {
+			if a.Key == semconv.GalileoStateKey {
+				state = a.Value.AsString()
+			}
+		}
+		parsed, _ := tracestate.Parse(tracestate.TakeTraceState(state, galileoVendor))
+		assert.Equal(t, tracestate.StrategyTail, parsed.Sample.SampledStrategy)
+	}
+	assert.Equal(t, int64(1), metric.GetSelfMonitor().Stats.TracesStats.TailBufferSpans.Load())
+}
+
+func TestTailSampler_Attributes(t *testing.T) {
+	p, next := newTailProcessor(&TailSampleConfig{
+		Enabled:    true,
+		Dyeing:     []model.Dyeing{{Key: "uid", Values: []string{"10086"}}},
+		Attributes: []model.Dyeing{{Key: "http.status_code", Values: []string{"500"}}},
+	})
+	p.OnEnd(tailSnapshot(1, "a"))
+	p.OnEnd(tailSnapshot(1, "b", attribute.String("http.status_code", "500")))
+	assert.Equal(t, []string{"a", "b"}, next.names())
+
+	p.OnEnd(tailSnapshot(2, "c"))
+	p.OnEnd(tailSnapshot(2, "d", attribute.String("uid", "10086")))
+	assert.Equal(t, []string{"a", "b", "c", "d"}, next.names())
+
+	p.OnEnd(tailSnapshot(3, "e", attribute.String("http.status_code", "200")))
+	assert.Equal(t, []string{"a", "b", "c", "d"}, next.names())
+}
+
+func TestTailSampler_Evict(t *testing.T) {
+	stats := &metric.GetSelfMonitor().Stats.TracesStats
+	evicted := stats.TailEvictCounter.Load()
+	p, next := newTailProcessor(&TailSampleConfig{Enabled: true, MaxSpans: 2})
+	p.OnEnd(tailSnapshot(1, "a"))
+	p.OnEnd(tailSnapshot(2, "b"))
+	p.OnEnd(tailSnapshot(3, "c"))
+	assert.Equal(t, evicted+1, stats.TailEvictCounter.Load())
+	assert.Equal(t, int64(2), stats.TailBufferSpans.Load())
+
+	// 最早的 trace 已被淘汰
+	p.tail.release(trace.TraceID{1}, next)
+	p.tail.release(trace.TraceID{2}, next)
+	assert.Equal(t, []string{"b"}, next.names())
+
+	p.tail.UpdateConfig(&TailSampleConfig{Enabled: true, MaxBytes: 1})
+	assert.Equal(t, int64(0), stats.TailBufferBytes.Load())
+}
+
+func TestTailSampler_Expire(t *testing.T) {
+	stats := &metric.GetSelfMonitor().Stats.TracesStats
+	expired := stats.TailExpireCounter.Load()
+	now := time.Now()
+	p, next := newTailProcessor(&TailSampleConfig{Enabled: true, DecisionWait: time.Second})
+	p.tail.now = func() time.Time { return now }
+	p.OnEnd(tailSnapshot(1, "a"))
+	now = now.Add(2 * time.Second)
+	p.OnEnd(tailSnapshot(2, "b"))
+	assert.Equal(t, expired+1, stats.TailExpireCounter.Load())
+
+	p.tail.release(trace.TraceID{1}, next)
+	assert.Empty(t, next.names())
+	p.tail.release(trace.TraceID{2}, next)
+	assert.Equal(t, []string{"b"}, next.names())
+}
+
+func TestTailSampler_Disabled(t *testing.T) {
+	p, next := newTailProcessor(&TailSampleConfig{Enabled: true})
+	p.OnEnd(tailSnapshot(1, "a"))
+	p.tail.UpdateConfig(&TailSampleConfig{})
+	p.tail.release(trace.TraceID{1}, next)
+	p.OnEnd(tailSnapshot(2, "b"))
+	assert.Empty(t, next.names())
+	assert.Equal(t, int64(0), metric.GetSelfMonitor().Stats.TracesStats.TailBufferSpans.Load())
+}
+
+func Test_updateTailConfig(t *testing.T) {
+	processor := &model.TracesProcessor{
+		EnableDeferredSample: true,
+		TailSampler: model.TailSamplerConfig{
+			Enable:         true,
+			DecisionWaitMs: 100,
+			SampleDyeing:   true,
+		},
+		Sampler: model.SamplerConfig{
+			EnableDyeing: true,
+			Dyeing:       []model.Dyeing{{Key: "uid", Values: []string{"1"}}},
+		},
+	}
+	cfg := updateTailConfig(processor)
+	assert.True(t, cfg.Enabled)
+	assert.Equal(t, 100*time.Millisecond, cfg.DecisionWait)
+	assert.Equal(t, processor.Sampler.Dyeing, cfg.Dyeing)
+
+	processor.EnableDeferredSample = false
+	processor.Sampler.EnableDyeing = false
+	cfg = updateTailConfig(processor)
+	assert.False(t, cfg.Enabled)
+	assert.Empty(t, cfg.Dyeing)
+}
@@ -51,19 +51,30 @@ func NewTracerProvider(endpoint string, options ...SetupOption) (apitrace.Tracer
 	for _, opt := range options {
 		opt(o)
 	}
+	for _, opt := range SetupOptions() {
+		opt(o)
+	}
 	exporter, err := newSpanExporter(endpoint, o)
 	if err != nil {
 		return nil, err
 	}
 	var providerOpts []sdktrace.TracerProviderOption
 	// 设置采样器
-	providerOpts = append(providerOpts, sdktrace.WithSampler(o.sampler))
+	sampler := o.sampler
+	if o.metricsSpanProcessor != nil {
+		// 只在当前 TracerProvider 记录不采样的 span，用于统计主被调监控
+		sampler = RecordUnsampled(sampler)
+		providerOpts = append(providerOpts, sdktrace.WithSpanProcessor(o.metricsSpanProcessor))
+	}
+	providerOpts = append(providerOpts, sdktrace.WithSampler(sampler))
 
 	var processor sdktrace.SpanProcessor
-	processor = NewDeferredSampleProcessor(
+	deferredProcessor := NewDeferredSampleProcessor(
 		NewBatchSpanProcessor(exporter, o.batchSpanOption...),
 		o.deferredSampler,
 	)
+	deferredProcessor.tail = o.tailSampler
+	processor = deferredProcessor
 
 	if o.resource.SchemaURL() == semconv.SchemaURL {
 		// OMP v1 使用旧兼容逻辑，NewResource 会塞 trpc.namespace 到 attr 中，
@@ -102,8 +113,10 @@ type setupOptions struct {
 	grpcDialOptions        []grpc.DialOption
 	sampler                sdktrace.Sampler
 	deferredSampler        DeferredSampler
+	tailSampler            *TailSampler
 	batchSpanOption        []BatchSpanProcessorOption
 	resSpanProcessorOption []ResourceSpanProcessorOption
+	metricsSpanProcessor   *MetricsSpanProcessor
 	apiKey                 string
 }
 
@@ -198,6 +211,20 @@ func WithDeferredSampler(deferredSampler DeferredSampler) SetupOption {
 	}
 }
 
+// WithTailSampler 传入尾部采样
+func WithTailSampler(tailSampler *TailSampler) SetupOption {
+	return func(cfg *setupOptions) {
+		cfg.tailSampler = tailSampler
+	}
+}
+
+// WithMetricsSpanProcessor 注册 MetricsSpanProcessor，并使该 TracerProvider 的采样器记录不采样的 span
+func WithMetricsSpanProcessor(p *MetricsSpanProcessor) SetupOption {
+	return func(cfg *setupOptions) {
+		cfg.metricsSpanProcessor = p
+	}
+}
+
 // WithBatchSpanProcessorOption 设置异步批量上报参数
 func WithBatchSpanProcessorOption(opts ...BatchSpanProcessorOption) SetupOption {
 	return func(cfg *setupOptions) {
@@ -33,11 +33,12 @@ const (
 	StrategyError    Strategy = 6 // 错误采样（后置采样）
 	StrategySlow     Strategy = 7 // 慢采样（后置采样）
 	StrategyUser     Strategy = 8 // 用户自定义采样
+	StrategyTail     Strategy = 9 // 尾部采样（同一 trace 片段中有 span 命中规则，整体采样）
 )
 
 var strategyNameList = []string{
 	"not_match", "match", "dyeing", "min_count", "random", "follow",
-	"error", "slow", "user",
+	"error", "slow", "user", "tail",
 }
 
 // String convert Strategy to String
@@ -59,6 +60,7 @@ var strategyValueMap = map[string]Strategy{
 	"error":     StrategyError,
 	"slow":      StrategySlow,
 	"user":      StrategyUser,
+	"tail":      StrategyTail,
 }
 
 // ParseStrategy 从字符串解析 Strategy
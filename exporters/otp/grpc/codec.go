@@ -0,0 +1,92 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// rawFrame 已经序列化好的 pb 数据，发送时不再序列化，用于复用 ReuseObject 中的 buffer。
+type rawFrame []byte
+
+type marshaler interface {
+	Marshal() ([]byte, error)
+}
+
+type unmarshaler interface {
+	Unmarshal([]byte) error
+}
+
+// codec gogo pb 编解码器。
+// model 中的 pb 对象是 gogofast 生成的，直接使用生成的 Marshal/Unmarshal 方法，避免 gRPC 默认编解码器的反射开销。
+// Name 返回 "proto"，服务端使用标准 pb 编解码器即可解析。
+type codec struct{}
+
+// Marshal 序列化。
+func (codec) Marshal(v interface{}) ([]byte, error) {
+	switch m := v.(type) {
+	case rawFrame:
+		return m, nil
+	case marshaler:
+		return m.Marshal()
+	default:
+		return nil, fmt.Errorf("otp grpc codec: unsupported marshal type %T", v)
+	}
+}
+
+// Unmarshal 反序列化。
+func (codec) Unmarshal(data []byte, v interface{}) error {
+	m, ok := v.(unmarshaler)
+	if !ok {
+		return fmt.Errorf("otp grpc codec: unsupported unmarshal type %T", v)
+	}
+	return m.Unmarshal(data)
+}
+
+// Name 编解码器名称。
+func (codec) Name() string {
+	return "proto"
+}
+
+// headerCredentials 每次 RPC 都在 metadata 中带上租户、API Key 等请求头。
+type headerCredentials map[string]string
+
+// headersKey stream context 中按请求覆盖的请求头。
+type headersKey struct{}
+
+// GetRequestMetadata 实现 credentials.PerRPCCredentials，stream context 中的请求头覆盖同名请求头。
+func (h headerCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	override, _ := ctx.Value(headersKey{}).(map[string]string)
+	md := make(map[string]string, len(h)+len(override))
+	for _, headers := range [...]map[string]string{h, override} {
+		for k, v := range headers {
+			if v != "" {
+				md[strings.ToLower(k)] = v
+			}
+		}
+	}
+	return md, nil
+}
+
+// RequireTransportSecurity 实现 credentials.PerRPCCredentials，collector 通常是内网明文连接。
+func (h headerCredentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,347 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpc : otp 协议 gRPC 传输方式。
+//
+// 1. 实现和 HTTP 方式相同的 otphttp.HTTPExporter 接口，metrics 和 profiles 导出器可以直接替换。
+// 2. 使用 OTPService 的双向流，每个 stream 上按顺序发送消息并等待响应，stream 用完后放回池中复用。
+// 3. 使用 gzip 压缩，租户、API Key 等请求头通过每次 RPC 的 metadata 传递。
+// 4. collector 不可达时返回包装了 otphttp.ErrUnavailable 的错误，上层可以缓存后补发。
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	otphttp "galiosight.ai/galio-sdk-go/exporters/otp/http"
+	"galiosight.ai/galio-sdk-go/lib/logs"
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/encoding/gzip" // 注册 gzip 压缩。
+	"google.golang.org/grpc/status"
+)
+
+const (
+	metricsMethod  = "/model.OTPService/ExportMetrics"
+	profilesMethod = "/model.OTPService/ExportProfiles"
+	// maxIdleStreams 每个方法最多缓存的空闲 stream 数量，通常和导出器的 worker 数量相当。
+	maxIdleStreams = 16
+)
+
+var streamDesc = &grpc.StreamDesc{ClientStreams: true, ServerStreams: true}
+
+var (
+	_ otphttp.HTTPExporter   = (*GRPCGeneralExporter)(nil)
+	_ otphttp.HeaderExporter = (*GRPCGeneralExporter)(nil)
+)
+
+// GRPCGeneralExporter 使用 gRPC 双向流方式导出数据。
+type GRPCGeneralExporter struct {
+	Log           *logs.Wrapper
+	MaxRetryCount atomic.Int32
+	Headers       map[string]string
+	timeout       time.Duration
+	compressor    string
+	mu            sync.RWMutex
+	target        string
+	conn          *grpc.ClientConn
+	idle          map[string]chan *otpStream // 按方法缓存的空闲 stream。
+}
+
+// otpStream 一个可复用的双向流。
+type otpStream struct {
+	cs     grpc.ClientStream
+	cancel context.CancelFunc
+	conn   *grpc.ClientConn // 创建 stream 时的连接，连接切换后旧 stream 不再复用。
+}
+
+// NewGRPCGeneralExporter 构造 gRPC otp 导出器。
+// collectorAddr 可以是 host:port，也可以是和 HTTP 方式相同的完整 url，此时只使用其中的 host:port。
+// 连接是异步建立的，构造时不会阻塞。
+func NewGRPCGeneralExporter(
+	timeoutMs int, collectorAddr string, log *logs.Wrapper, options ...Option,
+) *GRPCGeneralExporter {
+	opt := opts{compressor: "gzip"}
+	for _, o := range options {
+		o(&opt)
+	}
+	g := &GRPCGeneralExporter{
+		Log:           log,
+		MaxRetryCount: *atomic.NewInt32(opt.maxRetryCount),
+		Headers:       opt.headers,
+		timeout:       time.Duration(timeoutMs) * time.Millisecond,
+		compressor:    opt.compressor,
+		idle: map[string]chan *otpStream{
+			metricsMethod:  make(chan *otpStream, maxIdleStreams),
+			profilesMethod: make(chan *otpStream, maxIdleStreams),
+		},
+	}
+	g.connect(collectorAddr)
+	return g
+}
+
+type opts struct {
+	headers       map[string]string
+	maxRetryCount int32
+	compressor    string
+}
+
+// Option 构造参数。
+type Option func(*opts)
+
+// WithHeaders 设置每次 RPC 的 metadata。
+func WithHeaders(headers map[string]string) Option {
+	return func(o *opts) {
+		o.headers = headers
+	}
+}
+
+// WithMaxRetryCount 设置最大重试次数。
+func WithMaxRetryCount(maxRetryCount int32) Option {
+	return func(o *opts) {
+		o.maxRetryCount = maxRetryCount
+	}
+}
+
+// WithCompressor 设置压缩方式，默认 gzip，空字符串表示不压缩。
+// 压缩方式需要通过 google.golang.org/grpc/encoding 注册。
+func WithCompressor(compressor string) Option {
+	return func(o *opts) {
+		o.compressor = compressor
+	}
+}
+
+// UpdateConfig 更新配置，用于运行时配置热更新。collector 地址变化时重新建立连接。
+func (g *GRPCGeneralExporter) UpdateConfig(maxRetryCount int32, collector model.Collector) {
+	g.MaxRetryCount.Store(maxRetryCount)
+	g.connect(collector.Addr)
+	g.Log.Debugf("[galileo]GRPCGeneralExporter|MaxRetryCount=%v,cfg=%v", maxRetryCount, collector)
+}
+
+// connect 连接 collector，地址未变化时直接返回。
+// 旧连接上可能还有正在发送的请求，等待一个超时周期后再关闭。
+func (g *GRPCGeneralExporter) connect(collectorAddr string) {
+	target := grpcTarget(collectorAddr)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.conn != nil && g.target == target {
+		return
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(headerCredentials(g.Headers)),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec{})),
+	}
+	if g.compressor != "" {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(g.compressor)))
+	}
+	conn, err := grpc.NewClient(target, dialOpts...)
+	if err != nil {
+		// NewClient 不会建立连接，只会在参数错误时失败，保留旧连接。
+		g.Log.Errorf("[galileo]GRPCGeneralExporter.connect|target=%v,err=%v", target, err)
+		return
+	}
+	if old := g.conn; old != nil {
+		time.AfterFunc(g.timeout, func() { _ = old.Close() })
+	}
+	g.target = target
+	g.conn = conn
+}
+
+// Export 使用 gRPC 方式导出数据。此函数是并发安全的。
+// 用到的 buffer 由外面通过 reuseObject 传进来，进行对象重用，减少内存分配。
+// 只有 collector 不可达（codes.Unavailable）时才重试，超时等情况不重试，避免服务端收到重复的包。
+func (g *GRPCGeneralExporter) Export(message proto.Message, obj *otphttp.ReuseObject) error {
+	return g.export(message, obj, nil)
+}
+
+// ExportWithHeaders 使用 gRPC 方式导出数据，headers 覆盖构造时设置的同名 metadata。
+// stream 的 metadata 在创建时确定，覆盖请求头时使用新的 stream，发送完成后关闭，不放回池中。
+func (g *GRPCGeneralExporter) ExportWithHeaders(
+	message proto.Message, obj *otphttp.ReuseObject, headers map[string]string,
+) error {
+	return g.export(message, obj, headers)
+}
+
+func (g *GRPCGeneralExporter) export(message proto.Message, obj *otphttp.ReuseObject, headers map[string]string) error {
+	method, err := methodOf(message)
+	if err != nil {
+		return err
+	}
+	obj.Reset()
+	if err = obj.PbBuf.Marshal(message); err != nil {
+		return err
+	}
+	for i := 0; i < int(g.MaxRetryCount.Load())+1; i++ {
+		err = g.send(method, rawFrame(obj.PbBuf.Bytes()), headers)
+		g.Log.Debugf("[galileo]GRPCGeneralExporter.Export|method=%v,message=%+v,err=%v", method, message.String(), err)
+		if err == nil || status.Code(err) != codes.Unavailable {
+			break
+		}
+	}
+	if err == nil {
+		return nil
+	}
+	if status.Code(err) == codes.Unavailable {
+		err = fmt.Errorf("%w: %v", otphttp.ErrUnavailable, err)
+	}
+	g.Log.Errorf("[galileo]GRPCGeneralExporter.Export|err=%v", err)
+	return err
+}
+
+// send 在一个 stream 上发送一个消息并等待响应，成功后 stream 放回池中。
+// headers 不为空时使用带覆盖请求头的新 stream，用完即关闭。
+func (g *GRPCGeneralExporter) send(method string, frame rawFrame, headers map[string]string) error {
+	var s *otpStream
+	var reused bool
+	var err error
+	if len(headers) == 0 {
+		s, reused, err = g.getStream(method)
+	} else {
+		s, err = g.getHeaderStream(method, headers)
+	}
+	if err != nil {
+		return err
+	}
+	// stream 是长期复用的，无法设置单个消息的 deadline，超时后直接取消整个 stream。
+	timer := time.AfterFunc(g.timeout, s.cancel)
+	err = s.cs.SendMsg(frame)
+	if err != nil && reused {
+		// 空闲 stream 可能已经被服务端关闭，消息没有发送出去，换一个新的 stream 重新发送。
+		timer.Stop()
+		s.cancel()
+		if s, err = g.newStream(method, s.conn); err != nil {
+			return err
+		}
+		timer = time.AfterFunc(g.timeout, s.cancel)
+		err = s.cs.SendMsg(frame)
+	}
+	rsp := &model.ExportResponse{}
+	if err == nil {
+		err = s.cs.RecvMsg(rsp)
+	}
+	if !timer.Stop() && err != nil {
+		err = status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	if err != nil {
+		s.cancel()
+		return err
+	}
+	if len(headers) == 0 {
+		g.putStream(method, s)
+	} else {
+		_ = s.cs.CloseSend()
+		s.cancel()
+	}
+	if rsp.Code != 0 {
+		return fmt.Errorf("otp grpc rsp code=%d, msg=%s", rsp.Code, rsp.Msg)
+	}
+	return nil
+}
+
+// getStream 优先复用空闲 stream，连接已切换的旧 stream 直接关闭。
+func (g *GRPCGeneralExporter) getStream(method string) (*otpStream, bool, error) {
+	g.mu.RLock()
+	conn := g.conn
+	g.mu.RUnlock()
+	if conn == nil {
+		return nil, false, status.Error(codes.Unavailable, "otp grpc: not connected")
+	}
+	for {
+		select {
+		case s := <-g.idle[method]:
+			if s.conn == conn {
+				return s, true, nil
+			}
+			s.cancel()
+		default:
+			s, err := g.newStream(method, conn)
+			return s, false, err
+		}
+	}
+}
+
+// getHeaderStream 创建带覆盖请求头的 stream。
+func (g *GRPCGeneralExporter) getHeaderStream(method string, headers map[string]string) (*otpStream, error) {
+	g.mu.RLock()
+	conn := g.conn
+	g.mu.RUnlock()
+	if conn == nil {
+		return nil, status.Error(codes.Unavailable, "otp grpc: not connected")
+	}
+	return g.newStreamWithContext(context.WithValue(context.Background(), headersKey{}, headers), method, conn)
+}
+
+// newStream 在连接上创建新的 stream。
+func (g *GRPCGeneralExporter) newStream(method string, conn *grpc.ClientConn) (*otpStream, error) {
+	return g.newStreamWithContext(context.Background(), method, conn)
+}
+
+func (g *GRPCGeneralExporter) newStreamWithContext(
+	parent context.Context, method string, conn *grpc.ClientConn,
+) (*otpStream, error) {
+	ctx, cancel := context.WithCancel(parent)
+	cs, err := conn.NewStream(ctx, streamDesc, method)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &otpStream{cs: cs, cancel: cancel, conn: conn}, nil
+}
+
+// putStream 将 stream 放回池中，池满时关闭。
+func (g *GRPCGeneralExporter) putStream(method string, s *otpStream) {
+	select {
+	case g.idle[method] <- s:
+	default:
+		_ = s.cs.CloseSend()
+		s.cancel()
+	}
+}
+
+// methodOf 根据消息类型选择 RPC 方法。
+func methodOf(message proto.Message) (string, error) {
+	switch message.(type) {
+	case *model.Metrics:
+		return metricsMethod, nil
+	case *model.ProfilesBatch:
+		return profilesMethod, nil
+	default:
+		return "", fmt.Errorf("otp grpc: unsupported message type %T", message)
+	}
+}
+
+// grpcTarget 将 collector 地址转换成 gRPC 连接地址。
+func grpcTarget(collectorAddr string) string {
+	if !strings.Contains(collectorAddr, "://") {
+		return collectorAddr
+	}
+	u, err := url.Parse(collectorAddr)
+	if err != nil || u.Host == "" {
+		return collectorAddr
+	}
+	return u.Host
+}
@@ -0,0 +1,172 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	otphttp "galiosight.ai/galio-sdk-go/exporters/otp/http"
+	"galiosight.ai/galio-sdk-go/lib/logs"
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// testServer 模拟 OTPService，记录收到的消息及 metadata。
+type testServer struct {
+	mu       sync.Mutex
+	metrics  []*model.Metrics
+	profiles []*model.ProfilesBatch
+	apiKeys  []string
+	targets  []string
+	streams  int
+}
+
+func (s *testServer) handle(_ interface{}, stream grpc.ServerStream) error {
+	method, _ := grpc.MethodFromServerStream(stream)
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	s.mu.Lock()
+	s.streams++
+	s.mu.Unlock()
+	for {
+		var err error
+		switch method {
+		case metricsMethod:
+			m := &model.Metrics{}
+			if err = stream.RecvMsg(m); err == nil {
+				s.mu.Lock()
+				s.metrics = append(s.metrics, m)
+				s.mu.Unlock()
+			}
+		case profilesMethod:
+			p := &model.ProfilesBatch{}
+			if err = stream.RecvMsg(p); err == nil {
+				s.mu.Lock()
+				s.profiles = append(s.profiles, p)
+				s.mu.Unlock()
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s.mu.Lock()
+		s.apiKeys = append(s.apiKeys, md.Get(model.APIKeyHeaderKey)...)
+		s.targets = append(s.targets, md.Get(model.TargetHeaderKey)...)
+		s.mu.Unlock()
+		if err = stream.SendMsg(&model.ExportResponse{}); err != nil {
+			return err
+		}
+	}
+}
+
+func startServer(t *testing.T) (string, *testServer) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	ts := &testServer{}
+	srv := grpc.NewServer(grpc.UnknownServiceHandler(ts.handle), grpc.ForceServerCodec(codec{}))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String(), ts
+}
+
+func TestGRPCGeneralExporter_Export(t *testing.T) {
+	addr, ts := startServer(t)
+	g := NewGRPCGeneralExporter(
+		1000, "http://"+addr+"/otp/metrics", logs.DefaultWrapper(),
+		WithHeaders(map[string]string{model.APIKeyHeaderKey: "key"}),
+	)
+	r := otphttp.NewReuseObject()
+	for i := 0; i < 3; i++ {
+		require.Nil(t, g.Export(&model.Metrics{TimestampMs: int64(i)}, r))
+	}
+	require.Nil(t, g.Export(&model.ProfilesBatch{Sequence: 1}, r))
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	require.Len(t, ts.metrics, 3)
+	assert.Equal(t, int64(2), ts.metrics[2].TimestampMs)
+	require.Len(t, ts.profiles, 1)
+	assert.Equal(t, int64(1), ts.profiles[0].Sequence)
+	assert.Equal(t, []string{"key", "key", "key", "key"}, ts.apiKeys)
+	// 同一个方法复用同一个 stream。
+	assert.Equal(t, 2, ts.streams)
+}
+
+func TestGRPCGeneralExporter_ExportWithHeaders(t *testing.T) {
+	addr, ts := startServer(t)
+	g := NewGRPCGeneralExporter(
+		1000, "http://"+addr+"/otp/metrics", logs.DefaultWrapper(),
+		WithHeaders(map[string]string{model.APIKeyHeaderKey: "key", model.TargetHeaderKey: "self"}),
+	)
+	r := otphttp.NewReuseObject()
+	require.Nil(t, g.Export(&model.Metrics{}, r))
+	require.Nil(t, g.ExportWithHeaders(&model.Metrics{}, r, map[string]string{model.TargetHeaderKey: "a"}))
+	require.Nil(t, g.ExportWithHeaders(&model.Metrics{}, r, map[string]string{model.TargetHeaderKey: "b"}))
+	require.Nil(t, g.Export(&model.Metrics{}, r))
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	require.Len(t, ts.metrics, 4)
+	assert.Equal(t, []string{"key", "key", "key", "key"}, ts.apiKeys)
+	// 覆盖的 header 只作用于当次请求，不影响复用的 stream。
+	assert.Equal(t, []string{"self", "a", "b", "self"}, ts.targets)
+	assert.Equal(t, 3, ts.streams)
+}
+
+func TestGRPCGeneralExporter_Unavailable(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	addr := lis.Addr().String()
+	_ = lis.Close()
+	g := NewGRPCGeneralExporter(1000, addr, logs.DefaultWrapper(), WithMaxRetryCount(1))
+	err = g.Export(&model.Metrics{}, otphttp.NewReuseObject())
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, otphttp.ErrUnavailable))
+	assert.True(t, otphttp.IsUnavailable(err))
+}
+
+func TestGRPCGeneralExporter_UpdateConfig(t *testing.T) {
+	addr, ts := startServer(t)
+	g := NewGRPCGeneralExporter(1000, "127.0.0.1:1", logs.DefaultWrapper())
+	g.UpdateConfig(0, model.Collector{Addr: addr})
+	require.Nil(t, g.Export(&model.Metrics{}, otphttp.NewReuseObject()))
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	assert.Len(t, ts.metrics, 1)
+}
+
+func TestGRPCGeneralExporter_UnsupportedMessage(t *testing.T) {
+	g := NewGRPCGeneralExporter(1000, "127.0.0.1:1", logs.DefaultWrapper())
+	assert.NotNil(t, g.Export(&model.Profile{}, otphttp.NewReuseObject()))
+}
+
+func Test_grpcTarget(t *testing.T) {
+	assert.Equal(t, "a.b.c:8080", grpcTarget("a.b.c:8080"))
+	assert.Equal(t, "a.b.c:8080", grpcTarget("http://a.b.c:8080/otp/metrics"))
+	assert.Equal(t, "", grpcTarget(""))
+}
@@ -26,6 +26,7 @@ import (
 	"sync"
 	"time"
 
+	"galiosight.ai/galio-sdk-go/model"
 	"go.uber.org/atomic"
 )
 
@@ -33,8 +34,7 @@ import (
 type CollectorAddr struct {
 	// 上报地址，完整的 url,
 	FullURL string
-	// 排序后的直连地址列表，通常按健康度排序，这样优先选择前面的地址进行上报。
-	// 当前是随机排序，未来再进行算法上的优化。
+	// 直连地址列表，上报时轮询选择，并跳过处于熔断状态的地址，见 pickAddr。
 	directIPs []string
 	// enableDirectIP，用于判断是否开启 IP 直连，在某些网络环境下，IP 无法直连的，但是如果运行期去尝试连接，会浪费 CPU，浪费服务端连接资源。
 	// 所以在 CollectorAddr 对象创建时，对所有 IP 进行一次判断，如果所有 IP 都连不成功，则说明网络无法直连 IP。
@@ -138,6 +138,8 @@ func directURL(u *url.URL, directIPPort string) string {
 }
 
 // GetAddr 获取 OTP 服务地址，retryIdx: 第几次重试，第一次重试使用直连地址，第二次开始使用域名。
+//
+// Deprecated: 导出器按地址健康度选择发送地址，不再按重试次数轮换，此方法只为兼容保留。
 func (c *CollectorAddr) GetAddr(retryIdx int) string {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -154,3 +156,57 @@ func (c *CollectorAddr) GetAddr(retryIdx int) string {
 	idx := int(c.idx.Inc())
 	return c.directIPs[idx%n]
 }
+
+// pickAddr 按健康度选择本次发送的地址，返回地址及其健康状态，所有地址都不可用时返回 nil。
+// tried 是本次上报已经尝试过的地址，重试时优先选择其他地址。
+//
+// 候选地址是轮询顺序的直连地址，最后是域名地址，依次按以下优先级选择：
+// 1. 未尝试过且状态正常的地址。
+// 2. 未尝试过且退避时间已过的熔断地址，作为半开探测。
+// 3. 已尝试过但状态正常的地址。
+func (c *CollectorAddr) pickAddr(
+	tried []string, now time.Time, stats *model.SelfMonitorStats,
+) (string, *addrHealth) {
+	candidates := c.candidates()
+	for _, addr := range candidates {
+		if h := getAddrHealth(addr); !contains(tried, addr) && h.closed() {
+			return addr, h
+		}
+	}
+	for _, addr := range candidates {
+		if h := getAddrHealth(addr); !contains(tried, addr) && h.allow(now, stats) {
+			return addr, h
+		}
+	}
+	for _, addr := range candidates {
+		if h := getAddrHealth(addr); h.closed() {
+			return addr, h
+		}
+	}
+	return "", nil
+}
+
+// candidates 返回轮询顺序的直连地址，最后是域名地址。
+func (c *CollectorAddr) candidates() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	n := len(c.directIPs)
+	if !c.enableDirectIP || n == 0 {
+		return []string{c.FullURL}
+	}
+	addrs := make([]string, 0, n+1)
+	start := int(c.idx.Inc())
+	for i := 0; i < n; i++ {
+		addrs = append(addrs, c.directIPs[(start+i)%n])
+	}
+	return append(addrs, c.FullURL)
+}
+
+func contains(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
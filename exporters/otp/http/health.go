@@ -0,0 +1,187 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	httputil "galiosight.ai/galio-sdk-go/lib/http"
+	"galiosight.ai/galio-sdk-go/model"
+	"go.uber.org/atomic"
+)
+
+var (
+	// ErrUnavailable collector 不可达，数据没有到达服务端，其他传输方式（如 gRPC）包装此错误返回。
+	ErrUnavailable = errors.New("collector unavailable")
+	// ErrCircuitOpen 所有 collector 地址都处于熔断状态，本次请求没有实际发送。
+	ErrCircuitOpen = fmt.Errorf("%w: all collector addresses are circuit open", ErrUnavailable)
+)
+
+// circuitState 熔断器状态。
+type circuitState int32
+
+const (
+	circuitClosed   circuitState = iota // 正常，请求直接放行。
+	circuitOpen                         // 熔断，退避时间内请求直接跳过。
+	circuitHalfOpen                     // 半开，退避时间已过，只放行一个探测请求。
+)
+
+// 熔断参数，按变量定义，方便单测修改。
+var (
+	// failureThreshold 连续失败多少次后熔断。
+	failureThreshold = 3
+	// baseBackoff 第一次熔断的退避时间，之后每次探测失败翻倍。
+	baseBackoff = time.Second
+	// maxBackoff 最大退避时间。
+	maxBackoff = time.Minute
+	// backoffJitter 退避时间的随机抖动比例，避免所有 worker 同时探测。
+	backoffJitter = 0.2
+)
+
+// openAddrCount 当前处于熔断（含半开）状态的地址数量。
+var openAddrCount atomic.Int64
+
+// addrHealths 每个 collector 地址的健康状态，key 是完整的 url。
+// 同一个进程中，metrics 和 profiles 等导出器的所有 worker 共享同一份健康状态，
+// 这样一个 worker 发现地址不可用后，其他 worker 不会再去请求这个地址。
+var addrHealths sync.Map
+
+// addrHealth 单个 collector 地址的健康状态，使用带随机抖动的指数退避及半开熔断器。
+//
+// 1. 正常状态下，连续失败 failureThreshold 次后熔断，进入 open 状态。
+// 2. open 状态下，退避时间内请求直接跳过该地址。
+// 3. 退避时间过后进入 half-open 状态，只放行一个探测请求。
+// 4. 探测成功则恢复正常，探测失败则退避时间翻倍，重新进入 open 状态。
+type addrHealth struct {
+	mu        sync.Mutex
+	state     circuitState
+	failures  int       // 连续失败次数。
+	opens     int       // 连续熔断次数，用于计算指数退避时间。
+	openUntil time.Time // 退避结束时间。
+	probing   bool      // half-open 状态下，是否已经有探测请求在发送中。
+}
+
+// getAddrHealth 获取地址对应的健康状态，不存在则创建。
+func getAddrHealth(addr string) *addrHealth {
+	if h, ok := addrHealths.Load(addr); ok {
+		return h.(*addrHealth)
+	}
+	h, _ := addrHealths.LoadOrStore(addr, &addrHealth{})
+	return h.(*addrHealth)
+}
+
+// closed 地址是否处于正常状态。
+func (h *addrHealth) closed() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.state == circuitClosed
+}
+
+// allow 判断是否可以向该地址发送请求。
+// 退避时间已过时，转为 half-open 状态，并占用唯一的探测名额，调用方发送后必须调用 report。
+func (h *addrHealth) allow(now time.Time, stats *model.SelfMonitorStats) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	switch h.state {
+	case circuitClosed:
+		return true
+	case circuitOpen:
+		if now.Before(h.openUntil) {
+			return false
+		}
+		h.state = circuitHalfOpen
+		h.probing = true
+		stats.CollectorStats.CircuitHalfOpenTotal.Inc()
+		return true
+	default:
+		if h.probing {
+			return false
+		}
+		h.probing = true
+		stats.CollectorStats.CircuitHalfOpenTotal.Inc()
+		return true
+	}
+}
+
+// report 根据请求结果更新健康状态。
+func (h *addrHealth) report(err error, now time.Time, stats *model.SelfMonitorStats) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.probing = false
+	if !isUnhealthy(err) {
+		if h.state != circuitClosed {
+			openAddrCount.Dec()
+			stats.CollectorStats.CircuitCloseTotal.Inc()
+		}
+		h.state = circuitClosed
+		h.failures = 0
+		h.opens = 0
+		stats.CollectorStats.OpenAddrCount.Store(openAddrCount.Load())
+		return
+	}
+	h.failures++
+	if h.state == circuitClosed && h.failures < failureThreshold {
+		return
+	}
+	if h.state == circuitClosed {
+		openAddrCount.Inc()
+	}
+	h.state = circuitOpen
+	h.openUntil = now.Add(backoff(h.opens))
+	h.opens++
+	stats.CollectorStats.CircuitOpenTotal.Inc()
+	stats.CollectorStats.OpenAddrCount.Store(openAddrCount.Load())
+}
+
+// backoff 计算第 n 次（从 0 开始）熔断的退避时间：baseBackoff*2^n，上限 maxBackoff，并加上随机抖动。
+func backoff(n int) time.Duration {
+	d := baseBackoff
+	for i := 0; i < n && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	jitter := (rand.Float64()*2 - 1) * backoffJitter * float64(d)
+	return d + time.Duration(jitter)
+}
+
+// isUnhealthy 连接失败及超时，说明地址不可用。
+// 其他错误（如 HTTP 500）说明地址是通的，不影响健康状态。
+func isUnhealthy(err error) bool {
+	if err == nil {
+		return false
+	}
+	if httputil.IsNetOpError(err) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// IsUnavailable 判断 err 是否说明 collector 当前不可达，包括连接失败以及所有地址都处于熔断状态。
+// 此类错误说明数据没有到达服务端，可以安全地缓存后补发。
+func IsUnavailable(err error) bool {
+	return errors.Is(err, ErrUnavailable) || httputil.IsNetOpError(err)
+}
@@ -0,0 +1,128 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/lib/logs"
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// netErr 构造和 httputil.Post 返回结构一致的连接失败错误。
+var netErr = fmt.Errorf(
+	"httputil Do err: %w", &wrapErr{err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}},
+)
+
+type wrapErr struct{ err error }
+
+func (w *wrapErr) Error() string { return w.err.Error() }
+func (w *wrapErr) Unwrap() error { return w.err }
+
+func TestAddrHealth(t *testing.T) {
+	stats := &model.SelfMonitorStats{}
+	h := &addrHealth{}
+	now := time.Now()
+	for i := 0; i < failureThreshold-1; i++ {
+		h.report(netErr, now, stats)
+		assert.True(t, h.closed())
+	}
+	h.report(netErr, now, stats)
+	assert.False(t, h.closed())
+	assert.Equal(t, int64(1), stats.CircuitOpenTotal.Load())
+	// 退避时间内不放行。
+	assert.False(t, h.allow(now, stats))
+	// 退避时间过后，只放行一个探测请求。
+	later := now.Add(maxBackoff * 2)
+	assert.True(t, h.allow(later, stats))
+	assert.False(t, h.allow(later, stats))
+	assert.Equal(t, int64(1), stats.CircuitHalfOpenTotal.Load())
+	// 探测失败，退避时间翻倍。
+	h.report(netErr, later, stats)
+	assert.Equal(t, int64(2), stats.CircuitOpenTotal.Load())
+	assert.False(t, h.allow(later.Add(baseBackoff), stats))
+	// 探测成功，恢复正常。
+	evenLater := later.Add(maxBackoff * 2)
+	assert.True(t, h.allow(evenLater, stats))
+	h.report(nil, evenLater, stats)
+	assert.True(t, h.closed())
+	assert.Equal(t, int64(1), stats.CircuitCloseTotal.Load())
+}
+
+func TestAddrHealth_NonNetError(t *testing.T) {
+	stats := &model.SelfMonitorStats{}
+	h := &addrHealth{}
+	for i := 0; i < failureThreshold*2; i++ {
+		h.report(errors.New("httpStatusCodeErr: 500"), time.Now(), stats)
+	}
+	assert.True(t, h.closed())
+}
+
+func TestBackoff(t *testing.T) {
+	for n := 0; n < 10; n++ {
+		d := backoff(n)
+		assert.LessOrEqual(t, d, time.Duration(float64(maxBackoff)*(1+backoffJitter)))
+		assert.GreaterOrEqual(t, d, time.Duration(float64(baseBackoff)*(1-backoffJitter)))
+	}
+	assert.Less(t, backoff(0), backoff(5))
+}
+
+func TestCollectorAddr_pickAddr(t *testing.T) {
+	stats := &model.SelfMonitorStats{}
+	c := &CollectorAddr{enableDirectIP: true}
+	c.UpdateIPPorts("http://pick.test/d", []string{"10.0.0.1:1", "10.0.0.2:1"})
+	bad := "http://10.0.0.1:1/d"
+	for i := 0; i < failureThreshold; i++ {
+		getAddrHealth(bad).report(netErr, time.Now(), stats)
+	}
+	for i := 0; i < 4; i++ {
+		addr, h := c.pickAddr(nil, time.Now(), stats)
+		require.NotNil(t, h)
+		assert.Equal(t, "http://10.0.0.2:1/d", addr)
+	}
+	// 重试时优先选择未尝试过的地址。
+	addr, _ := c.pickAddr([]string{"http://10.0.0.2:1/d"}, time.Now(), stats)
+	assert.Equal(t, "http://pick.test/d", addr)
+}
+
+func TestHTTPGeneralExporter_CircuitOpen(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	stats := &model.SelfMonitorStats{}
+	h := NewHTTPGeneralExporter(1000, down.URL, logs.DefaultWrapper(), WithStats(stats))
+	msg := &model.Metrics{TimestampMs: 1}
+	for i := 0; i < failureThreshold; i++ {
+		err := h.Export(msg, NewReuseObject())
+		assert.True(t, IsUnavailable(err))
+	}
+	// 地址已熔断，不再实际发送请求。
+	err := h.Export(msg, NewReuseObject())
+	assert.True(t, errors.Is(err, ErrCircuitOpen))
+	assert.True(t, IsUnavailable(err))
+	assert.Equal(t, int64(1), stats.CircuitRejectTotal.Load())
+	assert.Equal(t, int64(1), stats.CircuitOpenTotal.Load())
+}
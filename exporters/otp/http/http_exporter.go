@@ -38,6 +38,15 @@ type HTTPExporter interface {
 	UpdateConfig(maxRetryCount int32, collector model.Collector)
 }
 
+// HeaderExporter 可选接口，支持按请求覆盖请求头的导出器实现此接口。
+// 多目标导出时，代理目标的数据使用目标自身的 X-Galileo-Target 请求头。
+type HeaderExporter interface {
+	// ExportWithHeaders 与 Export 相同，headers 覆盖构造时设置的同名请求头。
+	ExportWithHeaders(message proto.Message, obj *ReuseObject, headers map[string]string) error
+}
+
+var _ HeaderExporter = (*HTTPGeneralExporter)(nil)
+
 // HTTPGeneralExporter 使用 HTTP post 方式导出数据。
 type HTTPGeneralExporter struct {
 	Log           *logs.Wrapper
@@ -45,6 +54,7 @@ type HTTPGeneralExporter struct {
 	CollectorAddr *CollectorAddr
 	MaxRetryCount atomic.Int32
 	Headers       map[string]string
+	Stats         *model.SelfMonitorStats
 }
 
 // UpdateConfig 更新配置，用于运行时配置热更新。
@@ -67,6 +77,9 @@ func NewHTTPGeneralExporter(
 	for _, o := range options {
 		o(&opt)
 	}
+	if opt.stats == nil {
+		opt.stats = &model.SelfMonitorStats{}
+	}
 	return &HTTPGeneralExporter{
 		Log: log,
 		HTTPClient: &http.Client{
@@ -76,6 +89,7 @@ func NewHTTPGeneralExporter(
 		CollectorAddr: NewCollectorAddr(collectorAddr, opt.directIPPorts),
 		MaxRetryCount: *atomic.NewInt32(opt.maxRetryCount),
 		Headers:       opt.headers,
+		Stats:         opt.stats,
 	}
 }
 
@@ -83,6 +97,7 @@ type opts struct {
 	directIPPorts []string
 	headers       map[string]string
 	maxRetryCount int32
+	stats         *model.SelfMonitorStats
 }
 
 type option func(*opts)
@@ -108,6 +123,13 @@ func WithMaxRetryCount(maxRetryCount int32) option {
 	}
 }
 
+// WithStats 设置自监控统计对象，用于统计 collector 地址的熔断状态。
+func WithStats(stats *model.SelfMonitorStats) option {
+	return func(o *opts) {
+		o.stats = stats
+	}
+}
+
 // ReuseObject 保存可以重用的对象，减少内存分配。
 type ReuseObject struct {
 	PbBuf     *proto.Buffer
@@ -138,13 +160,22 @@ func (r *ReuseObject) Reset() {
 // 实现 HTTPExporter 接口，方便进行单测。
 // 由于 HTTP 长连接有时候会出现 EOF 错误，所以进行最多 h.maxRetryCount 次重试发送。
 func (h *HTTPGeneralExporter) Export(message proto.Message, obj *ReuseObject) error {
+	return h.export(message, obj, h.Headers)
+}
+
+// ExportWithHeaders 使用 HTTP 方式导出监控指标数据，headers 覆盖构造时设置的同名请求头。
+func (h *HTTPGeneralExporter) ExportWithHeaders(message proto.Message, obj *ReuseObject, headers map[string]string) error {
+	return h.export(message, obj, MergeHeaders(h.Headers, headers))
+}
+
+func (h *HTTPGeneralExporter) export(message proto.Message, obj *ReuseObject, headers map[string]string) error {
 	obj.Reset()
 	err := obj.PbBuf.Marshal(message)
 	if err != nil {
 		return err
 	}
 	obj.SnappyBuf = snappy.Encode(obj.SnappyBuf, obj.PbBuf.Bytes())
-	err = h.tryExport(message, obj)
+	err = h.tryExport(message, obj, headers)
 	if err != nil {
 		h.Log.Errorf("[galileo]HTTPGeneralExporter.Export|err=%v", err)
 		return err
@@ -154,14 +185,25 @@ func (h *HTTPGeneralExporter) Export(message proto.Message, obj *ReuseObject) er
 
 // tryExport 尝试发送请求，失败会进行重试。
 // 重试参数 h.maxRetryCount 可通过配置文件控制。
-// 实际执行次数等于 h.maxRetryCount+1。
+// 实际执行次数最多等于 h.maxRetryCount+1。
 // 只有连接失败等 net.OpError 错误才重试，HTTP 超时等情况不进行重试，避免服务端收到重复的包，导致数据翻倍。
-func (h *HTTPGeneralExporter) tryExport(message proto.Message, obj *ReuseObject) error {
+// 每次发送按健康度选择地址，跳过处于熔断状态的地址，所有地址都熔断时返回 ErrCircuitOpen。
+func (h *HTTPGeneralExporter) tryExport(message proto.Message, obj *ReuseObject, headers map[string]string) error {
 	var err error
+	tried := make([]string, 0, 2)
 	for i := 0; i < int(h.MaxRetryCount.Load())+1; i++ {
-		addr := h.CollectorAddr.GetAddr(i)
+		addr, health := h.CollectorAddr.pickAddr(tried, time.Now(), h.Stats)
+		if health == nil {
+			h.Stats.CollectorStats.CircuitRejectTotal.Inc()
+			if err == nil {
+				err = ErrCircuitOpen
+			}
+			break
+		}
+		tried = append(tried, addr)
 		var rsp []byte
-		rsp, err = httputil.Post(h.HTTPClient, addr, obj.SnappyBuf, httputil.WithHeaders(h.Headers))
+		rsp, err = httputil.Post(h.HTTPClient, addr, obj.SnappyBuf, httputil.WithHeaders(headers))
+		health.report(err, time.Now(), h.Stats)
 		h.Log.Debugf(
 			"[galileo]HTTPGeneralExporter.Export|addr=%v,message=%+v,rsp=%v,err=%v",
 			addr, message.String(), string(rsp), err,
@@ -175,3 +217,18 @@ func (h *HTTPGeneralExporter) tryExport(message proto.Message, obj *ReuseObject)
 	}
 	return err
 }
+
+// MergeHeaders 返回 base 被 override 覆盖后的请求头，不修改 base，override 为空时直接返回 base。
+func MergeHeaders(base, override map[string]string) map[string]string {
+	if len(override) == 0 {
+		return base
+	}
+	headers := make(map[string]string, len(base)+len(override))
+	for k, v := range base {
+		headers[k] = v
+	}
+	for k, v := range override {
+		headers[k] = v
+	}
+	return headers
+}
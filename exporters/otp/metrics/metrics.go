@@ -25,18 +25,25 @@
 // 5. 限流器的速率根据当前队列长度进行动态调整，预期能在时间窗口内发送完当前队列中的所有的数据。
 // 6. 数据是 pb 格式，先序列化，然后使用 snappy 压缩。
 // 7. 数据序列化及压缩过程中，需要频繁用到 []byte，为减少 gc，进行对象重用。每个线程使用自己的对象。
-// 7. 通过 HTTP post 方式发送数据到 otp 后端服务。
+// 7. 通过 HTTP post 方式发送数据到 otp 后端服务，collector 配置为 gRPC 传输时，使用 gRPC 双向流发送。
+// 8. 开启磁盘缓存时，collector 不可达导致发送失败的分页写入磁盘，collector 恢复后按时间顺序补发。
+// 9. 多目标监控按目标分页，各目标轮流入队，代理目标的分页使用目标自身的 X-Galileo-Target 请求头。
 package metrics
 
 import (
+	"time"
+
 	"galiosight.ai/galio-sdk-go/components"
 	"galiosight.ai/galio-sdk-go/configs"
+	otpgrpc "galiosight.ai/galio-sdk-go/exporters/otp/grpc"
 	otphttp "galiosight.ai/galio-sdk-go/exporters/otp/http"
 	"galiosight.ai/galio-sdk-go/lib/file"
 	"galiosight.ai/galio-sdk-go/lib/logs"
 	"galiosight.ai/galio-sdk-go/model"
 )
 
+var _ components.MultiTargetMetricsExporter = (*metricsExporter)(nil)
+
 // metricsExporter 监控导出器实现。
 type metricsExporter struct {
 	httpExporter otphttp.HTTPExporter
@@ -45,6 +52,7 @@ type metricsExporter struct {
 	log          *logs.Wrapper
 	stats        *model.SelfMonitorStats
 	fileExporter *file.Exporter
+	spool        *spool // 磁盘缓存，未开启时为 nil。
 }
 
 // UpdateConfig 更新配置。
@@ -65,29 +73,52 @@ func (m *metricsExporter) GetStats() *model.SelfMonitorStats {
 func NewExporter(cfg *configs.Metrics) (components.MetricsExporter, error) {
 	cfg = mergeDefaultCfg(cfg)
 	exporter := &metricsExporter{
-		cfg: cfg,
-		httpExporter: otphttp.NewHTTPGeneralExporter(
-			int(cfg.Exporter.TimeoutMs), cfg.Exporter.Collector.Addr, cfg.Log,
-			otphttp.WithDirectIPPorts(cfg.Exporter.Collector.DirectIpPort),
-			otphttp.WithHeaders(
-				map[string]string{
-					model.TenantHeaderKey:    cfg.Resource.TenantId,
-					model.TargetHeaderKey:    cfg.Resource.Target,
-					model.SchemaURLHeaderKey: cfg.SchemaURL,
-					model.APIKeyHeaderKey:    cfg.APIKey,
-				},
-			),
-			otphttp.WithMaxRetryCount(cfg.Exporter.MaxRetryCount),
-		),
+		cfg:          cfg,
+		httpExporter: newTransport(cfg),
 		out:          make(chan pageMetrics, cfg.Exporter.BufferSize),
 		log:          cfg.Log,
 		stats:        cfg.Stats,
 		fileExporter: file.NewExporter(cfg.Exporter.ExportToFile, "galileo/metrics", cfg.Log),
 	}
+	if cfg.Exporter.Spool.Enable {
+		// 磁盘缓存创建失败时，退化成不缓存，不影响正常上报。
+		s, err := newSpool(cfg.Exporter.Spool, cfg.Log, cfg.Stats)
+		if err != nil {
+			cfg.Log.Errorf("[galileo]metrics.NewExporter|newSpool err=%v", err)
+		} else {
+			exporter.spool = s
+		}
+	}
 	exporter.run()
 	return exporter, nil
 }
 
+// newTransport 根据 collector 的 DataTransmission 选择传输方式，默认使用 HTTP。
+// 传输方式只在创建时确定，运行时修改 DataTransmission 需要重建导出器。
+func newTransport(cfg *configs.Metrics) otphttp.HTTPExporter {
+	headers := map[string]string{
+		model.TenantHeaderKey:    cfg.Resource.TenantId,
+		model.TargetHeaderKey:    cfg.Resource.Target,
+		model.SchemaURLHeaderKey: cfg.SchemaURL,
+		model.APIKeyHeaderKey:    cfg.APIKey,
+	}
+	collector := cfg.Exporter.Collector
+	if collector.DataTransmission == model.DataTransmission_DATA_TRANSMISSION_gRPC {
+		return otpgrpc.NewGRPCGeneralExporter(
+			int(cfg.Exporter.TimeoutMs), collector.Addr, cfg.Log,
+			otpgrpc.WithHeaders(headers),
+			otpgrpc.WithMaxRetryCount(cfg.Exporter.MaxRetryCount),
+		)
+	}
+	return otphttp.NewHTTPGeneralExporter(
+		int(cfg.Exporter.TimeoutMs), collector.Addr, cfg.Log,
+		otphttp.WithDirectIPPorts(collector.DirectIpPort),
+		otphttp.WithHeaders(headers),
+		otphttp.WithMaxRetryCount(cfg.Exporter.MaxRetryCount),
+		otphttp.WithStats(cfg.Stats),
+	)
+}
+
 // mergeDefaultCfg 如果 cfg 参数未配置，使用默认配置填充。
 func mergeDefaultCfg(cfg *configs.Metrics) *configs.Metrics {
 	if cfg.Stats == nil {
@@ -108,9 +139,26 @@ func mergeDefaultCfg(cfg *configs.Metrics) *configs.Metrics {
 	if cfg.Exporter.TimeoutMs <= 0 {
 		cfg.Exporter.TimeoutMs = 1000
 	}
+	mergeDefaultSpool(&cfg.Exporter.Spool)
 	return cfg
 }
 
+// mergeDefaultSpool 如果磁盘缓存参数未配置，使用默认配置填充。
+func mergeDefaultSpool(spool *model.MetricsSpool) {
+	if spool.Dir == "" {
+		spool.Dir = "galileo/spool/metrics"
+	}
+	if spool.MaxBytes <= 0 {
+		spool.MaxBytes = 100 * 1024 * 1024
+	}
+	if spool.MaxAgeSeconds <= 0 {
+		spool.MaxAgeSeconds = 3600
+	}
+	if spool.ReplayIntervalSeconds <= 0 {
+		spool.ReplayIntervalSeconds = 10
+	}
+}
+
 // run 会启动若干个线程，执行数据上报任务。
 // 因为数据上报量会非常大，每个数据包创建一个线程的话，会导致大量线程，gc 负担很大，可能会影响性能。
 // 所以此处线程数量是固定的。
@@ -120,6 +168,9 @@ func (m *metricsExporter) run() {
 	for i := 0; i < int(m.cfg.Exporter.ThreadCount); i++ {
 		go m.worker(i)
 	}
+	if m.spool != nil {
+		go m.replay()
+	}
 }
 
 // worker 将 chan 中的数据，按照一定的速率上报给后端。
@@ -137,7 +188,7 @@ func (m *metricsExporter) run() {
 func (m *metricsExporter) worker(i int) {
 	r := otphttp.NewReuseObject()
 	for page := range m.out {
-		err := m.httpExporter.Export(page.metrics, r)
+		err := m.export(page.metrics, r)
 		if m.cfg.Exporter.ExportToFile {
 			m.fileExporter.Export(page.metrics)
 		}
@@ -148,61 +199,114 @@ func (m *metricsExporter) worker(i int) {
 			m.log.Errorf("[galileo]metricsExporter.worker|err=%v\n", err)
 			m.stats.MetricsStats.ReportErrorTotal.Inc()
 			m.stats.MetricsStats.ReportErrorRowsTotal.Add(int64(page.size))
+			m.spoolPage(page.metrics, err)
 		}
 	}
 }
 
+// spoolPage 只有连接失败或者所有地址都熔断时才写入磁盘缓存。
+// HTTP 超时等情况服务端可能已经收到数据，补发会导致数据翻倍。
+func (m *metricsExporter) spoolPage(page *model.Metrics, err error) {
+	if m.spool == nil || !otphttp.IsUnavailable(err) {
+		return
+	}
+	if err = m.spool.put(page); err != nil {
+		m.log.Errorf("[galileo]metricsExporter.spoolPage|err=%v", err)
+	}
+}
+
+// replay 定时检查磁盘缓存，collector 恢复后按时间顺序补发。
+func (m *metricsExporter) replay() {
+	ticker := time.NewTicker(time.Duration(m.cfg.Exporter.Spool.ReplayIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+	r := otphttp.NewReuseObject()
+	for range ticker.C {
+		m.replayOnce(r)
+	}
+}
+
+// replayOnce 从最旧的分页开始补发，直到缓存为空。
+// 出现网络错误或者熔断说明 collector 仍不可达，停止补发，等待下一个周期。
+// 其他错误说明分页本身有问题，重试也不会成功，直接丢弃，避免阻塞后面的分页。
+func (m *metricsExporter) replayOnce(r *otphttp.ReuseObject) {
+	for f := m.spool.peek(); f != nil; f = m.spool.peek() {
+		page, err := m.spool.read(f)
+		if err == nil {
+			err = m.export(page, r)
+			if err == nil {
+				m.spool.remove(f)
+				m.stats.MetricsStats.SpoolReplayPageTotal.Inc()
+				continue
+			}
+			if otphttp.IsUnavailable(err) {
+				return
+			}
+		}
+		m.log.Errorf("[galileo]metricsExporter.replayOnce|drop page=%v,err=%v", f.name, err)
+		if m.spool.remove(f) {
+			m.stats.MetricsStats.SpoolEvictPageTotal.Inc()
+		}
+	}
+}
+
+// export 发送一个分页，代理目标的分页覆盖 X-Galileo-Target 请求头。
+func (m *metricsExporter) export(page *model.Metrics, r *otphttp.ReuseObject) error {
+	if headers := m.targetHeaders(page); headers != nil {
+		if e, ok := m.httpExporter.(otphttp.HeaderExporter); ok {
+			return e.ExportWithHeaders(page, r, headers)
+		}
+	}
+	return m.httpExporter.Export(page, r)
+}
+
+// targetHeaders 分页的 target 与导出器自身的不同时（多目标处理器代理的目标），返回目标自身的请求头。
+// 根据分页的 NormalLabels 判断，磁盘缓存补发的分页同样适用。
+func (m *metricsExporter) targetHeaders(page *model.Metrics) map[string]string {
+	labels := page.NormalLabels
+	if labels == nil || int(model.NormalLabels_target) >= len(labels.Fields) {
+		return nil
+	}
+	target := labels.Fields[model.NormalLabels_target].Value
+	if target == "" || target == m.cfg.Resource.Target {
+		return nil
+	}
+	return map[string]string{model.TargetHeaderKey: target}
+}
+
 // Export 将数据放到 chan 中，然后通过多个 worker 并发进行上报。
 // 该函数是并发安全的。
 // 先进行分页，再放到队列中。目的是控制单包大小，方便数据平滑，避免单包过大导致的发送超时。
 // 如果数据量过多，chan 满的话，会阻塞住。
 func (m *metricsExporter) Export(metrics *model.Metrics) {
-	total := len(metrics.ClientMetrics) + len(metrics.ServerMetrics) + len(metrics.NormalMetrics) +
-		len(metrics.CustomMetrics)
+	total := rows(metrics)
 	pageSize := int(m.cfg.Exporter.PageSize)
 	for processed := 0; processed < total; {
-		page, pageCount := nextPage(metrics, pageSize)
+		page, pageCount := NextPage(metrics, pageSize)
 		processed += pageCount
 		m.out <- pageMetrics{metrics: page, size: pageCount}
 	}
 }
 
-func nextPage(metrics *model.Metrics, pageSize int) (*model.Metrics, int) {
-	page := &model.Metrics{
-		TimestampMs:  metrics.TimestampMs,
-		NormalLabels: metrics.NormalLabels,
-	}
-	pageCount := 0
-	if c := min(len(metrics.ClientMetrics), pageSize-pageCount); c != 0 {
-		page.ClientMetrics = make([]*model.ClientMetricsOTP, 0, c)
-		page.ClientMetrics = append(page.ClientMetrics, metrics.ClientMetrics[0:c]...)
-		metrics.ClientMetrics = metrics.ClientMetrics[c:len(metrics.ClientMetrics)]
-		pageCount += c
-	}
-	if c := min(len(metrics.ServerMetrics), pageSize-pageCount); c != 0 {
-		page.ServerMetrics = make([]*model.ServerMetricsOTP, 0, c)
-		page.ServerMetrics = append(page.ServerMetrics, metrics.ServerMetrics[0:c]...)
-		metrics.ServerMetrics = metrics.ServerMetrics[c:len(metrics.ServerMetrics)]
-		pageCount += c
-	}
-	if c := min(len(metrics.NormalMetrics), pageSize-pageCount); c != 0 {
-		page.NormalMetrics = make([]*model.NormalMetricOTP, 0, c)
-		page.NormalMetrics = append(page.NormalMetrics, metrics.NormalMetrics[0:c]...)
-		metrics.NormalMetrics = metrics.NormalMetrics[c:len(metrics.NormalMetrics)]
-		pageCount += c
-	}
-	if c := min(len(metrics.CustomMetrics), pageSize-pageCount); c != 0 {
-		page.CustomMetrics = make([]*model.CustomMetricsOTP, 0, c)
-		page.CustomMetrics = append(page.CustomMetrics, metrics.CustomMetrics[0:c]...)
-		metrics.CustomMetrics = metrics.CustomMetrics[c:len(metrics.CustomMetrics)]
-		pageCount += c
+// ExportMultiTarget 导出多个代理目标的监控。
+// 每个目标单独分页，分页不跨目标，各目标每轮取一页入队，数据量大的目标不会让其他目标排在它的所有分页之后。
+// 每个分页使用所属目标的 X-Galileo-Target 请求头，租户、API Key 使用导出器自身的配置。
+func (m *metricsExporter) ExportMultiTarget(batch *model.MultiTargetMetrics) {
+	pageSize := int(m.cfg.Exporter.PageSize)
+	pending := make([]*model.Metrics, 0, len(batch.Metrics))
+	for _, metrics := range batch.Metrics {
+		if metrics != nil && rows(metrics) > 0 {
+			pending = append(pending, metrics)
+		}
 	}
-	return page, pageCount
-}
-
-func min(x, y int) int {
-	if x < y {
-		return x
+	for len(pending) > 0 {
+		rest := pending[:0]
+		for _, metrics := range pending {
+			page, pageCount := NextPage(metrics, pageSize)
+			m.out <- pageMetrics{metrics: page, size: pageCount}
+			if rows(metrics) > 0 {
+				rest = append(rest, metrics)
+			}
+		}
+		pending = rest
 	}
-	return y
 }
@@ -19,12 +19,16 @@
 package metrics
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
+	"galiosight.ai/galio-sdk-go/components"
 	"galiosight.ai/galio-sdk-go/configs"
+	otpgrpc "galiosight.ai/galio-sdk-go/exporters/otp/grpc"
 	otphttp "galiosight.ai/galio-sdk-go/exporters/otp/http"
 	"galiosight.ai/galio-sdk-go/model"
 	"github.com/gogo/protobuf/proto"
@@ -369,9 +373,122 @@ func Test_metricsExporter_UpdateConfig(t *testing.T) {
 	require.Less(t, int64(1), stats.ReportHandledRowsTotal.Load())
 }
 
+// targetRequest collector 收到的一个请求。
+type targetRequest struct {
+	target  string
+	apiKey  string
+	metrics *model.Metrics
+}
+
+// newTargetCollector 模拟 collector，记录每个请求的 X-Galileo-Target 及数据。
+func newTargetCollector(t *testing.T) (*httptest.Server, func() []targetRequest) {
+	var mu sync.Mutex
+	var requests []targetRequest
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				decoded, err := snappy.Decode(nil, body)
+				require.NoError(t, err)
+				m := &model.Metrics{}
+				require.NoError(t, proto.Unmarshal(decoded, m))
+				mu.Lock()
+				requests = append(requests, targetRequest{
+					target:  r.Header.Get(model.TargetHeaderKey),
+					apiKey:  r.Header.Get(model.APIKeyHeaderKey),
+					metrics: m,
+				})
+				mu.Unlock()
+				_, _ = w.Write([]byte(`{"code":0,"msg":"success"}`))
+			},
+		),
+	)
+	t.Cleanup(ts.Close)
+	return ts, func() []targetRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]targetRequest(nil), requests...)
+	}
+}
+
+// targetMetrics 构造目标 target 的 n 条自定义监控。
+func targetMetrics(target string, n int) *model.Metrics {
+	resource := model.Resource{Target: target}
+	m := &model.Metrics{TimestampMs: 1, NormalLabels: model.ResourceToLabels(&resource)}
+	for i := 0; i < n; i++ {
+		m.CustomMetrics = append(m.CustomMetrics, &model.CustomMetricsOTP{MonitorName: target})
+	}
+	return m
+}
+
+func Test_metricsExporter_ExportMultiTarget(t *testing.T) {
+	ts, requests := newTargetCollector(t)
+	exporter, err := NewExporter(
+		&configs.Metrics{
+			Resource: model.Resource{Target: "PCG-123.gateway"},
+			APIKey:   "key",
+			Exporter: model.MetricsExporter{
+				Protocol:      "otp",
+				Collector:     model.Collector{Addr: ts.URL},
+				ThreadCount:   1,
+				BufferSize:    10,
+				WindowSeconds: 1,
+				PageSize:      2,
+				TimeoutMs:     1000,
+			},
+			Stats: &model.SelfMonitorStats{},
+		},
+	)
+	require.Nil(t, err)
+	exporter.Export(targetMetrics("PCG-123.gateway", 1))
+	exporter.(components.MultiTargetMetricsExporter).ExportMultiTarget(
+		&model.MultiTargetMetrics{
+			Metrics: []*model.Metrics{targetMetrics("a", 5), targetMetrics("b", 1), targetMetrics("c", 0)},
+		},
+	)
+	require.Eventually(t, func() bool { return len(requests()) == 5 }, 2*time.Second, 10*time.Millisecond)
+
+	var order []string
+	rows := make(map[string]int)
+	for _, r := range requests() {
+		target := r.metrics.NormalLabels.Fields[model.NormalLabels_target].Value
+		for _, c := range r.metrics.CustomMetrics {
+			require.Equal(t, target, c.MonitorName, "分页不跨目标")
+		}
+		require.LessOrEqual(t, len(r.metrics.CustomMetrics), 2)
+		require.Equal(t, "key", r.apiKey)
+		order = append(order, r.target)
+		rows[target] += len(r.metrics.CustomMetrics)
+	}
+	// 导出器自身的数据使用自身的 target，代理目标使用各自的 target，各目标轮流分页。
+	require.Equal(t, []string{"PCG-123.gateway", "a", "b", "a", "a"}, order)
+	require.Equal(t, map[string]int{"PCG-123.gateway": 1, "a": 5, "b": 1}, rows)
+	stats := exporter.(*metricsExporter).stats
+	require.Equal(t, int64(0), stats.ReportErrorTotal.Load())
+	require.Equal(t, int64(7), stats.ReportHandledRowsTotal.Load())
+}
+
+func Test_metricsExporter_targetHeaders(t *testing.T) {
+	m := &metricsExporter{cfg: &configs.Metrics{Resource: model.Resource{Target: "self"}}}
+	require.Nil(t, m.targetHeaders(&model.Metrics{}))
+	require.Nil(t, m.targetHeaders(targetMetrics("self", 1)))
+	require.Nil(t, m.targetHeaders(targetMetrics("", 1)))
+	require.Equal(t, map[string]string{model.TargetHeaderKey: "a"}, m.targetHeaders(targetMetrics("a", 1)))
+}
+
 func Test_snappy(t *testing.T) {
 	s := "x"
 	buf := make([]byte, 10)
 	ret := snappy.Encode(buf, []byte(s))
 	require.Equal(t, []byte{1, 0, 120}, ret)
 }
+
+func Test_newTransport(t *testing.T) {
+	cfg := mergeDefaultCfg(&configs.Metrics{})
+	_, ok := newTransport(cfg).(*otphttp.HTTPGeneralExporter)
+	require.True(t, ok)
+	cfg.Exporter.Collector.DataTransmission = model.DataTransmission_DATA_TRANSMISSION_gRPC
+	_, ok = newTransport(cfg).(*otpgrpc.GRPCGeneralExporter)
+	require.True(t, ok)
+}
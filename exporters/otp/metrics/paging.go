@@ -26,3 +26,52 @@ type pageMetrics struct {
 	metrics *model.Metrics
 	size    int
 }
+
+// NextPage 从 metrics 中取出最多 pageSize 条数据作为一页，返回分页及分页中的数据条数。
+// 取出的数据会从 metrics 的切片中去掉，循环调用直到返回 0 即完成分页，指标对象本身不会被复制。
+func NextPage(metrics *model.Metrics, pageSize int) (*model.Metrics, int) {
+	page := &model.Metrics{
+		TimestampMs:  metrics.TimestampMs,
+		NormalLabels: metrics.NormalLabels,
+		Temporality:  metrics.Temporality,
+	}
+	pageCount := 0
+	if c := min(len(metrics.ClientMetrics), pageSize-pageCount); c != 0 {
+		page.ClientMetrics = make([]*model.ClientMetricsOTP, 0, c)
+		page.ClientMetrics = append(page.ClientMetrics, metrics.ClientMetrics[0:c]...)
+		metrics.ClientMetrics = metrics.ClientMetrics[c:len(metrics.ClientMetrics)]
+		pageCount += c
+	}
+	if c := min(len(metrics.ServerMetrics), pageSize-pageCount); c != 0 {
+		page.ServerMetrics = make([]*model.ServerMetricsOTP, 0, c)
+		page.ServerMetrics = append(page.ServerMetrics, metrics.ServerMetrics[0:c]...)
+		metrics.ServerMetrics = metrics.ServerMetrics[c:len(metrics.ServerMetrics)]
+		pageCount += c
+	}
+	if c := min(len(metrics.NormalMetrics), pageSize-pageCount); c != 0 {
+		page.NormalMetrics = make([]*model.NormalMetricOTP, 0, c)
+		page.NormalMetrics = append(page.NormalMetrics, metrics.NormalMetrics[0:c]...)
+		metrics.NormalMetrics = metrics.NormalMetrics[c:len(metrics.NormalMetrics)]
+		pageCount += c
+	}
+	if c := min(len(metrics.CustomMetrics), pageSize-pageCount); c != 0 {
+		page.CustomMetrics = make([]*model.CustomMetricsOTP, 0, c)
+		page.CustomMetrics = append(page.CustomMetrics, metrics.CustomMetrics[0:c]...)
+		metrics.CustomMetrics = metrics.CustomMetrics[c:len(metrics.CustomMetrics)]
+		pageCount += c
+	}
+	return page, pageCount
+}
+
+// rows metrics 中未分页的数据条数。
+func rows(metrics *model.Metrics) int {
+	return len(metrics.ClientMetrics) + len(metrics.ServerMetrics) + len(metrics.NormalMetrics) +
+		len(metrics.CustomMetrics)
+}
+
+func min(x, y int) int {
+	if x < y {
+		return x
+	}
+	return y
+}
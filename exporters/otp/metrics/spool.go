@@ -0,0 +1,259 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/lib/logs"
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/golang/snappy"
+)
+
+const (
+	spoolFileSuffix = ".snappy"
+	spoolTmpSuffix  = ".tmp"
+)
+
+// spoolFile 磁盘缓存中的一个分页文件。
+type spoolFile struct {
+	name        string
+	timestampMs int64 // 分页数据的时间戳，用于按时间顺序补发。
+	createNano  int64 // 写入时间，用于过期淘汰，同一时间戳的分页按写入顺序补发。
+	size        int64
+}
+
+// spool 指标分页的磁盘缓存（预写队列）。
+//
+// 1. collector 不可达时，分页序列化成 pb 并用 snappy 压缩后写入磁盘，文件名 $dir/$timestampMs-$UnixNano.snappy。
+// 2. 先写临时文件再 rename，进程中途退出也不会留下半个分页。
+// 3. 启动时加载目录中已有的分页，进程重启后仍能补发。
+// 4. 磁盘占用超过 maxBytes 或者分页超过 maxAge 时，淘汰最旧的分页。
+// 5. 补发时按分页时间戳升序取出。
+type spool struct {
+	mu       sync.Mutex
+	dir      string
+	maxBytes int64
+	maxAge   time.Duration
+	files    []*spoolFile // 按 timestampMs、createNano 升序排列。
+	size     int64
+	log      *logs.Wrapper
+	stats    *model.SelfMonitorStats
+}
+
+// newSpool 创建磁盘缓存，并加载目录中已有的分页。
+func newSpool(cfg model.MetricsSpool, log *logs.Wrapper, stats *model.SelfMonitorStats) (*spool, error) {
+	s := &spool{
+		dir:      cfg.Dir,
+		maxBytes: cfg.MaxBytes,
+		maxAge:   time.Duration(cfg.MaxAgeSeconds) * time.Second,
+		log:      log,
+		stats:    stats,
+	}
+	if err := os.MkdirAll(s.dir, fs.ModePerm); err != nil {
+		return nil, fmt.Errorf("spool mkdir err: %w", err)
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.evictLocked(time.Now())
+	s.mu.Unlock()
+	return s, nil
+}
+
+// load 加载目录中已有的分页，删除残留的临时文件。
+func (s *spool) load() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("spool read dir err: %w", err)
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		name := e.Name()
+		if strings.HasSuffix(name, spoolTmpSuffix) {
+			_ = os.Remove(filepath.Join(s.dir, name))
+			continue
+		}
+		f, ok := parseSpoolFileName(name)
+		if !ok {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		f.size = info.Size()
+		s.files = append(s.files, f)
+		s.size += f.size
+	}
+	sort.Slice(s.files, func(i, j int) bool { return s.files[i].less(s.files[j]) })
+	s.stats.SpoolBytes.Store(s.size)
+	return nil
+}
+
+// put 将分页写入磁盘缓存，超限时淘汰最旧的分页。
+// 该函数是并发安全的。
+func (s *spool) put(page *model.Metrics) error {
+	data, err := page.Marshal()
+	if err != nil {
+		return err
+	}
+	data = snappy.Encode(nil, data)
+	now := time.Now()
+	f := &spoolFile{
+		timestampMs: page.TimestampMs,
+		createNano:  now.UnixNano(),
+		size:        int64(len(data)),
+	}
+	f.name = fmt.Sprintf("%d-%d%s", f.timestampMs, f.createNano, spoolFileSuffix)
+	path := filepath.Join(s.dir, f.name)
+	if err = os.WriteFile(path+spoolTmpSuffix, data, fs.ModePerm); err != nil {
+		return err
+	}
+	if err = os.Rename(path+spoolTmpSuffix, path); err != nil {
+		_ = os.Remove(path + spoolTmpSuffix)
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := sort.Search(len(s.files), func(i int) bool { return f.less(s.files[i]) })
+	s.files = append(s.files, nil)
+	copy(s.files[i+1:], s.files[i:])
+	s.files[i] = f
+	s.size += f.size
+	s.stats.SpoolPageTotal.Inc()
+	s.evictLocked(now)
+	return nil
+}
+
+// evictLocked 淘汰过期的分页，以及超过磁盘空间限制时最旧的分页。
+// 调用方需要持有 s.mu。
+func (s *spool) evictLocked(now time.Time) {
+	n := 0
+	for n < len(s.files) {
+		f := s.files[n]
+		expired := s.maxAge > 0 && now.Sub(time.Unix(0, f.createNano)) > s.maxAge
+		overflow := s.maxBytes > 0 && s.size > s.maxBytes
+		if !expired && !overflow {
+			break
+		}
+		s.removeFile(f)
+		s.size -= f.size
+		s.stats.SpoolEvictPageTotal.Inc()
+		n++
+	}
+	if n > 0 {
+		s.files = append(s.files[:0], s.files[n:]...)
+	}
+	s.stats.SpoolBytes.Store(s.size)
+}
+
+// peek 返回最旧的分页，缓存为空时返回 nil。
+func (s *spool) peek() *spoolFile {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.evictLocked(time.Now())
+	if len(s.files) == 0 {
+		return nil
+	}
+	return s.files[0]
+}
+
+// read 读取分页数据。
+func (s *spool) read(f *spoolFile) (*model.Metrics, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, f.name))
+	if err != nil {
+		return nil, err
+	}
+	if data, err = snappy.Decode(nil, data); err != nil {
+		return nil, err
+	}
+	page := &model.Metrics{}
+	if err = page.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// remove 从缓存中删除分页。分页已经被淘汰时，直接返回 false。
+func (s *spool) remove(f *spoolFile) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, cur := range s.files {
+		if cur == f {
+			s.removeFile(f)
+			s.files = append(s.files[:i], s.files[i+1:]...)
+			s.size -= f.size
+			s.stats.SpoolBytes.Store(s.size)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *spool) removeFile(f *spoolFile) {
+	if err := os.Remove(filepath.Join(s.dir, f.name)); err != nil && !os.IsNotExist(err) {
+		s.log.Errorf("[galileo]spool.removeFile|err=%v,name=%v", err, f.name)
+	}
+}
+
+// len 返回缓存中的分页数。
+func (s *spool) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.files)
+}
+
+func (f *spoolFile) less(o *spoolFile) bool {
+	if f.timestampMs != o.timestampMs {
+		return f.timestampMs < o.timestampMs
+	}
+	return f.createNano < o.createNano
+}
+
+// parseSpoolFileName 解析文件名 $timestampMs-$UnixNano.snappy。
+func parseSpoolFileName(name string) (*spoolFile, bool) {
+	if !strings.HasSuffix(name, spoolFileSuffix) {
+		return nil, false
+	}
+	ts, nano, ok := strings.Cut(strings.TrimSuffix(name, spoolFileSuffix), "-")
+	if !ok {
+		return nil, false
+	}
+	timestampMs, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	createNano, err := strconv.ParseInt(nano, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &spoolFile{name: name, timestampMs: timestampMs, createNano: createNano}, true
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/configs"
+	otphttp "galiosight.ai/galio-sdk-go/exporters/otp/http"
+	"galiosight.ai/galio-sdk-go/lib/logs"
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func newTestSpool(t *testing.T, maxBytes int64) (*spool, *model.SelfMonitorStats) {
+	stats := &model.SelfMonitorStats{}
+	s, err := newSpool(
+		model.MetricsSpool{Dir: t.TempDir(), MaxBytes: maxBytes, MaxAgeSeconds: 3600},
+		logs.DefaultWrapper(), stats,
+	)
+	require.Nil(t, err)
+	return s, stats
+}
+
+func Test_spool_order(t *testing.T) {
+	s, stats := newTestSpool(t, 0)
+	for _, ts := range []int64{3000, 1000, 2000} {
+		page := proto.Clone(data).(*model.Metrics)
+		page.TimestampMs = ts
+		require.Nil(t, s.put(page))
+	}
+	require.Equal(t, int64(3), stats.SpoolPageTotal.Load())
+	for _, ts := range []int64{1000, 2000, 3000} {
+		f := s.peek()
+		require.NotNil(t, f)
+		page, err := s.read(f)
+		require.Nil(t, err)
+		require.Equal(t, ts, page.TimestampMs)
+		require.Equal(t, len(data.ClientMetrics), len(page.ClientMetrics))
+		require.True(t, s.remove(f))
+	}
+	require.Nil(t, s.peek())
+	require.Equal(t, int64(0), stats.SpoolBytes.Load())
+}
+
+func Test_spool_evict(t *testing.T) {
+	s, stats := newTestSpool(t, 1)
+	require.Nil(t, s.put(proto.Clone(data).(*model.Metrics)))
+	// 单个分页已经超过磁盘空间限制，写入后立即被淘汰。
+	require.Equal(t, 0, s.len())
+	require.Equal(t, int64(1), stats.SpoolEvictPageTotal.Load())
+	require.Equal(t, int64(0), stats.SpoolBytes.Load())
+}
+
+func Test_spool_reload(t *testing.T) {
+	dir := t.TempDir()
+	cfg := model.MetricsSpool{Dir: dir, MaxBytes: 1 << 20, MaxAgeSeconds: 3600}
+	s, err := newSpool(cfg, logs.DefaultWrapper(), &model.SelfMonitorStats{})
+	require.Nil(t, err)
+	require.Nil(t, s.put(proto.Clone(data).(*model.Metrics)))
+	// 残留的临时文件和无法识别的文件不会被加载。
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "1-1.snappy.tmp"), []byte("x"), 0600))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "unknown"), []byte("x"), 0600))
+
+	stats := &model.SelfMonitorStats{}
+	reloaded, err := newSpool(cfg, logs.DefaultWrapper(), stats)
+	require.Nil(t, err)
+	require.Equal(t, 1, reloaded.len())
+	require.Less(t, int64(0), stats.SpoolBytes.Load())
+	_, err = os.Stat(filepath.Join(dir, "1-1.snappy.tmp"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func Test_metricsExporter_Spool_Replay(t *testing.T) {
+	// 先关闭服务，模拟 collector 不可达。
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	var received atomic.Int64
+	up := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				received.Inc()
+				_, _ = w.Write([]byte(`{"code":0,"msg":"success"}`))
+			},
+		),
+	)
+	defer up.Close()
+	cfg := &configs.Metrics{
+		Exporter: model.MetricsExporter{
+			Protocol:      "otp",
+			Collector:     model.Collector{Addr: down.URL},
+			ThreadCount:   1,
+			BufferSize:    10,
+			WindowSeconds: 1,
+			PageSize:      100,
+			TimeoutMs:     1000,
+			Spool: model.MetricsSpool{
+				Enable:                true,
+				Dir:                   t.TempDir(),
+				ReplayIntervalSeconds: 3600,
+			},
+		},
+		Stats: &model.SelfMonitorStats{},
+		Log:   logs.DefaultWrapper(),
+	}
+	exporter, err := NewExporter(cfg)
+	require.Nil(t, err)
+	m := exporter.(*metricsExporter)
+	require.NotNil(t, m.spool)
+	exporter.Export(proto.Clone(data).(*model.Metrics))
+	time.Sleep(time.Second)
+	require.Equal(t, int64(1), m.stats.ReportErrorTotal.Load())
+	require.Equal(t, int64(1), m.stats.SpoolPageTotal.Load())
+
+	r := otphttp.NewReuseObject()
+	// collector 仍不可达，分页保留在缓存中。
+	m.replayOnce(r)
+	require.Equal(t, 1, m.spool.len())
+
+	cfg.Exporter.Collector.Addr = up.URL
+	exporter.UpdateConfig(cfg)
+	m.replayOnce(r)
+	require.Equal(t, 0, m.spool.len())
+	require.Equal(t, int64(1), received.Load())
+	require.Equal(t, int64(1), m.stats.SpoolReplayPageTotal.Load())
+}
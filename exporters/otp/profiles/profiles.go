@@ -24,6 +24,7 @@ import (
 
 	"galiosight.ai/galio-sdk-go/components"
 	"galiosight.ai/galio-sdk-go/configs"
+	otpgrpc "galiosight.ai/galio-sdk-go/exporters/otp/grpc"
 	otphttp "galiosight.ai/galio-sdk-go/exporters/otp/http"
 	"galiosight.ai/galio-sdk-go/lib/file"
 	"galiosight.ai/galio-sdk-go/lib/logs"
@@ -47,19 +48,8 @@ type profilesExporter struct {
 func NewExporter(cfg *configs.Profiles) (components.ProfilesExporter, error) {
 	cfg = mergeDefaultCfg(cfg)
 	exporter := &profilesExporter{
-		cfg: cfg,
-		httpExporter: otphttp.NewHTTPGeneralExporter(
-			int(cfg.Exporter.TimeoutMs), cfg.Exporter.Collector.Addr, cfg.Log,
-			otphttp.WithDirectIPPorts(cfg.Exporter.Collector.DirectIpPort),
-			otphttp.WithHeaders(
-				map[string]string{
-					model.TenantHeaderKey: cfg.Resource.TenantId,
-					model.TargetHeaderKey: cfg.Resource.Target,
-					model.APIKeyHeaderKey: cfg.APIKey,
-				},
-			),
-			otphttp.WithMaxRetryCount(cfg.Exporter.MaxRetryCount),
-		),
+		cfg:          cfg,
+		httpExporter: newTransport(cfg),
 		queue:        make(chan *model.ProfilesBatch, cfg.Exporter.BufferSize),
 		log:          cfg.Log,
 		stats:        cfg.Stats,
@@ -91,6 +81,30 @@ func (p *profilesExporter) UpdateConfig(cfg *configs.Profiles) {
 	)
 }
 
+// newTransport 根据 collector 的 DataTransmission 选择传输方式，默认使用 HTTP。
+func newTransport(cfg *configs.Profiles) otphttp.HTTPExporter {
+	headers := map[string]string{
+		model.TenantHeaderKey: cfg.Resource.TenantId,
+		model.TargetHeaderKey: cfg.Resource.Target,
+		model.APIKeyHeaderKey: cfg.APIKey,
+	}
+	collector := cfg.Exporter.Collector
+	if collector.DataTransmission == model.DataTransmission_DATA_TRANSMISSION_gRPC {
+		return otpgrpc.NewGRPCGeneralExporter(
+			int(cfg.Exporter.TimeoutMs), collector.Addr, cfg.Log,
+			otpgrpc.WithHeaders(headers),
+			otpgrpc.WithMaxRetryCount(cfg.Exporter.MaxRetryCount),
+		)
+	}
+	return otphttp.NewHTTPGeneralExporter(
+		int(cfg.Exporter.TimeoutMs), collector.Addr, cfg.Log,
+		otphttp.WithDirectIPPorts(collector.DirectIpPort),
+		otphttp.WithHeaders(headers),
+		otphttp.WithMaxRetryCount(cfg.Exporter.MaxRetryCount),
+		otphttp.WithStats(cfg.Stats),
+	)
+}
+
 // mergeDefaultCfg 如果 cfg 参数未配置，使用默认配置填充。
 func mergeDefaultCfg(cfg *configs.Profiles) *configs.Profiles {
 	if cfg.Stats == nil {
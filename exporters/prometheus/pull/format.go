@@ -0,0 +1,384 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	libstrings "galiosight.ai/galio-sdk-go/lib/strings"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// Format 暴露格式。
+type Format int
+
+const (
+	// FormatText Prometheus text 格式 0.0.4。
+	FormatText Format = iota
+	// FormatOpenMetrics OpenMetrics 1.0.0 格式。
+	FormatOpenMetrics
+)
+
+const (
+	contentTypeText        = "text/plain; version=0.0.4; charset=utf-8"
+	contentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// ContentType 返回格式对应的 Content-Type。
+func (f Format) ContentType() string {
+	if f == FormatOpenMetrics {
+		return contentTypeOpenMetrics
+	}
+	return contentTypeText
+}
+
+// negotiate 根据 Accept 请求头选择格式，Prometheus 支持 OpenMetrics 时优先使用。
+func negotiate(accept string) Format {
+	if strings.Contains(accept, "application/openmetrics-text") {
+		return FormatOpenMetrics
+	}
+	return FormatText
+}
+
+// familyType 指标族类型。
+type familyType int
+
+const (
+	typeGauge familyType = iota
+	typeHistogram
+	typeSummary
+	typeCounter             // 累计模式的 counter。
+	typeCumulativeHistogram // 累计模式的 histogram。
+)
+
+// family 同名指标族，Prometheus 要求同名的样本连续输出。
+type family struct {
+	name    string
+	typ     familyType
+	samples []string
+}
+
+// writer 将一个窗口的 otp 指标转换成 Prometheus 暴露格式。
+// OMP 处理器导出的是窗口内的增量数据，不是单调递增的累计值，所以：
+// 1. counter、sum、set、max、min 都以 gauge 类型暴露，avg 以 sum/count 的 gauge 暴露。
+// 2. histogram 在 OpenMetrics 格式中以 gaugehistogram 类型暴露，在 text 格式中不声明类型，
+// 可以直接使用 histogram_quantile 计算窗口内的分位值，但不要对其使用 rate。
+// 3. summary 以 summary 类型暴露，分位值、sum、count 都是窗口内的值。
+// 处理器配置为累计模式时，counter、histogram 是时间线开始以来的累计值，所以以 counter、histogram 类型暴露，可以使用 rate。
+// OpenMetrics 格式要求 counter 样本名以 _total 结尾，不以 _total 结尾的 counter 仍以 gauge 类型暴露。
+type writer struct {
+	format       Format
+	cumulative   bool     // 是否累计模式的数据。
+	normalLabels []string // 已经格式化好的属性标签，每个样本都带上。
+	families     []*family
+	index        map[string]int
+}
+
+func newWriter(format Format, normalLabels *model.NormalLabels) *writer {
+	w := &writer{format: format, index: make(map[string]int)}
+	if normalLabels != nil {
+		for _, f := range normalLabels.Fields {
+			if f.Value != "" {
+				w.normalLabels = append(w.normalLabels, formatLabel(f.Name.String(), f.Value))
+			}
+		}
+	}
+	return w
+}
+
+// write 转换一个窗口的指标并写入 out。
+func (w *writer) write(out io.Writer, m *model.Metrics) error {
+	if m != nil {
+		w.add(m)
+	}
+	b := bufio.NewWriter(out)
+	for _, f := range w.families {
+		w.writeFamily(b, f)
+	}
+	if w.format == FormatOpenMetrics {
+		_, _ = b.WriteString("# EOF\n")
+	}
+	return b.Flush()
+}
+
+func (w *writer) add(m *model.Metrics) {
+	w.cumulative = m.Temporality == model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE
+	for _, c := range m.ClientMetrics {
+		labels := w.rpcLabels(c.RpcLabels)
+		w.addCounter("rpc_client_started_total", labels, float64(c.RpcClientStartedTotal))
+		w.addCounter("rpc_client_handled_total", labels, float64(c.RpcClientHandledTotal))
+		w.addHistogram("rpc_client_handled_seconds", labels, c.RpcClientHandledSeconds)
+	}
+	for _, s := range m.ServerMetrics {
+		labels := w.rpcLabels(s.RpcLabels)
+		w.addCounter("rpc_server_started_total", labels, float64(s.RpcServerStartedTotal))
+		w.addCounter("rpc_server_handled_total", labels, float64(s.RpcServerHandledTotal))
+		w.addHistogram("rpc_server_handled_seconds", labels, s.RpcServerHandledSeconds)
+	}
+	for _, n := range m.NormalMetrics {
+		w.addMetric(n.Metric, w.normalLabels)
+	}
+	for _, c := range m.CustomMetrics {
+		labels := w.customLabels(c.CustomLabels)
+		for _, metric := range c.Metrics {
+			w.addMetric(metric, labels)
+		}
+	}
+}
+
+func (w *writer) rpcLabels(labels *model.RPCLabels) []string {
+	if labels == nil {
+		return w.normalLabels
+	}
+	l := make([]string, 0, len(w.normalLabels)+len(labels.Fields))
+	l = append(l, w.normalLabels...)
+	for _, f := range labels.Fields {
+		l = append(l, formatLabel(f.Name.String(), f.Value))
+	}
+	return l
+}
+
+func (w *writer) customLabels(labels []*model.Label) []string {
+	l := make([]string, 0, len(w.normalLabels)+len(labels))
+	l = append(l, w.normalLabels...)
+	for _, label := range labels {
+		l = append(l, formatLabel(model.NameToIdentifier(label.Name), label.Value))
+	}
+	return l
+}
+
+func (w *writer) addMetric(m *model.MetricOTP, labels []string) {
+	if m == nil {
+		return
+	}
+	name := model.NameToIdentifier(m.Name)
+	switch m.Aggregation {
+	case model.Aggregation_AGGREGATION_HISTOGRAM, model.Aggregation_AGGREGATION_PROMETHEUS_HISTOGRAM:
+		w.addHistogram(name, labels, m.GetHistogram())
+	case model.Aggregation_AGGREGATION_COUNTER:
+		w.addCounter(name, labels, m.GetValue())
+	case model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM:
+		w.addExponentialHistogram(name, labels, m.GetExponentialHistogram())
+	case model.Aggregation_AGGREGATION_SUMMARY:
+		w.addSummary(name, labels, m.GetSummary())
+	case model.Aggregation_AGGREGATION_AVG:
+		if avg := m.GetAvg(); avg != nil {
+			if avg.Count != 0 {
+				w.addGauge(name, labels, avg.Sum/float64(avg.Count))
+			}
+			return
+		}
+		w.addGauge(name, labels, m.GetValue())
+	default:
+		w.addGauge(name, labels, m.GetValue())
+	}
+}
+
+// family 获取同名指标族，不存在则创建。同名不同类型的指标，返回 nil，直接丢弃。
+func (w *writer) family(name string, typ familyType) *family {
+	if i, ok := w.index[name]; ok {
+		if f := w.families[i]; f.typ == typ {
+			return f
+		}
+		return nil
+	}
+	f := &family{name: name, typ: typ}
+	w.index[name] = len(w.families)
+	w.families = append(w.families, f)
+	return f
+}
+
+func (w *writer) addGauge(name string, labels []string, value float64) {
+	if f := w.family(name, typeGauge); f != nil {
+		f.samples = append(f.samples, sample(name, labels, "", value))
+	}
+}
+
+// addCounter 累计模式以 counter 类型暴露，否则以 gauge 类型暴露。
+// OpenMetrics 格式的 counter 指标族名不带 _total 后缀，样本名带 _total 后缀。
+func (w *writer) addCounter(name string, labels []string, value float64) {
+	if !w.cumulative {
+		w.addGauge(name, labels, value)
+		return
+	}
+	familyName := name
+	if w.format == FormatOpenMetrics {
+		if !strings.HasSuffix(name, "_total") {
+			w.addGauge(name, labels, value)
+			return
+		}
+		familyName = strings.TrimSuffix(name, "_total")
+	}
+	if f := w.family(familyName, typeCounter); f != nil {
+		f.samples = append(f.samples, sample(name, labels, "", value))
+	}
+}
+
+// addHistogram vmrange 分桶转换成 le 累计分桶。
+// otp 只上报非 0 的分桶，每个分桶的上界作为 le，+Inf 分桶的值即总数。
+func (w *writer) addHistogram(name string, labels []string, h *model.Histogram) {
+	if h == nil {
+		return
+	}
+	typ := typeHistogram
+	if w.cumulative {
+		typ = typeCumulativeHistogram
+	}
+	f := w.family(name, typ)
+	if f == nil {
+		return
+	}
+	buckets := make([]leBucket, 0, len(h.Buckets))
+	for _, b := range h.Buckets {
+		_, end, err := libstrings.ParseVMRange(b.Range)
+		if err != nil || math.IsInf(end, 0) {
+			continue
+		}
+		buckets = append(buckets, leBucket{le: end, count: b.Count})
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].le < buckets[j].le })
+	w.writeBuckets(f, name, labels, buckets, h.Count, h.Sum)
+}
+
+func (w *writer) addSummary(name string, labels []string, s *model.Summary) {
+	if s == nil {
+		return
+	}
+	f := w.family(name, typeSummary)
+	if f == nil {
+		return
+	}
+	for _, q := range s.Quantiles {
+		quantile := formatLabel("quantile", libstrings.LeFloatToString(q.Quantile))
+		f.samples = append(f.samples, sample(name, labels, quantile, q.Value))
+	}
+	f.samples = append(f.samples, sample(name+"_sum", labels, "", s.Sum))
+	f.samples = append(f.samples, sample(name+"_count", labels, "", float64(s.Count)))
+}
+
+// leBucket 以上界 le 表示的分桶。
+type leBucket struct {
+	le    float64
+	count int64
+}
+
+// addExponentialHistogram 指数直方图转换成以分桶上界为 le 的普通直方图。
+// 负数分桶 index 越大越靠前，其次是值为 0 的分桶，最后是正数分桶。
+func (w *writer) addExponentialHistogram(name string, labels []string, h *model.ExponentialHistogram) {
+	if h == nil {
+		return
+	}
+	f := w.family(name, typeHistogram)
+	if f == nil {
+		return
+	}
+	negative, positive := h.GetNegative().GetBucketCounts(), h.GetPositive().GetBucketCounts()
+	buckets := make([]leBucket, 0, len(negative)+len(positive)+1)
+	for i := len(negative) - 1; i >= 0; i-- {
+		index := h.Negative.Offset + int32(i)
+		buckets = append(buckets, leBucket{le: -model.ExponentialUpperBound(h.Scale, index-1), count: negative[i]})
+	}
+	if h.ZeroCount != 0 {
+		buckets = append(buckets, leBucket{le: 0, count: h.ZeroCount})
+	}
+	for i := range positive {
+		index := h.Positive.Offset + int32(i)
+		buckets = append(buckets, leBucket{le: model.ExponentialUpperBound(h.Scale, index), count: positive[i]})
+	}
+	w.writeBuckets(f, name, labels, buckets, h.Count, h.Sum)
+}
+
+// writeBuckets 按 le 从小到大排好序的分桶写入累计计数及 count、sum。
+func (w *writer) writeBuckets(f *family, name string, labels []string, buckets []leBucket, count int64, sum float64) {
+	var cumulative int64
+	for i, b := range buckets {
+		cumulative += b.count
+		if i+1 < len(buckets) && buckets[i+1].le == b.le {
+			continue
+		}
+		le := formatLabel("le", libstrings.LeFloatToString(b.le))
+		f.samples = append(f.samples, sample(name+"_bucket", labels, le, float64(cumulative)))
+	}
+	inf := formatLabel("le", libstrings.VMRangeMax)
+	f.samples = append(f.samples, sample(name+"_bucket", labels, inf, float64(count)))
+	if w.format == FormatOpenMetrics && f.typ == typeHistogram {
+		f.samples = append(f.samples, sample(name+"_gcount", labels, "", float64(count)))
+		f.samples = append(f.samples, sample(name+"_gsum", labels, "", sum))
+		return
+	}
+	f.samples = append(f.samples, sample(name+"_count", labels, "", float64(count)))
+	f.samples = append(f.samples, sample(name+"_sum", labels, "", sum))
+}
+
+func (w *writer) writeFamily(b *bufio.Writer, f *family) {
+	switch {
+	case f.typ == typeGauge:
+		_, _ = b.WriteString("# TYPE " + f.name + " gauge\n")
+	case f.typ == typeSummary:
+		_, _ = b.WriteString("# TYPE " + f.name + " summary\n")
+	case f.typ == typeCounter:
+		_, _ = b.WriteString("# TYPE " + f.name + " counter\n")
+	case f.typ == typeCumulativeHistogram:
+		_, _ = b.WriteString("# TYPE " + f.name + " histogram\n")
+	case w.format == FormatOpenMetrics:
+		_, _ = b.WriteString("# TYPE " + f.name + " gaugehistogram\n")
+	default:
+		_, _ = b.WriteString("# TYPE " + f.name + " untyped\n")
+	}
+	for _, s := range f.samples {
+		_, _ = b.WriteString(s)
+	}
+}
+
+// sample 格式化一个样本，extra 是额外的标签（如 le），放在最后。
+func sample(name string, labels []string, extra string, value float64) string {
+	var b strings.Builder
+	b.WriteString(name)
+	if len(labels) != 0 || extra != "" {
+		b.WriteByte('{')
+		for i, l := range labels {
+			if i != 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(l)
+		}
+		if extra != "" {
+			if len(labels) != 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(extra)
+		}
+		b.WriteByte('}')
+	}
+	b.WriteByte(' ')
+	b.WriteString(libstrings.LeFloatToString(value))
+	b.WriteByte('\n')
+	return b.String()
+}
+
+// labelValueEscaper 标签值中的反斜杠、双引号、换行需要转义。
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatLabel(name, value string) string {
+	return name + `="` + labelValueEscaper.Replace(value) + `"`
+}
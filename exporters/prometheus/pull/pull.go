@@ -0,0 +1,130 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pull 将 OMP 处理器聚合后的指标以 Prometheus 拉取的方式暴露出来。
+//
+// 1. Exporter 实现 components.MetricsExporter，保存 aggregator.flushBuffer 最后一次导出的窗口数据。
+// 2. Exporter 实现 http.Handler，按 Accept 请求头以 Prometheus text 或者 OpenMetrics 格式输出。
+// 3. Exporter 可以包装另一个导出器，数据保存后继续交给它上报，两种方式可以同时使用。
+// 4. 每次拉取得到的都是最近一个完整窗口的数据，拉取间隔建议和窗口大小保持一致。
+// 5. Exporter 实现 components.MultiTargetMetricsExporter，多目标处理器代理目标的数据只交给被包装的导出器，
+// 不影响拉取到的处理器自身的窗口数据。
+package pull
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync/atomic"
+
+	"galiosight.ai/galio-sdk-go/components"
+	"galiosight.ai/galio-sdk-go/configs"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// Exporter Prometheus 拉取方式的导出器。
+type Exporter struct {
+	next  components.MetricsExporter // 被包装的导出器，可以为 nil。
+	stats *model.SelfMonitorStats
+	last  atomic.Value // *model.Metrics，最后一次导出的窗口数据。
+}
+
+var (
+	_ components.MetricsExporter            = (*Exporter)(nil)
+	_ components.MultiTargetMetricsExporter = (*Exporter)(nil)
+	_ http.Handler                          = (*Exporter)(nil)
+)
+
+// NewExporter 创建拉取方式的导出器，next 是被包装的导出器，不需要同时上报时传 nil。
+func NewExporter(next components.MetricsExporter) *Exporter {
+	e := &Exporter{next: next}
+	if next != nil {
+		e.stats = next.GetStats()
+	}
+	if e.stats == nil {
+		e.stats = &model.SelfMonitorStats{}
+	}
+	return e
+}
+
+// GetStats 返回自监控统计数据，包装了其他导出器时，返回被包装导出器的统计数据。
+func (e *Exporter) GetStats() *model.SelfMonitorStats {
+	return e.stats
+}
+
+// UpdateConfig 更新配置。
+func (e *Exporter) UpdateConfig(cfg *configs.Metrics) {
+	if e.next != nil {
+		e.next.UpdateConfig(cfg)
+	}
+}
+
+// Export 保存窗口数据，然后交给被包装的导出器。
+// 被包装的导出器分页时会修改 metrics 中的切片，所以先复制一份切片头，指标对象本身不会被修改，可以共享。
+func (e *Exporter) Export(metrics *model.Metrics) {
+	snapshot := &model.Metrics{
+		TimestampMs:   metrics.TimestampMs,
+		Temporality:   metrics.Temporality,
+		NormalLabels:  metrics.NormalLabels,
+		ClientMetrics: append([]*model.ClientMetricsOTP(nil), metrics.ClientMetrics...),
+		ServerMetrics: append([]*model.ServerMetricsOTP(nil), metrics.ServerMetrics...),
+		NormalMetrics: append([]*model.NormalMetricOTP(nil), metrics.NormalMetrics...),
+		CustomMetrics: append([]*model.CustomMetricsOTP(nil), metrics.CustomMetrics...),
+	}
+	e.last.Store(snapshot)
+	if e.next != nil {
+		e.next.Export(metrics)
+	}
+}
+
+// ExportMultiTarget 代理目标的数据不保存，只交给被包装的导出器，
+// 被包装的导出器没有实现 components.MultiTargetMetricsExporter 时，按目标逐个导出。
+func (e *Exporter) ExportMultiTarget(batch *model.MultiTargetMetrics) {
+	if e.next == nil {
+		return
+	}
+	if next, ok := e.next.(components.MultiTargetMetricsExporter); ok {
+		next.ExportMultiTarget(batch)
+		return
+	}
+	for _, metrics := range batch.Metrics {
+		e.next.Export(metrics)
+	}
+}
+
+// Write 以指定的格式输出最后一个窗口的数据，还没有数据时只输出空内容。
+func (e *Exporter) Write(w io.Writer, format Format) error {
+	last, _ := e.last.Load().(*model.Metrics)
+	var normalLabels *model.NormalLabels
+	if last != nil {
+		normalLabels = last.NormalLabels
+	}
+	return newWriter(format, normalLabels).write(w, last)
+}
+
+// ServeHTTP 实现 http.Handler，Prometheus 的 scrape 地址配置为此 handler 的路径即可。
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	format := negotiate(r.Header.Get("Accept"))
+	var buf bytes.Buffer
+	if err := e.Write(&buf, format); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", format.ContentType())
+	_, _ = w.Write(buf.Bytes())
+}
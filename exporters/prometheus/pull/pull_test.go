@@ -0,0 +1,239 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"galiosight.ai/galio-sdk-go/configs"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+type testExporter struct {
+	exported []*model.Metrics
+	stats    *model.SelfMonitorStats
+}
+
+func (t *testExporter) GetStats() *model.SelfMonitorStats { return t.stats }
+
+func (t *testExporter) Export(metrics *model.Metrics) {
+	t.exported = append(t.exported, metrics)
+	metrics.ClientMetrics = metrics.ClientMetrics[:0] // 模拟分页修改切片。
+}
+
+func (t *testExporter) UpdateConfig(*configs.Metrics) {}
+
+func testMetrics() *model.Metrics {
+	normalLabels := model.NewNormalLabels()
+	normalLabels.Fields[model.NormalLabels_target].Value = "PCG-123.demo.server"
+	return &model.Metrics{
+		TimestampMs:  1,
+		NormalLabels: normalLabels,
+		ClientMetrics: []*model.ClientMetricsOTP{{
+			RpcClientStartedTotal: 4,
+			RpcClientHandledTotal: 4,
+			RpcClientHandledSeconds: &model.Histogram{
+				Sum:   1.5,
+				Count: 4,
+				Buckets: []*model.Bucket{
+					{Range: "5.000e-01...1.000e+00", Count: 2},
+					{Range: "1.000e-01...2.000e-01", Count: 1},
+					{Range: "1.000e+00...+Inf", Count: 1},
+				},
+			},
+			RpcLabels: &model.RPCLabels{Fields: []model.RPCLabels_Field{
+				{Name: model.RPCLabels_callee_method, Value: `say"hi"`},
+			}},
+		}},
+		NormalMetrics: []*model.NormalMetricOTP{{Metric: &model.MetricOTP{
+			Name: "cpu", V: model.NewOTPValue(0.5), Aggregation: model.Aggregation_AGGREGATION_SET,
+		}}},
+		CustomMetrics: []*model.CustomMetricsOTP{{
+			Metrics: []*model.MetricOTP{
+				{Name: "耗时", V: model.NewOTPAvg(6, 3), Aggregation: model.Aggregation_AGGREGATION_AVG},
+			},
+			CustomLabels: []*model.Label{{Name: "_group", Value: "g"}},
+		}},
+	}
+}
+
+func TestExporter_ServeHTTP(t *testing.T) {
+	next := &testExporter{stats: &model.SelfMonitorStats{}}
+	e := NewExporter(next)
+	assert.Equal(t, next.stats, e.GetStats())
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, "", rec.Body.String())
+
+	e.Export(testMetrics())
+	require.Len(t, next.exported, 1)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, contentTypeText, rec.Header().Get("Content-Type"))
+	body := rec.Body.String()
+	target := `target="PCG-123.demo.server"`
+	assert.Contains(t, body, "# TYPE rpc_client_started_total gauge\n")
+	assert.Contains(t, body, `rpc_client_started_total{`+target+`,callee_method="say\"hi\""} 4`)
+	assert.Contains(t, body, `rpc_client_handled_seconds_bucket{`+target+`,callee_method="say\"hi\"",le="0.2"} 1`)
+	assert.Contains(t, body, `rpc_client_handled_seconds_bucket{`+target+`,callee_method="say\"hi\"",le="1"} 3`)
+	assert.Contains(t, body, `rpc_client_handled_seconds_bucket{`+target+`,callee_method="say\"hi\"",le="+Inf"} 4`)
+	assert.Contains(t, body, `rpc_client_handled_seconds_sum{`+target+`,callee_method="say\"hi\""} 1.5`)
+	assert.Contains(t, body, `cpu{`+target+`} 0.5`)
+	assert.Contains(t, body, model.NameToIdentifier("耗时")+`{`+target+`,_group="g"} 2`)
+
+	// 输出的内容可以被 Prometheus 解析。
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(strings.NewReader(body))
+	require.Nil(t, err)
+	assert.Len(t, families, 7)
+}
+
+type multiTargetExporter struct {
+	testExporter
+	batches []*model.MultiTargetMetrics
+}
+
+func (m *multiTargetExporter) ExportMultiTarget(batch *model.MultiTargetMetrics) {
+	m.batches = append(m.batches, batch)
+}
+
+func TestExporter_ExportMultiTarget(t *testing.T) {
+	own := testMetrics()
+	proxied := testMetrics()
+	proxied.NormalLabels = model.NewNormalLabels()
+	proxied.NormalLabels.Fields[model.NormalLabels_target].Value = "PCG-123.proxied.server"
+	batch := &model.MultiTargetMetrics{Metrics: []*model.Metrics{proxied}}
+
+	// 被包装的导出器支持多目标时整批导出，不支持时按目标逐个导出。
+	multi := &multiTargetExporter{}
+	e := NewExporter(multi)
+	e.Export(own)
+	e.ExportMultiTarget(batch)
+	assert.Equal(t, []*model.MultiTargetMetrics{batch}, multi.batches)
+	next := &testExporter{}
+	e = NewExporter(next)
+	e.Export(own)
+	e.ExportMultiTarget(batch)
+	assert.Equal(t, []*model.Metrics{own, proxied}, next.exported)
+	NewExporter(nil).ExportMultiTarget(batch)
+
+	// 拉取到的只有处理器自身的数据。
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Contains(t, rec.Body.String(), `target="PCG-123.demo.server"`)
+	assert.NotContains(t, rec.Body.String(), "PCG-123.proxied.server")
+}
+
+func TestExporter_OpenMetrics(t *testing.T) {
+	e := NewExporter(nil)
+	e.Export(testMetrics())
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text;version=1.0.0,text/plain;q=0.5")
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, contentTypeOpenMetrics, rec.Header().Get("Content-Type"))
+	body := rec.Body.String()
+	assert.Contains(t, body, "# TYPE rpc_client_handled_seconds gaugehistogram\n")
+	assert.Contains(t, body, "rpc_client_handled_seconds_gcount{")
+	assert.True(t, strings.HasSuffix(body, "# EOF\n"))
+}
+
+func TestExporter_Cumulative(t *testing.T) {
+	e := NewExporter(nil)
+	m := testMetrics()
+	m.Temporality = model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE
+	m.CustomMetrics[0].Metrics = append(m.CustomMetrics[0].Metrics, &model.MetricOTP{
+		Name: "req", V: model.NewOTPValue(3), Aggregation: model.Aggregation_AGGREGATION_COUNTER,
+	})
+	e.Export(m)
+
+	var b strings.Builder
+	require.Nil(t, e.Write(&b, FormatText))
+	body := b.String()
+	assert.Contains(t, body, "# TYPE rpc_client_started_total counter\n")
+	assert.Contains(t, body, "# TYPE req counter\n")
+	assert.Contains(t, body, "# TYPE rpc_client_handled_seconds histogram\n")
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(strings.NewReader(body))
+	require.Nil(t, err)
+	assert.Len(t, families, 6) // histogram 的 _bucket、_sum、_count 属于同一个指标族。
+
+	b.Reset()
+	require.Nil(t, e.Write(&b, FormatOpenMetrics))
+	body = b.String()
+	assert.Contains(t, body, "# TYPE rpc_client_started counter\nrpc_client_started_total{")
+	assert.Contains(t, body, "# TYPE req gauge\n") // OpenMetrics counter 需要 _total 后缀。
+	assert.Contains(t, body, "# TYPE rpc_client_handled_seconds histogram\n")
+	assert.Contains(t, body, "rpc_client_handled_seconds_count{")
+}
+
+func Test_writer_addExponentialHistogram(t *testing.T) {
+	w := newWriter(FormatText, nil)
+	w.addMetric(&model.MetricOTP{
+		Name: "cost",
+		V: model.NewOTPExponentialHistogram(&model.ExponentialHistogram{
+			Sum: 5, Count: 5, Scale: 0, ZeroCount: 1,
+			Positive: &model.ExponentialBuckets{Offset: 0, BucketCounts: []int64{2, 1}},
+			Negative: &model.ExponentialBuckets{Offset: 1, BucketCounts: []int64{1}},
+		}),
+		Aggregation: model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM,
+	}, nil)
+	var b strings.Builder
+	require.Nil(t, w.write(&b, nil))
+	body := b.String()
+	assert.Contains(t, body, `cost_bucket{le="-2"} 1`)
+	assert.Contains(t, body, `cost_bucket{le="0"} 2`)
+	assert.Contains(t, body, `cost_bucket{le="2"} 4`)
+	assert.Contains(t, body, `cost_bucket{le="4"} 5`)
+	assert.Contains(t, body, `cost_bucket{le="+Inf"} 5`)
+	assert.Contains(t, body, `cost_sum 5`)
+}
+
+func Test_writer_addSummary(t *testing.T) {
+	w := newWriter(FormatText, nil)
+	w.addMetric(&model.MetricOTP{
+		Name:        "size",
+		V:           model.NewOTPSummary(30, 3, []float64{0.5, 0.99}, []float64{10, 19.8}),
+		Aggregation: model.Aggregation_AGGREGATION_SUMMARY,
+	}, nil)
+	var b strings.Builder
+	require.Nil(t, w.write(&b, nil))
+	body := b.String()
+	assert.Contains(t, body, "# TYPE size summary\n")
+	assert.Contains(t, body, `size{quantile="0.5"} 10`)
+	assert.Contains(t, body, `size{quantile="0.99"} 19.8`)
+	assert.Contains(t, body, `size_sum 30`)
+	assert.Contains(t, body, `size_count 3`)
+
+	// 输出的内容可以被 Prometheus 解析。
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(strings.NewReader(body))
+	require.Nil(t, err)
+	require.Len(t, families, 1)
+	assert.Len(t, families["size"].GetMetric()[0].GetSummary().GetQuantile(), 2)
+}
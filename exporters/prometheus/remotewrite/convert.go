@@ -0,0 +1,264 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotewrite
+
+import (
+	"math"
+	"sort"
+
+	libstrings "galiosight.ai/galio-sdk-go/lib/strings"
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/model/prompb"
+)
+
+const nameLabel = "__name__"
+
+// converter 将一页 otp 指标转换成 remote write 请求。
+// 与 Prometheus 拉取导出器一致：
+// 1. 默认 OMP 处理器导出的是窗口内的增量数据，counter、sum、set、max、min 都以 gauge 类型上报，
+// histogram 以 gaugehistogram 类型上报，分桶转换成 le 累计分桶，avg 上报 sum/count。
+// 2. 处理器配置为累计模式时，counter、histogram 是累计值，以 counter、histogram 类型上报，可以使用 rate。
+// 3. summary 以 summary 类型上报，分位值、sum、count 都是窗口内的值。
+// 非并发安全，每次转换创建一个新的对象。
+type converter struct {
+	timestampMs  int64
+	cumulative   bool
+	normalLabels []prompb.Label       // 属性标签，每条时间线都带上。
+	families     map[string]bool      // 已经写入元数据的指标族。
+	req          *prompb.WriteRequest // 转换结果。
+}
+
+// toWriteRequest 将一页 otp 指标转换成 remote write 请求，所有样本使用窗口的时间戳。
+func toWriteRequest(m *model.Metrics) *prompb.WriteRequest {
+	c := &converter{
+		timestampMs: m.TimestampMs,
+		cumulative:  m.Temporality == model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE,
+		families:    make(map[string]bool),
+		req:         &prompb.WriteRequest{},
+	}
+	if m.NormalLabels != nil {
+		for _, f := range m.NormalLabels.Fields {
+			if f.Value != "" {
+				c.normalLabels = append(c.normalLabels, prompb.Label{Name: f.Name.String(), Value: f.Value})
+			}
+		}
+	}
+	for _, cm := range m.ClientMetrics {
+		labels := rpcLabels(cm.RpcLabels)
+		c.addCounter("rpc_client_started_total", labels, float64(cm.RpcClientStartedTotal))
+		c.addCounter("rpc_client_handled_total", labels, float64(cm.RpcClientHandledTotal))
+		c.addHistogram("rpc_client_handled_seconds", labels, cm.RpcClientHandledSeconds)
+	}
+	for _, sm := range m.ServerMetrics {
+		labels := rpcLabels(sm.RpcLabels)
+		c.addCounter("rpc_server_started_total", labels, float64(sm.RpcServerStartedTotal))
+		c.addCounter("rpc_server_handled_total", labels, float64(sm.RpcServerHandledTotal))
+		c.addHistogram("rpc_server_handled_seconds", labels, sm.RpcServerHandledSeconds)
+	}
+	for _, nm := range m.NormalMetrics {
+		c.addMetric(nm.Metric, nil)
+	}
+	for _, cm := range m.CustomMetrics {
+		labels := customLabels(cm.CustomLabels)
+		for _, metric := range cm.Metrics {
+			c.addMetric(metric, labels)
+		}
+	}
+	return c.req
+}
+
+func rpcLabels(labels *model.RPCLabels) []prompb.Label {
+	if labels == nil {
+		return nil
+	}
+	l := make([]prompb.Label, 0, len(labels.Fields))
+	for _, f := range labels.Fields {
+		l = append(l, prompb.Label{Name: f.Name.String(), Value: f.Value})
+	}
+	return l
+}
+
+func customLabels(labels []*model.Label) []prompb.Label {
+	l := make([]prompb.Label, 0, len(labels))
+	for _, label := range labels {
+		l = append(l, prompb.Label{Name: model.NameToIdentifier(label.Name), Value: label.Value})
+	}
+	return l
+}
+
+func (c *converter) addMetric(m *model.MetricOTP, labels []prompb.Label) {
+	if m == nil || m.Name == "" {
+		return
+	}
+	name := model.NameToIdentifier(m.Name)
+	switch m.Aggregation {
+	case model.Aggregation_AGGREGATION_COUNTER:
+		c.addCounter(name, labels, m.GetValue())
+	case model.Aggregation_AGGREGATION_HISTOGRAM, model.Aggregation_AGGREGATION_PROMETHEUS_HISTOGRAM:
+		c.addHistogram(name, labels, m.GetHistogram())
+	case model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM:
+		c.addExponentialHistogram(name, labels, m.GetExponentialHistogram())
+	case model.Aggregation_AGGREGATION_SUMMARY:
+		c.addSummary(name, labels, m.GetSummary())
+	case model.Aggregation_AGGREGATION_AVG:
+		if avg := m.GetAvg(); avg != nil {
+			if avg.Count != 0 {
+				c.addGauge(name, labels, avg.Sum/float64(avg.Count))
+			}
+			return
+		}
+		c.addGauge(name, labels, m.GetValue())
+	default:
+		c.addGauge(name, labels, m.GetValue())
+	}
+}
+
+// metadata 每个指标族只写入一次元数据，以第一次出现的类型为准。
+func (c *converter) metadata(name string, typ prompb.MetricMetadata_MetricType) {
+	if c.families[name] {
+		return
+	}
+	c.families[name] = true
+	c.req.Metadata = append(c.req.Metadata, prompb.MetricMetadata{Type: typ, MetricFamilyName: name})
+}
+
+func (c *converter) addGauge(name string, labels []prompb.Label, value float64) {
+	c.metadata(name, prompb.MetricMetadata_GAUGE)
+	c.addSample(name, labels, nil, value)
+}
+
+// addCounter 累计模式以 counter 类型上报，否则以 gauge 类型上报。
+func (c *converter) addCounter(name string, labels []prompb.Label, value float64) {
+	if !c.cumulative {
+		c.addGauge(name, labels, value)
+		return
+	}
+	c.metadata(name, prompb.MetricMetadata_COUNTER)
+	c.addSample(name, labels, nil, value)
+}
+
+func (c *converter) addSummary(name string, labels []prompb.Label, s *model.Summary) {
+	if s == nil {
+		return
+	}
+	c.metadata(name, prompb.MetricMetadata_SUMMARY)
+	for _, q := range s.Quantiles {
+		c.addSample(name, labels, &prompb.Label{Name: "quantile", Value: libstrings.LeFloatToString(q.Quantile)}, q.Value)
+	}
+	c.addSample(name+"_sum", labels, nil, s.Sum)
+	c.addSample(name+"_count", labels, nil, float64(s.Count))
+}
+
+// leBucket 以上界 le 表示的分桶。
+type leBucket struct {
+	le    float64
+	count int64
+}
+
+// addHistogram vmrange 分桶转换成 le 累计分桶。
+// otp 只上报非 0 的分桶，每个分桶的上界作为 le，+Inf 分桶的值即总数。
+func (c *converter) addHistogram(name string, labels []prompb.Label, h *model.Histogram) {
+	if h == nil {
+		return
+	}
+	buckets := make([]leBucket, 0, len(h.Buckets))
+	for _, b := range h.Buckets {
+		_, end, err := libstrings.ParseVMRange(b.Range)
+		if err != nil || math.IsInf(end, 0) {
+			continue
+		}
+		buckets = append(buckets, leBucket{le: end, count: b.Count})
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].le < buckets[j].le })
+	typ := prompb.MetricMetadata_GAUGEHISTOGRAM
+	if c.cumulative {
+		typ = prompb.MetricMetadata_HISTOGRAM
+	}
+	c.metadata(name, typ)
+	c.addBuckets(name, labels, buckets, h.Count, h.Sum)
+}
+
+// addExponentialHistogram 指数直方图转换成以分桶上界为 le 的普通直方图，指数直方图总是窗口内的值。
+// 负数分桶 index 越大越靠前，其次是值为 0 的分桶，最后是正数分桶。
+func (c *converter) addExponentialHistogram(name string, labels []prompb.Label, h *model.ExponentialHistogram) {
+	if h == nil {
+		return
+	}
+	negative, positive := h.GetNegative().GetBucketCounts(), h.GetPositive().GetBucketCounts()
+	buckets := make([]leBucket, 0, len(negative)+len(positive)+1)
+	for i := len(negative) - 1; i >= 0; i-- {
+		index := h.Negative.Offset + int32(i)
+		buckets = append(buckets, leBucket{le: -model.ExponentialUpperBound(h.Scale, index-1), count: negative[i]})
+	}
+	if h.ZeroCount != 0 {
+		buckets = append(buckets, leBucket{le: 0, count: h.ZeroCount})
+	}
+	for i := range positive {
+		index := h.Positive.Offset + int32(i)
+		buckets = append(buckets, leBucket{le: model.ExponentialUpperBound(h.Scale, index), count: positive[i]})
+	}
+	c.metadata(name, prompb.MetricMetadata_GAUGEHISTOGRAM)
+	c.addBuckets(name, labels, buckets, h.Count, h.Sum)
+}
+
+// addBuckets 按 le 从小到大排好序的分桶写入累计计数及 count、sum。
+func (c *converter) addBuckets(name string, labels []prompb.Label, buckets []leBucket, count int64, sum float64) {
+	var cumulative int64
+	for i, b := range buckets {
+		cumulative += b.count
+		if i+1 < len(buckets) && buckets[i+1].le == b.le {
+			continue
+		}
+		le := &prompb.Label{Name: "le", Value: libstrings.LeFloatToString(b.le)}
+		c.addSample(name+"_bucket", labels, le, float64(cumulative))
+	}
+	c.addSample(name+"_bucket", labels, &prompb.Label{Name: "le", Value: libstrings.VMRangeMax}, float64(count))
+	c.addSample(name+"_count", labels, nil, float64(count))
+	c.addSample(name+"_sum", labels, nil, sum)
+}
+
+// addSample 添加一条只有一个样本的时间线。
+// remote write 要求标签名唯一且按名字排序，指标名、extra（如 le）、时间线标签优先于属性标签。
+func (c *converter) addSample(name string, labels []prompb.Label, extra *prompb.Label, value float64) {
+	l := make([]prompb.Label, 0, 2+len(labels)+len(c.normalLabels))
+	l = append(l, prompb.Label{Name: nameLabel, Value: name})
+	if extra != nil {
+		l = append(l, *extra)
+	}
+	l = append(l, labels...)
+	l = append(l, c.normalLabels...)
+	c.req.Timeseries = append(c.req.Timeseries, prompb.TimeSeries{
+		Labels:  normalizeLabels(l),
+		Samples: []prompb.Sample{{Value: value, Timestamp: c.timestampMs}},
+	})
+}
+
+// normalizeLabels 按名字排序，去掉值为空的标签，同名标签保留靠前的一个。
+func normalizeLabels(labels []prompb.Label) []prompb.Label {
+	sort.SliceStable(labels, func(i, j int) bool { return labels[i].Name < labels[j].Name })
+	n := 0
+	for i := range labels {
+		if labels[i].Value == "" || (n > 0 && labels[n-1].Name == labels[i].Name) {
+			continue
+		}
+		labels[n] = labels[i]
+		n++
+	}
+	return labels[:n]
+}
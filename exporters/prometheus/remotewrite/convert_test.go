@@ -0,0 +1,171 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotewrite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/model/prompb"
+)
+
+func testMetrics() *model.Metrics {
+	normalLabels := model.NewNormalLabels()
+	normalLabels.Fields[model.NormalLabels_target].Value = "PCG-123.demo.server"
+	return &model.Metrics{
+		TimestampMs:  1000,
+		NormalLabels: normalLabels,
+		ClientMetrics: []*model.ClientMetricsOTP{{
+			RpcClientStartedTotal: 4,
+			RpcClientHandledTotal: 4,
+			RpcClientHandledSeconds: &model.Histogram{
+				Sum:   1.5,
+				Count: 4,
+				Buckets: []*model.Bucket{
+					{Range: "5.000e-01...1.000e+00", Count: 2},
+					{Range: "1.000e-01...2.000e-01", Count: 1},
+					{Range: "1.000e+00...+Inf", Count: 1},
+				},
+			},
+			RpcLabels: &model.RPCLabels{Fields: []model.RPCLabels_Field{
+				{Name: model.RPCLabels_callee_method, Value: "say"},
+				{Name: model.RPCLabels_caller_ip, Value: ""},
+			}},
+		}},
+		NormalMetrics: []*model.NormalMetricOTP{{Metric: &model.MetricOTP{
+			Name: "cpu", V: model.NewOTPValue(0.5), Aggregation: model.Aggregation_AGGREGATION_SET,
+		}}},
+		CustomMetrics: []*model.CustomMetricsOTP{{
+			Metrics: []*model.MetricOTP{
+				{Name: "cost", V: model.NewOTPAvg(6, 3), Aggregation: model.Aggregation_AGGREGATION_AVG},
+				{Name: "req", V: model.NewOTPValue(3), Aggregation: model.Aggregation_AGGREGATION_COUNTER},
+				{}, // 窗口内没有数据的点。
+			},
+			CustomLabels: []*model.Label{{Name: "_group", Value: "g"}, {Name: "target", Value: "override"}},
+		}},
+	}
+}
+
+// seriesByKey 以 "指标名{le}" 为 key 索引时间线。
+func seriesByKey(req *prompb.WriteRequest) map[string]prompb.TimeSeries {
+	m := make(map[string]prompb.TimeSeries)
+	for _, ts := range req.Timeseries {
+		key := ""
+		for _, l := range ts.Labels {
+			switch l.Name {
+			case nameLabel:
+				key = l.Value + key
+			case "le", "quantile":
+				key += "{" + l.Value + "}"
+			}
+		}
+		m[key] = ts
+	}
+	return m
+}
+
+func labelValue(ts prompb.TimeSeries, name string) string {
+	for _, l := range ts.Labels {
+		if l.Name == name {
+			return l.Value
+		}
+	}
+	return ""
+}
+
+func Test_toWriteRequest(t *testing.T) {
+	req := toWriteRequest(testMetrics())
+	series := seriesByKey(req)
+	assert.Len(t, series, 10)
+	for _, ts := range req.Timeseries {
+		require.Len(t, ts.Samples, 1)
+		assert.Equal(t, int64(1000), ts.Samples[0].Timestamp)
+		for i := 1; i < len(ts.Labels); i++ {
+			assert.Less(t, ts.Labels[i-1].Name, ts.Labels[i].Name) // 按名字排序且唯一。
+		}
+	}
+
+	started := series["rpc_client_started_total"]
+	assert.Equal(t, 4.0, started.Samples[0].Value)
+	assert.Equal(t, "say", labelValue(started, "callee_method"))
+	assert.Equal(t, "PCG-123.demo.server", labelValue(started, "target"))
+	for _, l := range started.Labels {
+		assert.NotEqual(t, "caller_ip", l.Name) // 空值标签不上报。
+	}
+
+	assert.Equal(t, 1.0, series["rpc_client_handled_seconds_bucket{0.2}"].Samples[0].Value)
+	assert.Equal(t, 3.0, series["rpc_client_handled_seconds_bucket{1}"].Samples[0].Value)
+	assert.Equal(t, 4.0, series["rpc_client_handled_seconds_bucket{+Inf}"].Samples[0].Value)
+	assert.Equal(t, 4.0, series["rpc_client_handled_seconds_count"].Samples[0].Value)
+	assert.Equal(t, 1.5, series["rpc_client_handled_seconds_sum"].Samples[0].Value)
+
+	assert.Equal(t, 0.5, series["cpu"].Samples[0].Value)
+	assert.Equal(t, 2.0, series["cost"].Samples[0].Value)
+	assert.Equal(t, "g", labelValue(series["cost"], "_group"))
+	assert.Equal(t, "override", labelValue(series["cost"], "target")) // 时间线标签优先于属性标签。
+	assert.Equal(t, 3.0, series["req"].Samples[0].Value)
+
+	types := make(map[string]prompb.MetricMetadata_MetricType)
+	for _, md := range req.Metadata {
+		types[md.MetricFamilyName] = md.Type
+	}
+	assert.Equal(t, prompb.MetricMetadata_GAUGE, types["rpc_client_started_total"])
+	assert.Equal(t, prompb.MetricMetadata_GAUGEHISTOGRAM, types["rpc_client_handled_seconds"])
+	assert.Equal(t, prompb.MetricMetadata_GAUGE, types["req"])
+}
+
+func Test_toWriteRequest_Cumulative(t *testing.T) {
+	m := testMetrics()
+	m.Temporality = model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE
+	types := make(map[string]prompb.MetricMetadata_MetricType)
+	for _, md := range toWriteRequest(m).Metadata {
+		types[md.MetricFamilyName] = md.Type
+	}
+	assert.Equal(t, prompb.MetricMetadata_COUNTER, types["rpc_client_started_total"])
+	assert.Equal(t, prompb.MetricMetadata_HISTOGRAM, types["rpc_client_handled_seconds"])
+	assert.Equal(t, prompb.MetricMetadata_COUNTER, types["req"])
+	assert.Equal(t, prompb.MetricMetadata_GAUGE, types["cpu"])
+}
+
+func Test_toWriteRequest_Summary(t *testing.T) {
+	m := &model.Metrics{
+		TimestampMs: 1,
+		NormalMetrics: []*model.NormalMetricOTP{{Metric: &model.MetricOTP{
+			Name:        "size",
+			Aggregation: model.Aggregation_AGGREGATION_SUMMARY,
+			V: &model.MetricOTP_Summary{Summary: &model.Summary{
+				Count: 3, Sum: 30, Quantiles: []*model.Quantile{{Quantile: 0.5, Value: 10}},
+			}},
+		}}},
+	}
+	series := seriesByKey(toWriteRequest(m))
+	assert.Equal(t, 10.0, series["size{0.5}"].Samples[0].Value)
+	assert.Equal(t, 30.0, series["size_sum"].Samples[0].Value)
+	assert.Equal(t, 3.0, series["size_count"].Samples[0].Value)
+}
+
+func Test_normalizeLabels(t *testing.T) {
+	labels := normalizeLabels([]prompb.Label{
+		{Name: "b", Value: "1"}, {Name: "a", Value: ""}, {Name: "b", Value: "2"}, {Name: "a", Value: "3"},
+	})
+	assert.Equal(t, []prompb.Label{{Name: "a", Value: "3"}, {Name: "b", Value: "1"}}, labels)
+}
@@ -0,0 +1,155 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package remotewrite 将 OMP 处理器聚合后的指标以 Prometheus remote write v1 协议上报。
+//
+// 1. 接收到的数据，复用 otp 导出器的分页逻辑进行分页，避免单个请求太大。
+// 2. 分页放入 chan 队列之中，启动若干 worker 并发转换成 WriteRequest 发送。
+// 3. WriteRequest 是 pb 格式，序列化后使用 snappy 压缩，与 otp 一样复用 buffer。
+// 4. 复用 otp 的 HTTP 导出器发送，支持直连地址、地址熔断及连接失败重试，请求头带上 API key 等鉴权信息。
+package remotewrite
+
+import (
+	"galiosight.ai/galio-sdk-go/components"
+	"galiosight.ai/galio-sdk-go/configs"
+	otphttp "galiosight.ai/galio-sdk-go/exporters/otp/http"
+	otpmetrics "galiosight.ai/galio-sdk-go/exporters/otp/metrics"
+	"galiosight.ai/galio-sdk-go/lib/logs"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// remote write 协议要求的请求头。
+const (
+	versionHeaderKey = "X-Prometheus-Remote-Write-Version"
+	version          = "0.1.0"
+)
+
+// page 一页数据及其中的 otp 数据条数。
+type page struct {
+	metrics *model.Metrics
+	size    int
+}
+
+// exporter remote write 导出器实现。
+type exporter struct {
+	httpExporter otphttp.HTTPExporter
+	cfg          *configs.Metrics
+	out          chan page
+	log          *logs.Wrapper
+	stats        *model.SelfMonitorStats
+}
+
+var _ components.MetricsExporter = (*exporter)(nil)
+
+// NewExporter 根据配置创建 remote write 导出器，collector.addr 为 remote write 地址，如：
+// http://127.0.0.1:9090/api/v1/write。
+func NewExporter(cfg *configs.Metrics) (components.MetricsExporter, error) {
+	fixConfig(cfg)
+	e := &exporter{
+		httpExporter: newTransport(cfg),
+		cfg:          cfg,
+		out:          make(chan page, cfg.Exporter.BufferSize),
+		log:          cfg.Log,
+		stats:        cfg.Stats,
+	}
+	for i := 0; i < int(cfg.Exporter.ThreadCount); i++ {
+		go e.worker()
+	}
+	return e, nil
+}
+
+// fixConfig 如果 cfg 参数未配置，使用默认配置填充，与 otp 导出器的默认配置一致。
+func fixConfig(cfg *configs.Metrics) {
+	if cfg.Stats == nil {
+		cfg.Stats = &model.SelfMonitorStats{}
+	}
+	if cfg.Log == nil {
+		cfg.Log = logs.DefaultWrapper()
+	}
+	if cfg.Exporter.ThreadCount <= 0 {
+		cfg.Exporter.ThreadCount = 10
+	}
+	if cfg.Exporter.BufferSize <= 0 {
+		cfg.Exporter.BufferSize = 1000 * 1000
+	}
+	if cfg.Exporter.PageSize <= 0 {
+		cfg.Exporter.PageSize = 1000
+	}
+	if cfg.Exporter.TimeoutMs <= 0 {
+		cfg.Exporter.TimeoutMs = 1000
+	}
+}
+
+func newTransport(cfg *configs.Metrics) otphttp.HTTPExporter {
+	headers := map[string]string{
+		"Content-Encoding":       "snappy",
+		"Content-Type":           "application/x-protobuf",
+		versionHeaderKey:         version,
+		model.TenantHeaderKey:    cfg.Resource.TenantId,
+		model.TargetHeaderKey:    cfg.Resource.Target,
+		model.SchemaURLHeaderKey: cfg.SchemaURL,
+		model.APIKeyHeaderKey:    cfg.APIKey,
+	}
+	collector := cfg.Exporter.Collector
+	return otphttp.NewHTTPGeneralExporter(
+		int(cfg.Exporter.TimeoutMs), collector.Addr, cfg.Log,
+		otphttp.WithDirectIPPorts(collector.DirectIpPort),
+		otphttp.WithHeaders(headers),
+		otphttp.WithMaxRetryCount(cfg.Exporter.MaxRetryCount),
+		otphttp.WithStats(cfg.Stats),
+	)
+}
+
+// UpdateConfig 更新配置。
+func (e *exporter) UpdateConfig(cfg *configs.Metrics) {
+	e.httpExporter.UpdateConfig(cfg.Exporter.MaxRetryCount, cfg.Exporter.Collector)
+}
+
+// GetStats 返回自监控统计数据。
+// 注意返回的是指针，调用方只能读取结果，不能修改它。
+func (e *exporter) GetStats() *model.SelfMonitorStats {
+	return e.stats
+}
+
+// Export 分页后放到 chan 中，由多个 worker 并发上报。
+// 该函数是并发安全的，chan 满的话，会阻塞住。
+func (e *exporter) Export(metrics *model.Metrics) {
+	total := len(metrics.ClientMetrics) + len(metrics.ServerMetrics) + len(metrics.NormalMetrics) +
+		len(metrics.CustomMetrics)
+	pageSize := int(e.cfg.Exporter.PageSize)
+	for processed := 0; processed < total; {
+		p, size := otpmetrics.NextPage(metrics, pageSize)
+		processed += size
+		e.out <- page{metrics: p, size: size}
+	}
+}
+
+// worker 转换成 WriteRequest 并上报，上报时更新自监控指标。
+func (e *exporter) worker() {
+	r := otphttp.NewReuseObject()
+	for p := range e.out {
+		err := e.httpExporter.Export(toWriteRequest(p.metrics), r)
+		e.stats.MetricsStats.ReportHandledTotal.Inc()
+		e.stats.MetricsStats.ReportHandledRowsTotal.Add(int64(p.size))
+		if err != nil {
+			e.log.Errorf("[galileo]remotewrite.worker|err=%v\n", err)
+			e.stats.MetricsStats.ReportErrorTotal.Inc()
+			e.stats.MetricsStats.ReportErrorRowsTotal.Add(int64(p.size))
+		}
+	}
+}
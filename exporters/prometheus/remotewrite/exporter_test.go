@@ -0,0 +1,103 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotewrite
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"galiosight.ai/galio-sdk-go/configs"
+	"galiosight.ai/galio-sdk-go/lib/logs"
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/model/prompb"
+)
+
+func TestExporter_Export(t *testing.T) {
+	received := make(chan *prompb.WriteRequest, 10)
+	headers := make(chan http.Header, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.Nil(t, err)
+		data, err := snappy.Decode(nil, body)
+		require.Nil(t, err)
+		req := &prompb.WriteRequest{}
+		require.Nil(t, req.Unmarshal(data))
+		headers <- r.Header
+		received <- req
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	cfg := &configs.Metrics{
+		Log:    logs.NopWrapper(),
+		APIKey: "key",
+		Exporter: model.MetricsExporter{
+			Collector:   model.Collector{Addr: srv.URL + "/api/v1/write"},
+			ThreadCount: 1,
+			PageSize:    2, // 3 条 otp 数据分 2 页上报。
+		},
+	}
+	e, err := NewExporter(cfg)
+	require.Nil(t, err)
+	e.Export(testMetrics())
+
+	var series int
+	for i := 0; i < 2; i++ {
+		select {
+		case req := <-received:
+			series += len(req.Timeseries)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout")
+		}
+		h := <-headers
+		assert.Equal(t, "snappy", h.Get("Content-Encoding"))
+		assert.Equal(t, "application/x-protobuf", h.Get("Content-Type"))
+		assert.Equal(t, version, h.Get(versionHeaderKey))
+		assert.Equal(t, "key", h.Get(model.APIKeyHeaderKey))
+	}
+	assert.Equal(t, 10, series)
+	assert.Eventually(t, func() bool {
+		return e.GetStats().MetricsStats.ReportHandledRowsTotal.Load() == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.Zero(t, e.GetStats().MetricsStats.ReportErrorTotal.Load())
+}
+
+func TestExporter_ExportError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+	cfg := &configs.Metrics{
+		Log:      logs.NopWrapper(),
+		Exporter: model.MetricsExporter{Collector: model.Collector{Addr: srv.URL}, ThreadCount: 1},
+	}
+	e, err := NewExporter(cfg)
+	require.Nil(t, err)
+	e.Export(testMetrics())
+	assert.Eventually(t, func() bool {
+		return e.GetStats().MetricsStats.ReportErrorRowsTotal.Load() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -20,11 +20,15 @@
 package helper
 
 import (
+	"net/http"
+
 	"galiosight.ai/galio-sdk-go/components"
 	"galiosight.ai/galio-sdk-go/configs"
 	"galiosight.ai/galio-sdk-go/errs"
 	"galiosight.ai/galio-sdk-go/exporters/otlp"
 	"galiosight.ai/galio-sdk-go/exporters/otp"
+	"galiosight.ai/galio-sdk-go/exporters/prometheus"
+	"galiosight.ai/galio-sdk-go/exporters/prometheus/pull"
 	"galiosight.ai/galio-sdk-go/processors/omp"
 	"galiosight.ai/galio-sdk-go/self"
 	selfmetric "galiosight.ai/galio-sdk-go/self/metric"
@@ -38,6 +42,7 @@ func buildFactories() *components.Factories {
 	factories.ExporterFactories = components.BuildExporterFactories(
 		otp.NewFactory(),
 		otlp.NewFactory(),
+		prometheus.NewFactory(),
 	)
 	return factories
 }
@@ -73,6 +78,37 @@ func GetMetricsProcessor(cfg *configs.Metrics) (components.MetricsProcessor, err
 	return getMetricsProcessor(cfg, exporter)
 }
 
+// GetMultiTargetMetricsProcessor 获取多目标监控处理器，处理器不支持多目标时返回错误。
+func GetMultiTargetMetricsProcessor(cfg *configs.Metrics) (components.MultiTargetMetricsProcessor, error) {
+	processor, err := GetMetricsProcessor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	multiTarget, ok := processor.(components.MultiTargetMetricsProcessor)
+	if !ok {
+		return nil, errs.ErrMultiTargetUnsupported
+	}
+	return multiTarget, nil
+}
+
+// GetMetricsProcessorWithPrometheusHandler 获取监控处理器，同时返回 Prometheus 拉取数据的 http.Handler。
+// 聚合后的数据照常通过配置的导出器上报，同时保留最后一个窗口的数据，供 Prometheus 拉取。
+func GetMetricsProcessorWithPrometheusHandler(cfg *configs.Metrics) (
+	components.MetricsProcessor, http.Handler, error,
+) {
+	self.Init(&cfg.Resource, selfmetric.WithAPIKey(cfg.APIKey))
+	exporter, err := getMetricsExporter(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	pullExporter := pull.NewExporter(exporter)
+	processor, err := getMetricsProcessor(cfg, pullExporter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return processor, pullExporter, nil
+}
+
 // GetLogsExporter 获取日志导出器。
 func GetLogsExporter(cfg *configs.Logs) (components.LogsExporter, error) {
 	self.Init(&cfg.Resource, selfmetric.WithAPIKey(cfg.APIKey))
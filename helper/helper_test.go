@@ -19,10 +19,20 @@
 package helper
 
 import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"galiosight.ai/galio-sdk-go/components"
 	"galiosight.ai/galio-sdk-go/configs"
 	"galiosight.ai/galio-sdk-go/model"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetMetricsProcessor(t *testing.T) {
@@ -51,6 +61,23 @@ func TestGetMetricsProcessor(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "获取监控处理器成功 (remote write)",
+			args: args{
+				cfg: &configs.Metrics{
+					Processor: model.MetricsProcessor{
+						Protocol:       "omp",
+						WindowSeconds:  10,
+						ClearSeconds:   100,
+						ExpiresSeconds: 100,
+					},
+					Exporter: model.MetricsExporter{
+						Protocol: "prometheus_remote_write",
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "获取监控处理器失败 (导出器失败)",
 			args: args{
@@ -95,3 +122,197 @@ func TestGetMetricsProcessor(t *testing.T) {
 		)
 	}
 }
+
+func TestGetMultiTargetMetricsProcessor(t *testing.T) {
+	type args struct {
+		cfg *configs.Metrics
+	}
+	tests := []struct {
+		args    args
+		name    string
+		wantErr bool
+	}{
+		{
+			name: "获取多目标监控处理器成功",
+			args: args{
+				cfg: &configs.Metrics{
+					Processor: model.MetricsProcessor{
+						Protocol:       "omp",
+						WindowSeconds:  10,
+						ClearSeconds:   100,
+						ExpiresSeconds: 100,
+						MultiTarget:    model.MultiTargetConfig{MaxTargets: 10},
+					},
+					Exporter: model.MetricsExporter{
+						Protocol: "otp",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "获取多目标监控处理器失败 (导出器失败)",
+			args: args{
+				cfg: &configs.Metrics{
+					Processor: model.MetricsProcessor{
+						Protocol:       "omp",
+						WindowSeconds:  10,
+						ClearSeconds:   100,
+						ExpiresSeconds: 100,
+					},
+					Exporter: model.MetricsExporter{
+						Protocol: "otp1",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "获取多目标监控处理器失败 (处理器失败)",
+			args: args{
+				cfg: &configs.Metrics{
+					Processor: model.MetricsProcessor{
+						Protocol: "omp1",
+					},
+					Exporter: model.MetricsExporter{
+						Protocol: "otp",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				p, err := GetMultiTargetMetricsProcessor(tt.args.cfg)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("GetMultiTargetMetricsProcessor() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if err == nil && p.ForTarget("PCG-123.gateway.backend") == nil {
+					t.Errorf("GetMultiTargetMetricsProcessor().ForTarget() = nil")
+				}
+			},
+		)
+	}
+}
+
+// newTargetCollector 模拟 collector，按 X-Galileo-Target 记录收到的自定义监控所属的 target。
+func newTargetCollector(t *testing.T) (*httptest.Server, func() map[string]map[string]bool) {
+	var mu sync.Mutex
+	targets := make(map[string]map[string]bool) // X-Galileo-Target -> 数据中的 target。
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				decoded, err := snappy.Decode(nil, body)
+				require.NoError(t, err)
+				m := &model.Metrics{}
+				require.NoError(t, proto.Unmarshal(decoded, m))
+				require.Equal(t, "key", r.Header.Get(model.APIKeyHeaderKey))
+				if len(m.CustomMetrics) > 0 {
+					header := r.Header.Get(model.TargetHeaderKey)
+					mu.Lock()
+					if targets[header] == nil {
+						targets[header] = make(map[string]bool)
+					}
+					targets[header][m.NormalLabels.Fields[model.NormalLabels_target].Value] = true
+					mu.Unlock()
+				}
+				_, _ = w.Write([]byte(`{"code":0,"msg":"success"}`))
+			},
+		),
+	)
+	t.Cleanup(ts.Close)
+	return ts, func() map[string]map[string]bool {
+		mu.Lock()
+		defer mu.Unlock()
+		copied := make(map[string]map[string]bool, len(targets))
+		for k, v := range targets {
+			copied[k] = v
+		}
+		return copied
+	}
+}
+
+// newMultiTargetCfg 1 秒窗口，上报到 collector 的多目标监控配置。
+func newMultiTargetCfg(collector string) *configs.Metrics {
+	return &configs.Metrics{
+		Resource: model.Resource{Target: "PCG-123.gateway"},
+		APIKey:   "key",
+		Processor: model.MetricsProcessor{
+			Protocol:       "omp",
+			WindowSeconds:  1,
+			ClearSeconds:   100,
+			ExpiresSeconds: 100,
+			MultiTarget:    model.MultiTargetConfig{MaxTargets: 10},
+		},
+		Exporter: model.MetricsExporter{
+			Protocol:  "otp",
+			Collector: model.Collector{Addr: collector},
+			PageSize:  1,
+			TimeoutMs: 1000,
+		},
+	}
+}
+
+func reportCustom(p components.TargetMetricsProcessor) {
+	for _, uid := range []string{"1", "2"} {
+		p.ProcessCustomMetrics(
+			&model.CustomMetrics{
+				MonitorName:  "proxy",
+				Metrics:      []model.Metric{{Name: "req", Aggregation: model.Aggregation_AGGREGATION_SUM, Value: 1}},
+				CustomLabels: []model.Label{{Name: "uid", Value: uid}},
+			},
+		)
+	}
+}
+
+func TestGetMultiTargetMetricsProcessor_Export(t *testing.T) {
+	ts, received := newTargetCollector(t)
+	p, err := GetMultiTargetMetricsProcessor(newMultiTargetCfg(ts.URL))
+	require.NoError(t, err)
+	reportCustom(p)
+	reportCustom(p.ForTarget("PCG-123.gateway.a"))
+	reportCustom(p.ForTarget("PCG-123.gateway.b"))
+	// 每个目标的数据使用各自的 X-Galileo-Target 上报，不混入其他目标。
+	want := map[string]map[string]bool{
+		"PCG-123.gateway":   {"PCG-123.gateway": true},
+		"PCG-123.gateway.a": {"PCG-123.gateway.a": true},
+		"PCG-123.gateway.b": {"PCG-123.gateway.b": true},
+	}
+	require.Eventually(
+		t, func() bool { return len(received()) == len(want) }, 5*time.Second, 50*time.Millisecond,
+	)
+	require.Equal(t, want, received())
+}
+
+func TestGetMetricsProcessorWithPrometheusHandler_ForTarget(t *testing.T) {
+	ts, received := newTargetCollector(t)
+	p, handler, err := GetMetricsProcessorWithPrometheusHandler(newMultiTargetCfg(ts.URL))
+	require.NoError(t, err)
+	multiTarget, ok := p.(components.MultiTargetMetricsProcessor)
+	require.True(t, ok)
+	reportCustom(multiTarget)
+	reportCustom(multiTarget.ForTarget("PCG-123.gateway.a"))
+
+	// 拉取到的是处理器自身的窗口数据，不会被代理目标的数据覆盖。
+	require.Eventually(
+		t, func() bool {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+			body := rec.Body.String()
+			return strings.Contains(body, `target="PCG-123.gateway"`) && !strings.Contains(body, "PCG-123.gateway.a")
+		}, 5*time.Second, 20*time.Millisecond,
+	)
+	// 代理目标的数据照常上报。
+	want := map[string]map[string]bool{
+		"PCG-123.gateway":   {"PCG-123.gateway": true},
+		"PCG-123.gateway.a": {"PCG-123.gateway.a": true},
+	}
+	require.Eventually(
+		t, func() bool { return len(received()) == len(want) }, 5*time.Second, 50*time.Millisecond,
+	)
+	require.Equal(t, want, received())
+}
@@ -40,7 +40,7 @@ func WithHeaders(headers map[string]string) option {
 	}
 }
 
-// Post 用 post 方式发送 HTTP 请求。
+// Post 用 post 方式发送 HTTP 请求，2xx 状态码都认为成功（如 remote write 返回 204）。
 func Post(client *http.Client, url string, data []byte, opts ...option) ([]byte, error) {
 	r := bytes.NewReader(data)
 	req, err := http.NewRequest("POST", url, r)
@@ -63,7 +63,7 @@ func Post(client *http.Client, url string, data []byte, opts ...option) ([]byte,
 		return nil, fmt.Errorf("httputil Do err: %w", err)
 	}
 	defer func() { _ = rsp.Body.Close() }()
-	if rsp.StatusCode != http.StatusOK {
+	if rsp.StatusCode < http.StatusOK || rsp.StatusCode >= http.StatusMultipleChoices {
 		rspBytes, _ := io.ReadAll(rsp.Body)
 		return rspBytes, fmt.Errorf("httpStatusCodeErr: %v, rsp=%v", rsp.StatusCode, string(rspBytes))
 	}
@@ -50,6 +50,20 @@ func TestPost(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestPostNoContent(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+		),
+	)
+	defer ts.Close()
+	buf, err := Post(ts.Client(), ts.URL, serializeTss())
+	assert.Nil(t, err)
+	assert.Empty(t, buf)
+}
+
 func TestPostURLError(t *testing.T) {
 	b := serializeTss()
 	buf, err := Post(&http.Client{}, "a", b)
@@ -28,6 +28,7 @@ import (
 	common "go.opentelemetry.io/proto/otlp/common/v1"
 	logs "go.opentelemetry.io/proto/otlp/logs/v1"
 	resource "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
 
 	"galiosight.ai/galio-sdk-go/self/metric"
 )
@@ -48,9 +49,21 @@ func (b *batchLog) add(data []byte) {
 	if err != nil {
 		return
 	}
+	b.append(log, len(data))
+}
+
+// addRecord 添加一条 recordCore 生成的日志，返回日志的字节数。
+// 此方法是线程安全的。
+func (b *batchLog) addRecord(record *logs.LogRecord) int {
+	size := proto.Size(record)
+	b.append(record, size)
+	return size
+}
+
+func (b *batchLog) append(record *logs.LogRecord, size int) {
 	b.mu.Lock()
-	b.records = append(b.records, log)
-	b.recordByteCnt += len(data)
+	b.records = append(b.records, record)
+	b.recordByteCnt += size
 	b.mu.Unlock()
 }
 
@@ -33,8 +33,20 @@ import (
 	"galiosight.ai/galio-sdk-go/self/metric"
 )
 
-// newZapCore 创建日志 core 对象。
+// newZapCore 创建日志 core 对象，配置了 RecordCore 时使用 recordCore，否则使用 JSON 格式的 core。
 func newZapCore(baseLogsCfg *baseconfigs.Logs, zl zap.AtomicLevel) (zapcore.Core, error) {
+	w, err := newLogsWriteSyncer(baseLogsCfg)
+	if err != nil {
+		return nil, err
+	}
+	if baseLogsCfg.RecordCore {
+		return newRecordCore(w, zl), nil
+	}
+	return newCore(w, zl), nil
+}
+
+// newLogsWriteSyncer 根据日志配置创建 exporter 及 writeSyncer，zap core 与 slog handler 共用。
+func newLogsWriteSyncer(baseLogsCfg *baseconfigs.Logs) (*writeSyncer, error) {
 	res := &baseLogsCfg.Resource
 	metric.Init(res, baseLogsCfg.SelfMonitor, baseLogsCfg.Log, metric.WithAPIKey(baseLogsCfg.APIKey))
 	exporter, err := helper.GetLogsExporter(baseLogsCfg) // 假设这里已经创建了带 schemaURL 的 exporter
@@ -42,16 +54,13 @@ func newZapCore(baseLogsCfg *baseconfigs.Logs, zl zap.AtomicLevel) (zapcore.Core
 		return nil, errors.New("NewLogsExporter error: " + err.Error())
 	}
 
-	// core 选项。
+	// writeSyncer 选项。
 	logsExporter := baseLogsCfg.Exporter
-	core := newCore(
-		NewWriteSyncer(
-			exporter,
-			expres.GenResource(baseLogsCfg.SchemaURL, res, expres.SchemaTypeLog),
-			toSyncerOptions(logsExporter, baseLogsCfg.Log)...,
-		), zl,
-	)
-	return core, err
+	return NewWriteSyncer(
+		exporter,
+		expres.GenResource(baseLogsCfg.SchemaURL, res, expres.SchemaTypeLog),
+		toSyncerOptions(logsExporter, baseLogsCfg.Log)...,
+	), nil
 }
 
 // toSyncerOptions 根据日志配置的 exporter 等转换成 writerSyncer 的选项
@@ -74,7 +83,7 @@ func toSyncerOptions(
 	return options
 }
 
-// newCore 构造 zap core 实例。
+// newCore 构造 JSON 格式的 zap core 实例，日志通过 syncer.Write 写入。
 func newCore(syncer zapcore.WriteSyncer, zl zap.AtomicLevel) zapcore.Core {
 	// 默认增加 ctxCore，吃掉_ctx field
 	return &ctxCore{Core: zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig()), syncer, zl)}
@@ -49,10 +49,15 @@ func TestNewZapCore(t *testing.T) {
 		Stats: metric.GetSelfMonitor().Stats,
 	}
 	zl := zap.NewAtomicLevelAt(zapcore.Level(baseLogsCfg.Log.GetLevel()))
-	_, err := newZapCore(baseLogsCfg, zl)
-	assert.Nil(t, err)
+	core, err := newZapCore(baseLogsCfg, zl)
 	assert.Nil(t, err)
+	assert.False(t, isRecordCore(core))
 	baseLogsCfg.Processor.MustLogTraced = true
 	_, err = newZapCore(baseLogsCfg, zl)
 	assert.Nil(t, err)
+	// 显式开启后才使用 recordCore。
+	baseLogsCfg.RecordCore = true
+	core, err = newZapCore(baseLogsCfg, zl)
+	assert.Nil(t, err)
+	assert.True(t, isRecordCore(core))
 }
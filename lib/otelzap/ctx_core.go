@@ -73,13 +73,14 @@ func (c *ctxCore) With(fields []zapcore.Field) zapcore.Core {
 	var ctx context.Context
 	for i, f := range fields {
 		if ctx = takeCtx(f); ctx != nil {
-			fields[i] = zap.Skip() // skip ctx field
+			// skip ctx field，JSON encoder 会忽略此字段，recordCore 从中获取 trace 信息
+			fields[i] = zapcore.Field{Key: fieldCtx, Type: zapcore.SkipType, Interface: f.Interface}
 			break
 		}
 	}
 	ret := &ctxCore{ctx: c.ctx, Core: c.Core}
 	n := len(fields)
-	if ctx != nil {
+	if ctx != nil && !isRecordCore(c.Core) {
 		n -= 1
 	}
 	if n > 0 { // 除了 ctx 外还有 n 个 field，需要 clone
@@ -101,3 +102,19 @@ func takeCtx(v zapcore.Field) context.Context {
 	}
 	return val.ctx
 }
+
+// isRecordCore 是否是 recordCore，recordCore 需要从 ctx field 中获取 trace 信息。
+func isRecordCore(c zapcore.Core) bool {
+	for {
+		switch v := c.(type) {
+		case *MatchCore:
+			c = v.Core
+		case *ctxCore:
+			c = v.Core
+		case *recordCore:
+			return true
+		default:
+			return false
+		}
+	}
+}
@@ -37,12 +37,10 @@ func WithContextDyeingLevel(s coreStrategy) zap.Option {
 			core = NewMatchCore(core, false, s)
 		}
 		return newCtxCore(core, func(c zapcore.Core, ctx context.Context) zapcore.Core {
-			sc := trace.SpanContextFromContext(ctx)
-			ts, _ := tracestate.Parse(sc.TraceState().Get(galileoVendor)) // TODO toraxie 可能比较低效，先实现功能，再优化性能
 			if m, ok := c.(*MatchCore); ok {
 				c = m.Core
 			}
-			return NewMatchCore(c, ts.Sample.RootStrategy == tracestate.StrategyDyeing, s)
+			return NewMatchCore(c, dyeingMatched(ctx), s)
 		})
 	})
 }
@@ -54,11 +52,25 @@ func WithContextSampleLevel(s coreStrategy) zap.Option {
 			core = NewMatchCore(core, false, s)
 		}
 		return newCtxCore(core, func(c zapcore.Core, ctx context.Context) zapcore.Core {
-			sc := trace.SpanContextFromContext(ctx)
 			if m, ok := c.(*MatchCore); ok {
 				c = m.Core
 			}
-			return NewMatchCore(c, sc.IsSampled(), s)
+			return NewMatchCore(c, sampleMatched(ctx), s)
 		})
 	})
 }
+
+// matchFunc 判断 ctx 中的 span 是否命中，命中的日志按 coreStrategy 处理。
+type matchFunc func(ctx context.Context) bool
+
+// dyeingMatched span 是否命中染色。
+func dyeingMatched(ctx context.Context) bool {
+	sc := trace.SpanContextFromContext(ctx)
+	ts, _ := tracestate.Parse(sc.TraceState().Get(galileoVendor)) // TODO toraxie 可能比较低效，先实现功能，再优化性能
+	return ts.Sample.RootStrategy == tracestate.StrategyDyeing
+}
+
+// sampleMatched span 是否命中采样。
+func sampleMatched(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsSampled()
+}
@@ -53,9 +53,22 @@ func NewLogger(cfg *configs.Logs, options ...zap.Option) (*zap.Logger, error) {
 // 额外设置 WithContextSampleLevel
 func toZapOptions(cfg *configs.Logs) []zap.Option {
 	var ret []zap.Option
+	strategy, dyeing := toStrategy(cfg)
+	if strategy != DefaultStrategy {
+		opt := WithContextSampleLevel(strategy)
+		if dyeing {
+			opt = WithContextDyeingLevel(strategy)
+		}
+		return append(ret, opt)
+	}
+	return ret
+}
+
+// toStrategy 根据配置获取日志策略，以及命中 trace 的判断方式是染色（dyeing 为 true）还是采样。
+func toStrategy(cfg *configs.Logs) (strategy coreStrategy, dyeing bool) {
 	logsProcessor := &cfg.Processor
 	coreType := model.IOCore
-	strategy := DefaultStrategy
+	strategy = DefaultStrategy
 	if logsProcessor.MustLogTraced {
 		coreType = model.SampleCore
 		strategy |= MustLogTraced
@@ -64,13 +77,7 @@ func toZapOptions(cfg *configs.Logs) []zap.Option {
 		coreType = model.SampleCore
 		strategy |= OnlyTraceLog
 	}
-	if coreType == model.SampleCore {
-		// 只有当 cfg 配置了 MustLogTraced or OnlyTraceLog 时，这段代码才生效，即才会使用配置中的 LogTracedType 字段
-		opt := WithContextSampleLevel(strategy)
-		if logsProcessor.LogTracedType == string(model.LogTracedDyeing) {
-			opt = WithContextDyeingLevel(strategy)
-		}
-		return append(ret, opt)
-	}
-	return ret
+	// 只有当 cfg 配置了 MustLogTraced or OnlyTraceLog 时，才会使用配置中的 LogTracedType 字段
+	dyeing = coreType == model.SampleCore && logsProcessor.LogTracedType == string(model.LogTracedDyeing)
+	return strategy, dyeing
 }
@@ -46,15 +46,20 @@ func NewMatchCore(c zapcore.Core, matched bool, strategy coreStrategy) *MatchCor
 
 // Enabled 根据传入的日志级别和自身采样标识判断是否支持。
 func (c *MatchCore) Enabled(level zapcore.Level) bool {
-	switch c.strategy {
+	return c.strategy.enabled(c.Core.Enabled(level), c.matched)
+}
+
+// enabled 根据日志级别是否满足（levelEnabled）及是否命中 trace（matched）判断是否导出日志。
+func (s coreStrategy) enabled(levelEnabled, matched bool) bool {
+	switch s {
 	case OnlyTraceLog: // 只导出采样的日志，未突破日志级别
-		return c.Core.Enabled(level) && c.matched
+		return levelEnabled && matched
 	case MustLogTraced: // 导出符合级别的所有日志，且采样日志突破级别
-		return c.Core.Enabled(level) || c.matched
+		return levelEnabled || matched
 	case OnlyTraceLog | MustLogTraced: // 只导出采样的日志，且采样日志突破级别
-		return c.matched
+		return matched
 	default: // 默认导出符合级别的所有日志
-		return c.Core.Enabled(level)
+		return levelEnabled
 	}
 }
 
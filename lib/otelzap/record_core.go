@@ -0,0 +1,83 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelzap
+
+import (
+	"errors"
+
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"go.uber.org/zap/zapcore"
+)
+
+var _ zapcore.Core = (*recordCore)(nil)
+
+// RecordWriter 接收 recordCore 生成的 LogRecord，NewWriteSyncer 返回的 writeSyncer 实现了此接口。
+type RecordWriter interface {
+	WriteRecord(record *logpb.LogRecord) error
+	Sync() error
+}
+
+// recordCore 直接将日志转换成 OTLP LogRecord 写入 writeSyncer 的 zap core，
+// 与 JSON encoder + writeSyncer.Write 相比，省去了 JSON 编码、拷贝及再解析的开销。
+type recordCore struct {
+	zapcore.LevelEnabler
+	enc    *recordEncoder
+	writer RecordWriter
+}
+
+// NewRecordCore 创建直接生成 OTLP LogRecord 的 zap core，日志通过 w 批量上报。
+// 与 newCore 一样默认增加 ctxCore，Context 字段中的 trace ID、span ID 及采样标记会写入 LogRecord。
+func NewRecordCore(w RecordWriter, enab zapcore.LevelEnabler) zapcore.Core {
+	return newRecordCore(w, enab)
+}
+
+func newRecordCore(w RecordWriter, enab zapcore.LevelEnabler) zapcore.Core {
+	return &ctxCore{Core: &recordCore{LevelEnabler: enab, enc: &recordEncoder{}, writer: w}}
+}
+
+// With 实现 zapcore.Core 接口，添加的字段在之后的每条日志中共享。
+func (c *recordCore) With(fields []zapcore.Field) zapcore.Core {
+	enc := c.enc.clone()
+	enc.addFields(fields)
+	return &recordCore{LevelEnabler: c.LevelEnabler, enc: enc, writer: c.writer}
+}
+
+// Check 实现 zapcore.Core 接口。
+func (c *recordCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Write 实现 zapcore.Core 接口，traceID、spanID 不合法时丢弃日志，与 JSON 格式的处理一致。
+func (c *recordCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	enc := c.enc.clone()
+	enc.addFields(fields)
+	record := enc.encodeEntry(ent)
+	if !isValidRecord(record) {
+		return errors.New("invalid traceID spanID")
+	}
+	return c.writer.WriteRecord(record)
+}
+
+// Sync 实现 zapcore.Core 接口。
+func (c *recordCore) Sync() error {
+	return c.writer.Sync()
+}
@@ -0,0 +1,273 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelzap
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"galiosight.ai/galio-sdk-go/configs"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+type mockRecordWriter struct {
+	records []*logpb.LogRecord
+	mu      sync.Mutex
+}
+
+func (w *mockRecordWriter) WriteRecord(record *logpb.LogRecord) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.records = append(w.records, record)
+	return nil
+}
+
+func (w *mockRecordWriter) Sync() error {
+	return nil
+}
+
+type user struct {
+	Name string
+	Age  int
+}
+
+func (u user) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("name", u.Name)
+	enc.AddInt("age", u.Age)
+	return nil
+}
+
+func attrMap(record *logpb.LogRecord) map[string]*commonpb.AnyValue {
+	m := make(map[string]*commonpb.AnyValue, len(record.Attributes))
+	for _, kv := range record.Attributes {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func traceContext(sampled bool) (context.Context, trace.SpanContext) {
+	var flags trace.TraceFlags
+	if sampled {
+		flags = trace.FlagsSampled
+	}
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanID:     trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		TraceFlags: flags,
+	})
+	return trace.ContextWithSpanContext(context.Background(), sc), sc
+}
+
+func TestRecordCore_Fields(t *testing.T) {
+	w := &mockRecordWriter{}
+	logger := zap.New(newRecordCore(w, zap.DebugLevel), zap.AddCaller())
+	logger.Info(
+		"hello",
+		zap.Int("int", 1),
+		zap.Uint64("uint64", 1<<63),
+		zap.Float64("float", 1.5),
+		zap.Bool("bool", true),
+		zap.Binary("bytes", []byte{1, 2}),
+		zap.ByteString("bytestring", []byte("str")),
+		zap.Duration("duration", 1500*time.Millisecond),
+		zap.Error(errors.New("err")),
+		zap.Object("user", user{Name: "galileo", Age: 3}),
+		zap.Ints("ints", []int{1, 2}),
+		zap.Any("reflected", map[string]int{"a": 1}),
+		zap.Namespace("ns"),
+		zap.String("inner", "v"),
+	)
+	require.Len(t, w.records, 1)
+	record := w.records[0]
+	assert.Equal(t, "info", record.SeverityText)
+	assert.Equal(t, logpb.SeverityNumber_SEVERITY_NUMBER_INFO, record.SeverityNumber)
+	assert.Equal(t, "hello", record.Body.GetStringValue())
+	assert.NotZero(t, record.TimeUnixNano)
+	assert.Empty(t, record.TraceId)
+
+	attrs := attrMap(record)
+	assert.Equal(t, int64(1), attrs["int"].GetIntValue())
+	assert.Equal(t, float64(1<<63), attrs["uint64"].GetDoubleValue())
+	assert.Equal(t, 1.5, attrs["float"].GetDoubleValue())
+	assert.True(t, attrs["bool"].GetBoolValue())
+	assert.Equal(t, []byte{1, 2}, attrs["bytes"].GetBytesValue())
+	assert.Equal(t, "str", attrs["bytestring"].GetStringValue())
+	assert.Equal(t, 1.5, attrs["duration"].GetDoubleValue())
+	assert.Equal(t, "err", attrs["error"].GetStringValue())
+	assert.Equal(t, `{"a":1}`, attrs["reflected"].GetStringValue())
+	assert.Contains(t, attrs["line"].GetStringValue(), "record_core_test.go")
+
+	userValues := attrs["user"].GetKvlistValue().GetValues()
+	require.Len(t, userValues, 2)
+	assert.Equal(t, "galileo", userValues[0].Value.GetStringValue())
+	assert.Equal(t, int64(3), userValues[1].Value.GetIntValue())
+
+	ints := attrs["ints"].GetArrayValue().GetValues()
+	require.Len(t, ints, 2)
+	assert.Equal(t, int64(2), ints[1].GetIntValue())
+
+	ns := attrs["ns"].GetKvlistValue().GetValues()
+	require.Len(t, ns, 1)
+	assert.Equal(t, "inner", ns[0].Key)
+}
+
+func TestRecordCore_Trace(t *testing.T) {
+	w := &mockRecordWriter{}
+	logger := zap.New(newRecordCore(w, zap.InfoLevel))
+	ctx, sc := traceContext(true)
+
+	// Context 作为日志字段。
+	logger.Info("field", Context(ctx))
+	// Context 通过 With 设置。
+	logger.With(Context(ctx)).Info("with")
+	// 兼容 JSON 格式的 traceID、spanID、sampled 字段。
+	logger.With(
+		zap.String(fieldTraceID, sc.TraceID().String()), zap.String(fieldSpanID, sc.SpanID().String()),
+		zap.String(fieldSampled, trueString),
+	).Info("string")
+	// traceID 不合法时丢弃日志。
+	logger.Info("invalid", zap.String(fieldTraceID, "1234"))
+
+	require.Len(t, w.records, 3)
+	traceID, spanID := sc.TraceID(), sc.SpanID()
+	for _, record := range w.records {
+		assert.Equal(t, traceID[:], record.TraceId, record.Body.GetStringValue())
+		assert.Equal(t, spanID[:], record.SpanId, record.Body.GetStringValue())
+		assert.True(t, attrMap(record)[fieldSampled].GetBoolValue(), record.Body.GetStringValue())
+		assert.NotContains(t, attrMap(record), fieldCtx)
+	}
+	assert.Equal(t, uint32(trace.FlagsSampled), w.records[0].Flags)
+}
+
+func TestRecordCore_With(t *testing.T) {
+	w := &mockRecordWriter{}
+	parent := zap.New(newRecordCore(w, zap.InfoLevel)).With(zap.String("parent", "p"), zap.Namespace("ns"))
+	parent.With(zap.String("child", "a")).Info("a")
+	parent.With(zap.String("child", "b")).Info("b")
+	parent.Info("parent")
+
+	require.Len(t, w.records, 3)
+	for i, want := range []string{"a", "b", ""} {
+		attrs := attrMap(w.records[i])
+		assert.Equal(t, "p", attrs["parent"].GetStringValue())
+		ns := attrs["ns"].GetKvlistValue().GetValues()
+		if want == "" {
+			assert.Empty(t, ns)
+			continue
+		}
+		require.Len(t, ns, 1)
+		assert.Equal(t, want, ns[0].Value.GetStringValue())
+	}
+}
+
+func TestRecordCore_MatchCore(t *testing.T) {
+	w := &mockRecordWriter{}
+	logger := zap.New(
+		newRecordCore(w, zap.NewAtomicLevelAt(zap.InfoLevel)),
+		toZapOptions(&configs.Logs{Processor: model.LogsProcessor{MustLogTraced: true}})...,
+	)
+	sampledCtx, sc := traceContext(true)
+	unsampledCtx, _ := traceContext(false)
+	logger.With(Context(unsampledCtx)).Debug("unsampled")
+	logger.With(Context(sampledCtx)).Debug("sampled")
+
+	require.Len(t, w.records, 1)
+	traceID := sc.TraceID()
+	assert.Equal(t, "sampled", w.records[0].Body.GetStringValue())
+	assert.Equal(t, traceID[:], w.records[0].TraceId)
+}
+
+func TestWriteSyncer_WriteRecord(t *testing.T) {
+	exporter := &mockLogsExporter{}
+	logger := zap.New(NewRecordCore(NewWriteSyncer(exporter, nil, WithMaxExportBatchSize(10)), zap.InfoLevel))
+	for i := 0; i < 25; i++ {
+		logger.Info("test message", zap.Int("i", i))
+	}
+	require.Nil(t, logger.Sync())
+	assert.Equal(t, 3, len(exporter.data))
+	assert.Equal(t, 25, logRecordCnt(exporter))
+}
+
+// benchmarkFields 模拟常见的业务日志字段。
+var benchmarkFields = []zap.Field{
+	zap.String("method", "/trpc.galileo.Demo/Hello"),
+	zap.Int("code", 0),
+	zap.Duration("cost", 15*time.Millisecond),
+	zap.Bool("retry", false),
+	zap.Object("user", user{Name: "galileo", Age: 3}),
+}
+
+// BenchmarkJSONCore 原有链路：JSON 编码、拷贝后再解析成 LogRecord。
+func BenchmarkJSONCore(b *testing.B) {
+	ctx, _ := traceContext(true)
+	sc := trace.SpanContextFromContext(ctx)
+	syncer := zapcore.AddSync(writerFunc(func(p []byte) (int, error) {
+		data := make([]byte, len(p))
+		copy(data, p)
+		_, err := convertToLogRecord(data)
+		return len(p), err
+	}))
+	logger := zap.New(newCore(syncer, zap.NewAtomicLevelAt(zap.InfoLevel))).With(
+		zap.String(fieldTraceID, sc.TraceID().String()), zap.String(fieldSpanID, sc.SpanID().String()),
+		zap.String(fieldSampled, trueString),
+	)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info("benchmark", benchmarkFields...)
+	}
+}
+
+// BenchmarkRecordCore 直接生成 LogRecord。
+func BenchmarkRecordCore(b *testing.B) {
+	ctx, _ := traceContext(true)
+	logger := zap.New(newRecordCore(discardRecordWriter{}, zap.NewAtomicLevelAt(zap.InfoLevel))).With(Context(ctx))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info("benchmark", benchmarkFields...)
+	}
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
+type discardRecordWriter struct{}
+
+func (discardRecordWriter) WriteRecord(*logpb.LogRecord) error {
+	return nil
+}
+
+func (discardRecordWriter) Sync() error {
+	return nil
+}
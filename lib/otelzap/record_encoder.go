@@ -0,0 +1,420 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelzap
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"go.uber.org/zap/zapcore"
+)
+
+var (
+	_ zapcore.ObjectEncoder = (*recordEncoder)(nil)
+	_ zapcore.ArrayEncoder  = (*arrayEncoder)(nil)
+)
+
+// recordEncoder 将 zap field 直接转换成 OTLP LogRecord 的属性，保留 int、float、bool、bytes、嵌套对象等类型，
+// 避免 JSON 编码后再解析。traceID、spanID、sampled 及 Context 字段转换成 LogRecord 的 trace 信息，与 JSON 格式兼容。
+type recordEncoder struct {
+	attrs      []*commonpb.KeyValue     // 顶层属性。
+	namespaces []*commonpb.KeyValueList // OpenNamespace 打开的命名空间，之后的属性添加到最后一个命名空间中。
+	traceID    []byte                   // trace ID，16 字节。
+	spanID     []byte                   // span ID，8 字节。
+	flags      uint32                   // W3C trace flags，从 Context 中获取。
+	sampled    bool                     // 是否命中采样。
+	hasSampled bool                     // 是否设置了 sampled，设置了才添加 sampled 属性。
+	nested     bool                     // 是否是嵌套对象，嵌套对象中没有 trace 相关字段。
+}
+
+// clone 拷贝 encoder，With 添加的属性在多条日志间共享，新添加的属性不会相互影响。
+func (e *recordEncoder) clone() *recordEncoder {
+	c := *e
+	c.attrs = append([]*commonpb.KeyValue(nil), e.attrs...)
+	if len(e.namespaces) == 0 {
+		return &c
+	}
+	// 打开的命名空间仍然会被修改，需要沿着命名空间链拷贝。命名空间总是其父级的最后一个属性。
+	c.namespaces = make([]*commonpb.KeyValueList, len(e.namespaces))
+	parent := c.attrs
+	for i, ns := range e.namespaces {
+		list := &commonpb.KeyValueList{Values: append([]*commonpb.KeyValue(nil), ns.Values...)}
+		parent[len(parent)-1] = &commonpb.KeyValue{Key: parent[len(parent)-1].Key, Value: kvlistValue(list)}
+		c.namespaces[i] = list
+		parent = list.Values
+	}
+	return &c
+}
+
+// addFields 添加 zap field，顶层的 trace 相关字段单独处理。
+func (e *recordEncoder) addFields(fields []zapcore.Field) {
+	for i := range fields {
+		e.addField(&fields[i])
+	}
+}
+
+func (e *recordEncoder) addField(f *zapcore.Field) {
+	if e.nested || len(e.namespaces) > 0 {
+		f.AddTo(e)
+		return
+	}
+	switch {
+	case f.Key == fieldCtx:
+		if ctx := takeCtx(*f); ctx != nil {
+			e.setContext(ctx)
+			return
+		}
+	case f.Type == zapcore.StringType && f.Key == fieldTraceID:
+		e.traceID, _ = hex.DecodeString(f.String)
+		return
+	case f.Type == zapcore.StringType && f.Key == fieldSpanID:
+		e.spanID, _ = hex.DecodeString(f.String)
+		return
+	case f.Type == zapcore.StringType && f.Key == fieldSampled:
+		e.sampled, e.hasSampled = f.String == trueString, true
+		return
+	}
+	f.AddTo(e)
+}
+
+// setContext 从 ctx 中获取 trace ID、span ID 及采样标记。
+func (e *recordEncoder) setContext(ctx context.Context) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	traceID, spanID := sc.TraceID(), sc.SpanID()
+	e.traceID, e.spanID = traceID[:], spanID[:]
+	e.flags = uint32(sc.TraceFlags())
+	e.sampled, e.hasSampled = sc.IsSampled(), true
+}
+
+// encodeEntry 使用日志条目及 encoder 中的属性生成 LogRecord，调用后 encoder 不能再使用。
+func (e *recordEncoder) encodeEntry(ent zapcore.Entry) *logpb.LogRecord {
+	attrs := e.attrs
+	if ent.LoggerName != "" {
+		attrs = append(attrs, &commonpb.KeyValue{Key: "logger", Value: stringValue(ent.LoggerName)})
+	}
+	if ent.Caller.Defined {
+		attrs = append(attrs, &commonpb.KeyValue{Key: "line", Value: stringValue(ent.Caller.String())})
+	}
+	if ent.Stack != "" {
+		attrs = append(attrs, &commonpb.KeyValue{Key: "stacktrace", Value: stringValue(ent.Stack)})
+	}
+	if e.hasSampled {
+		attrs = append(attrs, &commonpb.KeyValue{Key: fieldSampled, Value: boolValue(e.sampled)})
+	}
+	return &logpb.LogRecord{
+		TimeUnixNano:   uint64(ent.Time.UnixNano()),
+		SeverityNumber: severityNumber(ent.Level),
+		SeverityText:   ent.Level.String(),
+		Body:           stringValue(ent.Message),
+		Attributes:     attrs,
+		Flags:          e.flags,
+		TraceId:        e.traceID,
+		SpanId:         e.spanID,
+	}
+}
+
+// severityNumber zap 日志级别转换成 OTLP 日志级别。
+func severityNumber(level zapcore.Level) logpb.SeverityNumber {
+	switch level {
+	case zapcore.DebugLevel:
+		return logpb.SeverityNumber_SEVERITY_NUMBER_DEBUG
+	case zapcore.InfoLevel:
+		return logpb.SeverityNumber_SEVERITY_NUMBER_INFO
+	case zapcore.WarnLevel:
+		return logpb.SeverityNumber_SEVERITY_NUMBER_WARN
+	case zapcore.ErrorLevel:
+		return logpb.SeverityNumber_SEVERITY_NUMBER_ERROR
+	case zapcore.DPanicLevel, zapcore.PanicLevel, zapcore.FatalLevel:
+		return logpb.SeverityNumber_SEVERITY_NUMBER_FATAL
+	default:
+		return logpb.SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED
+	}
+}
+
+func (e *recordEncoder) add(key string, v *commonpb.AnyValue) {
+	kv := &commonpb.KeyValue{Key: key, Value: v}
+	if n := len(e.namespaces); n > 0 {
+		e.namespaces[n-1].Values = append(e.namespaces[n-1].Values, kv)
+		return
+	}
+	e.attrs = append(e.attrs, kv)
+}
+
+// AddArray 实现 zapcore.ObjectEncoder 接口，数组转换成 ArrayValue。
+func (e *recordEncoder) AddArray(key string, marshaler zapcore.ArrayMarshaler) error {
+	v, err := marshalArray(marshaler)
+	e.add(key, v)
+	return err
+}
+
+// AddObject 实现 zapcore.ObjectEncoder 接口，嵌套对象转换成 KvlistValue。
+func (e *recordEncoder) AddObject(key string, marshaler zapcore.ObjectMarshaler) error {
+	v, err := marshalObject(marshaler)
+	e.add(key, v)
+	return err
+}
+
+// AddBinary 实现 zapcore.ObjectEncoder 接口，value 在日志函数返回后可能被修改，需要拷贝。
+func (e *recordEncoder) AddBinary(key string, value []byte) { e.add(key, bytesValue(value)) }
+
+// AddByteString 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddByteString(key string, value []byte) {
+	e.add(key, stringValue(string(value)))
+}
+
+// AddBool 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddBool(key string, value bool) { e.add(key, boolValue(value)) }
+
+// AddComplex128 实现 zapcore.ObjectEncoder 接口，OTLP 没有复数类型，转换成字符串。
+func (e *recordEncoder) AddComplex128(key string, value complex128) { e.add(key, complexValue(value)) }
+
+// AddComplex64 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddComplex64(key string, value complex64) {
+	e.add(key, complexValue(complex128(value)))
+}
+
+// AddDuration 实现 zapcore.ObjectEncoder 接口，与 JSON 格式一致，转换成秒。
+func (e *recordEncoder) AddDuration(key string, value time.Duration) {
+	e.add(key, durationValue(value))
+}
+
+// AddFloat64 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddFloat64(key string, value float64) { e.add(key, doubleValue(value)) }
+
+// AddFloat32 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddFloat32(key string, value float32) {
+	e.add(key, doubleValue(float64(value)))
+}
+
+// AddInt 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddInt(key string, value int) { e.add(key, intValue(int64(value))) }
+
+// AddInt64 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddInt64(key string, value int64) { e.add(key, intValue(value)) }
+
+// AddInt32 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddInt32(key string, value int32) { e.add(key, intValue(int64(value))) }
+
+// AddInt16 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddInt16(key string, value int16) { e.add(key, intValue(int64(value))) }
+
+// AddInt8 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddInt8(key string, value int8) { e.add(key, intValue(int64(value))) }
+
+// AddString 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddString(key, value string) { e.add(key, stringValue(value)) }
+
+// AddTime 实现 zapcore.ObjectEncoder 接口，与 JSON 格式一致，转换成秒级时间戳。
+func (e *recordEncoder) AddTime(key string, value time.Time) { e.add(key, timeValue(value)) }
+
+// AddUint 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddUint(key string, value uint) { e.add(key, uintValue(uint64(value))) }
+
+// AddUint64 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddUint64(key string, value uint64) { e.add(key, uintValue(value)) }
+
+// AddUint32 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddUint32(key string, value uint32) { e.add(key, intValue(int64(value))) }
+
+// AddUint16 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddUint16(key string, value uint16) { e.add(key, intValue(int64(value))) }
+
+// AddUint8 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddUint8(key string, value uint8) { e.add(key, intValue(int64(value))) }
+
+// AddUintptr 实现 zapcore.ObjectEncoder 接口。
+func (e *recordEncoder) AddUintptr(key string, value uintptr) { e.add(key, uintValue(uint64(value))) }
+
+// AddReflected 实现 zapcore.ObjectEncoder 接口，与 JSON 格式一致，转换成 JSON 字符串。
+func (e *recordEncoder) AddReflected(key string, value interface{}) error {
+	v, err := reflectedValue(value)
+	e.add(key, v)
+	return err
+}
+
+// OpenNamespace 实现 zapcore.ObjectEncoder 接口，之后添加的属性都放到 key 对应的 KvlistValue 中。
+func (e *recordEncoder) OpenNamespace(key string) {
+	list := &commonpb.KeyValueList{}
+	e.add(key, kvlistValue(list))
+	e.namespaces = append(e.namespaces, list)
+}
+
+// arrayEncoder 将 zap 数组转换成 OTLP ArrayValue。
+type arrayEncoder struct {
+	values []*commonpb.AnyValue
+}
+
+func (a *arrayEncoder) append(v *commonpb.AnyValue) { a.values = append(a.values, v) }
+
+// AppendBool 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendBool(v bool) { a.append(boolValue(v)) }
+
+// AppendByteString 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendByteString(v []byte) { a.append(stringValue(string(v))) }
+
+// AppendComplex128 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendComplex128(v complex128) { a.append(complexValue(v)) }
+
+// AppendComplex64 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendComplex64(v complex64) { a.append(complexValue(complex128(v))) }
+
+// AppendFloat64 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendFloat64(v float64) { a.append(doubleValue(v)) }
+
+// AppendFloat32 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendFloat32(v float32) { a.append(doubleValue(float64(v))) }
+
+// AppendInt 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendInt(v int) { a.append(intValue(int64(v))) }
+
+// AppendInt64 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendInt64(v int64) { a.append(intValue(v)) }
+
+// AppendInt32 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendInt32(v int32) { a.append(intValue(int64(v))) }
+
+// AppendInt16 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendInt16(v int16) { a.append(intValue(int64(v))) }
+
+// AppendInt8 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendInt8(v int8) { a.append(intValue(int64(v))) }
+
+// AppendString 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendString(v string) { a.append(stringValue(v)) }
+
+// AppendUint 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendUint(v uint) { a.append(uintValue(uint64(v))) }
+
+// AppendUint64 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendUint64(v uint64) { a.append(uintValue(v)) }
+
+// AppendUint32 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendUint32(v uint32) { a.append(intValue(int64(v))) }
+
+// AppendUint16 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendUint16(v uint16) { a.append(intValue(int64(v))) }
+
+// AppendUint8 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendUint8(v uint8) { a.append(intValue(int64(v))) }
+
+// AppendUintptr 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendUintptr(v uintptr) { a.append(uintValue(uint64(v))) }
+
+// AppendDuration 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendDuration(v time.Duration) { a.append(durationValue(v)) }
+
+// AppendTime 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendTime(v time.Time) { a.append(timeValue(v)) }
+
+// AppendArray 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendArray(marshaler zapcore.ArrayMarshaler) error {
+	v, err := marshalArray(marshaler)
+	a.append(v)
+	return err
+}
+
+// AppendObject 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendObject(marshaler zapcore.ObjectMarshaler) error {
+	v, err := marshalObject(marshaler)
+	a.append(v)
+	return err
+}
+
+// AppendReflected 实现 zapcore.ArrayEncoder 接口。
+func (a *arrayEncoder) AppendReflected(value interface{}) error {
+	v, err := reflectedValue(value)
+	a.append(v)
+	return err
+}
+
+func marshalArray(marshaler zapcore.ArrayMarshaler) (*commonpb.AnyValue, error) {
+	arr := &arrayEncoder{}
+	err := marshaler.MarshalLogArray(arr)
+	return &commonpb.AnyValue{
+		Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{Values: arr.values}},
+	}, err
+}
+
+func marshalObject(marshaler zapcore.ObjectMarshaler) (*commonpb.AnyValue, error) {
+	obj := &recordEncoder{nested: true}
+	err := marshaler.MarshalLogObject(obj)
+	return kvlistValue(&commonpb.KeyValueList{Values: obj.attrs}), err
+}
+
+func reflectedValue(value interface{}) (*commonpb.AnyValue, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return stringValue(err.Error()), err
+	}
+	return stringValue(string(b)), nil
+}
+
+func stringValue(v string) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}}
+}
+
+func boolValue(v bool) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+}
+
+func intValue(v int64) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}
+}
+
+// uintValue 超出 int64 范围时转换成 double。
+func uintValue(v uint64) *commonpb.AnyValue {
+	if v > math.MaxInt64 {
+		return doubleValue(float64(v))
+	}
+	return intValue(int64(v))
+}
+
+func doubleValue(v float64) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+}
+
+func bytesValue(v []byte) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_BytesValue{BytesValue: append([]byte(nil), v...)}}
+}
+
+func complexValue(v complex128) *commonpb.AnyValue {
+	return stringValue(fmt.Sprint(v))
+}
+
+func durationValue(v time.Duration) *commonpb.AnyValue {
+	return doubleValue(v.Seconds())
+}
+
+func timeValue(v time.Time) *commonpb.AnyValue {
+	return doubleValue(float64(v.UnixNano()) / float64(time.Second))
+}
+
+func kvlistValue(v *commonpb.KeyValueList) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: v}}
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelzap
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"runtime"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"galiosight.ai/galio-sdk-go/configs"
+)
+
+var _ slog.Handler = (*SlogHandler)(nil)
+
+// SlogHandler 伽利略 OTLP 日志的 slog.Handler 实现，与 zap 日志共用 writeSyncer 批量上报、resource 及日志策略。
+// 日志直接转换成 OTLP LogRecord，slog.*Context 传入的 ctx 中的 span 会写入 LogRecord，
+// 并按 OnlyTraceLog、MustLogTraced 策略判断采样（或染色）的日志是否导出。
+type SlogHandler struct {
+	enab     zapcore.LevelEnabler
+	strategy coreStrategy
+	match    matchFunc
+	enc      *recordEncoder
+	writer   RecordWriter
+}
+
+// NewSlogHandler 根据日志配置创建 slog.Handler。
+// 注意，此方法会创建 exporter 及上报协程，不能频繁创建。
+func NewSlogHandler(cfg *configs.Logs) (*SlogHandler, error) {
+	w, err := newLogsWriteSyncer(cfg)
+	if err != nil {
+		return nil, err
+	}
+	strategy, dyeing := toStrategy(cfg)
+	match := matchFunc(sampleMatched)
+	if dyeing {
+		match = dyeingMatched
+	}
+	return newSlogHandler(w, zap.NewAtomicLevelAt(parseLevel(cfg.Processor.GetLevel())), strategy, match), nil
+}
+
+func newSlogHandler(w RecordWriter, enab zapcore.LevelEnabler, strategy coreStrategy, match matchFunc) *SlogHandler {
+	return &SlogHandler{enab: enab, strategy: strategy, match: match, enc: &recordEncoder{}, writer: w}
+}
+
+// Enabled 实现 slog.Handler 接口，与 MatchCore 一致，根据日志级别及 ctx 中的 span 是否命中判断是否导出。
+func (h *SlogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	levelEnabled := h.enab.Enabled(toZapLevel(level))
+	if h.strategy == DefaultStrategy {
+		return levelEnabled
+	}
+	return h.strategy.enabled(levelEnabled, ctx != nil && h.match(ctx))
+}
+
+// Handle 实现 slog.Handler 接口，ctx 中的 trace ID、span ID 及采样标记写入 LogRecord。
+func (h *SlogHandler) Handle(ctx context.Context, r slog.Record) error {
+	enc := h.enc.clone()
+	if ctx != nil {
+		enc.setContext(ctx)
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		enc.addSlogAttr(a)
+		return true
+	})
+	ent := zapcore.Entry{Level: toZapLevel(r.Level), Time: r.Time, Message: r.Message}
+	if r.PC != 0 {
+		frame, _ := runtime.CallersFrames([]uintptr{r.PC}).Next()
+		ent.Caller = zapcore.NewEntryCaller(frame.PC, frame.File, frame.Line, frame.File != "")
+	}
+	record := enc.encodeEntry(ent)
+	if !isValidRecord(record) {
+		return errors.New("invalid traceID spanID")
+	}
+	return h.writer.WriteRecord(record)
+}
+
+// WithAttrs 实现 slog.Handler 接口。
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	c := *h
+	c.enc = h.enc.clone()
+	for _, a := range attrs {
+		c.enc.addSlogAttr(a)
+	}
+	return &c
+}
+
+// WithGroup 实现 slog.Handler 接口，之后的属性都放到 name 对应的嵌套对象中。
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	c := *h
+	c.enc = h.enc.clone()
+	c.enc.OpenNamespace(name)
+	return &c
+}
+
+// Sync 将队列中的日志全部上报，通常在进程退出前调用，见 writeSyncer.Sync。
+func (h *SlogHandler) Sync() error {
+	return h.writer.Sync()
+}
+
+// toZapLevel slog 日志级别转换成 zap 日志级别，slog 自定义的中间级别向下取整。
+func toZapLevel(level slog.Level) zapcore.Level {
+	switch {
+	case level < slog.LevelInfo:
+		return zapcore.DebugLevel
+	case level < slog.LevelWarn:
+		return zapcore.InfoLevel
+	case level < slog.LevelError:
+		return zapcore.WarnLevel
+	default:
+		return zapcore.ErrorLevel
+	}
+}
+
+// addSlogAttr 添加 slog 属性，保留属性类型，Group 转换成嵌套对象。
+func (e *recordEncoder) addSlogAttr(a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) { // 与 slog 内置 handler 一致，忽略空属性。
+		return
+	}
+	v := a.Value
+	switch v.Kind() {
+	case slog.KindString:
+		f := zap.String(a.Key, v.String()) // 兼容 traceID、spanID、sampled 字段。
+		e.addField(&f)
+	case slog.KindInt64:
+		e.AddInt64(a.Key, v.Int64())
+	case slog.KindUint64:
+		e.AddUint64(a.Key, v.Uint64())
+	case slog.KindFloat64:
+		e.AddFloat64(a.Key, v.Float64())
+	case slog.KindBool:
+		e.AddBool(a.Key, v.Bool())
+	case slog.KindDuration:
+		e.AddDuration(a.Key, v.Duration())
+	case slog.KindTime:
+		e.AddTime(a.Key, v.Time())
+	case slog.KindGroup:
+		e.addSlogGroup(a.Key, v.Group())
+	default:
+		e.addSlogAny(a.Key, v.Any())
+	}
+}
+
+// addSlogGroup key 为空时属性内联到当前层级，与 slog 的规则一致。
+func (e *recordEncoder) addSlogGroup(key string, attrs []slog.Attr) {
+	if len(attrs) == 0 {
+		return
+	}
+	if key == "" {
+		for _, a := range attrs {
+			e.addSlogAttr(a)
+		}
+		return
+	}
+	_ = e.AddObject(key, zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for _, a := range attrs {
+			enc.(*recordEncoder).addSlogAttr(a)
+		}
+		return nil
+	}))
+}
+
+func (e *recordEncoder) addSlogAny(key string, v interface{}) {
+	switch x := v.(type) {
+	case error:
+		e.AddString(key, x.Error())
+	case []byte:
+		e.AddBinary(key, x)
+	case zapcore.ObjectMarshaler:
+		_ = e.AddObject(key, x)
+	case zapcore.ArrayMarshaler:
+		_ = e.AddArray(key, x)
+	default:
+		_ = e.AddReflected(key, x)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelzap
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"go.uber.org/zap"
+
+	"galiosight.ai/galio-sdk-go/configs"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+func TestSlogHandler_Attrs(t *testing.T) {
+	w := &mockRecordWriter{}
+	logger := slog.New(newSlogHandler(w, zap.DebugLevel, DefaultStrategy, sampleMatched))
+	logger.With("with", 1).WithGroup("g").Info(
+		"hello",
+		"string", "s",
+		"int", 1,
+		"float", 1.5,
+		"bool", true,
+		"duration", 1500*time.Millisecond,
+		"err", errors.New("err"),
+		slog.Group("group", "inner", uint64(2), slog.String(fieldTraceID, "not a trace id")),
+		slog.Group("", "inline", "v"),
+		"user", user{Name: "galileo", Age: 3},
+	)
+	require.Len(t, w.records, 1)
+	record := w.records[0]
+	assert.Equal(t, "hello", record.Body.GetStringValue())
+	assert.Equal(t, "info", record.SeverityText)
+	assert.Contains(t, attrMap(record)["line"].GetStringValue(), "slog_handler_test.go")
+	assert.Equal(t, int64(1), attrMap(record)["with"].GetIntValue())
+
+	g := attrMap(&logpb.LogRecord{Attributes: attrMap(record)["g"].GetKvlistValue().GetValues()})
+	assert.Equal(t, "s", g["string"].GetStringValue())
+	assert.Equal(t, int64(1), g["int"].GetIntValue())
+	assert.Equal(t, 1.5, g["float"].GetDoubleValue())
+	assert.True(t, g["bool"].GetBoolValue())
+	assert.Equal(t, 1.5, g["duration"].GetDoubleValue())
+	assert.Equal(t, "err", g["err"].GetStringValue())
+	assert.Equal(t, "v", g["inline"].GetStringValue())
+	assert.Len(t, g["user"].GetKvlistValue().GetValues(), 2)
+	group := g["group"].GetKvlistValue().GetValues()
+	require.Len(t, group, 2)
+	assert.Equal(t, int64(2), group[0].Value.GetIntValue())
+	// 嵌套对象中的 traceID 作为普通属性。
+	assert.Equal(t, "not a trace id", group[1].Value.GetStringValue())
+	assert.Empty(t, record.TraceId)
+}
+
+func TestSlogHandler_Context(t *testing.T) {
+	w := &mockRecordWriter{}
+	logger := slog.New(newSlogHandler(w, zap.InfoLevel, DefaultStrategy, sampleMatched))
+	ctx, sc := traceContext(true)
+	logger.InfoContext(ctx, "hello")
+	logger.DebugContext(ctx, "debug")
+
+	require.Len(t, w.records, 1)
+	traceID, spanID := sc.TraceID(), sc.SpanID()
+	assert.Equal(t, traceID[:], w.records[0].TraceId)
+	assert.Equal(t, spanID[:], w.records[0].SpanId)
+	assert.True(t, attrMap(w.records[0])[fieldSampled].GetBoolValue())
+}
+
+func TestSlogHandler_Strategy(t *testing.T) {
+	tests := []struct {
+		cfg  model.LogsProcessor
+		want []string
+	}{
+		{model.LogsProcessor{}, []string{"info", "warn"}},
+		{model.LogsProcessor{OnlyTraceLog: true}, []string{"warn"}},
+		{model.LogsProcessor{MustLogTraced: true}, []string{"sampled", "info", "warn"}},
+		{model.LogsProcessor{OnlyTraceLog: true, MustLogTraced: true}, []string{"sampled", "warn"}},
+		{
+			model.LogsProcessor{OnlyTraceLog: true, MustLogTraced: true, LogTracedType: string(model.LogTracedDyeing)},
+			[]string{"dyeing"},
+		},
+	}
+	for _, test := range tests {
+		t.Run("", func(t *testing.T) {
+			w := &mockRecordWriter{}
+			strategy, dyeing := toStrategy(&configs.Logs{Processor: test.cfg})
+			match := matchFunc(sampleMatched)
+			if dyeing {
+				match = dyeingMatched
+			}
+			logger := slog.New(newSlogHandler(w, zap.InfoLevel, strategy, match))
+			ctx := context.Background()
+			logger.DebugContext(ctx, "debug")
+			logger.DebugContext(sampleContext(true), "sampled")
+			logger.InfoContext(ctx, "info")
+			logger.WarnContext(sampleContext(true), "warn")
+			logger.DebugContext(dyeingContext(true), "dyeing")
+
+			var got []string
+			for _, record := range w.records {
+				got = append(got, record.Body.GetStringValue())
+			}
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestToZapLevel(t *testing.T) {
+	assert.Equal(t, zap.DebugLevel, toZapLevel(slog.LevelDebug))
+	assert.Equal(t, zap.InfoLevel, toZapLevel(slog.LevelInfo))
+	assert.Equal(t, zap.InfoLevel, toZapLevel(slog.LevelInfo+2))
+	assert.Equal(t, zap.WarnLevel, toZapLevel(slog.LevelWarn))
+	assert.Equal(t, zap.ErrorLevel, toZapLevel(slog.LevelError+4))
+}
+
+func TestSlogHandler_WriteSyncer(t *testing.T) {
+	exporter := &mockLogsExporter{}
+	h := newSlogHandler(
+		NewWriteSyncer(exporter, nil, WithMaxExportBatchSize(10)), zap.InfoLevel, DefaultStrategy, sampleMatched,
+	)
+	logger := slog.New(h)
+	for i := 0; i < 15; i++ {
+		logger.Info("test message", "i", i)
+	}
+	require.Nil(t, h.Sync())
+	assert.Equal(t, 15, logRecordCnt(exporter))
+}
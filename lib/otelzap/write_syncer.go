@@ -45,6 +45,7 @@ type writeSyncer struct {
 	schemaURL    string
 	timer        *timer.SafeTimer
 	rawData      chan []byte
+	records      chan *logpb.LogRecord
 	batch        batchLog
 	fileExporter *file.Exporter
 	debugger     debug.UTF8Debugger
@@ -69,6 +70,7 @@ func NewWriteSyncer(
 		resPB:        nil,
 		timer:        timer.NewSafeTimer(o.batchTimeout),
 		rawData:      make(chan []byte, o.maxQueueSize),
+		records:      make(chan *logpb.LogRecord, o.maxQueueSize),
 		batch:        batchLog{maxRecordCnt: o.maxExportBatchSize, maxByteCnt: o.maxPacketSize},
 		fileExporter: file.NewExporter(o.exportToFile, "galileo/logs", o.log),
 		debugger:     debug.NewUTF8Debugger(),
@@ -111,6 +113,8 @@ func (w *writeSyncer) processQueue() {
 			w.export()
 		case data := <-w.rawData:
 			w.processRecord(data)
+		case record := <-w.records:
+			w.processLogRecord(record)
 		case <-w.syncRequest:
 			w.processAllRecords()
 			w.export()
@@ -128,6 +132,16 @@ func (w *writeSyncer) processRecord(data []byte) {
 	}
 }
 
+// processLogRecord 处理一条 recordCore 生成的日志。
+// 此方法是线程安全的。
+func (w *writeSyncer) processLogRecord(record *logpb.LogRecord) {
+	size := w.batch.addRecord(record)
+	metric.GetSelfMonitor().Stats.LogsStats.RawWriteByteSize.Add(int64(size))
+	if w.batch.hasBatch() {
+		w.export()
+	}
+}
+
 // export 将日志数据上报到伽利略平台。
 // 此方法是线程安全的。
 func (w *writeSyncer) export() {
@@ -184,6 +198,23 @@ func (w *writeSyncer) Write(data []byte) (n int, err error) {
 	return len(data), nil
 }
 
+// WriteRecord 写入 recordCore 生成的日志，队列满时的处理与 Write 一致。
+// record 写入后不能再修改。
+func (w *writeSyncer) WriteRecord(record *logpb.LogRecord) error {
+	if w.options.blockOnQueueFull {
+		w.records <- record
+		return nil
+	}
+
+	select {
+	case w.records <- record:
+	default:
+		w.options.stats.LogsStats.DropCounter.Inc()
+		w.options.log.Infof("[galileo]otelzap writeSyncer Enqueue dropped")
+	}
+	return nil
+}
+
 // Sync 实现 Sync 接口，将当前队列中的数据全部导出到伽利略平台。
 // 此方法是线程安全的。
 // 在进程退出的时候，可以调用此方法保证异步日志上报完成。
@@ -205,6 +236,8 @@ func (w *writeSyncer) processAllRecords() {
 		select {
 		case data := <-w.rawData:
 			w.processRecord(data)
+		case record := <-w.records:
+			w.processLogRecord(record)
 		default:
 			return
 		}
@@ -20,6 +20,8 @@ package strings
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 // VMRangeFloatToString VictoriaMetrics range 分桶浮点数转字符串
@@ -36,3 +38,19 @@ const (
 	// VMRangeMin vm range 最小。
 	VMRangeMin = "-Inf"
 )
+
+// ParseVMRange 解析 vm range 分桶字符串 "开始...结束"，返回分桶的上下界。
+// 上下界可以是 VMRangeMin 和 VMRangeMax，解析为负无穷和正无穷。
+func ParseVMRange(r string) (start, end float64, err error) {
+	i := strings.Index(r, VMRangeSeparator)
+	if i < 0 {
+		return 0, 0, fmt.Errorf("invalid vmrange %q", r)
+	}
+	if start, err = strconv.ParseFloat(r[:i], 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid vmrange %q: %w", r, err)
+	}
+	if end, err = strconv.ParseFloat(r[i+len(VMRangeSeparator):], 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid vmrange %q: %w", r, err)
+	}
+	return start, end, nil
+}
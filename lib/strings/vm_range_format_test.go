@@ -19,7 +19,10 @@
 package strings
 
 import (
+	"math"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestVMRangeFloatToString(t *testing.T) {
@@ -59,3 +62,24 @@ func TestVMRangeFloatToString(t *testing.T) {
 		)
 	}
 }
+
+func TestParseVMRange(t *testing.T) {
+	start, end, err := ParseVMRange("5.000e-02...6.000e-02")
+	assert.Nil(t, err)
+	assert.Equal(t, 0.05, start)
+	assert.Equal(t, 0.06, end)
+
+	start, end, err = ParseVMRange("1.000e+01...+Inf")
+	assert.Nil(t, err)
+	assert.Equal(t, 10.0, start)
+	assert.True(t, math.IsInf(end, +1))
+
+	start, _, err = ParseVMRange("-Inf...0.000e+00")
+	assert.Nil(t, err)
+	assert.True(t, math.IsInf(start, -1))
+
+	_, _, err = ParseVMRange("0.1")
+	assert.NotNil(t, err)
+	_, _, err = ParseVMRange("a...b")
+	assert.NotNil(t, err)
+}
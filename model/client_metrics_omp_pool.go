@@ -35,5 +35,6 @@ func GetClientMetrics(labelCount int) *ClientMetrics {
 
 // PutClientMetrics 把 *ClientMetrics 放回对象池。
 func PutClientMetrics(c *ClientMetrics) {
+	c.SpanContext.reset()
 	clientMetricsPool.Put(c)
 }
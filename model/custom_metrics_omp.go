@@ -18,7 +18,10 @@
 
 package model
 
-var _ OMPMetric = (*CustomMetrics)(nil)
+var (
+	_ OMPMetric = (*CustomMetrics)(nil)
+	_ OMPWeight = (*CustomMetrics)(nil)
+)
 
 // NewCustomMetrics 构造 *CustomMetrics，标签长度 labelCount，数据点个数 pointCount。
 func NewCustomMetrics(labelCount, pointCount int) *CustomMetrics {
@@ -62,6 +65,14 @@ func (c *CustomMetrics) PointValue(i int) float64 {
 	return c.Metrics[i].Value
 }
 
+// PointWeight 第 i 个监控点的观测次数及观测值总和。
+func (c *CustomMetrics) PointWeight(i int) (uint64, float64) {
+	if i < 0 || i >= c.PointCount() {
+		return 0, 0
+	}
+	return c.Metrics[i].Count, c.Metrics[i].Sum
+}
+
 // LabelCount 标签数量。
 func (c *CustomMetrics) LabelCount() int {
 	return len(c.CustomLabels)
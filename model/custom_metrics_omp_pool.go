@@ -42,5 +42,9 @@ func GetCustomMetrics(labelCount, pointCount int) *CustomMetrics {
 
 // PutCustomMetrics 把 *CustomMetrics 放回对象池。
 func PutCustomMetrics(c *CustomMetrics) {
+	for i := range c.Metrics { // 调用方可能逐个字段设置数据点，批量写入字段需要清零。
+		c.Metrics[i].Count = 0
+		c.Metrics[i].Sum = 0
+	}
 	customMetricsPool.Put(c)
 }
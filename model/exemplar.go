@@ -0,0 +1,109 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// OMPExemplar 可选接口，携带 span 上下文的监控数据实现此接口，聚合时为 histogram 分桶生成 exemplar。
+type OMPExemplar interface {
+	// ExemplarSpanContext 关联的 span 上下文，没有关联时返回 nil。
+	ExemplarSpanContext() *SpanContext
+}
+
+// OTPExemplar 可选接口，支持 exemplar 的 otp 指标实现此接口。
+type OTPExemplar interface {
+	// SetExemplars 设置第 i 个数据的 histogram 分桶 exemplar，与 SetHistogram 的 ranges 一一对应，元素可以为 nil。
+	// 需要在 SetHistogram 之后调用。
+	SetExemplars(i int, exemplars []*Exemplar)
+}
+
+// SpanContextSetter 可以关联 span 上下文的监控数据，如主被调监控。
+type SpanContextSetter interface {
+	// SetSpanContext 设置关联的 span 上下文，traceID 为空表示不关联。
+	SetSpanContext(traceID, spanID []byte, sampled bool)
+}
+
+var (
+	_ OMPExemplar       = (*ClientMetrics)(nil)
+	_ OMPExemplar       = (*ServerMetrics)(nil)
+	_ SpanContextSetter = (*ClientMetrics)(nil)
+	_ SpanContextSetter = (*ServerMetrics)(nil)
+	_ OTPExemplar       = (*ClientMetricsOTP)(nil)
+	_ OTPExemplar       = (*ServerMetricsOTP)(nil)
+)
+
+// set 复用已有的内存，对象池中的监控数据反复设置时不产生内存分配。
+func (s *SpanContext) set(traceID, spanID []byte, sampled bool) {
+	s.TraceId = append(s.TraceId[:0], traceID...)
+	s.SpanId = append(s.SpanId[:0], spanID...)
+	s.Sampled = sampled
+}
+
+func (s *SpanContext) reset() {
+	s.set(nil, nil, false)
+}
+
+// valid 是否关联了 span。
+func (s *SpanContext) valid() *SpanContext {
+	if len(s.TraceId) == 0 {
+		return nil
+	}
+	return s
+}
+
+// SetSpanContext 设置关联的 span 上下文。
+func (c *ClientMetrics) SetSpanContext(traceID, spanID []byte, sampled bool) {
+	c.SpanContext.set(traceID, spanID, sampled)
+}
+
+// ExemplarSpanContext 关联的 span 上下文，没有关联时返回 nil。
+func (c *ClientMetrics) ExemplarSpanContext() *SpanContext {
+	return c.SpanContext.valid()
+}
+
+// SetSpanContext 设置关联的 span 上下文。
+func (s *ServerMetrics) SetSpanContext(traceID, spanID []byte, sampled bool) {
+	s.SpanContext.set(traceID, spanID, sampled)
+}
+
+// ExemplarSpanContext 关联的 span 上下文，没有关联时返回 nil。
+func (s *ServerMetrics) ExemplarSpanContext() *SpanContext {
+	return s.SpanContext.valid()
+}
+
+// SetExemplars 设置第 i 个数据的 histogram 分桶 exemplar。
+func (c *ClientMetricsOTP) SetExemplars(i int, exemplars []*Exemplar) {
+	if i == ClientMetricHandledSecondsPoint {
+		setBucketExemplars(c.RpcClientHandledSeconds, exemplars)
+	}
+}
+
+// SetExemplars 设置第 i 个数据的 histogram 分桶 exemplar。
+func (s *ServerMetricsOTP) SetExemplars(i int, exemplars []*Exemplar) {
+	if i == ServerMetricHandledSecondsPoint {
+		setBucketExemplars(s.RpcServerHandledSeconds, exemplars)
+	}
+}
+
+func setBucketExemplars(h *Histogram, exemplars []*Exemplar) {
+	if h == nil || len(h.Buckets) != len(exemplars) {
+		return
+	}
+	for i := range h.Buckets {
+		h.Buckets[i].Exemplar = exemplars[i]
+	}
+}
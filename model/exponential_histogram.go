@@ -0,0 +1,52 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "math"
+
+// OTPExponentialHistogram 支持指数直方图的 otp 监控数据，如属性监控、自定义监控。
+type OTPExponentialHistogram interface {
+	// SetExponentialHistogram 设置第 i 个数据的指数直方图值，（单值监控项 i 填 0）。
+	SetExponentialHistogram(i int, h *ExponentialHistogram)
+}
+
+var (
+	_ OTPExponentialHistogram = (*NormalMetricOTP)(nil)
+	_ OTPExponentialHistogram = (*CustomMetricsOTP)(nil)
+)
+
+// NewOTPExponentialHistogram 构造 otp 指数直方图类型。
+func NewOTPExponentialHistogram(h *ExponentialHistogram) *MetricOTP_ExponentialHistogram {
+	return &MetricOTP_ExponentialHistogram{ExponentialHistogram: h}
+}
+
+// SetExponentialHistogram 设置第 i 个数据的指数直方图值，（单值监控项 i 填 0）。
+func (n *NormalMetricOTP) SetExponentialHistogram(i int, h *ExponentialHistogram) {
+	n.Metric.V = NewOTPExponentialHistogram(h)
+}
+
+// SetExponentialHistogram 设置第 i 个数据的指数直方图值，（单值监控项 i 填 0）。
+func (c *CustomMetricsOTP) SetExponentialHistogram(i int, h *ExponentialHistogram) {
+	c.Metrics[i].V = NewOTPExponentialHistogram(h)
+}
+
+// ExponentialUpperBound 指数直方图第 index 个分桶的上边界，即 base^(index+1)，base = 2^(2^-scale)。
+func ExponentialUpperBound(scale, index int32) float64 {
+	return math.Exp2(math.Ldexp(float64(index)+1, -int(scale)))
+}
@@ -231,11 +231,13 @@ func ValidRune(r int32) bool {
 // histogram 和 avg，落地时会拆成多个指标，由 otp 来追加的，所以返回的 usage 是空。
 // 其中，histogram 会转成 _sum,_count,_bucket 三个指标。
 // avg 会转成 _sum, _count.
+// summary 会转成带 quantile 标签的分位值及 _sum, _count.
 func AggregationToTypeUsage(m Aggregation) (string, string) {
 	const (
 		gauge     = "gauge"
 		counter   = "counter"
 		histogram = "histogram"
+		summary   = "summary"
 
 		set   = "set"
 		total = "total"
@@ -253,8 +255,10 @@ func AggregationToTypeUsage(m Aggregation) (string, string) {
 		return gauge, max
 	case Aggregation_AGGREGATION_MIN:
 		return gauge, min
-	case Aggregation_AGGREGATION_HISTOGRAM:
+	case Aggregation_AGGREGATION_HISTOGRAM, Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM:
 		return histogram, ""
+	case Aggregation_AGGREGATION_SUMMARY:
+		return summary, ""
 	default:
 		return gauge, set
 	}
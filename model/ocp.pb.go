@@ -158,6 +158,73 @@ func (MetricsSampleType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_95e63dd5714d69d6, []int{3}
 }
 
+// MetricsTemporality 指标时间性。
+type MetricsTemporality int32
+
+const (
+	// 差值，每个窗口上报窗口内的增量，默认值。
+	MetricsTemporality_METRICS_TEMPORALITY_DELTA MetricsTemporality = 0
+	// 累计值，COUNTER、SUM、HISTOGRAM 跨窗口累加，从时间线开始时间起算。
+	MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE MetricsTemporality = 1
+)
+
+var MetricsTemporality_name = map[int32]string{
+	0: "METRICS_TEMPORALITY_DELTA",
+	1: "METRICS_TEMPORALITY_CUMULATIVE",
+}
+
+var MetricsTemporality_value = map[string]int32{
+	"METRICS_TEMPORALITY_DELTA":      0,
+	"METRICS_TEMPORALITY_CUMULATIVE": 1,
+}
+
+func (x MetricsTemporality) String() string {
+	return proto.EnumName(MetricsTemporality_name, int32(x))
+}
+
+func (MetricsTemporality) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{4}
+}
+
+// LabelRuleAction 标签规则动作。
+type LabelRuleAction int32
+
+const (
+	LabelRuleAction_LABEL_RULE_ACTION_INVALID LabelRuleAction = 0
+	// 正则替换标签值，regex 完整匹配时替换成 replacement，支持 $1 引用分组。
+	LabelRuleAction_LABEL_RULE_ACTION_REPLACE LabelRuleAction = 1
+	// 允许列表，不在 allow_values 中的值替换成 other_value。
+	LabelRuleAction_LABEL_RULE_ACTION_ALLOW_LIST LabelRuleAction = 2
+	// 哈希分桶，值替换成 hash 后的桶号，取值 [0, buckets)。
+	LabelRuleAction_LABEL_RULE_ACTION_HASH LabelRuleAction = 3
+	// 丢弃整条时间线，label_name、regex 及 selectors 全部匹配时丢弃。
+	LabelRuleAction_LABEL_RULE_ACTION_DROP LabelRuleAction = 4
+)
+
+var LabelRuleAction_name = map[int32]string{
+	0: "LABEL_RULE_ACTION_INVALID",
+	1: "LABEL_RULE_ACTION_REPLACE",
+	2: "LABEL_RULE_ACTION_ALLOW_LIST",
+	3: "LABEL_RULE_ACTION_HASH",
+	4: "LABEL_RULE_ACTION_DROP",
+}
+
+var LabelRuleAction_value = map[string]int32{
+	"LABEL_RULE_ACTION_INVALID":    0,
+	"LABEL_RULE_ACTION_REPLACE":    1,
+	"LABEL_RULE_ACTION_ALLOW_LIST": 2,
+	"LABEL_RULE_ACTION_HASH":       3,
+	"LABEL_RULE_ACTION_DROP":       4,
+}
+
+func (x LabelRuleAction) String() string {
+	return proto.EnumName(LabelRuleAction_name, int32(x))
+}
+
+func (LabelRuleAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{5}
+}
+
 // 枚举接入点
 type AccessPoint int32
 
@@ -190,7 +257,7 @@ func (x AccessPoint) String() string {
 }
 
 func (AccessPoint) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{4}
+	return fileDescriptor_95e63dd5714d69d6, []int{6}
 }
 
 // Collector 数据收集服务器信息。
@@ -859,6 +926,307 @@ func (m *LabelIgnore) GetLabelNames() []string {
 	return nil
 }
 
+// TargetPointLimit 代理目标的单值点数上限。
+type TargetPointLimit struct {
+	// Target 目标名，即 resource.target。
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target" yaml:"target"`
+	// PointLimit 每个窗口的单值点数上限。
+	PointLimit int64 `protobuf:"varint,2,opt,name=point_limit,json=pointLimit,proto3" json:"point_limit" yaml:"point_limit"`
+}
+
+func (m *TargetPointLimit) Reset()         { *m = TargetPointLimit{} }
+func (m *TargetPointLimit) String() string { return proto.CompactTextString(m) }
+func (*TargetPointLimit) ProtoMessage()    {}
+func (*TargetPointLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{7}
+}
+func (m *TargetPointLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TargetPointLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TargetPointLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TargetPointLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetPointLimit.Merge(m, src)
+}
+func (m *TargetPointLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TargetPointLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetPointLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetPointLimit proto.InternalMessageInfo
+
+func (m *TargetPointLimit) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *TargetPointLimit) GetPointLimit() int64 {
+	if m != nil {
+		return m.PointLimit
+	}
+	return 0
+}
+
+// MultiTargetConfig 多目标配置。
+type MultiTargetConfig struct {
+	// MaxTargets 最多代理的目标数，超出后新目标的数据丢弃，默认 1000。
+	MaxTargets int32 `protobuf:"varint,1,opt,name=max_targets,json=maxTargets,proto3" json:"max_targets" yaml:"max_targets"`
+	// PointLimit 每个目标每个窗口的单值点数上限，超出后该目标不允许新线创建，0 表示不限制（仍受 point_limit 约束）。
+	PointLimit int64 `protobuf:"varint,2,opt,name=point_limit,json=pointLimit,proto3" json:"point_limit" yaml:"point_limit"`
+	// TargetPointLimits 按目标配置单值点数上限，优先于 point_limit。
+	TargetPointLimits []TargetPointLimit `protobuf:"bytes,3,rep,name=target_point_limits,json=targetPointLimits,proto3" json:"target_point_limits" yaml:"target_point_limits"`
+	// BatchTargets 每个 MultiTargetMetrics 最多包含的目标数，默认 100。
+	BatchTargets int32 `protobuf:"varint,4,opt,name=batch_targets,json=batchTargets,proto3" json:"batch_targets" yaml:"batch_targets"`
+}
+
+func (m *MultiTargetConfig) Reset()         { *m = MultiTargetConfig{} }
+func (m *MultiTargetConfig) String() string { return proto.CompactTextString(m) }
+func (*MultiTargetConfig) ProtoMessage()    {}
+func (*MultiTargetConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{8}
+}
+func (m *MultiTargetConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiTargetConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiTargetConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiTargetConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiTargetConfig.Merge(m, src)
+}
+func (m *MultiTargetConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiTargetConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiTargetConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiTargetConfig proto.InternalMessageInfo
+
+func (m *MultiTargetConfig) GetMaxTargets() int32 {
+	if m != nil {
+		return m.MaxTargets
+	}
+	return 0
+}
+
+func (m *MultiTargetConfig) GetPointLimit() int64 {
+	if m != nil {
+		return m.PointLimit
+	}
+	return 0
+}
+
+func (m *MultiTargetConfig) GetTargetPointLimits() []TargetPointLimit {
+	if m != nil {
+		return m.TargetPointLimits
+	}
+	return nil
+}
+
+func (m *MultiTargetConfig) GetBatchTargets() int32 {
+	if m != nil {
+		return m.BatchTargets
+	}
+	return 0
+}
+
+// LabelMatcher 标签匹配器。
+type LabelMatcher struct {
+	// Name 标签名，主被调监控使用主被调标签名，如：callee_method。
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	// Regex 标签值的正则，完整匹配，标签不存在时按空串匹配。
+	Regex string `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex" yaml:"regex"`
+}
+
+func (m *LabelMatcher) Reset()         { *m = LabelMatcher{} }
+func (m *LabelMatcher) String() string { return proto.CompactTextString(m) }
+func (*LabelMatcher) ProtoMessage()    {}
+func (*LabelMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{9}
+}
+func (m *LabelMatcher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LabelMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LabelMatcher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LabelMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelMatcher.Merge(m, src)
+}
+func (m *LabelMatcher) XXX_Size() int {
+	return m.Size()
+}
+func (m *LabelMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelMatcher proto.InternalMessageInfo
+
+func (m *LabelMatcher) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LabelMatcher) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+// LabelRule 标签改写规则，按配置顺序依次执行，在屏蔽标签之后生效。
+type LabelRule struct {
+	// MonitorName 生效的监控项名，为空时对主被调及所有自定义监控项生效。
+	MonitorName string `protobuf:"bytes,1,opt,name=monitor_name,json=monitorName,proto3" json:"monitor_name" yaml:"monitor_name"`
+	// Action 规则动作。
+	Action LabelRuleAction `protobuf:"varint,2,opt,name=action,proto3,enum=model.LabelRuleAction" json:"action" yaml:"action"`
+	// LabelName 改写的标签名，DROP 动作时作为一个匹配条件，可以为空。
+	LabelName string `protobuf:"bytes,3,opt,name=label_name,json=labelName,proto3" json:"label_name" yaml:"label_name"`
+	// Regex 标签值的正则，完整匹配。REPLACE 必填；其他动作只处理匹配的值，为空时不限制。
+	Regex string `protobuf:"bytes,4,opt,name=regex,proto3" json:"regex" yaml:"regex"`
+	// Replacement REPLACE 动作替换后的值。
+	Replacement string `protobuf:"bytes,5,opt,name=replacement,proto3" json:"replacement" yaml:"replacement"`
+	// AllowValues ALLOW_LIST 动作允许的值。
+	AllowValues []string `protobuf:"bytes,6,rep,name=allow_values,json=allowValues,proto3" json:"allow_values" yaml:"allow_values"`
+	// OtherValue ALLOW_LIST 动作不在允许列表中的值替换成此值，默认 other。
+	OtherValue string `protobuf:"bytes,7,opt,name=other_value,json=otherValue,proto3" json:"other_value" yaml:"other_value"`
+	// Buckets HASH 动作的桶数，必须大于 0。
+	Buckets int32 `protobuf:"varint,8,opt,name=buckets,proto3" json:"buckets" yaml:"buckets"`
+	// Selectors DROP 动作的匹配条件，全部匹配时丢弃。
+	Selectors []LabelMatcher `protobuf:"bytes,9,rep,name=selectors,proto3" json:"selectors" yaml:"selectors"`
+}
+
+func (m *LabelRule) Reset()         { *m = LabelRule{} }
+func (m *LabelRule) String() string { return proto.CompactTextString(m) }
+func (*LabelRule) ProtoMessage()    {}
+func (*LabelRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{10}
+}
+func (m *LabelRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LabelRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LabelRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LabelRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelRule.Merge(m, src)
+}
+func (m *LabelRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *LabelRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelRule proto.InternalMessageInfo
+
+func (m *LabelRule) GetMonitorName() string {
+	if m != nil {
+		return m.MonitorName
+	}
+	return ""
+}
+
+func (m *LabelRule) GetAction() LabelRuleAction {
+	if m != nil {
+		return m.Action
+	}
+	return LabelRuleAction_LABEL_RULE_ACTION_INVALID
+}
+
+func (m *LabelRule) GetLabelName() string {
+	if m != nil {
+		return m.LabelName
+	}
+	return ""
+}
+
+func (m *LabelRule) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *LabelRule) GetReplacement() string {
+	if m != nil {
+		return m.Replacement
+	}
+	return ""
+}
+
+func (m *LabelRule) GetAllowValues() []string {
+	if m != nil {
+		return m.AllowValues
+	}
+	return nil
+}
+
+func (m *LabelRule) GetOtherValue() string {
+	if m != nil {
+		return m.OtherValue
+	}
+	return ""
+}
+
+func (m *LabelRule) GetBuckets() int32 {
+	if m != nil {
+		return m.Buckets
+	}
+	return 0
+}
+
+func (m *LabelRule) GetSelectors() []LabelMatcher {
+	if m != nil {
+		return m.Selectors
+	}
+	return nil
+}
+
 // SecondGranularity 秒级监控。
 type SecondGranularity struct {
 	// MonitorName 监控项名。
@@ -877,7 +1245,7 @@ func (m *SecondGranularity) Reset()         { *m = SecondGranularity{} }
 func (m *SecondGranularity) String() string { return proto.CompactTextString(m) }
 func (*SecondGranularity) ProtoMessage()    {}
 func (*SecondGranularity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{7}
+	return fileDescriptor_95e63dd5714d69d6, []int{11}
 }
 func (m *SecondGranularity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1323,7 @@ func (m *SampleMonitor) Reset()         { *m = SampleMonitor{} }
 func (m *SampleMonitor) String() string { return proto.CompactTextString(m) }
 func (*SampleMonitor) ProtoMessage()    {}
 func (*SampleMonitor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{8}
+	return fileDescriptor_95e63dd5714d69d6, []int{12}
 }
 func (m *SampleMonitor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1005,42 +1373,28 @@ func (m *SampleMonitor) GetFraction() float64 {
 	return 0
 }
 
-// RPCHasTwoIP 主被调监控有 "两个 ip" 配置。
-// 主被调监控，"两个 ip" 举例：
-// ip1、ip2、请求数 1
-// ip1、ip3、请求数 2
-// ip4、ip2、请求数 2
-// ip4、ip3、请求数 1
-// ip5、ip2、请求数 1
-// ip5、ip3、请求数 2
-// 主被调监控，"一个 ip" 举例：
-// ip1、-、请求数 (只有主调) 3
-// ip4、-、请求数 (只有主调) 3
-// ip5、-、请求数 (只有主调) 3
-// -、ip2、请求数 (只有被调) 4
-// -、ip3、请求数 (只有被调) 5
-// 因此当 ip 数较多的情况，"一个 ip" 能起到数据降维的作用，但是 "一个 ip" 无法同时查询两个 ip。
-type RPCHasTwoIP struct {
-	// MonitorName 监控项名。
-	MonitorName string `protobuf:"bytes,1,opt,name=monitor_name,json=monitorName,proto3" json:"monitor_name" yaml:"monitor_name"`
-	// BeginSecond 开始时间戳（秒级）。
-	BeginSecond int64 `protobuf:"varint,2,opt,name=begin_second,json=beginSecond,proto3" json:"begin_second" yaml:"begin_second"`
-	// EndSecond 结束时间戳（秒级）。
-	EndSecond int64 `protobuf:"varint,3,opt,name=end_second,json=endSecond,proto3" json:"end_second" yaml:"end_second"`
+// ExponentialHistogramConfig 指数直方图配置。
+type ExponentialHistogramConfig struct {
+	// MaxSize 正数、负数各自的最大分桶数，超出后降低 scale 合并分桶，默认 160。
+	MaxSize int32 `protobuf:"varint,1,opt,name=max_size,json=maxSize,proto3" json:"max_size" yaml:"max_size"`
+	// MaxScale 初始（最大）scale，取值 [-10, 20]，max_scale_set 为 false 且值为 0 时使用默认值 20。
+	MaxScale int32 `protobuf:"varint,2,opt,name=max_scale,json=maxScale,proto3" json:"max_scale" yaml:"max_scale"`
+	// MaxScaleSet 是否显式配置了 max_scale，需要配置 scale 0 时设置为 true。
+	MaxScaleSet bool `protobuf:"varint,3,opt,name=max_scale_set,json=maxScaleSet,proto3" json:"max_scale_set" yaml:"max_scale_set"`
 }
 
-func (m *RPCHasTwoIP) Reset()         { *m = RPCHasTwoIP{} }
-func (m *RPCHasTwoIP) String() string { return proto.CompactTextString(m) }
-func (*RPCHasTwoIP) ProtoMessage()    {}
-func (*RPCHasTwoIP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{9}
+func (m *ExponentialHistogramConfig) Reset()         { *m = ExponentialHistogramConfig{} }
+func (m *ExponentialHistogramConfig) String() string { return proto.CompactTextString(m) }
+func (*ExponentialHistogramConfig) ProtoMessage()    {}
+func (*ExponentialHistogramConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{13}
 }
-func (m *RPCHasTwoIP) XXX_Unmarshal(b []byte) error {
+func (m *ExponentialHistogramConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RPCHasTwoIP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExponentialHistogramConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RPCHasTwoIP.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExponentialHistogramConfig.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1050,35 +1404,299 @@ func (m *RPCHasTwoIP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RPCHasTwoIP) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RPCHasTwoIP.Merge(m, src)
+func (m *ExponentialHistogramConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExponentialHistogramConfig.Merge(m, src)
 }
-func (m *RPCHasTwoIP) XXX_Size() int {
+func (m *ExponentialHistogramConfig) XXX_Size() int {
 	return m.Size()
 }
-func (m *RPCHasTwoIP) XXX_DiscardUnknown() {
-	xxx_messageInfo_RPCHasTwoIP.DiscardUnknown(m)
+func (m *ExponentialHistogramConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExponentialHistogramConfig.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RPCHasTwoIP proto.InternalMessageInfo
+var xxx_messageInfo_ExponentialHistogramConfig proto.InternalMessageInfo
 
-func (m *RPCHasTwoIP) GetMonitorName() string {
+func (m *ExponentialHistogramConfig) GetMaxSize() int32 {
 	if m != nil {
-		return m.MonitorName
+		return m.MaxSize
 	}
-	return ""
+	return 0
 }
 
-func (m *RPCHasTwoIP) GetBeginSecond() int64 {
+func (m *ExponentialHistogramConfig) GetMaxScale() int32 {
 	if m != nil {
-		return m.BeginSecond
+		return m.MaxScale
 	}
 	return 0
 }
 
-func (m *RPCHasTwoIP) GetEndSecond() int64 {
+func (m *ExponentialHistogramConfig) GetMaxScaleSet() bool {
 	if m != nil {
-		return m.EndSecond
+		return m.MaxScaleSet
+	}
+	return false
+}
+
+// SummaryConfig 分位值统计配置。
+type SummaryConfig struct {
+	// RelativeAccuracy 分位值的相对误差，取值 (0, 1)，默认 0.01。
+	RelativeAccuracy float64 `protobuf:"fixed64,1,opt,name=relative_accuracy,json=relativeAccuracy,proto3" json:"relative_accuracy" yaml:"relative_accuracy"`
+	// Quantiles 上报的分位，取值 [0, 1]，默认 0.5、0.9、0.99。
+	Quantiles []float64 `protobuf:"fixed64,2,rep,packed,name=quantiles,proto3" json:"quantiles" yaml:"quantiles"`
+}
+
+func (m *SummaryConfig) Reset()         { *m = SummaryConfig{} }
+func (m *SummaryConfig) String() string { return proto.CompactTextString(m) }
+func (*SummaryConfig) ProtoMessage()    {}
+func (*SummaryConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{14}
+}
+func (m *SummaryConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SummaryConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SummaryConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SummaryConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SummaryConfig.Merge(m, src)
+}
+func (m *SummaryConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *SummaryConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SummaryConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SummaryConfig proto.InternalMessageInfo
+
+func (m *SummaryConfig) GetRelativeAccuracy() float64 {
+	if m != nil {
+		return m.RelativeAccuracy
+	}
+	return 0
+}
+
+func (m *SummaryConfig) GetQuantiles() []float64 {
+	if m != nil {
+		return m.Quantiles
+	}
+	return nil
+}
+
+// SeriesLimits 时间线预算配置。
+// 预算按聚合窗口计算，窗口内新建的时间线（多值点）数超过预算后，
+// 新时间线的样本不再丢弃，而是折叠到该监控项唯一的 __overflow__ 时间线中。
+// 属性监控没有标签，不参与预算。
+type SeriesLimits struct {
+	// ClientLimit 主调监控时间线上限，0 表示不限制。
+	ClientLimit int64 `protobuf:"varint,1,opt,name=client_limit,json=clientLimit,proto3" json:"client_limit" yaml:"client_limit"`
+	// ServerLimit 被调监控时间线上限，0 表示不限制。
+	ServerLimit int64 `protobuf:"varint,2,opt,name=server_limit,json=serverLimit,proto3" json:"server_limit" yaml:"server_limit"`
+	// CustomLimit 自定义监控（所有监控项合计）时间线上限，0 表示不限制。
+	CustomLimit int64 `protobuf:"varint,3,opt,name=custom_limit,json=customLimit,proto3" json:"custom_limit" yaml:"custom_limit"`
+	// Monitors 监控项级别的时间线上限，主被调监控项名为 rpc_client、rpc_server。
+	Monitors []MonitorSeriesLimit `protobuf:"bytes,4,rep,name=monitors,proto3" json:"monitors" yaml:"monitors"`
+}
+
+func (m *SeriesLimits) Reset()         { *m = SeriesLimits{} }
+func (m *SeriesLimits) String() string { return proto.CompactTextString(m) }
+func (*SeriesLimits) ProtoMessage()    {}
+func (*SeriesLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{15}
+}
+func (m *SeriesLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeriesLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SeriesLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SeriesLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeriesLimits.Merge(m, src)
+}
+func (m *SeriesLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeriesLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeriesLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeriesLimits proto.InternalMessageInfo
+
+func (m *SeriesLimits) GetClientLimit() int64 {
+	if m != nil {
+		return m.ClientLimit
+	}
+	return 0
+}
+
+func (m *SeriesLimits) GetServerLimit() int64 {
+	if m != nil {
+		return m.ServerLimit
+	}
+	return 0
+}
+
+func (m *SeriesLimits) GetCustomLimit() int64 {
+	if m != nil {
+		return m.CustomLimit
+	}
+	return 0
+}
+
+func (m *SeriesLimits) GetMonitors() []MonitorSeriesLimit {
+	if m != nil {
+		return m.Monitors
+	}
+	return nil
+}
+
+// MonitorSeriesLimit 监控项时间线上限。
+type MonitorSeriesLimit struct {
+	// MonitorName 监控项名。
+	MonitorName string `protobuf:"bytes,1,opt,name=monitor_name,json=monitorName,proto3" json:"monitor_name" yaml:"monitor_name"`
+	// Limit 时间线上限，0 表示不限制。
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit" yaml:"limit"`
+}
+
+func (m *MonitorSeriesLimit) Reset()         { *m = MonitorSeriesLimit{} }
+func (m *MonitorSeriesLimit) String() string { return proto.CompactTextString(m) }
+func (*MonitorSeriesLimit) ProtoMessage()    {}
+func (*MonitorSeriesLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{16}
+}
+func (m *MonitorSeriesLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonitorSeriesLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MonitorSeriesLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MonitorSeriesLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorSeriesLimit.Merge(m, src)
+}
+func (m *MonitorSeriesLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonitorSeriesLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorSeriesLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorSeriesLimit proto.InternalMessageInfo
+
+func (m *MonitorSeriesLimit) GetMonitorName() string {
+	if m != nil {
+		return m.MonitorName
+	}
+	return ""
+}
+
+func (m *MonitorSeriesLimit) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// RPCHasTwoIP 主被调监控有 "两个 ip" 配置。
+// 主被调监控，"两个 ip" 举例：
+// ip1、ip2、请求数 1
+// ip1、ip3、请求数 2
+// ip4、ip2、请求数 2
+// ip4、ip3、请求数 1
+// ip5、ip2、请求数 1
+// ip5、ip3、请求数 2
+// 主被调监控，"一个 ip" 举例：
+// ip1、-、请求数 (只有主调) 3
+// ip4、-、请求数 (只有主调) 3
+// ip5、-、请求数 (只有主调) 3
+// -、ip2、请求数 (只有被调) 4
+// -、ip3、请求数 (只有被调) 5
+// 因此当 ip 数较多的情况，"一个 ip" 能起到数据降维的作用，但是 "一个 ip" 无法同时查询两个 ip。
+type RPCHasTwoIP struct {
+	// MonitorName 监控项名。
+	MonitorName string `protobuf:"bytes,1,opt,name=monitor_name,json=monitorName,proto3" json:"monitor_name" yaml:"monitor_name"`
+	// BeginSecond 开始时间戳（秒级）。
+	BeginSecond int64 `protobuf:"varint,2,opt,name=begin_second,json=beginSecond,proto3" json:"begin_second" yaml:"begin_second"`
+	// EndSecond 结束时间戳（秒级）。
+	EndSecond int64 `protobuf:"varint,3,opt,name=end_second,json=endSecond,proto3" json:"end_second" yaml:"end_second"`
+}
+
+func (m *RPCHasTwoIP) Reset()         { *m = RPCHasTwoIP{} }
+func (m *RPCHasTwoIP) String() string { return proto.CompactTextString(m) }
+func (*RPCHasTwoIP) ProtoMessage()    {}
+func (*RPCHasTwoIP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{17}
+}
+func (m *RPCHasTwoIP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RPCHasTwoIP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RPCHasTwoIP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RPCHasTwoIP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RPCHasTwoIP.Merge(m, src)
+}
+func (m *RPCHasTwoIP) XXX_Size() int {
+	return m.Size()
+}
+func (m *RPCHasTwoIP) XXX_DiscardUnknown() {
+	xxx_messageInfo_RPCHasTwoIP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RPCHasTwoIP proto.InternalMessageInfo
+
+func (m *RPCHasTwoIP) GetMonitorName() string {
+	if m != nil {
+		return m.MonitorName
+	}
+	return ""
+}
+
+func (m *RPCHasTwoIP) GetBeginSecond() int64 {
+	if m != nil {
+		return m.BeginSecond
+	}
+	return 0
+}
+
+func (m *RPCHasTwoIP) GetEndSecond() int64 {
+	if m != nil {
+		return m.EndSecond
 	}
 	return 0
 }
@@ -1116,13 +1734,23 @@ type MetricsProcessor struct {
 	SampleMonitors []SampleMonitor `protobuf:"bytes,12,rep,name=sample_monitors,json=sampleMonitors,proto3" json:"sample_monitors" yaml:"sample_monitors"`
 	// RpcHasTwoIps 主被调监控有 "两个 ip" 配置。
 	RpcHasTwoIps []RPCHasTwoIP `protobuf:"bytes,13,rep,name=rpc_has_two_ips,json=rpcHasTwoIps,proto3" json:"rpc_has_two_ips" yaml:"rpc_has_two_ips"`
+	// SeriesLimits 时间线预算配置，超出预算的新时间线折叠到 __overflow__ 时间线。
+	SeriesLimits SeriesLimits `protobuf:"bytes,14,opt,name=series_limits,json=seriesLimits,proto3" json:"series_limits" yaml:"series_limits"`
+	// ExponentialHistogram 指数直方图配置。
+	ExponentialHistogram ExponentialHistogramConfig `protobuf:"bytes,15,opt,name=exponential_histogram,json=exponentialHistogram,proto3" json:"exponential_histogram" yaml:"exponential_histogram"`
+	// Summary 分位值统计配置。
+	Summary SummaryConfig `protobuf:"bytes,16,opt,name=summary,proto3" json:"summary" yaml:"summary"`
+	// LabelRules 标签改写规则，支持正则替换、允许列表、哈希分桶及丢弃时间线。
+	LabelRules []LabelRule `protobuf:"bytes,17,rep,name=label_rules,json=labelRules,proto3" json:"label_rules" yaml:"label_rules"`
+	// MultiTarget 多目标配置，网关等代理多个逻辑服务上报监控时使用。
+	MultiTarget MultiTargetConfig `protobuf:"bytes,18,opt,name=multi_target,json=multiTarget,proto3" json:"multi_target" yaml:"multi_target"`
 }
 
 func (m *MetricsProcessor) Reset()         { *m = MetricsProcessor{} }
 func (m *MetricsProcessor) String() string { return proto.CompactTextString(m) }
 func (*MetricsProcessor) ProtoMessage()    {}
 func (*MetricsProcessor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{10}
+	return fileDescriptor_95e63dd5714d69d6, []int{18}
 }
 func (m *MetricsProcessor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1242,6 +1870,41 @@ func (m *MetricsProcessor) GetRpcHasTwoIps() []RPCHasTwoIP {
 	return nil
 }
 
+func (m *MetricsProcessor) GetSeriesLimits() SeriesLimits {
+	if m != nil {
+		return m.SeriesLimits
+	}
+	return SeriesLimits{}
+}
+
+func (m *MetricsProcessor) GetExponentialHistogram() ExponentialHistogramConfig {
+	if m != nil {
+		return m.ExponentialHistogram
+	}
+	return ExponentialHistogramConfig{}
+}
+
+func (m *MetricsProcessor) GetSummary() SummaryConfig {
+	if m != nil {
+		return m.Summary
+	}
+	return SummaryConfig{}
+}
+
+func (m *MetricsProcessor) GetLabelRules() []LabelRule {
+	if m != nil {
+		return m.LabelRules
+	}
+	return nil
+}
+
+func (m *MetricsProcessor) GetMultiTarget() MultiTargetConfig {
+	if m != nil {
+		return m.MultiTarget
+	}
+	return MultiTargetConfig{}
+}
+
 // MetricsExporter 监控导出器配置。
 type MetricsExporter struct {
 	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol" yaml:"protocol"`
@@ -1265,13 +1928,18 @@ type MetricsExporter struct {
 	MaxRetryCount int32 `protobuf:"varint,8,opt,name=max_retry_count,json=maxRetryCount,proto3" json:"max_retry_count" yaml:"max_retry_count"`
 	// 是否导出到文件，此开关在调试及自动化测试中会比较有用，默认 false。
 	ExportToFile bool `protobuf:"varint,9,opt,name=export_to_file,json=exportToFile,proto3" json:"export_to_file" yaml:"export_to_file"`
+	// collector 不可达时，本地磁盘缓存配置。
+	Spool MetricsSpool `protobuf:"bytes,10,opt,name=spool,proto3" json:"spool" yaml:"spool"`
+	// 指标时间性，默认差值。后端需要累计值（如 Prometheus remote-write）时配置为累计值，
+	// 累计值时间线在 processor.expires_seconds 内没有数据后过期，每 processor.clear_seconds 检查一次。
+	Temporality MetricsTemporality `protobuf:"varint,11,opt,name=temporality,proto3,enum=model.MetricsTemporality" json:"temporality" yaml:"temporality"`
 }
 
 func (m *MetricsExporter) Reset()         { *m = MetricsExporter{} }
 func (m *MetricsExporter) String() string { return proto.CompactTextString(m) }
 func (*MetricsExporter) ProtoMessage()    {}
 func (*MetricsExporter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{11}
+	return fileDescriptor_95e63dd5714d69d6, []int{19}
 }
 func (m *MetricsExporter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1363,40 +2031,47 @@ func (m *MetricsExporter) GetExportToFile() bool {
 	return false
 }
 
-// PrometheusPushConfig prometheus 上报配置
-type PrometheusPushConfig struct {
-	// 是否启用 Prometheus 推送配置
-	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable" yaml:"enable"`
-	// Prometheus 推送的目标 URL，参考：
-	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url" yaml:"url"`
-	// Prometheus 推送任务的名称
-	Job string `protobuf:"bytes,3,opt,name=job,proto3" json:"job" yaml:"job"`
-	// 推送间隔时间，单位为秒，默认 20 秒，如果需要秒级监控，可以改成 1 秒。间隔越小，成本越高。
-	Interval int32 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval" yaml:"interval"`
-	// 是否使用基本认证
-	UseBasicAuth bool `protobuf:"varint,5,opt,name=use_basic_auth,json=useBasicAuth,proto3" json:"use_basic_auth" yaml:"use_basic_auth"`
-	// 认证的用户名
-	Username string `protobuf:"bytes,6,opt,name=username,proto3" json:"username" yaml:"username"`
-	// 认证的密码
-	Password string `protobuf:"bytes,7,opt,name=password,proto3" json:"password" yaml:"password"`
-	// 分组信息，键值对形式
-	Grouping map[string]string `protobuf:"bytes,8,rep,name=grouping,proto3" json:"grouping" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" yaml:"grouping"`
-	// HTTP 头信息，键值对形式
-	HttpHeaders map[string]string `protobuf:"bytes,9,rep,name=http_headers,json=httpHeaders,proto3" json:"http_headers" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" yaml:"http_headers"`
+func (m *MetricsExporter) GetSpool() MetricsSpool {
+	if m != nil {
+		return m.Spool
+	}
+	return MetricsSpool{}
 }
 
-func (m *PrometheusPushConfig) Reset()         { *m = PrometheusPushConfig{} }
-func (m *PrometheusPushConfig) String() string { return proto.CompactTextString(m) }
-func (*PrometheusPushConfig) ProtoMessage()    {}
-func (*PrometheusPushConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{12}
+func (m *MetricsExporter) GetTemporality() MetricsTemporality {
+	if m != nil {
+		return m.Temporality
+	}
+	return MetricsTemporality_METRICS_TEMPORALITY_DELTA
 }
-func (m *PrometheusPushConfig) XXX_Unmarshal(b []byte) error {
+
+// MetricsSpool 指标上报失败时的本地磁盘缓存配置。
+// collector 不可达时，分页写入磁盘，collector 恢复后按时间顺序补发。
+type MetricsSpool struct {
+	// 是否开启，默认 false。
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable" yaml:"enable"`
+	// 缓存目录，默认 galileo/spool/metrics。
+	Dir string `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir" yaml:"dir"`
+	// 缓存占用的最大磁盘空间，超过后淘汰最旧的分页，默认 100 MB。
+	MaxBytes int64 `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes" yaml:"max_bytes"`
+	// 缓存分页的最长保留时间，超过后淘汰，默认 3600 秒。
+	MaxAgeSeconds int32 `protobuf:"varint,4,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds" yaml:"max_age_seconds"`
+	// 检查 collector 是否恢复并补发的间隔，默认 10 秒。
+	ReplayIntervalSeconds int32 `protobuf:"varint,5,opt,name=replay_interval_seconds,json=replayIntervalSeconds,proto3" json:"replay_interval_seconds" yaml:"replay_interval_seconds"`
+}
+
+func (m *MetricsSpool) Reset()         { *m = MetricsSpool{} }
+func (m *MetricsSpool) String() string { return proto.CompactTextString(m) }
+func (*MetricsSpool) ProtoMessage()    {}
+func (*MetricsSpool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{20}
+}
+func (m *MetricsSpool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PrometheusPushConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MetricsSpool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PrometheusPushConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MetricsSpool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1406,33 +2081,123 @@ func (m *PrometheusPushConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *PrometheusPushConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrometheusPushConfig.Merge(m, src)
+func (m *MetricsSpool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricsSpool.Merge(m, src)
 }
-func (m *PrometheusPushConfig) XXX_Size() int {
+func (m *MetricsSpool) XXX_Size() int {
 	return m.Size()
 }
-func (m *PrometheusPushConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrometheusPushConfig.DiscardUnknown(m)
+func (m *MetricsSpool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricsSpool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrometheusPushConfig proto.InternalMessageInfo
+var xxx_messageInfo_MetricsSpool proto.InternalMessageInfo
 
-func (m *PrometheusPushConfig) GetEnable() bool {
+func (m *MetricsSpool) GetEnable() bool {
 	if m != nil {
 		return m.Enable
 	}
 	return false
 }
 
-func (m *PrometheusPushConfig) GetUrl() string {
+func (m *MetricsSpool) GetDir() string {
 	if m != nil {
-		return m.Url
+		return m.Dir
 	}
 	return ""
 }
 
-func (m *PrometheusPushConfig) GetJob() string {
+func (m *MetricsSpool) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *MetricsSpool) GetMaxAgeSeconds() int32 {
+	if m != nil {
+		return m.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (m *MetricsSpool) GetReplayIntervalSeconds() int32 {
+	if m != nil {
+		return m.ReplayIntervalSeconds
+	}
+	return 0
+}
+
+// PrometheusPushConfig prometheus 上报配置
+type PrometheusPushConfig struct {
+	// 是否启用 Prometheus 推送配置
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable" yaml:"enable"`
+	// Prometheus 推送的目标 URL，参考：
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url" yaml:"url"`
+	// Prometheus 推送任务的名称
+	Job string `protobuf:"bytes,3,opt,name=job,proto3" json:"job" yaml:"job"`
+	// 推送间隔时间，单位为秒，默认 20 秒，如果需要秒级监控，可以改成 1 秒。间隔越小，成本越高。
+	Interval int32 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval" yaml:"interval"`
+	// 是否使用基本认证
+	UseBasicAuth bool `protobuf:"varint,5,opt,name=use_basic_auth,json=useBasicAuth,proto3" json:"use_basic_auth" yaml:"use_basic_auth"`
+	// 认证的用户名
+	Username string `protobuf:"bytes,6,opt,name=username,proto3" json:"username" yaml:"username"`
+	// 认证的密码
+	Password string `protobuf:"bytes,7,opt,name=password,proto3" json:"password" yaml:"password"`
+	// 分组信息，键值对形式
+	Grouping map[string]string `protobuf:"bytes,8,rep,name=grouping,proto3" json:"grouping" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" yaml:"grouping"`
+	// HTTP 头信息，键值对形式
+	HttpHeaders map[string]string `protobuf:"bytes,9,rep,name=http_headers,json=httpHeaders,proto3" json:"http_headers" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" yaml:"http_headers"`
+}
+
+func (m *PrometheusPushConfig) Reset()         { *m = PrometheusPushConfig{} }
+func (m *PrometheusPushConfig) String() string { return proto.CompactTextString(m) }
+func (*PrometheusPushConfig) ProtoMessage()    {}
+func (*PrometheusPushConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{21}
+}
+func (m *PrometheusPushConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrometheusPushConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrometheusPushConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrometheusPushConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrometheusPushConfig.Merge(m, src)
+}
+func (m *PrometheusPushConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrometheusPushConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrometheusPushConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrometheusPushConfig proto.InternalMessageInfo
+
+func (m *PrometheusPushConfig) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *PrometheusPushConfig) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *PrometheusPushConfig) GetJob() string {
 	if m != nil {
 		return m.Job
 	}
@@ -1493,7 +2258,7 @@ func (m *OpenTelemetryPushConfig) Reset()         { *m = OpenTelemetryPushConfig
 func (m *OpenTelemetryPushConfig) String() string { return proto.CompactTextString(m) }
 func (*OpenTelemetryPushConfig) ProtoMessage()    {}
 func (*OpenTelemetryPushConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{13}
+	return fileDescriptor_95e63dd5714d69d6, []int{22}
 }
 func (m *OpenTelemetryPushConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1548,7 +2313,7 @@ func (m *HistogramBucket) Reset()         { *m = HistogramBucket{} }
 func (m *HistogramBucket) String() string { return proto.CompactTextString(m) }
 func (*HistogramBucket) ProtoMessage()    {}
 func (*HistogramBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{14}
+	return fileDescriptor_95e63dd5714d69d6, []int{23}
 }
 func (m *HistogramBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +2372,7 @@ func (m *TracesConfig) Reset()         { *m = TracesConfig{} }
 func (m *TracesConfig) String() string { return proto.CompactTextString(m) }
 func (*TracesConfig) ProtoMessage()    {}
 func (*TracesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{15}
+	return fileDescriptor_95e63dd5714d69d6, []int{24}
 }
 func (m *TracesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1689,13 +2454,15 @@ type TracesProcessor struct {
 	// 开启 trace 与 profile 的关联，需要同时配置 profiles_config -> enable: true
 	// 才可生效。
 	EnableProfile bool `protobuf:"varint,10,opt,name=enable_profile,json=enableProfile,proto3" json:"enable_profile" yaml:"enable_profile"`
+	// 尾部采样配置，需要同时开启 enable_deferred_sample 才会生效。
+	TailSampler TailSamplerConfig `protobuf:"bytes,12,opt,name=tail_sampler,json=tailSampler,proto3" json:"tail_sampler" yaml:"tail_sampler"`
 }
 
 func (m *TracesProcessor) Reset()         { *m = TracesProcessor{} }
 func (m *TracesProcessor) String() string { return proto.CompactTextString(m) }
 func (*TracesProcessor) ProtoMessage()    {}
 func (*TracesProcessor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{16}
+	return fileDescriptor_95e63dd5714d69d6, []int{25}
 }
 func (m *TracesProcessor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1794,6 +2561,106 @@ func (m *TracesProcessor) GetEnableProfile() bool {
 	return false
 }
 
+func (m *TracesProcessor) GetTailSampler() TailSamplerConfig {
+	if m != nil {
+		return m.TailSampler
+	}
+	return TailSamplerConfig{}
+}
+
+// TailSamplerConfig 尾部采样配置。
+// 未采样的 span 按 trace ID 缓冲一段时间，同一 trace 的任意 span 命中出错、慢操作（延迟采样规则）、
+// 染色或属性规则时，将本地缓冲的整个 trace 片段一起上报。
+type TailSamplerConfig struct {
+	// 是否开启，默认 false。
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable" yaml:"enable"`
+	// 未命中规则的 span 最长缓冲时间，单位 ms，默认 10000。
+	DecisionWaitMs int32 `protobuf:"varint,2,opt,name=decision_wait_ms,json=decisionWaitMs,proto3" json:"decision_wait_ms" yaml:"decision_wait_ms"`
+	// 缓冲的最大 span 数，超过后淘汰最早的 trace 片段，默认 10000。
+	MaxSpans int32 `protobuf:"varint,3,opt,name=max_spans,json=maxSpans,proto3" json:"max_spans" yaml:"max_spans"`
+	// 缓冲占用的最大内存（估算值），超过后淘汰最早的 trace 片段，默认 16 MB。
+	MaxBytes int64 `protobuf:"varint,4,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes" yaml:"max_bytes"`
+	// span 属性命中 sampler.dyeing 染色规则时采样整个 trace 片段，默认 false。
+	SampleDyeing bool `protobuf:"varint,5,opt,name=sample_dyeing,json=sampleDyeing,proto3" json:"sample_dyeing" yaml:"sample_dyeing"`
+	// span 属性命中的规则，key 为属性名，values 为属性值。
+	Attributes []Dyeing `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes" yaml:"attributes"`
+}
+
+func (m *TailSamplerConfig) Reset()         { *m = TailSamplerConfig{} }
+func (m *TailSamplerConfig) String() string { return proto.CompactTextString(m) }
+func (*TailSamplerConfig) ProtoMessage()    {}
+func (*TailSamplerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{26}
+}
+func (m *TailSamplerConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TailSamplerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TailSamplerConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TailSamplerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TailSamplerConfig.Merge(m, src)
+}
+func (m *TailSamplerConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TailSamplerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TailSamplerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TailSamplerConfig proto.InternalMessageInfo
+
+func (m *TailSamplerConfig) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *TailSamplerConfig) GetDecisionWaitMs() int32 {
+	if m != nil {
+		return m.DecisionWaitMs
+	}
+	return 0
+}
+
+func (m *TailSamplerConfig) GetMaxSpans() int32 {
+	if m != nil {
+		return m.MaxSpans
+	}
+	return 0
+}
+
+func (m *TailSamplerConfig) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *TailSamplerConfig) GetSampleDyeing() bool {
+	if m != nil {
+		return m.SampleDyeing
+	}
+	return false
+}
+
+func (m *TailSamplerConfig) GetAttributes() []Dyeing {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 type TracesExporter struct {
 	// protocol 如：otlp。
 	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol" yaml:"protocol"`
@@ -1819,7 +2686,7 @@ func (m *TracesExporter) Reset()         { *m = TracesExporter{} }
 func (m *TracesExporter) String() string { return proto.CompactTextString(m) }
 func (*TracesExporter) ProtoMessage()    {}
 func (*TracesExporter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{17}
+	return fileDescriptor_95e63dd5714d69d6, []int{27}
 }
 func (m *TracesExporter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1911,7 +2778,7 @@ func (m *LogsConfig) Reset()         { *m = LogsConfig{} }
 func (m *LogsConfig) String() string { return proto.CompactTextString(m) }
 func (*LogsConfig) ProtoMessage()    {}
 func (*LogsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{18}
+	return fileDescriptor_95e63dd5714d69d6, []int{28}
 }
 func (m *LogsConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1983,7 +2850,7 @@ func (m *LogsProcessor) Reset()         { *m = LogsProcessor{} }
 func (m *LogsProcessor) String() string { return proto.CompactTextString(m) }
 func (*LogsProcessor) ProtoMessage()    {}
 func (*LogsProcessor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{19}
+	return fileDescriptor_95e63dd5714d69d6, []int{29}
 }
 func (m *LogsProcessor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2080,13 +2947,15 @@ type LogsExporter struct {
 	PacketSize int32 `protobuf:"varint,8,opt,name=packet_size,json=packetSize,proto3" json:"packet_size" yaml:"packet_size"`
 	// 是否导出到文件，此开关在调试及自动化测试中会比较有用，默认 false。
 	ExportToFile bool `protobuf:"varint,9,opt,name=export_to_file,json=exportToFile,proto3" json:"export_to_file" yaml:"export_to_file"`
+	// OTLP/HTTP 上报配置，collector.data_transmission 不是 gRPC 时生效。
+	Http LogsHTTP `protobuf:"bytes,10,opt,name=http,proto3" json:"http" yaml:"http"`
 }
 
 func (m *LogsExporter) Reset()         { *m = LogsExporter{} }
 func (m *LogsExporter) String() string { return proto.CompactTextString(m) }
 func (*LogsExporter) ProtoMessage()    {}
 func (*LogsExporter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{20}
+	return fileDescriptor_95e63dd5714d69d6, []int{30}
 }
 func (m *LogsExporter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2164,6 +3033,178 @@ func (m *LogsExporter) GetExportToFile() bool {
 	return false
 }
 
+func (m *LogsExporter) GetHttp() LogsHTTP {
+	if m != nil {
+		return m.Http
+	}
+	return LogsHTTP{}
+}
+
+// LogsHTTP 日志 OTLP/HTTP 上报配置。
+type LogsHTTP struct {
+	// 编码方式，protobuf 或 json，默认 protobuf。
+	Encoding string `protobuf:"bytes,1,opt,name=encoding,proto3" json:"encoding" yaml:"encoding"`
+	// 压缩方式，gzip 或 none，默认 gzip。
+	Compression string `protobuf:"bytes,2,opt,name=compression,proto3" json:"compression" yaml:"compression"`
+	// 代理地址，如 http://proxy.example.com:8080。
+	// 为空时读取 HTTPS_PROXY、HTTP_PROXY、NO_PROXY 环境变量。
+	ProxyUrl string `protobuf:"bytes,3,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxy_url" yaml:"proxy_url"`
+	// 上报超时，默认 5000 ms。
+	TimeoutMs int32 `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms" yaml:"timeout_ms"`
+	// TLS 配置，collector 地址为 https 时生效。
+	Tls ClientTLS `protobuf:"bytes,5,opt,name=tls,proto3" json:"tls" yaml:"tls"`
+}
+
+func (m *LogsHTTP) Reset()         { *m = LogsHTTP{} }
+func (m *LogsHTTP) String() string { return proto.CompactTextString(m) }
+func (*LogsHTTP) ProtoMessage()    {}
+func (*LogsHTTP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{31}
+}
+func (m *LogsHTTP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogsHTTP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogsHTTP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogsHTTP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsHTTP.Merge(m, src)
+}
+func (m *LogsHTTP) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogsHTTP) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsHTTP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsHTTP proto.InternalMessageInfo
+
+func (m *LogsHTTP) GetEncoding() string {
+	if m != nil {
+		return m.Encoding
+	}
+	return ""
+}
+
+func (m *LogsHTTP) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *LogsHTTP) GetProxyUrl() string {
+	if m != nil {
+		return m.ProxyUrl
+	}
+	return ""
+}
+
+func (m *LogsHTTP) GetTimeoutMs() int32 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+func (m *LogsHTTP) GetTls() ClientTLS {
+	if m != nil {
+		return m.Tls
+	}
+	return ClientTLS{}
+}
+
+// ClientTLS 客户端 TLS 配置。
+type ClientTLS struct {
+	// CA 证书文件，为空时使用系统证书。
+	CaFile string `protobuf:"bytes,1,opt,name=ca_file,json=caFile,proto3" json:"ca_file" yaml:"ca_file"`
+	// 客户端证书文件，双向认证时使用，需和 key_file 同时配置。
+	CertFile string `protobuf:"bytes,2,opt,name=cert_file,json=certFile,proto3" json:"cert_file" yaml:"cert_file"`
+	// 客户端私钥文件。
+	KeyFile string `protobuf:"bytes,3,opt,name=key_file,json=keyFile,proto3" json:"key_file" yaml:"key_file"`
+	// 校验证书时使用的服务端名称，为空时使用 collector 地址中的域名。
+	ServerName string `protobuf:"bytes,4,opt,name=server_name,json=serverName,proto3" json:"server_name" yaml:"server_name"`
+	// 跳过服务端证书校验，仅用于调试，默认 false。
+	InsecureSkipVerify bool `protobuf:"varint,5,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify" yaml:"insecure_skip_verify"`
+}
+
+func (m *ClientTLS) Reset()         { *m = ClientTLS{} }
+func (m *ClientTLS) String() string { return proto.CompactTextString(m) }
+func (*ClientTLS) ProtoMessage()    {}
+func (*ClientTLS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{32}
+}
+func (m *ClientTLS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientTLS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientTLS.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientTLS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientTLS.Merge(m, src)
+}
+func (m *ClientTLS) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientTLS) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientTLS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientTLS proto.InternalMessageInfo
+
+func (m *ClientTLS) GetCaFile() string {
+	if m != nil {
+		return m.CaFile
+	}
+	return ""
+}
+
+func (m *ClientTLS) GetCertFile() string {
+	if m != nil {
+		return m.CertFile
+	}
+	return ""
+}
+
+func (m *ClientTLS) GetKeyFile() string {
+	if m != nil {
+		return m.KeyFile
+	}
+	return ""
+}
+
+func (m *ClientTLS) GetServerName() string {
+	if m != nil {
+		return m.ServerName
+	}
+	return ""
+}
+
+func (m *ClientTLS) GetInsecureSkipVerify() bool {
+	if m != nil {
+		return m.InsecureSkipVerify
+	}
+	return false
+}
+
 type ProfilesConfig struct {
 	// 是否启用
 	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable" yaml:"enable"`
@@ -2177,7 +3218,7 @@ func (m *ProfilesConfig) Reset()         { *m = ProfilesConfig{} }
 func (m *ProfilesConfig) String() string { return proto.CompactTextString(m) }
 func (*ProfilesConfig) ProtoMessage()    {}
 func (*ProfilesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{21}
+	return fileDescriptor_95e63dd5714d69d6, []int{33}
 }
 func (m *ProfilesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2263,13 +3304,17 @@ type ProfilesProcessor struct {
 	EnableDeltaProfiles bool `protobuf:"varint,9,opt,name=enable_delta_profiles,json=enableDeltaProfiles,proto3" json:"enable_delta_profiles" yaml:"enable_delta_profiles"`
 	// 是否开启 profile 关联 trace，默认是 false。
 	EnableLinkTrace bool `protobuf:"varint,10,opt,name=enable_link_trace,json=enableLinkTrace,proto3" json:"enable_link_trace" yaml:"enable_link_trace"`
+	// 异常检测配置，比较相邻周期的 goroutine、heap profile，发现 goroutine 泄漏及内存增长。
+	Anomaly ProfilesAnomalyConfig `protobuf:"bytes,11,opt,name=anomaly,proto3" json:"anomaly" yaml:"anomaly"`
+	// 一次性按需采集，id 变化且未过期时触发一次，不影响周期采集。
+	Capture ProfileCapture `protobuf:"bytes,12,opt,name=capture,proto3" json:"capture" yaml:"capture"`
 }
 
 func (m *ProfilesProcessor) Reset()         { *m = ProfilesProcessor{} }
 func (m *ProfilesProcessor) String() string { return proto.CompactTextString(m) }
 func (*ProfilesProcessor) ProtoMessage()    {}
 func (*ProfilesProcessor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{22}
+	return fileDescriptor_95e63dd5714d69d6, []int{34}
 }
 func (m *ProfilesProcessor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2361,8 +3406,177 @@ func (m *ProfilesProcessor) GetEnableLinkTrace() bool {
 	return false
 }
 
-type ProfilesExporter struct {
-	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol" yaml:"protocol"`
+func (m *ProfilesProcessor) GetAnomaly() ProfilesAnomalyConfig {
+	if m != nil {
+		return m.Anomaly
+	}
+	return ProfilesAnomalyConfig{}
+}
+
+func (m *ProfilesProcessor) GetCapture() ProfileCapture {
+	if m != nil {
+		return m.Capture
+	}
+	return ProfileCapture{}
+}
+
+// ProfileCapture 按需采集请求。
+type ProfileCapture struct {
+	// 采集 ID，同一个 ID 只触发一次，会作为 capture_id 标签带到 ProfilesBatch 上。
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	// 采集的性能数据类型，支持 CPU、heap、mutex、block、goroutine，默认 CPU。
+	ProfileTypes []string `protobuf:"bytes,2,rep,name=profile_types,json=profileTypes,proto3" json:"profile_types" yaml:"profile_types"`
+	// 采集时长，单位 s，默认 10s，最长 300s。heap、goroutine 等快照类型在采集结束时获取。
+	DurationSeconds int32 `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds" yaml:"duration_seconds"`
+	// CPU profile 采样频率（Hz），默认 100 Hz，最大 1000 Hz。
+	CpuProfileRate int32 `protobuf:"varint,4,opt,name=cpu_profile_rate,json=cpuProfileRate,proto3" json:"cpu_profile_rate" yaml:"cpu_profile_rate"`
+	// 过期时间，unix 时间戳，单位 s，仅 ocp 下发时使用，过期后不再触发。
+	ExpireAt int64 `protobuf:"varint,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at" yaml:"expire_at"`
+}
+
+func (m *ProfileCapture) Reset()         { *m = ProfileCapture{} }
+func (m *ProfileCapture) String() string { return proto.CompactTextString(m) }
+func (*ProfileCapture) ProtoMessage()    {}
+func (*ProfileCapture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{35}
+}
+func (m *ProfileCapture) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileCapture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileCapture.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfileCapture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileCapture.Merge(m, src)
+}
+func (m *ProfileCapture) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileCapture) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileCapture.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileCapture proto.InternalMessageInfo
+
+func (m *ProfileCapture) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProfileCapture) GetProfileTypes() []string {
+	if m != nil {
+		return m.ProfileTypes
+	}
+	return nil
+}
+
+func (m *ProfileCapture) GetDurationSeconds() int32 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+func (m *ProfileCapture) GetCpuProfileRate() int32 {
+	if m != nil {
+		return m.CpuProfileRate
+	}
+	return 0
+}
+
+func (m *ProfileCapture) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+// ProfilesAnomalyConfig profile 异常检测配置。
+type ProfilesAnomalyConfig struct {
+	// 是否开启，需要同时采集 goroutine 或 heap profile，默认 false。
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable" yaml:"enable"`
+	// 同一调用栈连续增长多少个采集周期才判定为异常，默认 3。
+	GrowthPeriods int32 `protobuf:"varint,2,opt,name=growth_periods,json=growthPeriods,proto3" json:"growth_periods" yaml:"growth_periods"`
+	// 同一调用栈的 goroutine 数在连续增长期间累计增长超过此值判定为泄漏，默认 100。
+	GoroutineGrowth int64 `protobuf:"varint,3,opt,name=goroutine_growth,json=goroutineGrowth,proto3" json:"goroutine_growth" yaml:"goroutine_growth"`
+	// 同一分配位置的 inuse_space 在连续增长期间累计增长超过此值判定为内存增长，单位字节，默认 64MB。
+	HeapGrowthBytes int64 `protobuf:"varint,4,opt,name=heap_growth_bytes,json=heapGrowthBytes,proto3" json:"heap_growth_bytes" yaml:"heap_growth_bytes"`
+}
+
+func (m *ProfilesAnomalyConfig) Reset()         { *m = ProfilesAnomalyConfig{} }
+func (m *ProfilesAnomalyConfig) String() string { return proto.CompactTextString(m) }
+func (*ProfilesAnomalyConfig) ProtoMessage()    {}
+func (*ProfilesAnomalyConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e63dd5714d69d6, []int{36}
+}
+func (m *ProfilesAnomalyConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfilesAnomalyConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfilesAnomalyConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfilesAnomalyConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfilesAnomalyConfig.Merge(m, src)
+}
+func (m *ProfilesAnomalyConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfilesAnomalyConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfilesAnomalyConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfilesAnomalyConfig proto.InternalMessageInfo
+
+func (m *ProfilesAnomalyConfig) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *ProfilesAnomalyConfig) GetGrowthPeriods() int32 {
+	if m != nil {
+		return m.GrowthPeriods
+	}
+	return 0
+}
+
+func (m *ProfilesAnomalyConfig) GetGoroutineGrowth() int64 {
+	if m != nil {
+		return m.GoroutineGrowth
+	}
+	return 0
+}
+
+func (m *ProfilesAnomalyConfig) GetHeapGrowthBytes() int64 {
+	if m != nil {
+		return m.HeapGrowthBytes
+	}
+	return 0
+}
+
+type ProfilesExporter struct {
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol" yaml:"protocol"`
 	// collector 服务端信息
 	Collector Collector `protobuf:"bytes,2,opt,name=collector,proto3" json:"collector" yaml:"collector"`
 	// 数据发送缓冲区大小，即 PofilesBatch 的个数，默认 5。
@@ -2379,7 +3593,7 @@ func (m *ProfilesExporter) Reset()         { *m = ProfilesExporter{} }
 func (m *ProfilesExporter) String() string { return proto.CompactTextString(m) }
 func (*ProfilesExporter) ProtoMessage()    {}
 func (*ProfilesExporter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{23}
+	return fileDescriptor_95e63dd5714d69d6, []int{37}
 }
 func (m *ProfilesExporter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2489,7 +3703,7 @@ func (m *SamplerConfig) Reset()         { *m = SamplerConfig{} }
 func (m *SamplerConfig) String() string { return proto.CompactTextString(m) }
 func (*SamplerConfig) ProtoMessage()    {}
 func (*SamplerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{24}
+	return fileDescriptor_95e63dd5714d69d6, []int{38}
 }
 func (m *SamplerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2611,7 +3825,7 @@ func (m *WorkflowSamplerConfig) Reset()         { *m = WorkflowSamplerConfig{} }
 func (m *WorkflowSamplerConfig) String() string { return proto.CompactTextString(m) }
 func (*WorkflowSamplerConfig) ProtoMessage()    {}
 func (*WorkflowSamplerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{25}
+	return fileDescriptor_95e63dd5714d69d6, []int{39}
 }
 func (m *WorkflowSamplerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2682,7 +3896,7 @@ func (m *TokenBucketConfig) Reset()         { *m = TokenBucketConfig{} }
 func (m *TokenBucketConfig) String() string { return proto.CompactTextString(m) }
 func (*TokenBucketConfig) ProtoMessage()    {}
 func (*TokenBucketConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{26}
+	return fileDescriptor_95e63dd5714d69d6, []int{40}
 }
 func (m *TokenBucketConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2743,7 +3957,7 @@ func (m *Dyeing) Reset()         { *m = Dyeing{} }
 func (m *Dyeing) String() string { return proto.CompactTextString(m) }
 func (*Dyeing) ProtoMessage()    {}
 func (*Dyeing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{27}
+	return fileDescriptor_95e63dd5714d69d6, []int{41}
 }
 func (m *Dyeing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2827,7 +4041,7 @@ type Resource struct {
 	CmdbId string `protobuf:"bytes,17,opt,name=cmdb_id,json=cmdbId,proto3" json:"cmdb_id" yaml:"cmdb_id"`
 	// TargetType 观测对象类型
 	TargetType int32 `protobuf:"varint,18,opt,name=target_type,json=targetType,proto3" json:"target_type" yaml:"target_type"`
-	// Language 开发语言
+	//  Language 开发语言
 	Language string `protobuf:"bytes,20,opt,name=language,proto3" json:"language" yaml:"language"`
 	// SdkName SDK 名
 	SdkName string `protobuf:"bytes,21,opt,name=sdk_name,json=sdkName,proto3" json:"sdk_name" yaml:"sdk_name"`
@@ -2843,7 +4057,7 @@ func (m *Resource) Reset()         { *m = Resource{} }
 func (m *Resource) String() string { return proto.CompactTextString(m) }
 func (*Resource) ProtoMessage()    {}
 func (*Resource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{28}
+	return fileDescriptor_95e63dd5714d69d6, []int{42}
 }
 func (m *Resource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3057,7 +4271,7 @@ func (m *MetricSchema) Reset()         { *m = MetricSchema{} }
 func (m *MetricSchema) String() string { return proto.CompactTextString(m) }
 func (*MetricSchema) ProtoMessage()    {}
 func (*MetricSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{29}
+	return fileDescriptor_95e63dd5714d69d6, []int{43}
 }
 func (m *MetricSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3154,7 +4368,7 @@ func (m *LogSchema) Reset()         { *m = LogSchema{} }
 func (m *LogSchema) String() string { return proto.CompactTextString(m) }
 func (*LogSchema) ProtoMessage()    {}
 func (*LogSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{30}
+	return fileDescriptor_95e63dd5714d69d6, []int{44}
 }
 func (m *LogSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3207,7 +4421,7 @@ func (m *TraceSchema) Reset()         { *m = TraceSchema{} }
 func (m *TraceSchema) String() string { return proto.CompactTextString(m) }
 func (*TraceSchema) ProtoMessage()    {}
 func (*TraceSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{31}
+	return fileDescriptor_95e63dd5714d69d6, []int{45}
 }
 func (m *TraceSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3255,7 +4469,7 @@ func (m *ProfileSchema) Reset()         { *m = ProfileSchema{} }
 func (m *ProfileSchema) String() string { return proto.CompactTextString(m) }
 func (*ProfileSchema) ProtoMessage()    {}
 func (*ProfileSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{32}
+	return fileDescriptor_95e63dd5714d69d6, []int{46}
 }
 func (m *ProfileSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3321,7 +4535,7 @@ func (m *TelemetrySchemaRequest) Reset()         { *m = TelemetrySchemaRequest{}
 func (m *TelemetrySchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*TelemetrySchemaRequest) ProtoMessage()    {}
 func (*TelemetrySchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{33}
+	return fileDescriptor_95e63dd5714d69d6, []int{47}
 }
 func (m *TelemetrySchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +4618,7 @@ func (m *TelemetrySchemaResponse) Reset()         { *m = TelemetrySchemaResponse
 func (m *TelemetrySchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*TelemetrySchemaResponse) ProtoMessage()    {}
 func (*TelemetrySchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{34}
+	return fileDescriptor_95e63dd5714d69d6, []int{48}
 }
 func (m *TelemetrySchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3462,7 +4676,7 @@ func (m *BloomDyeing) Reset()         { *m = BloomDyeing{} }
 func (m *BloomDyeing) String() string { return proto.CompactTextString(m) }
 func (*BloomDyeing) ProtoMessage()    {}
 func (*BloomDyeing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{35}
+	return fileDescriptor_95e63dd5714d69d6, []int{49}
 }
 func (m *BloomDyeing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3531,7 +4745,7 @@ func (m *RpcSamplingConfig) Reset()         { *m = RpcSamplingConfig{} }
 func (m *RpcSamplingConfig) String() string { return proto.CompactTextString(m) }
 func (*RpcSamplingConfig) ProtoMessage()    {}
 func (*RpcSamplingConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{36}
+	return fileDescriptor_95e63dd5714d69d6, []int{50}
 }
 func (m *RpcSamplingConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3586,7 +4800,7 @@ func (m *RpcConfig) Reset()         { *m = RpcConfig{} }
 func (m *RpcConfig) String() string { return proto.CompactTextString(m) }
 func (*RpcConfig) ProtoMessage()    {}
 func (*RpcConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95e63dd5714d69d6, []int{37}
+	return fileDescriptor_95e63dd5714d69d6, []int{51}
 }
 func (m *RpcConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3634,6 +4848,8 @@ func init() {
 	proto.RegisterEnum("model.DataProtocol", DataProtocol_name, DataProtocol_value)
 	proto.RegisterEnum("model.DataTransmission", DataTransmission_name, DataTransmission_value)
 	proto.RegisterEnum("model.MetricsSampleType", MetricsSampleType_name, MetricsSampleType_value)
+	proto.RegisterEnum("model.MetricsTemporality", MetricsTemporality_name, MetricsTemporality_value)
+	proto.RegisterEnum("model.LabelRuleAction", LabelRuleAction_name, LabelRuleAction_value)
 	proto.RegisterEnum("model.AccessPoint", AccessPoint_name, AccessPoint_value)
 	proto.RegisterType((*Collector)(nil), "model.Collector")
 	proto.RegisterType((*GetConfigRequest)(nil), "model.GetConfigRequest")
@@ -3642,11 +4858,20 @@ func init() {
 	proto.RegisterType((*SelfMonitor)(nil), "model.SelfMonitor")
 	proto.RegisterType((*MetricsConfig)(nil), "model.MetricsConfig")
 	proto.RegisterType((*LabelIgnore)(nil), "model.LabelIgnore")
+	proto.RegisterType((*TargetPointLimit)(nil), "model.TargetPointLimit")
+	proto.RegisterType((*MultiTargetConfig)(nil), "model.MultiTargetConfig")
+	proto.RegisterType((*LabelMatcher)(nil), "model.LabelMatcher")
+	proto.RegisterType((*LabelRule)(nil), "model.LabelRule")
 	proto.RegisterType((*SecondGranularity)(nil), "model.SecondGranularity")
 	proto.RegisterType((*SampleMonitor)(nil), "model.SampleMonitor")
+	proto.RegisterType((*ExponentialHistogramConfig)(nil), "model.ExponentialHistogramConfig")
+	proto.RegisterType((*SummaryConfig)(nil), "model.SummaryConfig")
+	proto.RegisterType((*SeriesLimits)(nil), "model.SeriesLimits")
+	proto.RegisterType((*MonitorSeriesLimit)(nil), "model.MonitorSeriesLimit")
 	proto.RegisterType((*RPCHasTwoIP)(nil), "model.RPCHasTwoIP")
 	proto.RegisterType((*MetricsProcessor)(nil), "model.MetricsProcessor")
 	proto.RegisterType((*MetricsExporter)(nil), "model.MetricsExporter")
+	proto.RegisterType((*MetricsSpool)(nil), "model.MetricsSpool")
 	proto.RegisterType((*PrometheusPushConfig)(nil), "model.PrometheusPushConfig")
 	proto.RegisterMapType((map[string]string)(nil), "model.PrometheusPushConfig.GroupingEntry")
 	proto.RegisterMapType((map[string]string)(nil), "model.PrometheusPushConfig.HttpHeadersEntry")
@@ -3654,12 +4879,17 @@ func init() {
 	proto.RegisterType((*HistogramBucket)(nil), "model.HistogramBucket")
 	proto.RegisterType((*TracesConfig)(nil), "model.TracesConfig")
 	proto.RegisterType((*TracesProcessor)(nil), "model.TracesProcessor")
+	proto.RegisterType((*TailSamplerConfig)(nil), "model.TailSamplerConfig")
 	proto.RegisterType((*TracesExporter)(nil), "model.TracesExporter")
 	proto.RegisterType((*LogsConfig)(nil), "model.LogsConfig")
 	proto.RegisterType((*LogsProcessor)(nil), "model.LogsProcessor")
 	proto.RegisterType((*LogsExporter)(nil), "model.LogsExporter")
+	proto.RegisterType((*LogsHTTP)(nil), "model.LogsHTTP")
+	proto.RegisterType((*ClientTLS)(nil), "model.ClientTLS")
 	proto.RegisterType((*ProfilesConfig)(nil), "model.ProfilesConfig")
 	proto.RegisterType((*ProfilesProcessor)(nil), "model.ProfilesProcessor")
+	proto.RegisterType((*ProfileCapture)(nil), "model.ProfileCapture")
+	proto.RegisterType((*ProfilesAnomalyConfig)(nil), "model.ProfilesAnomalyConfig")
 	proto.RegisterType((*ProfilesExporter)(nil), "model.ProfilesExporter")
 	proto.RegisterType((*SamplerConfig)(nil), "model.SamplerConfig")
 	proto.RegisterType((*WorkflowSamplerConfig)(nil), "model.WorkflowSamplerConfig")
@@ -3680,231 +4910,302 @@ func init() {
 func init() { proto.RegisterFile("ocp.proto", fileDescriptor_95e63dd5714d69d6) }
 
 var fileDescriptor_95e63dd5714d69d6 = []byte{
-	// 3576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3a, 0x4d, 0x6f, 0x24, 0x49,
-	0x56, 0x5d, 0xae, 0x2a, 0xbb, 0xea, 0xd5, 0x87, 0xd3, 0xd1, 0xfe, 0xa8, 0xee, 0xe9, 0xe9, 0x8f,
-	0x9a, 0x19, 0xb6, 0x69, 0x56, 0xde, 0x59, 0xb3, 0xb3, 0xd3, 0x33, 0x0d, 0x33, 0xb8, 0xed, 0xea,
-	0x6e, 0xb7, 0xca, 0x76, 0x91, 0x55, 0x3b, 0xab, 0xe1, 0x92, 0xca, 0xca, 0x0c, 0x57, 0xe5, 0x3a,
-	0x2b, 0x33, 0x89, 0x88, 0xb2, 0xdb, 0x7b, 0x07, 0x84, 0x84, 0x10, 0x42, 0x42, 0x02, 0xc1, 0x85,
-	0x1b, 0x07, 0xb8, 0x23, 0x24, 0x24, 0x4e, 0x68, 0x8e, 0x7b, 0xdc, 0x13, 0x82, 0x99, 0x1b, 0x07,
-	0xf8, 0x07, 0x08, 0xc5, 0x8b, 0x88, 0xac, 0xcc, 0x2a, 0xdb, 0xed, 0x05, 0x16, 0x24, 0xf6, 0x96,
-	0xf1, 0xbe, 0x22, 0xe2, 0x7d, 0xc4, 0x7b, 0xf1, 0x22, 0xa1, 0x1a, 0x7b, 0xc9, 0x76, 0xc2, 0x62,
-	0x11, 0x93, 0xf2, 0x24, 0xf6, 0x69, 0x78, 0x77, 0x7d, 0x14, 0x8f, 0x62, 0x84, 0x7c, 0x47, 0x7e,
-	0x29, 0x64, 0xfb, 0x2f, 0x96, 0xa0, 0xba, 0x17, 0x87, 0x21, 0xf5, 0x44, 0xcc, 0x08, 0x81, 0x92,
-	0xeb, 0xfb, 0xac, 0x55, 0x78, 0x58, 0x78, 0x5c, 0xb5, 0xf1, 0x9b, 0x3c, 0x83, 0xa6, 0xa0, 0x21,
-	0x9d, 0x50, 0xc1, 0x2e, 0x1c, 0xdf, 0x15, 0x6e, 0x6b, 0xe9, 0x61, 0xe1, 0x71, 0x73, 0x67, 0x7d,
-	0x1b, 0xe5, 0x6e, 0x0f, 0x0c, 0x72, 0xdf, 0x15, 0xae, 0xdd, 0x10, 0xd9, 0x21, 0x79, 0x0a, 0x0d,
-	0xc9, 0xe2, 0xe0, 0x64, 0x5e, 0x1c, 0xb6, 0x8a, 0xc8, 0x7b, 0x5b, 0xf3, 0x4a, 0x9a, 0x9e, 0x46,
-	0xd9, 0x75, 0x3f, 0x33, 0x22, 0xfb, 0xb0, 0x86, 0x9c, 0x82, 0xb9, 0x11, 0x9f, 0x04, 0x9c, 0x07,
-	0x71, 0xd4, 0x2a, 0x21, 0xf7, 0x56, 0x86, 0x7b, 0x90, 0x41, 0xdb, 0x96, 0x3f, 0x07, 0x21, 0x2d,
-	0x58, 0x39, 0xa3, 0x0c, 0x79, 0xcb, 0x0f, 0x0b, 0x8f, 0xcb, 0xb6, 0x19, 0x92, 0xf7, 0xa1, 0xe9,
-	0x07, 0x8c, 0x7a, 0xc2, 0x09, 0x12, 0x27, 0x89, 0x99, 0x68, 0x2d, 0x3f, 0x2c, 0x3e, 0xae, 0xda,
-	0x75, 0x05, 0x3d, 0x48, 0x7a, 0x31, 0x13, 0xed, 0xbf, 0x2d, 0x82, 0xf5, 0x92, 0x8a, 0xbd, 0x38,
-	0x3a, 0x09, 0x46, 0x36, 0xfd, 0xed, 0x29, 0xe5, 0x82, 0xdc, 0x85, 0x4a, 0x12, 0xba, 0xe2, 0x24,
-	0x66, 0x13, 0xad, 0xa9, 0x74, 0x4c, 0x1e, 0x40, 0x2d, 0x1e, 0xfe, 0x48, 0x8a, 0x8d, 0xdc, 0x09,
-	0x45, 0x55, 0x55, 0x6d, 0x50, 0xa0, 0x23, 0x77, 0x42, 0xc9, 0x53, 0x58, 0x91, 0xea, 0x09, 0x3c,
-	0x8e, 0xba, 0xa8, 0xed, 0xb4, 0xf4, 0x6e, 0x52, 0x2b, 0x18, 0x15, 0x3c, 0x2f, 0x7d, 0xf5, 0x4f,
-	0x0f, 0x6e, 0xd9, 0x86, 0x9c, 0x7c, 0x1f, 0x96, 0x05, 0x73, 0x3d, 0xca, 0x51, 0x0d, 0x6f, 0x67,
-	0xd4, 0xd4, 0x64, 0x07, 0x4a, 0x61, 0x3c, 0xe2, 0xa8, 0x80, 0xb7, 0x73, 0x21, 0x2d, 0xb1, 0xa0,
-	0x48, 0xa3, 0xb3, 0xd6, 0x32, 0x2e, 0x5f, 0x7e, 0x4a, 0x08, 0xa7, 0xa2, 0xb5, 0xa2, 0x20, 0x9c,
-	0x0a, 0xf2, 0x5d, 0xa8, 0x30, 0xca, 0xe3, 0x29, 0xf3, 0x68, 0xab, 0x82, 0xb2, 0x57, 0xb5, 0x6c,
-	0x5b, 0x83, 0xb5, 0xc8, 0x94, 0x8c, 0x7c, 0x0a, 0x95, 0x84, 0xc5, 0x27, 0x41, 0x48, 0x79, 0xab,
-	0x7a, 0xa3, 0xe5, 0xa4, 0xf4, 0x64, 0x1b, 0xca, 0x61, 0xec, 0xb9, 0x61, 0x0b, 0x72, 0x8c, 0x19,
-	0xeb, 0xf0, 0x24, 0x8e, 0x38, 0xb5, 0x15, 0x59, 0xfb, 0x5f, 0x0b, 0xb0, 0xb6, 0x20, 0xf5, 0xff,
-	0xa9, 0x37, 0xb7, 0xff, 0xad, 0x0c, 0x6b, 0x0b, 0x9a, 0x90, 0xe1, 0xec, 0xc5, 0x3e, 0x45, 0x27,
-	0x2d, 0xdb, 0xf8, 0x2d, 0xed, 0x38, 0xe1, 0x23, 0xed, 0x98, 0xf2, 0x93, 0x6c, 0xc2, 0xb2, 0x70,
-	0xd9, 0x88, 0x0a, 0xdc, 0x4e, 0xd5, 0xd6, 0x23, 0xf2, 0x1e, 0x34, 0x3c, 0x94, 0xe7, 0x70, 0xca,
-	0xce, 0x28, 0xc3, 0xf5, 0x56, 0xed, 0xba, 0x02, 0xf6, 0x11, 0x46, 0xbe, 0x05, 0xab, 0x8c, 0x8e,
-	0x02, 0x2e, 0x28, 0x33, 0x64, 0x65, 0x24, 0x6b, 0x1a, 0xb0, 0x26, 0x7c, 0x06, 0x75, 0x4e, 0xc3,
-	0x13, 0x67, 0x12, 0x47, 0x81, 0x88, 0x19, 0xba, 0x56, 0x6d, 0x87, 0xe8, 0xcd, 0xf7, 0x69, 0x78,
-	0x72, 0xa8, 0x30, 0xda, 0xf0, 0x35, 0x3e, 0x03, 0x91, 0x5d, 0x68, 0xea, 0x28, 0x70, 0xd4, 0xec,
-	0xe8, 0x87, 0xb5, 0xd4, 0x6a, 0x87, 0x0a, 0xa9, 0xb6, 0xaf, 0x05, 0x34, 0x26, 0x59, 0x20, 0xf9,
-	0x0c, 0x1a, 0x2a, 0x1e, 0x8c, 0x04, 0xe5, 0xb2, 0xc6, 0x76, 0x03, 0xc4, 0xe5, 0x04, 0xd4, 0x45,
-	0x06, 0x46, 0x9e, 0x42, 0x4d, 0x46, 0x86, 0xe1, 0x56, 0xde, 0xbb, 0xa6, 0xb9, 0xbb, 0xf1, 0x28,
-	0xcf, 0x0b, 0x61, 0x0a, 0x21, 0xef, 0x40, 0x55, 0xd0, 0xc8, 0x8d, 0x84, 0x13, 0xf8, 0xe8, 0xbc,
-	0x55, 0xbb, 0xa2, 0x00, 0x07, 0x7e, 0xd6, 0xa4, 0xb5, 0xfc, 0x01, 0xb5, 0x0f, 0xab, 0xc6, 0xf7,
-	0xcd, 0xa4, 0x75, 0x9c, 0x74, 0x43, 0x4f, 0xda, 0xd3, 0xd8, 0xdc, 0xc4, 0xcd, 0x24, 0x07, 0x25,
-	0x1f, 0x41, 0xdd, 0xf5, 0x3c, 0xca, 0xb9, 0x93, 0xc4, 0x41, 0x24, 0x5a, 0x0d, 0xf4, 0x39, 0xa3,
-	0xf6, 0x5d, 0x44, 0xf5, 0x24, 0xc6, 0xae, 0xb9, 0xb3, 0x01, 0x79, 0x8d, 0x93, 0x4f, 0xa8, 0x18,
-	0xd3, 0x29, 0x77, 0x92, 0x29, 0x1f, 0xb7, 0x9a, 0x38, 0xf9, 0x3b, 0xb3, 0xc9, 0x35, 0xb6, 0x37,
-	0xe5, 0xe3, 0x85, 0x25, 0x64, 0x70, 0xa4, 0x0f, 0x24, 0x4e, 0x68, 0x34, 0x0b, 0x3b, 0x14, 0xb7,
-	0x8a, 0xe2, 0xee, 0x6b, 0x71, 0xc7, 0x09, 0x8d, 0xd2, 0xd0, 0x5b, 0x90, 0xb8, 0x96, 0xe3, 0x97,
-	0xe8, 0xf6, 0xef, 0x16, 0xa0, 0x96, 0x71, 0x1a, 0x3c, 0x93, 0x4d, 0x54, 0x9a, 0x33, 0xd9, 0x04,
-	0xdf, 0xf7, 0xa0, 0xea, 0x99, 0x83, 0x00, 0x1d, 0xbf, 0xb6, 0x63, 0xcd, 0x1f, 0x3b, 0x7a, 0xa6,
-	0x19, 0x21, 0xf9, 0x00, 0x9a, 0x8c, 0xca, 0xc4, 0xe0, 0x70, 0xea, 0xc5, 0x91, 0xaf, 0xce, 0xeb,
-	0xb2, 0xdd, 0x50, 0xd0, 0xbe, 0x02, 0xb6, 0xff, 0xbe, 0x00, 0x8d, 0x9c, 0xfb, 0xc9, 0x78, 0xa2,
-	0x91, 0x3b, 0x0c, 0x55, 0xdc, 0x55, 0x6c, 0x3d, 0x22, 0xcf, 0xa0, 0x9a, 0xb0, 0x58, 0xea, 0x38,
-	0x66, 0xfa, 0xec, 0xdf, 0xca, 0xfb, 0x6f, 0xcf, 0xa0, 0xcd, 0x6a, 0x52, 0x7a, 0xf2, 0x14, 0x2a,
-	0xf4, 0x8d, 0x9c, 0x57, 0xc7, 0x61, 0x6d, 0x67, 0x33, 0xcf, 0xdb, 0xd1, 0x58, 0x73, 0x6e, 0x1a,
-	0x6a, 0xf2, 0x2e, 0x80, 0x5a, 0x80, 0xc3, 0x39, 0xc5, 0xe0, 0xac, 0xd8, 0x55, 0x05, 0xe9, 0x73,
-	0xda, 0xfe, 0x4d, 0xa8, 0x75, 0xdd, 0x21, 0x0d, 0x0f, 0x46, 0x51, 0xcc, 0x28, 0x79, 0x04, 0x75,
-	0x1d, 0xa1, 0x2a, 0x81, 0x29, 0x5d, 0xd6, 0x34, 0x0c, 0x33, 0xd8, 0x03, 0xa8, 0x85, 0x92, 0x03,
-	0x09, 0x78, 0x6b, 0x09, 0xd3, 0x26, 0x20, 0x48, 0xe2, 0x79, 0xfb, 0x1f, 0x0a, 0xb0, 0xa6, 0xd4,
-	0xf3, 0x92, 0xb9, 0xd1, 0x34, 0x74, 0x59, 0x20, 0x2e, 0x6e, 0x22, 0xf9, 0x11, 0xd4, 0x87, 0x74,
-	0x14, 0x44, 0x5a, 0xe3, 0x68, 0xab, 0xa2, 0x5d, 0x43, 0x98, 0x12, 0xa8, 0x76, 0xe3, 0x1b, 0x82,
-	0x22, 0x12, 0x54, 0x69, 0xe4, 0x6b, 0xf4, 0x07, 0xd0, 0x3c, 0x0f, 0x22, 0x3f, 0x3e, 0x4f, 0x8d,
-	0x56, 0x52, 0x46, 0x53, 0x50, 0x6d, 0x34, 0xb9, 0x05, 0x21, 0xc2, 0x94, 0xa6, 0x8c, 0x62, 0x40,
-	0x88, 0xd0, 0x58, 0xf5, 0xf7, 0x0b, 0xd0, 0xe8, 0xbb, 0x93, 0x24, 0xa4, 0xc6, 0xc1, 0x6e, 0xb0,
-	0xfc, 0x4f, 0xa0, 0xc6, 0x91, 0xc7, 0x11, 0x17, 0x09, 0xd5, 0x89, 0xa5, 0x95, 0x37, 0x93, 0x12,
-	0x3a, 0xb8, 0x48, 0xa8, 0x0d, 0x3c, 0xfd, 0x96, 0xee, 0x7b, 0xc2, 0x5c, 0x4f, 0xc8, 0x73, 0x40,
-	0x6e, 0xaa, 0x60, 0xa7, 0xe3, 0x36, 0x83, 0x9a, 0xdd, 0xdb, 0x7b, 0xe5, 0xf2, 0xc1, 0x79, 0x7c,
-	0xd0, 0xfb, 0x5f, 0xd1, 0x63, 0xfb, 0x1f, 0xcb, 0x60, 0xcd, 0x3b, 0xe5, 0xb5, 0x31, 0xb6, 0xa8,
-	0xf8, 0xa5, 0xcb, 0x14, 0x2f, 0x73, 0x4a, 0x48, 0x5d, 0x36, 0x17, 0x53, 0x75, 0x04, 0x1a, 0xa2,
-	0x6f, 0xc1, 0x2a, 0x7d, 0x93, 0x04, 0x8c, 0xf2, 0x9c, 0x15, 0x8b, 0x76, 0x53, 0x83, 0x33, 0x66,
-	0xc4, 0x53, 0xcd, 0x09, 0x83, 0x49, 0x20, 0x8c, 0x19, 0x11, 0xd4, 0x95, 0x10, 0xf2, 0x3d, 0xd8,
-	0xd4, 0xbe, 0xaf, 0x23, 0xc9, 0x31, 0xb5, 0xd7, 0x32, 0xc6, 0xc1, 0xba, 0xc2, 0xea, 0x2d, 0x1e,
-	0xa6, 0x85, 0xd6, 0xd6, 0x1c, 0x79, 0xba, 0x8e, 0x15, 0x9c, 0x62, 0x23, 0xc9, 0x31, 0x98, 0xe5,
-	0x1c, 0xc0, 0xda, 0x38, 0xe0, 0x22, 0x1e, 0x31, 0x77, 0xe2, 0x0c, 0xa7, 0xde, 0x29, 0x15, 0xbc,
-	0x55, 0x79, 0x58, 0xcc, 0x04, 0xeb, 0x2b, 0x83, 0x7f, 0x8e, 0x68, 0x1d, 0xac, 0xd6, 0x38, 0x0f,
-	0xe6, 0xe4, 0xd7, 0xa1, 0xa1, 0x62, 0x2c, 0xc0, 0xb0, 0x94, 0xd5, 0x52, 0x31, 0x93, 0x2e, 0x33,
-	0x11, 0x6b, 0x92, 0x55, 0x38, 0x03, 0x71, 0xf2, 0x5d, 0xd8, 0x60, 0x54, 0x38, 0x32, 0xe1, 0x3b,
-	0x2e, 0x77, 0xe8, 0x1b, 0x8f, 0x26, 0xe8, 0x5b, 0x80, 0xdb, 0x26, 0x4c, 0x96, 0x0a, 0x3e, 0xdd,
-	0xe5, 0x1d, 0x83, 0x21, 0xc7, 0x70, 0x5b, 0x6d, 0xd2, 0x19, 0xcd, 0x82, 0x96, 0xb7, 0x6a, 0x38,
-	0x6f, 0x2b, 0x4d, 0xd3, 0x73, 0x51, 0xad, 0x67, 0x27, 0x7c, 0x1e, 0xc1, 0xc9, 0x1e, 0xac, 0xea,
-	0x68, 0xd0, 0xae, 0xc9, 0x5b, 0x75, 0x14, 0x66, 0x92, 0x76, 0x2e, 0xbe, 0x4c, 0xee, 0xe0, 0x59,
-	0x20, 0x27, 0x9f, 0xc3, 0x2a, 0x4b, 0x3c, 0x67, 0xec, 0x72, 0x47, 0x9c, 0xc7, 0x4e, 0x90, 0xf0,
-	0x56, 0x23, 0xa7, 0x89, 0x4c, 0x64, 0x18, 0x4d, 0xb0, 0xc4, 0xd3, 0xa0, 0x84, 0xb7, 0x7f, 0xba,
-	0x04, 0xab, 0x73, 0x27, 0xe4, 0xcf, 0x21, 0x57, 0x3c, 0x82, 0xba, 0x18, 0x33, 0xea, 0xfa, 0x8e,
-	0x17, 0x4f, 0x23, 0xa1, 0xbd, 0xba, 0xa6, 0x60, 0x7b, 0x12, 0x24, 0x7d, 0x75, 0x38, 0x3d, 0x39,
-	0x91, 0x65, 0x52, 0xf0, 0x63, 0xaa, 0x8f, 0x25, 0x50, 0xa0, 0x7e, 0xf0, 0x63, 0x2a, 0xcb, 0x84,
-	0xc4, 0x1d, 0x51, 0x85, 0x56, 0xe5, 0x5d, 0x45, 0x02, 0x10, 0xf9, 0x2e, 0x80, 0x08, 0x26, 0x34,
-	0x9e, 0x0a, 0x67, 0xa2, 0x9c, 0xb7, 0x6c, 0x57, 0x35, 0xe4, 0x90, 0x5f, 0x12, 0x7d, 0x2b, 0x97,
-	0x45, 0xdf, 0x2f, 0xc1, 0xea, 0xc4, 0x7d, 0xe3, 0x30, 0xcc, 0xc2, 0x6a, 0xa5, 0x15, 0x45, 0x37,
-	0x71, 0xdf, 0xd8, 0x12, 0xaa, 0xd6, 0xfa, 0x3e, 0x34, 0x55, 0xfa, 0x70, 0x44, 0xec, 0xc8, 0x6a,
-	0x02, 0xcb, 0x9d, 0x8a, 0x5d, 0x57, 0xd0, 0x41, 0xfc, 0x22, 0x08, 0x69, 0xfb, 0x5f, 0x8a, 0xb0,
-	0x7e, 0x59, 0x19, 0x70, 0x65, 0x02, 0xb4, 0xa0, 0x38, 0x65, 0xa1, 0x29, 0x3d, 0xa7, 0x2c, 0x94,
-	0x90, 0x1f, 0xc5, 0x43, 0x5d, 0x77, 0xca, 0x4f, 0x69, 0x9b, 0x20, 0x12, 0x94, 0x9d, 0xb9, 0xa1,
-	0xd6, 0x51, 0x3a, 0x96, 0xcb, 0x9a, 0x72, 0xea, 0x0c, 0x5d, 0x1e, 0x78, 0x8e, 0x3b, 0x15, 0x63,
-	0x9d, 0xcd, 0xea, 0x53, 0x4e, 0x9f, 0x4b, 0xe0, 0xee, 0x54, 0x8c, 0xa5, 0x84, 0x29, 0xa7, 0x0c,
-	0xcf, 0x46, 0x75, 0x7f, 0x49, 0xc7, 0x68, 0x79, 0x97, 0xf3, 0xf3, 0x98, 0xf9, 0xfa, 0x26, 0x93,
-	0x8e, 0x49, 0x07, 0x2a, 0x23, 0x16, 0x4f, 0x93, 0x20, 0x1a, 0xe9, 0xa0, 0xfd, 0xe5, 0x6b, 0x6a,
-	0x9d, 0xed, 0x97, 0x9a, 0xb6, 0x13, 0x09, 0x76, 0x61, 0xa7, 0xac, 0xe4, 0x18, 0xea, 0x63, 0x21,
-	0x12, 0x67, 0x4c, 0x5d, 0x9f, 0x32, 0x13, 0xb8, 0xdf, 0xbe, 0x4e, 0xd4, 0x2b, 0x21, 0x92, 0x57,
-	0x8a, 0x5c, 0x49, 0xab, 0x8d, 0x67, 0x90, 0xbb, 0xcf, 0xa0, 0x91, 0x9b, 0x4b, 0x2a, 0xed, 0x94,
-	0x5e, 0x68, 0xcf, 0x95, 0x9f, 0x64, 0x1d, 0xca, 0x67, 0x6e, 0x38, 0x35, 0xd7, 0x4d, 0x35, 0xf8,
-	0x74, 0xe9, 0x69, 0xe1, 0xee, 0x67, 0x60, 0xcd, 0x4b, 0xff, 0x59, 0xf8, 0xdb, 0x7b, 0xb0, 0x75,
-	0x45, 0x69, 0x76, 0x73, 0x2b, 0xb7, 0x3f, 0x87, 0xd5, 0xb9, 0x73, 0x4f, 0xde, 0x4c, 0x32, 0xc9,
-	0x0b, 0xbf, 0x65, 0x29, 0x6c, 0x0e, 0x4d, 0x59, 0x53, 0x14, 0x6c, 0x33, 0x6c, 0xff, 0x5d, 0x01,
-	0xea, 0xd9, 0x02, 0xfd, 0xca, 0xb9, 0x3f, 0x5d, 0x2c, 0xb1, 0x36, 0x73, 0x05, 0xfe, 0x35, 0x15,
-	0xd6, 0xc7, 0x0b, 0x15, 0xd6, 0x46, 0x8e, 0xf5, 0xbf, 0x5a, 0x60, 0xfd, 0x75, 0x09, 0x56, 0xe7,
-	0x26, 0x7f, 0xcb, 0x09, 0xb4, 0xa2, 0x0e, 0x41, 0xb3, 0x83, 0xfc, 0x79, 0xc9, 0x72, 0x95, 0xb1,
-	0x21, 0x25, 0xdf, 0x06, 0xe2, 0x07, 0x1c, 0x57, 0x81, 0xd7, 0x16, 0x67, 0x18, 0xfb, 0x17, 0xb8,
-	0x8f, 0x8a, 0x6d, 0x69, 0x0c, 0xae, 0xe2, 0x79, 0xec, 0x5f, 0x90, 0x4f, 0xe0, 0x8e, 0xa1, 0xe6,
-	0x82, 0x51, 0x77, 0x92, 0x65, 0xaa, 0x21, 0xd3, 0xa6, 0x26, 0xe8, 0x23, 0x7e, 0xc6, 0x3a, 0x4b,
-	0xa9, 0x3e, 0x3d, 0xa1, 0x8c, 0x51, 0xdf, 0x51, 0x6b, 0xd0, 0x3b, 0xd7, 0x29, 0x75, 0x5f, 0x23,
-	0xd5, 0xa2, 0xc9, 0x0e, 0x6c, 0xcc, 0x91, 0x3b, 0x94, 0x31, 0x7d, 0x0d, 0xac, 0xd8, 0xb7, 0xfd,
-	0x1c, 0x79, 0x47, 0xa2, 0xc8, 0x0b, 0x78, 0x38, 0xcf, 0xc3, 0xc3, 0xf8, 0xdc, 0xf1, 0xa7, 0xcc,
-	0x95, 0x29, 0x4b, 0x9e, 0x84, 0x2a, 0x1f, 0xdf, 0xcb, 0xb3, 0xf7, 0xc3, 0xf8, 0x7c, 0x5f, 0x13,
-	0x1d, 0x62, 0x3a, 0x37, 0x9b, 0x4d, 0x5c, 0x46, 0x23, 0xa1, 0xa4, 0xa9, 0x38, 0x97, 0xb3, 0x6f,
-	0x68, 0x74, 0x0f, 0xb1, 0x7d, 0x8d, 0x24, 0x87, 0x60, 0x9d, 0xc7, 0xec, 0xf4, 0x44, 0xce, 0x69,
-	0x2c, 0xa2, 0xae, 0x7d, 0xf7, 0xb4, 0x45, 0x7e, 0xa8, 0xd1, 0x97, 0x59, 0x66, 0xf5, 0x3c, 0x8f,
-	0x94, 0x67, 0xf4, 0xac, 0x16, 0xc1, 0x43, 0x55, 0x25, 0xe3, 0x46, 0x5a, 0x83, 0x48, 0x60, 0xfb,
-	0x0f, 0x97, 0xa0, 0x99, 0x77, 0xb8, 0x9f, 0x43, 0xbe, 0xfa, 0xef, 0x25, 0xa3, 0x1b, 0x66, 0x1b,
-	0x59, 0x9d, 0xb9, 0x32, 0x80, 0x95, 0x14, 0x95, 0x69, 0x40, 0x81, 0x50, 0xce, 0xcd, 0xd2, 0xcc,
-	0x9f, 0x14, 0x00, 0x66, 0xf7, 0xeb, 0x2b, 0x43, 0xff, 0xe9, 0x62, 0xe8, 0xaf, 0x67, 0x6e, 0xe7,
-	0xd7, 0x04, 0xfe, 0x47, 0x0b, 0x81, 0x7f, 0x3b, 0xc3, 0x78, 0x55, 0xd8, 0xb7, 0xff, 0x60, 0x09,
-	0x1a, 0x39, 0xc9, 0xd7, 0xda, 0xe9, 0x7d, 0x68, 0xc6, 0x51, 0x78, 0xa1, 0xe3, 0x2c, 0x8c, 0x55,
-	0x07, 0xa6, 0x62, 0xd7, 0x25, 0x14, 0xed, 0xdd, 0x8d, 0x47, 0x92, 0x2a, 0x25, 0x70, 0xe4, 0x1a,
-	0xb4, 0x69, 0x54, 0x2b, 0xa2, 0x1b, 0x8f, 0x0e, 0x63, 0x9f, 0xca, 0xe3, 0x3a, 0xa4, 0x67, 0x34,
-	0xd4, 0x9d, 0x16, 0x35, 0xc0, 0xaa, 0x59, 0xf9, 0x17, 0xa3, 0x5e, 0x7c, 0x46, 0xd9, 0x85, 0x0e,
-	0x2e, 0xed, 0x76, 0xb6, 0x86, 0x62, 0x15, 0x30, 0xe5, 0x02, 0xe7, 0x40, 0xb9, 0x2a, 0x17, 0x56,
-	0xec, 0x86, 0x04, 0x77, 0xe3, 0x11, 0x2e, 0xc7, 0x97, 0x74, 0x33, 0x12, 0x75, 0xa5, 0xa9, 0xe0,
-	0x84, 0x8d, 0xd0, 0xd0, 0xc8, 0xbb, 0xcb, 0xeb, 0x52, 0xa5, 0x68, 0x95, 0xa4, 0x3a, 0xea, 0x59,
-	0x7d, 0xfd, 0x82, 0x7b, 0xed, 0x5f, 0x16, 0xa0, 0x99, 0x6f, 0xd0, 0x5c, 0xe9, 0xb9, 0xbf, 0xb6,
-	0xe8, 0xb9, 0xad, 0xb9, 0x16, 0xcf, 0x35, 0xde, 0xfb, 0xc9, 0x82, 0xf7, 0x6e, 0xcd, 0x31, 0x5f,
-	0xe9, 0xc1, 0x7f, 0x5e, 0x84, 0xb5, 0x85, 0x19, 0xae, 0xb5, 0xdb, 0x7b, 0xd0, 0xd0, 0x87, 0x17,
-	0xfa, 0x83, 0xbc, 0xbe, 0x61, 0xcf, 0x5c, 0x03, 0xa5, 0x3b, 0x60, 0x31, 0x9a, 0x50, 0x16, 0xc4,
-	0xfe, 0xdc, 0xed, 0xad, 0xa1, 0xa0, 0x46, 0xd1, 0x1f, 0xc2, 0xba, 0x97, 0x4c, 0x67, 0xa7, 0x79,
-	0xfe, 0x32, 0x4e, 0xbc, 0x64, 0x6a, 0xce, 0x70, 0xc3, 0xf1, 0x18, 0x2c, 0xc9, 0x61, 0x56, 0xc0,
-	0x5c, 0x41, 0x75, 0x29, 0xdc, 0xf4, 0x92, 0xa9, 0xde, 0x89, 0xed, 0x0a, 0x2a, 0x93, 0xd4, 0x64,
-	0x2a, 0xe8, 0x9b, 0x94, 0x36, 0xbd, 0x5c, 0x2b, 0x9b, 0xaf, 0x23, 0x56, 0x73, 0xbc, 0xd0, 0x38,
-	0x99, 0x43, 0x87, 0x61, 0xec, 0x9d, 0xe6, 0x67, 0x50, 0x1e, 0x60, 0x21, 0x26, 0x3b, 0xc7, 0x0e,
-	0x6c, 0xa4, 0x89, 0x30, 0x54, 0x4d, 0xe1, 0x59, 0x63, 0xbb, 0x62, 0xdf, 0x36, 0x79, 0x30, 0xc4,
-	0x36, 0xb0, 0xea, 0x61, 0x3f, 0x81, 0x35, 0xcd, 0x13, 0x06, 0xd1, 0xa9, 0x0a, 0x2d, 0x9d, 0x06,
-	0x74, 0xf0, 0x76, 0x83, 0xe8, 0x14, 0x63, 0xab, 0xfd, 0x37, 0x4b, 0x60, 0xcd, 0x9b, 0xf0, 0xff,
-	0x22, 0xa8, 0xde, 0x72, 0xf5, 0xf8, 0x1f, 0xbd, 0x53, 0xa8, 0xb3, 0xe4, 0x75, 0xa9, 0x52, 0xb6,
-	0x96, 0x5f, 0x97, 0x2a, 0x2b, 0x56, 0xc5, 0xce, 0x5d, 0xac, 0xec, 0x59, 0x7c, 0xdb, 0x73, 0xd1,
-	0xdc, 0xfe, 0x8f, 0xa2, 0x69, 0xd9, 0xb0, 0xb7, 0x04, 0x5c, 0xb6, 0xd9, 0xb2, 0x94, 0x6f, 0xb6,
-	0x60, 0x96, 0x96, 0xd5, 0x87, 0x33, 0xd7, 0x8e, 0x69, 0x20, 0x34, 0x75, 0x95, 0x5f, 0x81, 0x65,
-	0xff, 0x82, 0xca, 0x12, 0xa2, 0x84, 0xf5, 0x7d, 0xc3, 0x34, 0xf1, 0x11, 0x68, 0x1e, 0x60, 0x14,
-	0x89, 0x8c, 0x1a, 0xe3, 0x29, 0x8a, 0x47, 0x5f, 0x5b, 0xb4, 0x87, 0x28, 0xa2, 0x99, 0x6b, 0x4c,
-	0x82, 0xc8, 0x94, 0x54, 0xd5, 0xac, 0x6b, 0x1c, 0x06, 0x91, 0xae, 0xa6, 0xb6, 0x41, 0x7b, 0x97,
-	0x33, 0x0c, 0xe3, 0x78, 0x62, 0xc4, 0x2a, 0x47, 0xd2, 0x62, 0x9e, 0x4b, 0x8c, 0x96, 0xfd, 0x0c,
-	0xea, 0x39, 0xc2, 0x5a, 0xee, 0x0a, 0x9d, 0xa1, 0x34, 0xbd, 0xf7, 0x61, 0x86, 0xf9, 0x63, 0x00,
-	0x19, 0x07, 0xba, 0xc7, 0x52, 0xcf, 0xf5, 0x03, 0x06, 0xf1, 0x29, 0x8d, 0x54, 0x49, 0xaf, 0x9f,
-	0x1e, 0xaa, 0x92, 0x56, 0x35, 0x5f, 0xbe, 0x0f, 0xcb, 0xfa, 0x45, 0xa0, 0x91, 0x3b, 0xd4, 0xec,
-	0xc4, 0x33, 0x35, 0x56, 0xae, 0x62, 0xd2, 0xd4, 0x92, 0xcf, 0x0b, 0x03, 0x1a, 0x09, 0xdd, 0x72,
-	0x7e, 0x2b, 0x9f, 0xa2, 0x6e, 0x7f, 0x55, 0x80, 0x8d, 0x4b, 0x2b, 0x32, 0xf2, 0x11, 0x6c, 0xe9,
-	0x02, 0x12, 0xbd, 0xc8, 0x49, 0x28, 0x93, 0x5a, 0x9e, 0x0a, 0xf3, 0x34, 0xb2, 0xae, 0xd0, 0xe8,
-	0xa9, 0x3d, 0xca, 0x0e, 0x11, 0x47, 0xbe, 0x03, 0xeb, 0xd2, 0xb5, 0x17, 0x78, 0x54, 0x67, 0x6b,
-	0x6d, 0xe2, 0xbe, 0x99, 0x63, 0x78, 0x1f, 0x9a, 0x89, 0x2b, 0xc6, 0x4e, 0xca, 0x65, 0xda, 0x5b,
-	0x12, 0x7a, 0xa8, 0xc9, 0xc9, 0x23, 0xa8, 0x87, 0xc1, 0x09, 0x95, 0x21, 0x24, 0x9d, 0x57, 0x87,
-	0x5c, 0xcd, 0xc0, 0xfa, 0xd4, 0x6b, 0x7f, 0x09, 0x6b, 0x0b, 0xaa, 0x95, 0x6e, 0xcb, 0x85, 0x54,
-	0xef, 0xc8, 0x5c, 0xde, 0xd2, 0xb1, 0xbc, 0x4f, 0xe1, 0x61, 0x25, 0x97, 0x56, 0xb2, 0xf1, 0x5b,
-	0x96, 0x09, 0xc3, 0x29, 0xe3, 0x6a, 0x11, 0x25, 0x5b, 0x0d, 0xda, 0x3b, 0xb0, 0xac, 0x0d, 0xbb,
-	0x78, 0x0f, 0xdc, 0x84, 0x65, 0xbc, 0xfa, 0x99, 0xa6, 0xae, 0x1e, 0xb5, 0xff, 0xb8, 0x0c, 0x15,
-	0xf3, 0xa6, 0x97, 0x79, 0x2e, 0x2a, 0xe4, 0x9e, 0x8b, 0xee, 0x41, 0x15, 0x1b, 0xc2, 0x89, 0x3c,
-	0xd3, 0xd4, 0xed, 0x6f, 0x06, 0x20, 0x77, 0xa0, 0x42, 0xa3, 0x33, 0xd5, 0xb1, 0x54, 0xd7, 0xfd,
-	0x15, 0x1a, 0x9d, 0x61, 0xb7, 0x72, 0x13, 0x96, 0x19, 0x1d, 0x99, 0x07, 0xb1, 0xaa, 0xad, 0x47,
-	0xaa, 0x15, 0xc0, 0x85, 0x1b, 0x79, 0x54, 0x57, 0x3a, 0xe9, 0x18, 0xef, 0x8f, 0xb2, 0x3c, 0x5a,
-	0xd6, 0xf7, 0x47, 0x59, 0x16, 0x7d, 0x00, 0x4d, 0x2f, 0x8e, 0x84, 0x1b, 0x44, 0x54, 0xb7, 0x46,
-	0xd5, 0x15, 0xbf, 0x91, 0x42, 0x8f, 0xf4, 0x35, 0xd3, 0xbc, 0xb8, 0xa8, 0x72, 0x26, 0x7d, 0x71,
-	0xc9, 0xbe, 0xeb, 0x56, 0xaf, 0x7f, 0xd7, 0x85, 0x85, 0x77, 0x5d, 0x0b, 0x8a, 0x6e, 0x92, 0xe0,
-	0xe5, 0xa9, 0x6a, 0xcb, 0x4f, 0xb9, 0x2f, 0xed, 0xff, 0x75, 0xb5, 0x2f, 0xed, 0xdf, 0x77, 0xa0,
-	0xc2, 0xa9, 0x96, 0xd3, 0x50, 0x2b, 0xe0, 0x54, 0x09, 0x79, 0x17, 0xe0, 0x84, 0xb9, 0x13, 0x8a,
-	0x9d, 0x3b, 0x74, 0xff, 0xaa, 0x5d, 0x45, 0xc8, 0x9e, 0xdc, 0xe1, 0x23, 0xa8, 0x4b, 0x19, 0x81,
-	0x47, 0x15, 0xf7, 0xaa, 0x6a, 0xfd, 0x6a, 0x18, 0x4a, 0xc8, 0x3d, 0x36, 0x59, 0x73, 0x8f, 0x4d,
-	0x5b, 0xb0, 0xe2, 0x4d, 0xfc, 0xa1, 0x44, 0xad, 0xa9, 0x25, 0xc9, 0xe1, 0x81, 0x8f, 0xfd, 0x70,
-	0xb4, 0xa2, 0x2a, 0xf3, 0x88, 0x4a, 0x02, 0x0a, 0x64, 0xfa, 0xd3, 0xa1, 0x1b, 0x8d, 0xa6, 0xee,
-	0x88, 0xb6, 0xd6, 0x95, 0x54, 0x33, 0xc6, 0xfd, 0xf8, 0xa7, 0x6a, 0x45, 0x1b, 0x7a, 0x3f, 0xfe,
-	0x29, 0xae, 0x86, 0x40, 0xc9, 0x0b, 0xc4, 0x45, 0x6b, 0x53, 0x99, 0x49, 0x7e, 0xcb, 0x3d, 0xba,
-	0xbe, 0x3c, 0xe3, 0xf0, 0xd1, 0x7d, 0xeb, 0x61, 0xe1, 0x71, 0xc3, 0xae, 0x22, 0xa4, 0x17, 0x33,
-	0xa1, 0x1e, 0x14, 0x43, 0xea, 0x72, 0xea, 0x18, 0x33, 0xb5, 0xcc, 0x83, 0x22, 0x82, 0xbf, 0xd0,
-	0x4f, 0x9e, 0xbf, 0xb7, 0x04, 0x75, 0xd5, 0xd9, 0xeb, 0x7b, 0x63, 0x3a, 0x71, 0x6f, 0xf8, 0x74,
-	0xa1, 0x3a, 0xba, 0xb9, 0xd7, 0x79, 0x05, 0x9a, 0x23, 0x40, 0x45, 0x14, 0xb3, 0x04, 0xa8, 0x88,
-	0x87, 0x50, 0x73, 0x47, 0x23, 0x46, 0x47, 0x58, 0x99, 0x68, 0x8f, 0xcd, 0x82, 0x70, 0x19, 0x4a,
-	0x84, 0x1b, 0x06, 0x2e, 0xd7, 0xae, 0xab, 0xc5, 0xee, 0x4a, 0x50, 0x66, 0x16, 0x9f, 0x72, 0x4f,
-	0x3b, 0xb1, 0x9e, 0x65, 0x9f, 0x72, 0x4f, 0xba, 0x0e, 0xf6, 0x73, 0x65, 0x8d, 0x8a, 0x81, 0xa8,
-	0x46, 0x32, 0xa4, 0xa7, 0x5c, 0xda, 0x40, 0x79, 0xae, 0x1a, 0xb4, 0x3f, 0x83, 0x6a, 0x37, 0x1e,
-	0x69, 0x2d, 0xdc, 0x81, 0x8a, 0xac, 0xda, 0x33, 0x1a, 0x58, 0x09, 0xe3, 0x91, 0x09, 0xb4, 0xcb,
-	0xa4, 0xb6, 0x3f, 0x80, 0x1a, 0x96, 0x1c, 0x5a, 0xc2, 0x55, 0x64, 0xaf, 0xa1, 0xa1, 0xeb, 0x91,
-	0x99, 0xc2, 0xb3, 0xd5, 0xa0, 0x51, 0x78, 0xa6, 0x18, 0xbc, 0x52, 0xd6, 0xbf, 0x2f, 0xc1, 0x66,
-	0xda, 0x54, 0x52, 0xe2, 0xcc, 0xdf, 0x15, 0xd9, 0xdf, 0x0a, 0x0a, 0x37, 0xfb, 0xad, 0xe0, 0x3d,
-	0x68, 0x70, 0xca, 0x02, 0x37, 0x74, 0xa2, 0xe9, 0x64, 0x48, 0x99, 0x3e, 0x06, 0xeb, 0x0a, 0x78,
-	0x84, 0x30, 0xf2, 0x1b, 0xe6, 0x0d, 0xd9, 0xe1, 0x38, 0x9f, 0x2a, 0x5e, 0x67, 0x97, 0xbd, 0xac,
-	0x2f, 0xe5, 0x9f, 0x90, 0x15, 0x0c, 0x9b, 0xf2, 0xea, 0x76, 0x66, 0x04, 0x94, 0x72, 0x79, 0x34,
-	0xa3, 0xc3, 0xdc, 0x0b, 0xb2, 0x61, 0xff, 0x18, 0x5f, 0x90, 0x53, 0xe6, 0x32, 0x32, 0x5b, 0xb3,
-	0xab, 0x66, 0x8e, 0x15, 0x42, 0x03, 0xc0, 0x4e, 0xba, 0xd1, 0xb3, 0x61, 0x5e, 0xce, 0x75, 0xd2,
-	0x73, 0x66, 0x99, 0x7b, 0x08, 0xd6, 0x42, 0xda, 0x9f, 0xc3, 0xd6, 0x82, 0xc2, 0x7f, 0x96, 0xdf,
-	0x04, 0xda, 0x1c, 0x6a, 0xd9, 0x9a, 0x62, 0x31, 0x7b, 0xdc, 0x81, 0xca, 0x30, 0xd0, 0xd7, 0x26,
-	0x95, 0x22, 0x57, 0x86, 0x81, 0xba, 0x33, 0x3d, 0x80, 0xda, 0xd8, 0xe5, 0x63, 0x63, 0x1e, 0x95,
-	0x15, 0x41, 0x82, 0xb4, 0x71, 0x36, 0x61, 0x79, 0x18, 0x88, 0x89, 0x9b, 0xa0, 0x4e, 0x8b, 0xb6,
-	0x1e, 0xc9, 0x44, 0xb8, 0x90, 0xf6, 0x73, 0xf5, 0x5b, 0x61, 0xae, 0x7e, 0x7b, 0x0c, 0x45, 0x96,
-	0x78, 0x98, 0xbf, 0x66, 0xca, 0xb5, 0x13, 0x2f, 0x57, 0x31, 0x48, 0x92, 0xf6, 0x33, 0xa8, 0xa6,
-	0xf0, 0x4b, 0xfb, 0x91, 0xd7, 0x94, 0x89, 0x4f, 0xfe, 0xb4, 0x00, 0x8d, 0xdc, 0xaf, 0x22, 0xe4,
-	0x2e, 0x6c, 0x0e, 0x3a, 0xdd, 0xce, 0x61, 0x67, 0x60, 0x7f, 0xe9, 0xec, 0xef, 0x0e, 0x76, 0x9d,
-	0x83, 0xa3, 0x2f, 0x76, 0xbb, 0x07, 0xfb, 0xd6, 0xad, 0x4b, 0x70, 0xf2, 0xf3, 0x60, 0xaf, 0x6f,
-	0x15, 0xc8, 0x16, 0xdc, 0x9e, 0xc3, 0x75, 0x8f, 0x5f, 0xf6, 0xad, 0x25, 0x72, 0x07, 0x36, 0xe6,
-	0x10, 0x03, 0x7b, 0x77, 0xaf, 0xd3, 0xb7, 0x8a, 0xe4, 0x1d, 0xd8, 0x9a, 0x43, 0xf5, 0xec, 0xe3,
-	0x17, 0x07, 0xdd, 0x4e, 0xdf, 0x2a, 0x3d, 0xf9, 0xab, 0x02, 0xd4, 0xb3, 0x7f, 0xa2, 0x48, 0x41,
-	0x86, 0x66, 0x70, 0xbc, 0x77, 0xdc, 0xcd, 0x2c, 0x6c, 0x13, 0x48, 0x1e, 0x75, 0x3c, 0xe8, 0xf6,
-	0xac, 0x02, 0xb9, 0x07, 0xad, 0x3c, 0xbc, 0x67, 0x1f, 0x1f, 0x76, 0x06, 0xaf, 0x3a, 0x3f, 0x90,
-	0x2b, 0x6b, 0xc1, 0x7a, 0x1e, 0xfb, 0x7a, 0xb7, 0xf3, 0xb2, 0x63, 0x5b, 0xc5, 0x45, 0x79, 0x87,
-	0x1f, 0x7e, 0xf8, 0xb1, 0x55, 0x22, 0x1b, 0xb0, 0x36, 0x3f, 0x4f, 0xcf, 0x2a, 0x3f, 0xf9, 0x9d,
-	0x02, 0x58, 0xf3, 0xbf, 0xbd, 0x90, 0x77, 0xe1, 0x8e, 0xd9, 0xed, 0x51, 0xff, 0xf0, 0xa0, 0xdf,
-	0x3f, 0x38, 0x3e, 0xca, 0xeb, 0x72, 0x11, 0xfd, 0x6a, 0x30, 0x90, 0xcb, 0xbe, 0x14, 0x37, 0xb2,
-	0x7b, 0x7b, 0xd6, 0xd2, 0xe5, 0x38, 0x21, 0x71, 0xc5, 0x27, 0x09, 0xac, 0x2d, 0x3c, 0xcf, 0x92,
-	0x07, 0xf0, 0x8e, 0xb6, 0x92, 0xd3, 0xdf, 0x3d, 0xec, 0x75, 0x3b, 0xce, 0xe0, 0xcb, 0x5e, 0x27,
-	0xb3, 0x92, 0x7b, 0xd0, 0xba, 0x8c, 0xc0, 0xde, 0x3d, 0xda, 0x57, 0x2a, 0xbc, 0x14, 0x7b, 0xfc,
-	0xc3, 0xbe, 0xb5, 0xf4, 0xe4, 0xcf, 0x0a, 0x50, 0xcb, 0xfc, 0x7c, 0x21, 0x55, 0xba, 0xbb, 0xb7,
-	0xd7, 0xe9, 0xf7, 0x9d, 0xde, 0xf1, 0xc1, 0xd1, 0x20, 0xbf, 0xdf, 0x1c, 0xa6, 0xff, 0xd2, 0xe9,
-	0xfd, 0xe0, 0x79, 0xf7, 0x60, 0xcf, 0x2a, 0x48, 0x3f, 0x58, 0xc0, 0xd9, 0x07, 0x5f, 0xec, 0x0e,
-	0x3a, 0x6a, 0xc3, 0x39, 0xe4, 0xde, 0x91, 0x61, 0x2c, 0x2e, 0x30, 0x4a, 0x9c, 0x66, 0x2c, 0x3d,
-	0xff, 0xf4, 0xab, 0xaf, 0xef, 0x17, 0x7e, 0xf2, 0xf5, 0xfd, 0xc2, 0x3f, 0x7f, 0x7d, 0xbf, 0xf0,
-	0x47, 0xdf, 0xdc, 0xbf, 0xf5, 0x93, 0x6f, 0xee, 0xdf, 0xfa, 0xe9, 0x37, 0xf7, 0x6f, 0xc1, 0x1d,
-	0x2f, 0x9e, 0x6c, 0x0b, 0x1a, 0x79, 0x34, 0x12, 0xdb, 0x23, 0x37, 0x0c, 0x42, 0xaa, 0xff, 0x23,
-	0xfc, 0x2d, 0xf5, 0x93, 0xe1, 0x70, 0x19, 0x47, 0xbf, 0xfa, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0x18, 0x95, 0x53, 0x7f, 0x28, 0x00, 0x00,
+	// 4716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3b, 0x4b, 0x8f, 0x23, 0x49,
+	0x5a, 0x9d, 0x65, 0xbb, 0xca, 0xfe, 0xfc, 0x28, 0x57, 0x74, 0x3d, 0xdc, 0x8f, 0xe9, 0xee, 0xc9,
+	0x99, 0x61, 0x7b, 0x7b, 0x57, 0x3d, 0xb3, 0xbd, 0x33, 0xd3, 0x3d, 0xd3, 0xcb, 0x0c, 0xee, 0x2a,
+	0x4f, 0x77, 0x35, 0xae, 0x2e, 0x93, 0x76, 0xf7, 0x68, 0x56, 0x48, 0xa9, 0x70, 0x66, 0x94, 0x2b,
+	0xb7, 0xd2, 0x99, 0xb9, 0x11, 0xe1, 0x7a, 0xec, 0x1d, 0x10, 0x12, 0x42, 0x2b, 0x24, 0x10, 0x48,
+	0x5c, 0xb8, 0xc1, 0x61, 0xe1, 0x02, 0x5a, 0x84, 0x84, 0xc4, 0x09, 0x0d, 0x12, 0x87, 0x3d, 0x72,
+	0x42, 0x30, 0x73, 0x82, 0x03, 0xfb, 0x0f, 0x10, 0x8a, 0x57, 0x3a, 0xd3, 0x76, 0xd5, 0xd4, 0x02,
+	0x0b, 0x12, 0xdc, 0x1c, 0xdf, 0x2b, 0x22, 0xbf, 0x88, 0xef, 0x19, 0x61, 0xa8, 0xc4, 0x5e, 0x72,
+	0x3f, 0xa1, 0x31, 0x8f, 0x51, 0x69, 0x1c, 0xfb, 0x24, 0xbc, 0xbe, 0x3e, 0x8a, 0x47, 0xb1, 0x84,
+	0xbc, 0x2d, 0x7e, 0x29, 0xa4, 0xfd, 0x87, 0x4b, 0x50, 0xd9, 0x8e, 0xc3, 0x90, 0x78, 0x3c, 0xa6,
+	0x08, 0x41, 0x11, 0xfb, 0x3e, 0x6d, 0x59, 0x77, 0xac, 0xbb, 0x15, 0x47, 0xfe, 0x46, 0x8f, 0xa1,
+	0xc1, 0x49, 0x48, 0xc6, 0x84, 0xd3, 0x33, 0xd7, 0xc7, 0x1c, 0xb7, 0x96, 0xee, 0x58, 0x77, 0x1b,
+	0x0f, 0xd6, 0xef, 0x4b, 0xb9, 0xf7, 0x07, 0x06, 0xb9, 0x83, 0x39, 0x76, 0xea, 0x3c, 0x3b, 0x44,
+	0x8f, 0xa0, 0x2e, 0x58, 0x5c, 0x39, 0x99, 0x17, 0x87, 0xad, 0x82, 0xe4, 0xbd, 0xaa, 0x79, 0x05,
+	0x4d, 0x4f, 0xa3, 0x9c, 0x9a, 0x9f, 0x19, 0xa1, 0x1d, 0x58, 0x93, 0x9c, 0x9c, 0xe2, 0x88, 0x8d,
+	0x03, 0xc6, 0x82, 0x38, 0x6a, 0x15, 0x25, 0xf7, 0x56, 0x86, 0x7b, 0x90, 0x41, 0x3b, 0x4d, 0x7f,
+	0x06, 0x82, 0x5a, 0xb0, 0x72, 0x4c, 0xa8, 0xe4, 0x2d, 0xdd, 0xb1, 0xee, 0x96, 0x1c, 0x33, 0x44,
+	0x6f, 0x42, 0xc3, 0x0f, 0x28, 0xf1, 0xb8, 0x1b, 0x24, 0x6e, 0x12, 0x53, 0xde, 0x5a, 0xbe, 0x53,
+	0xb8, 0x5b, 0x71, 0x6a, 0x0a, 0xba, 0x9b, 0xf4, 0x62, 0xca, 0xed, 0xbf, 0x2c, 0x40, 0xf3, 0x29,
+	0xe1, 0xdb, 0x71, 0x74, 0x10, 0x8c, 0x1c, 0xf2, 0xfd, 0x09, 0x61, 0x1c, 0x5d, 0x87, 0x72, 0x12,
+	0x62, 0x7e, 0x10, 0xd3, 0xb1, 0xd6, 0x54, 0x3a, 0x46, 0xb7, 0xa1, 0x1a, 0x0f, 0xbf, 0x27, 0xc4,
+	0x46, 0x78, 0x4c, 0xa4, 0xaa, 0x2a, 0x0e, 0x28, 0xd0, 0x0b, 0x3c, 0x26, 0xe8, 0x11, 0xac, 0x08,
+	0xf5, 0x04, 0x1e, 0x93, 0xba, 0xa8, 0x3e, 0x68, 0xe9, 0xaf, 0x49, 0x77, 0xc1, 0xa8, 0xe0, 0x49,
+	0xf1, 0xf3, 0x7f, 0xbc, 0x7d, 0xc5, 0x31, 0xe4, 0xe8, 0x7d, 0x58, 0xe6, 0x14, 0x7b, 0x84, 0xb5,
+	0x8a, 0x97, 0x62, 0xd4, 0xd4, 0xe8, 0x01, 0x14, 0xc3, 0x78, 0xc4, 0x5a, 0xa5, 0x4b, 0x71, 0x49,
+	0x5a, 0xd4, 0x84, 0x02, 0x89, 0x8e, 0x5b, 0xcb, 0x72, 0xf9, 0xe2, 0xa7, 0x80, 0x30, 0xc2, 0x5b,
+	0x2b, 0x0a, 0xc2, 0x08, 0x47, 0xdf, 0x82, 0x32, 0x25, 0x2c, 0x9e, 0x50, 0x8f, 0xb4, 0xca, 0x52,
+	0xf6, 0xaa, 0x96, 0xed, 0x68, 0xb0, 0x16, 0x99, 0x92, 0xa1, 0x0f, 0xa1, 0x9c, 0xd0, 0xf8, 0x20,
+	0x08, 0x09, 0x6b, 0x55, 0x2e, 0xb5, 0x9c, 0x94, 0x1e, 0xdd, 0x87, 0x52, 0x18, 0x7b, 0x38, 0x6c,
+	0x41, 0x8e, 0x31, 0xb3, 0x3b, 0x2c, 0x89, 0x23, 0x46, 0x1c, 0x45, 0x66, 0xff, 0xab, 0x05, 0x6b,
+	0x73, 0x52, 0xff, 0x8f, 0x9e, 0x66, 0xfb, 0xdf, 0x4a, 0xb0, 0x36, 0xa7, 0x09, 0x61, 0xce, 0x5e,
+	0xec, 0x13, 0x79, 0x48, 0x4b, 0x8e, 0xfc, 0x2d, 0xf6, 0x71, 0xcc, 0x46, 0xfa, 0x60, 0x8a, 0x9f,
+	0x68, 0x13, 0x96, 0x39, 0xa6, 0x23, 0xc2, 0xe5, 0xe7, 0x54, 0x1c, 0x3d, 0x42, 0x6f, 0x40, 0xdd,
+	0x93, 0xf2, 0x5c, 0x46, 0xe8, 0x31, 0xa1, 0x72, 0xbd, 0x15, 0xa7, 0xa6, 0x80, 0x7d, 0x09, 0x43,
+	0x5f, 0x83, 0x55, 0x4a, 0x46, 0x01, 0xe3, 0x84, 0x1a, 0xb2, 0x92, 0x24, 0x6b, 0x18, 0xb0, 0x26,
+	0x7c, 0x0c, 0x35, 0x46, 0xc2, 0x03, 0x77, 0x1c, 0x47, 0x01, 0x8f, 0xa9, 0x3c, 0x5a, 0xd5, 0x07,
+	0x48, 0x7f, 0x7c, 0x9f, 0x84, 0x07, 0x7b, 0x0a, 0xa3, 0x37, 0xbe, 0xca, 0xa6, 0x20, 0xd4, 0x86,
+	0x86, 0xb6, 0x02, 0x57, 0xcd, 0x2e, 0xcf, 0x61, 0x35, 0xdd, 0xb5, 0x3d, 0x85, 0x54, 0x9f, 0xaf,
+	0x05, 0xd4, 0xc7, 0x59, 0x20, 0xfa, 0x08, 0xea, 0xca, 0x1e, 0x8c, 0x04, 0x75, 0x64, 0xcd, 0xde,
+	0x0d, 0x24, 0x2e, 0x27, 0xa0, 0xc6, 0x33, 0x30, 0xf4, 0x08, 0xaa, 0xc2, 0x32, 0x0c, 0xb7, 0x3a,
+	0xbd, 0x6b, 0x9a, 0xbb, 0x1b, 0x8f, 0xf2, 0xbc, 0x10, 0xa6, 0x10, 0x74, 0x03, 0x2a, 0x9c, 0x44,
+	0x38, 0xe2, 0x6e, 0xe0, 0xcb, 0xc3, 0x5b, 0x71, 0xca, 0x0a, 0xb0, 0xeb, 0x67, 0xb7, 0xb4, 0x9a,
+	0x77, 0x50, 0x3b, 0xb0, 0x6a, 0xce, 0xbe, 0x99, 0xb4, 0x26, 0x27, 0xdd, 0xd0, 0x93, 0xf6, 0x34,
+	0x36, 0x37, 0x71, 0x23, 0xc9, 0x41, 0xd1, 0x7b, 0x50, 0xc3, 0x9e, 0x47, 0x18, 0x73, 0x93, 0x38,
+	0x88, 0x78, 0xab, 0x2e, 0xcf, 0x9c, 0x51, 0x7b, 0x5b, 0xa2, 0x7a, 0x02, 0xe3, 0x54, 0xf1, 0x74,
+	0x80, 0x9e, 0xcb, 0xc9, 0xc7, 0x84, 0x1f, 0x92, 0x09, 0x73, 0x93, 0x09, 0x3b, 0x6c, 0x35, 0xe4,
+	0xe4, 0x37, 0xa6, 0x93, 0x6b, 0x6c, 0x6f, 0xc2, 0x0e, 0xe7, 0x96, 0x90, 0xc1, 0xa1, 0x3e, 0xa0,
+	0x38, 0x21, 0xd1, 0xd4, 0xec, 0xa4, 0xb8, 0x55, 0x29, 0xee, 0x96, 0x16, 0xb7, 0x9f, 0x90, 0x28,
+	0x35, 0xbd, 0x39, 0x89, 0x6b, 0x39, 0x7e, 0x81, 0xb6, 0x7f, 0xdd, 0x82, 0x6a, 0xe6, 0xd0, 0x48,
+	0x9f, 0x6c, 0xac, 0xd2, 0xf8, 0x64, 0x3d, 0x46, 0xef, 0x42, 0xc5, 0x33, 0x8e, 0x40, 0x1e, 0xfc,
+	0xea, 0x83, 0xe6, 0xac, 0xdb, 0xd1, 0x33, 0x4d, 0x09, 0xd1, 0x5b, 0xd0, 0xa0, 0x44, 0x04, 0x06,
+	0x97, 0x11, 0x2f, 0x8e, 0x7c, 0xe5, 0xaf, 0x4b, 0x4e, 0x5d, 0x41, 0xfb, 0x0a, 0x68, 0xff, 0xb5,
+	0x05, 0xf5, 0xdc, 0xf1, 0x13, 0xf6, 0x44, 0x22, 0x3c, 0x0c, 0x95, 0xdd, 0x95, 0x1d, 0x3d, 0x42,
+	0x8f, 0xa1, 0x92, 0xd0, 0x58, 0xe8, 0x38, 0xa6, 0xda, 0xf7, 0x6f, 0xe5, 0xcf, 0x6f, 0xcf, 0xa0,
+	0xcd, 0x6a, 0x52, 0x7a, 0xf4, 0x08, 0xca, 0xe4, 0x54, 0xcc, 0xab, 0xed, 0xb0, 0xfa, 0x60, 0x33,
+	0xcf, 0xdb, 0xd1, 0x58, 0xe3, 0x37, 0x0d, 0x35, 0x7a, 0x0d, 0x40, 0x2d, 0xc0, 0x65, 0x8c, 0x48,
+	0xe3, 0x2c, 0x3b, 0x15, 0x05, 0xe9, 0x33, 0x62, 0xff, 0x0a, 0x54, 0xbb, 0x78, 0x48, 0xc2, 0xdd,
+	0x51, 0x14, 0x53, 0x82, 0x5e, 0x87, 0x9a, 0xb6, 0x50, 0x15, 0xc0, 0x94, 0x2e, 0xab, 0x1a, 0x26,
+	0x23, 0xd8, 0x6d, 0xa8, 0x86, 0x82, 0x43, 0x12, 0xb0, 0xd6, 0x92, 0x0c, 0x9b, 0x20, 0x41, 0x02,
+	0xcf, 0xec, 0x5f, 0x86, 0xe6, 0x40, 0xba, 0x10, 0x79, 0x96, 0xba, 0xc1, 0x38, 0xe0, 0x19, 0x27,
+	0x63, 0xe5, 0x9c, 0xcc, 0x6d, 0xa8, 0xca, 0x83, 0xe9, 0x86, 0x82, 0x4c, 0xee, 0x4e, 0xc1, 0x81,
+	0x24, 0x65, 0xb4, 0xff, 0xce, 0x82, 0xb5, 0xbd, 0x49, 0xc8, 0x03, 0x25, 0x52, 0xeb, 0xf8, 0x36,
+	0x54, 0xc7, 0xf8, 0xd4, 0x55, 0x42, 0x98, 0x76, 0x70, 0x30, 0xc6, 0xa7, 0x8a, 0x8a, 0x7d, 0xa5,
+	0x5c, 0xb4, 0x07, 0x57, 0x15, 0xb7, 0x9b, 0xa1, 0x13, 0x7b, 0x5c, 0xc8, 0xec, 0xcb, 0xec, 0x67,
+	0x98, 0xf3, 0xc8, 0x67, 0xe0, 0x4c, 0x38, 0xcb, 0x21, 0xe6, 0xde, 0x61, 0xba, 0xa4, 0xa2, 0x5c,
+	0x52, 0x4d, 0x02, 0xf5, 0xa2, 0xec, 0x47, 0x50, 0x93, 0xba, 0xde, 0x13, 0x40, 0x22, 0xd3, 0xad,
+	0x8c, 0x92, 0xe5, 0x6f, 0xb4, 0x0e, 0x25, 0x4a, 0x46, 0xe4, 0x54, 0x7b, 0x68, 0x35, 0xb0, 0xff,
+	0x7e, 0x09, 0x2a, 0x92, 0xd5, 0x99, 0x84, 0x97, 0xda, 0xa4, 0xfb, 0xb0, 0x8c, 0x3d, 0x2e, 0xdc,
+	0x8a, 0x8a, 0x6f, 0xe6, 0xb4, 0xa4, 0x42, 0xda, 0x12, 0xeb, 0x68, 0x2a, 0x71, 0x4a, 0xa6, 0x9b,
+	0xaa, 0x03, 0x41, 0x25, 0xdd, 0xd3, 0xe9, 0xaa, 0x8a, 0x99, 0x55, 0xa1, 0x3b, 0x50, 0xa5, 0x24,
+	0x09, 0xb1, 0x47, 0xc6, 0x24, 0xe2, 0xda, 0xf1, 0x67, 0x41, 0x62, 0xa5, 0x38, 0x0c, 0xe3, 0x13,
+	0xf7, 0x18, 0x87, 0x13, 0xc2, 0x74, 0x8e, 0x55, 0x95, 0xb0, 0x57, 0x12, 0x24, 0x33, 0x26, 0x7e,
+	0x48, 0xa8, 0x22, 0xd1, 0x09, 0x06, 0x48, 0x90, 0xa4, 0x10, 0x2e, 0x72, 0x38, 0xf1, 0x8e, 0x84,
+	0x52, 0xcb, 0xca, 0x45, 0xea, 0x21, 0x7a, 0x08, 0x15, 0x46, 0x94, 0xb9, 0x8a, 0x7c, 0xa2, 0x90,
+	0xf1, 0xe7, 0x59, 0x3d, 0x1b, 0x6b, 0x4a, 0x69, 0xed, 0xbf, 0xb1, 0x60, 0x4d, 0x19, 0xf0, 0x53,
+	0x8a, 0xa3, 0x49, 0x88, 0x69, 0xc0, 0xcf, 0x2e, 0xa3, 0xd6, 0xd7, 0xa1, 0x36, 0x24, 0xa3, 0x20,
+	0xd2, 0x3e, 0x41, 0x9f, 0xab, 0xaa, 0x84, 0x29, 0x81, 0xca, 0xde, 0x7c, 0x43, 0x50, 0x90, 0x04,
+	0x15, 0x12, 0xf9, 0x1a, 0xfd, 0x16, 0x34, 0x4e, 0x82, 0xc8, 0x8f, 0x4f, 0x52, 0xb7, 0xa2, 0x4e,
+	0x4a, 0x5d, 0x41, 0x15, 0x95, 0xd4, 0x0a, 0xe7, 0x61, 0x4a, 0x53, 0x52, 0xe7, 0x97, 0xf3, 0xd0,
+	0xf8, 0x9d, 0xdf, 0xb4, 0xa0, 0xde, 0xc7, 0xe3, 0x24, 0x24, 0xc6, 0x05, 0x5e, 0x62, 0xf9, 0x1f,
+	0x40, 0x95, 0x49, 0x1e, 0x97, 0x9f, 0x25, 0x44, 0x1f, 0x8d, 0x56, 0xde, 0x91, 0x28, 0xa1, 0x83,
+	0xb3, 0x84, 0x38, 0xc0, 0xd2, 0xdf, 0xc2, 0xc1, 0x1e, 0x50, 0x7d, 0xa4, 0xc4, 0x47, 0x59, 0x4e,
+	0x3a, 0xb6, 0x4f, 0xe1, 0xba, 0x70, 0x3f, 0x11, 0x89, 0x78, 0x80, 0xc3, 0x67, 0x01, 0xe3, 0xf1,
+	0x88, 0xe2, 0xb1, 0xb6, 0xd5, 0x6b, 0x50, 0x16, 0xb6, 0xca, 0x82, 0x1f, 0x98, 0x4c, 0x64, 0x65,
+	0x8c, 0x4f, 0xfb, 0xc1, 0x0f, 0x88, 0x08, 0x8d, 0x12, 0xe5, 0xe1, 0x50, 0xad, 0xa6, 0xe4, 0x08,
+	0xda, 0xbe, 0x18, 0x23, 0x1b, 0xea, 0x29, 0xd2, 0x65, 0x3a, 0x3d, 0x29, 0x3b, 0x55, 0x43, 0xd0,
+	0x27, 0xdc, 0xfe, 0x2e, 0xd4, 0xfb, 0x93, 0xf1, 0x18, 0xd3, 0x33, 0x3d, 0xd9, 0x37, 0x60, 0x8d,
+	0x92, 0x10, 0xf3, 0xe0, 0x98, 0xb8, 0xd8, 0xf3, 0x26, 0x14, 0x7b, 0x67, 0x72, 0x56, 0xcb, 0x69,
+	0x1a, 0x44, 0x5b, 0xc3, 0xd1, 0x4d, 0xa8, 0x7c, 0x7f, 0x82, 0x23, 0x1e, 0x84, 0xda, 0x8f, 0x59,
+	0xce, 0x14, 0x60, 0xff, 0xb9, 0x05, 0xb5, 0x3e, 0xa1, 0x01, 0x61, 0xda, 0xc6, 0x5f, 0x87, 0x9a,
+	0x17, 0x06, 0x24, 0x75, 0x2a, 0x96, 0xda, 0x7c, 0x05, 0x93, 0x34, 0x82, 0x44, 0x65, 0x41, 0x39,
+	0xbf, 0x53, 0x55, 0xb0, 0x94, 0xc4, 0x9b, 0x30, 0x1e, 0x8f, 0x35, 0x49, 0x41, 0x4b, 0x91, 0x30,
+	0x45, 0xf2, 0x18, 0xca, 0x7a, 0xd7, 0xc4, 0xe9, 0x10, 0xc7, 0xfa, 0x9a, 0xd9, 0x23, 0x05, 0xce,
+	0x2c, 0xcb, 0xf8, 0x7b, 0xc3, 0x60, 0xef, 0x01, 0x9a, 0xa7, 0xba, 0xcc, 0xe1, 0x58, 0x87, 0x52,
+	0x76, 0xd1, 0x6a, 0x60, 0x53, 0xa8, 0x3a, 0xbd, 0xed, 0x67, 0x98, 0x0d, 0x4e, 0xe2, 0xdd, 0xde,
+	0xff, 0x88, 0x8d, 0xd8, 0xbf, 0x57, 0x86, 0xe6, 0x6c, 0x48, 0xbc, 0x30, 0xc2, 0xcf, 0x1b, 0xd5,
+	0xd2, 0x22, 0xa3, 0x12, 0x19, 0x6d, 0x48, 0x30, 0x9d, 0x89, 0xe8, 0x35, 0x09, 0x34, 0x44, 0x5f,
+	0x83, 0x55, 0x72, 0x9a, 0x04, 0x94, 0xb0, 0x9c, 0x85, 0x16, 0x9c, 0x86, 0x06, 0x67, 0x4c, 0x34,
+	0x1b, 0x62, 0x4a, 0x73, 0x21, 0xe6, 0x5d, 0xd8, 0xd4, 0x91, 0x57, 0xc7, 0x71, 0xd7, 0x54, 0x7e,
+	0xcb, 0xf2, 0x24, 0xaf, 0x2b, 0xac, 0xfe, 0xc4, 0xbd, 0xb4, 0xcc, 0xdb, 0x9a, 0x21, 0x4f, 0xd7,
+	0xb1, 0x22, 0xa7, 0xd8, 0x48, 0x72, 0x0c, 0x66, 0x39, 0xbb, 0xb0, 0x76, 0x68, 0x2c, 0xcf, 0x9d,
+	0x3a, 0xcc, 0x42, 0x26, 0x55, 0x48, 0x2d, 0xf3, 0x89, 0x44, 0xeb, 0xa3, 0xd3, 0x3c, 0xcc, 0x83,
+	0x19, 0xfa, 0x45, 0xa8, 0xab, 0x60, 0x10, 0xc8, 0xa4, 0xc0, 0xf8, 0x56, 0x94, 0xf5, 0xad, 0x2a,
+	0x5f, 0x30, 0xa9, 0x72, 0x38, 0x05, 0x31, 0xf4, 0x2d, 0xd8, 0xa0, 0x84, 0xbb, 0xa2, 0xdc, 0x70,
+	0x31, 0x73, 0xc9, 0xa9, 0x47, 0x12, 0xe9, 0x37, 0x40, 0x7e, 0x36, 0xa2, 0x22, 0x8c, 0xfb, 0xa4,
+	0xcd, 0x3a, 0x06, 0x83, 0xf6, 0xe1, 0xaa, 0xfa, 0x48, 0x77, 0x34, 0x75, 0xc8, 0xac, 0x55, 0x95,
+	0xf3, 0xb6, 0xd2, 0x22, 0x61, 0xc6, 0x63, 0xeb, 0xd9, 0x11, 0x9b, 0x45, 0x30, 0xb4, 0x0d, 0xab,
+	0xda, 0xd3, 0xa5, 0x96, 0x54, 0xbb, 0x53, 0xc8, 0x94, 0x0c, 0x39, 0xdf, 0x69, 0x32, 0x57, 0x96,
+	0x05, 0x32, 0xf4, 0x31, 0xac, 0xd2, 0xc4, 0x73, 0x0f, 0x31, 0x73, 0xf9, 0x49, 0xec, 0x06, 0x09,
+	0x6b, 0xd5, 0x73, 0x9a, 0xc8, 0x58, 0x86, 0xd1, 0x04, 0x4d, 0x3c, 0x0d, 0x4a, 0x98, 0x28, 0x3a,
+	0x98, 0x34, 0x42, 0x93, 0x5e, 0x34, 0x72, 0x45, 0x47, 0xd6, 0xbb, 0x18, 0x7e, 0x96, 0x81, 0xa1,
+	0x5f, 0x85, 0x0d, 0x32, 0x75, 0xac, 0x6e, 0xba, 0x51, 0x3a, 0x7b, 0x7e, 0x5d, 0xcb, 0x39, 0xdf,
+	0xf9, 0x6a, 0xa9, 0xeb, 0x64, 0x01, 0x05, 0x7a, 0x17, 0x56, 0x98, 0x72, 0x9e, 0xad, 0x66, 0xae,
+	0x9c, 0xca, 0xb9, 0x54, 0xd3, 0x86, 0xd0, 0xa4, 0xe8, 0xa1, 0x49, 0xff, 0xe8, 0x44, 0xb8, 0xcd,
+	0xb5, 0x3b, 0x85, 0x4c, 0x3e, 0x9d, 0xa6, 0x17, 0x69, 0x1d, 0x64, 0x00, 0x0c, 0xb5, 0xa1, 0x36,
+	0x16, 0x89, 0x9c, 0x4e, 0x91, 0x5a, 0x28, 0x57, 0xc7, 0xcf, 0xe5, 0x78, 0xa6, 0x0e, 0x1c, 0x4f,
+	0x11, 0xf6, 0xdf, 0x16, 0x60, 0x75, 0x26, 0xdf, 0xfd, 0x39, 0x64, 0xfe, 0xaf, 0x43, 0x8d, 0x1f,
+	0x52, 0x82, 0x7d, 0xd7, 0x8b, 0x27, 0x11, 0xd7, 0x5e, 0xa2, 0xaa, 0x60, 0xdb, 0x02, 0x24, 0x6c,
+	0x7f, 0x38, 0x39, 0x38, 0x20, 0x54, 0x85, 0x35, 0x15, 0xc2, 0x41, 0x81, 0x4c, 0x64, 0x4b, 0xf0,
+	0x88, 0x28, 0xb4, 0x2a, 0xd6, 0xcb, 0x02, 0x20, 0x91, 0xaf, 0x01, 0xf0, 0x60, 0x4c, 0xe2, 0x09,
+	0x77, 0xc7, 0xca, 0x19, 0x94, 0x9c, 0x8a, 0x86, 0xec, 0xb1, 0x05, 0xde, 0x6c, 0x65, 0x91, 0x37,
+	0xfb, 0x05, 0x58, 0x15, 0xf1, 0x91, 0xca, 0x9a, 0x4a, 0xad, 0x54, 0xe5, 0x47, 0x22, 0x6c, 0x3a,
+	0x02, 0xaa, 0xd6, 0xfa, 0x26, 0x34, 0x54, 0x31, 0xe0, 0xf2, 0xd8, 0x15, 0xb5, 0xa1, 0x2c, 0x5e,
+	0xcb, 0x4e, 0x4d, 0x41, 0x07, 0xf1, 0x27, 0x41, 0x48, 0xd0, 0xdb, 0x50, 0x62, 0x49, 0x1c, 0x9b,
+	0xf6, 0xca, 0xd5, 0x99, 0xa4, 0x40, 0xa0, 0xb4, 0xa6, 0x14, 0x1d, 0x7a, 0x0c, 0x55, 0x4e, 0xc6,
+	0x49, 0x4c, 0x71, 0x18, 0xf0, 0x33, 0x59, 0xbd, 0x36, 0x1e, 0x5c, 0xcb, 0xb3, 0x0d, 0xa6, 0x04,
+	0x4e, 0x96, 0xda, 0xfe, 0x0b, 0x0b, 0x6a, 0x59, 0xd1, 0xe7, 0x16, 0x4d, 0x4d, 0x28, 0xf8, 0x01,
+	0x35, 0xed, 0x0a, 0x3f, 0xa0, 0x26, 0x67, 0x18, 0x9e, 0x71, 0xc2, 0x74, 0xe8, 0x10, 0x39, 0xc3,
+	0x13, 0x31, 0x36, 0x3a, 0x91, 0x9a, 0xcf, 0xa7, 0x57, 0x63, 0x7c, 0xda, 0x1e, 0x11, 0xa3, 0xbb,
+	0xf7, 0x61, 0x4b, 0xa6, 0xa9, 0x67, 0x6e, 0x10, 0x71, 0x42, 0x8f, 0x71, 0x3e, 0xd5, 0x2a, 0x39,
+	0x1b, 0x0a, 0xbd, 0xab, 0xb1, 0x26, 0xeb, 0xfa, 0xe7, 0x02, 0xac, 0x2f, 0x2a, 0x7d, 0x2f, 0x5a,
+	0xff, 0x84, 0x86, 0x66, 0xfd, 0x13, 0x1a, 0x0a, 0xc8, 0xf7, 0xe2, 0xa1, 0x4e, 0xb1, 0xc5, 0x4f,
+	0x71, 0x82, 0xcd, 0x2a, 0xf4, 0x6a, 0xd3, 0xb1, 0xd8, 0xbc, 0x09, 0x23, 0xee, 0x10, 0xb3, 0xc0,
+	0x73, 0xf1, 0x84, 0x1f, 0xea, 0x0a, 0xae, 0x36, 0x61, 0xe4, 0x89, 0x00, 0xb6, 0x27, 0xfc, 0x50,
+	0x48, 0x98, 0x30, 0x42, 0x65, 0x44, 0x56, 0x3d, 0xbb, 0x74, 0x2c, 0xed, 0x03, 0x33, 0x76, 0x12,
+	0x53, 0x5f, 0x27, 0xd7, 0xe9, 0x18, 0x75, 0xa0, 0x3c, 0xa2, 0xf1, 0x24, 0x09, 0xa2, 0x91, 0x0e,
+	0x15, 0x5f, 0xbf, 0xa0, 0xbe, 0xbf, 0xff, 0x54, 0xd3, 0x76, 0x22, 0x4e, 0xcf, 0x9c, 0x94, 0x15,
+	0xed, 0x43, 0xed, 0x90, 0xf3, 0xc4, 0x3d, 0x24, 0xd8, 0x27, 0x69, 0x2a, 0xfe, 0xcd, 0x8b, 0x44,
+	0x3d, 0xe3, 0x3c, 0x79, 0xa6, 0xc8, 0x95, 0xb4, 0xea, 0xe1, 0x14, 0x72, 0xfd, 0x31, 0xd4, 0x73,
+	0x73, 0x09, 0xa5, 0x1d, 0x91, 0x33, 0x6d, 0xdf, 0xe2, 0xa7, 0xc8, 0x56, 0x54, 0xc1, 0xa0, 0xeb,
+	0x24, 0x39, 0xf8, 0x70, 0xe9, 0x91, 0x75, 0xfd, 0x23, 0x68, 0xce, 0x4a, 0xff, 0x59, 0xf8, 0xed,
+	0x6d, 0xd8, 0x3a, 0xa7, 0x1d, 0x71, 0xf9, 0x5d, 0xb6, 0x3f, 0x86, 0xd5, 0x99, 0x68, 0xbb, 0xb0,
+	0xda, 0xcb, 0xd4, 0x36, 0x2a, 0xff, 0x34, 0x43, 0xfb, 0xaf, 0x2c, 0xa8, 0x65, 0x9b, 0x52, 0xe7,
+	0xce, 0xfd, 0xe1, 0x7c, 0x5b, 0x61, 0x33, 0xd7, 0xd4, 0xba, 0xa0, 0xab, 0xf0, 0x70, 0xae, 0xab,
+	0xb0, 0x91, 0x63, 0xfd, 0xcf, 0x36, 0x15, 0x7e, 0x5a, 0x84, 0xd5, 0x99, 0xc9, 0xbf, 0xc2, 0x4f,
+	0xaf, 0xa8, 0xd0, 0x6b, 0xbe, 0x20, 0x1f, 0xa5, 0xe9, 0x4c, 0x24, 0x52, 0x40, 0xf4, 0x4d, 0x40,
+	0x7e, 0xc0, 0xe4, 0x2a, 0x64, 0xab, 0xce, 0x1d, 0xc6, 0xfe, 0x99, 0xfc, 0x8e, 0xb2, 0xd3, 0xd4,
+	0x18, 0xb9, 0x8a, 0x27, 0xb1, 0x7f, 0x86, 0x3e, 0x80, 0x6b, 0x86, 0x9a, 0x71, 0x4a, 0xf0, 0x38,
+	0xcb, 0x54, 0x95, 0x4c, 0x9b, 0x9a, 0xa0, 0x2f, 0xf1, 0x53, 0xd6, 0x69, 0x22, 0xe7, 0x93, 0x03,
+	0x42, 0x29, 0xf1, 0x5d, 0xb5, 0x86, 0x56, 0x29, 0x9b, 0xc8, 0xed, 0x68, 0xa4, 0x5a, 0x34, 0x7a,
+	0x00, 0x1b, 0x33, 0xe4, 0x2e, 0xa1, 0x54, 0xb7, 0x3e, 0xcb, 0xce, 0x55, 0x3f, 0x47, 0xde, 0x11,
+	0x28, 0xf4, 0x09, 0xdc, 0x99, 0xe5, 0x61, 0xa2, 0x7c, 0xf6, 0x27, 0x14, 0x8b, 0x44, 0x49, 0xc4,
+	0x0b, 0x95, 0x05, 0xde, 0xcc, 0xb3, 0xf7, 0xc3, 0xf8, 0x64, 0x47, 0x13, 0xed, 0x49, 0xff, 0x66,
+	0x3e, 0x36, 0xc1, 0x54, 0x94, 0x2c, 0x52, 0x9a, 0xb2, 0x73, 0x31, 0xfb, 0x86, 0x46, 0xf7, 0x24,
+	0xb6, 0xaf, 0x91, 0x68, 0x0f, 0x9a, 0x27, 0x31, 0x3d, 0x3a, 0x10, 0x73, 0x9a, 0x1d, 0x51, 0xad,
+	0xce, 0x9b, 0x7a, 0x47, 0x3e, 0xd5, 0xe8, 0x45, 0x3b, 0xb3, 0x7a, 0x92, 0x47, 0x8a, 0x48, 0x36,
+	0xcd, 0x80, 0x65, 0xe8, 0x51, 0x29, 0x60, 0x3d, 0xcd, 0x7c, 0x05, 0x50, 0x64, 0x06, 0x1c, 0x07,
+	0x61, 0x3a, 0x63, 0x2d, 0x97, 0x19, 0x0c, 0x70, 0x10, 0x2e, 0x9a, 0xad, 0xca, 0xa7, 0x08, 0xfb,
+	0x5f, 0x2c, 0x58, 0x9b, 0x23, 0x3c, 0xd7, 0x66, 0xee, 0x42, 0xd3, 0x27, 0x5e, 0x20, 0xfa, 0xac,
+	0xee, 0x09, 0x0e, 0x64, 0x18, 0x56, 0x15, 0x43, 0xc3, 0xc0, 0x3f, 0xc5, 0x81, 0x88, 0xc5, 0xa6,
+	0x42, 0x4d, 0x70, 0x64, 0xca, 0x05, 0x59, 0xa1, 0x8a, 0x71, 0x3e, 0x14, 0x15, 0x67, 0x42, 0xd1,
+	0x1b, 0x50, 0xd7, 0x3b, 0xe8, 0x9f, 0x11, 0xa1, 0x78, 0xed, 0xb8, 0x15, 0x70, 0x47, 0xc2, 0xd0,
+	0xb7, 0x01, 0x30, 0xe7, 0x34, 0x18, 0x4e, 0xb8, 0xee, 0x8e, 0x54, 0x1f, 0xd4, 0xcd, 0x85, 0x80,
+	0x24, 0x31, 0x89, 0xd4, 0x94, 0xcc, 0xfe, 0xed, 0x25, 0x68, 0xe4, 0xed, 0xf3, 0xe7, 0x90, 0x04,
+	0xfd, 0xd7, 0x32, 0x9c, 0x4b, 0xa6, 0x30, 0xa2, 0x84, 0xc2, 0xc2, 0xdf, 0x29, 0x29, 0x2a, 0x7d,
+	0x01, 0x05, 0x92, 0x72, 0x2e, 0x95, 0xbb, 0xd8, 0xbf, 0x6b, 0x01, 0x4c, 0x5b, 0xf0, 0xe7, 0xee,
+	0xfa, 0xa3, 0x79, 0x4f, 0xb9, 0x9e, 0x69, 0xe0, 0x5f, 0xe0, 0x27, 0xdf, 0x9b, 0xf3, 0x93, 0x57,
+	0x33, 0x8c, 0xe7, 0x79, 0x49, 0xfb, 0xb7, 0x96, 0xa0, 0x9e, 0x93, 0x7c, 0xe1, 0x3e, 0xbd, 0x09,
+	0x8d, 0x38, 0x0a, 0xcf, 0xb4, 0x5b, 0x0a, 0x63, 0x75, 0x49, 0x53, 0x76, 0x6a, 0x02, 0x2a, 0xf7,
+	0xbb, 0x1b, 0x8f, 0x04, 0x55, 0x4a, 0xe0, 0x8a, 0x35, 0x98, 0x4e, 0x23, 0xd7, 0x14, 0x7b, 0xb1,
+	0xaf, 0x6a, 0x79, 0x72, 0x4c, 0x42, 0xdd, 0x93, 0x53, 0x03, 0x59, 0xda, 0x2a, 0x73, 0xa4, 0xc4,
+	0x8b, 0x8f, 0x09, 0x3d, 0xd3, 0xbe, 0x48, 0x5b, 0xa9, 0xa3, 0xa1, 0x32, 0x8d, 0x9a, 0x30, 0x2e,
+	0xe7, 0x90, 0x72, 0x55, 0xea, 0x50, 0x76, 0xea, 0x02, 0xdc, 0x8d, 0x47, 0x72, 0x39, 0xbe, 0xa0,
+	0x9b, 0x92, 0xa8, 0x9e, 0x52, 0x59, 0x4e, 0x58, 0x0f, 0x0d, 0x8d, 0x68, 0x1e, 0x3d, 0x2f, 0x96,
+	0x0b, 0xcd, 0xa2, 0xfd, 0xe3, 0x25, 0xa8, 0x65, 0xf5, 0xf5, 0xff, 0xfb, 0xd4, 0xa2, 0xaf, 0x43,
+	0x51, 0xe4, 0x3c, 0x2d, 0xc8, 0xdd, 0x9d, 0x0a, 0x05, 0x3d, 0x1b, 0x0c, 0x4c, 0x3d, 0x29, 0x49,
+	0xec, 0x3f, 0xb5, 0xa0, 0x6c, 0x10, 0x42, 0x6b, 0x24, 0xf2, 0x62, 0x5f, 0xf8, 0x14, 0xad, 0x35,
+	0x33, 0x16, 0x1d, 0x59, 0x2f, 0x1e, 0x27, 0x94, 0xa8, 0x1b, 0x46, 0x95, 0x90, 0x64, 0x41, 0x52,
+	0x01, 0x34, 0x3e, 0x3d, 0x73, 0x45, 0xc2, 0x52, 0x48, 0x95, 0x7e, 0x7a, 0xf6, 0x92, 0x86, 0x33,
+	0x85, 0x49, 0x71, 0xb6, 0x30, 0xb9, 0x0b, 0x05, 0x1e, 0x9a, 0x8b, 0xe4, 0x74, 0x37, 0x64, 0xfb,
+	0x6b, 0xd0, 0xed, 0xeb, 0x15, 0x0b, 0x12, 0xfb, 0xcf, 0x2c, 0xa8, 0xa4, 0x08, 0xb4, 0x05, 0x2b,
+	0x1e, 0x56, 0x8a, 0xd0, 0xdd, 0x7f, 0x0f, 0x4b, 0x15, 0xdc, 0x80, 0x8a, 0x47, 0x28, 0x57, 0x28,
+	0xb5, 0xd8, 0xb2, 0x00, 0x48, 0xe4, 0x35, 0x28, 0x1f, 0x91, 0x33, 0x85, 0x53, 0x0b, 0x5d, 0x39,
+	0x22, 0x67, 0x12, 0x75, 0x1b, 0x74, 0x4b, 0x4d, 0x35, 0xa1, 0x54, 0x53, 0x1a, 0x14, 0x48, 0xf6,
+	0xa0, 0xde, 0x81, 0xf5, 0x20, 0x62, 0xc4, 0x9b, 0x50, 0xe2, 0xb2, 0xa3, 0x20, 0x71, 0x8f, 0x09,
+	0x0d, 0x0e, 0xce, 0xb4, 0x0f, 0x46, 0x06, 0xd7, 0x3f, 0x0a, 0x92, 0x57, 0x12, 0x63, 0xff, 0x91,
+	0x05, 0x8d, 0xfc, 0x8d, 0xda, 0xb9, 0x7e, 0xe4, 0x3b, 0xf3, 0x7e, 0xa4, 0x35, 0x73, 0x27, 0x77,
+	0x81, 0x2f, 0xf9, 0x60, 0xce, 0x97, 0x6c, 0xcd, 0x30, 0x9f, 0xeb, 0x4f, 0x7e, 0x58, 0x84, 0xb5,
+	0xb9, 0x19, 0x2e, 0xb4, 0xa2, 0x37, 0xa0, 0xae, 0x23, 0xaf, 0xb4, 0x4e, 0x75, 0xbf, 0x51, 0x71,
+	0x6a, 0x1a, 0x28, 0x8c, 0x53, 0xd6, 0x9b, 0x09, 0xa1, 0x41, 0xec, 0xcf, 0x34, 0xbc, 0xea, 0x0a,
+	0x6a, 0x8e, 0xfd, 0x3b, 0xb0, 0xee, 0x25, 0x93, 0x69, 0x2a, 0x92, 0xef, 0x4d, 0x23, 0x2f, 0x99,
+	0x98, 0x04, 0xc4, 0x70, 0xdc, 0x85, 0xa6, 0xe0, 0x30, 0x2b, 0xa0, 0x98, 0x13, 0x5d, 0xed, 0x36,
+	0xbc, 0x64, 0xa2, 0xbf, 0xc4, 0xc1, 0x9c, 0x88, 0x0c, 0x6b, 0x3c, 0xe1, 0xe4, 0x34, 0xa5, 0x4d,
+	0x7b, 0xcd, 0xca, 0x02, 0xd7, 0x25, 0x56, 0x73, 0x7c, 0xa2, 0x71, 0x22, 0x01, 0x1c, 0x86, 0xb1,
+	0x77, 0x94, 0x9f, 0x41, 0xd9, 0x63, 0x53, 0x62, 0xb2, 0x73, 0x3c, 0x80, 0x8d, 0x34, 0x8b, 0x0b,
+	0xd5, 0x2d, 0xfe, 0xf4, 0x25, 0x42, 0xd9, 0xb9, 0x6a, 0x92, 0xb8, 0x50, 0xde, 0xdb, 0x4b, 0x14,
+	0xba, 0x07, 0x6b, 0x9a, 0x27, 0x0c, 0xa2, 0x23, 0xe5, 0xe8, 0x74, 0x0e, 0xa3, 0x5d, 0x69, 0x37,
+	0x88, 0x8e, 0xa4, 0xa7, 0x43, 0xdf, 0x81, 0x15, 0x1c, 0xc5, 0x63, 0x1c, 0xaa, 0x74, 0x72, 0x9a,
+	0x32, 0x19, 0x69, 0x6d, 0x85, 0xcd, 0x27, 0xb3, 0x9a, 0x05, 0xbd, 0x27, 0x6c, 0x24, 0xe1, 0x13,
+	0x4a, 0x16, 0x5f, 0xf3, 0x6e, 0x2b, 0xa4, 0x61, 0xd3, 0xb4, 0xf6, 0x8f, 0xa7, 0xc7, 0x56, 0x53,
+	0xa0, 0x06, 0x2c, 0x05, 0xbe, 0x3e, 0x09, 0x4b, 0x81, 0x3f, 0x7f, 0x06, 0x96, 0x16, 0x9c, 0x81,
+	0xaf, 0x43, 0x73, 0x6e, 0x63, 0x55, 0xba, 0xb3, 0xea, 0x5f, 0x62, 0x57, 0x8b, 0x0b, 0x77, 0xf5,
+	0x06, 0x54, 0x54, 0xcf, 0xd4, 0xc5, 0xa6, 0x3f, 0x5a, 0x56, 0x80, 0x36, 0xb7, 0x7f, 0x64, 0xc1,
+	0xc6, 0x42, 0xcd, 0x9c, 0x6b, 0x77, 0x6f, 0x41, 0x63, 0x44, 0xe3, 0x13, 0x7e, 0xe8, 0xaa, 0x83,
+	0x99, 0x76, 0x79, 0x15, 0xb4, 0xa7, 0x80, 0xe2, 0x53, 0x46, 0x31, 0x8d, 0x27, 0x3c, 0x88, 0x88,
+	0xab, 0x50, 0xba, 0x4f, 0xb0, 0x9a, 0xc2, 0x9f, 0x4a, 0xb0, 0xd8, 0xde, 0x43, 0x82, 0x13, 0x4d,
+	0x95, 0x4b, 0xe4, 0x56, 0x05, 0x42, 0x91, 0xc9, 0x7c, 0xce, 0xfe, 0xd1, 0x12, 0x34, 0x67, 0x2d,
+	0xf4, 0x7f, 0x23, 0x82, 0x7d, 0x45, 0xf3, 0xe8, 0xbf, 0xb5, 0x2b, 0xa4, 0x02, 0xf7, 0xf3, 0x62,
+	0xb9, 0xd4, 0x5c, 0x7e, 0x5e, 0x2c, 0xaf, 0x34, 0xcb, 0x4e, 0xae, 0x35, 0xe6, 0x4c, 0x83, 0xa9,
+	0x33, 0x13, 0x3a, 0xed, 0x7f, 0x2f, 0x40, 0xfd, 0x72, 0xd9, 0x78, 0xf6, 0x6a, 0x69, 0x29, 0x7f,
+	0xb5, 0x24, 0x2b, 0x08, 0x4a, 0x63, 0xea, 0xce, 0x5c, 0x3e, 0xd5, 0x25, 0x34, 0xf5, 0x04, 0xdf,
+	0x80, 0x65, 0x9d, 0x65, 0x17, 0xcf, 0xcf, 0xa1, 0x35, 0x89, 0x30, 0x08, 0xe3, 0x08, 0x72, 0x99,
+	0xb9, 0x76, 0x00, 0x8a, 0x68, 0x6a, 0xf9, 0x63, 0x71, 0x95, 0xa1, 0xca, 0xbd, 0x4a, 0xd6, 0xf2,
+	0xf7, 0x82, 0x48, 0x57, 0x7a, 0xf7, 0x41, 0x3b, 0x0f, 0x77, 0x18, 0xc6, 0xf1, 0xd8, 0x88, 0x55,
+	0x7e, 0x42, 0x8b, 0x79, 0x22, 0x30, 0x5a, 0xf6, 0x63, 0xa8, 0xe5, 0x08, 0xab, 0xb9, 0xa6, 0x72,
+	0x86, 0xd2, 0x54, 0x3a, 0xc3, 0x0c, 0xf3, 0x43, 0x00, 0x61, 0x72, 0xfa, 0xd6, 0xa1, 0x96, 0xeb,
+	0x90, 0x0f, 0xe2, 0x23, 0x12, 0xa9, 0x76, 0x83, 0xd2, 0xb9, 0x53, 0x11, 0xb4, 0xea, 0x3a, 0xe2,
+	0x7d, 0x58, 0xd6, 0x2f, 0x74, 0xea, 0xb9, 0x98, 0xe5, 0x24, 0x9e, 0xa9, 0xff, 0x72, 0xae, 0x49,
+	0x53, 0x0b, 0x3e, 0x75, 0xc5, 0xd5, 0x6a, 0x5c, 0x8e, 0x4f, 0x51, 0xdb, 0x9f, 0x5b, 0xb0, 0xb1,
+	0xb0, 0x5a, 0x44, 0xef, 0xc1, 0x96, 0x2e, 0x8d, 0xe4, 0x29, 0x12, 0xe6, 0x2c, 0xb4, 0x3c, 0xe1,
+	0xe6, 0x82, 0x70, 0x5d, 0xa1, 0xe5, 0x49, 0xed, 0x11, 0xba, 0x27, 0x71, 0xe8, 0x6d, 0x58, 0x17,
+	0x47, 0x7b, 0x8e, 0x47, 0x79, 0x81, 0xb5, 0x31, 0x3e, 0x9d, 0x61, 0x78, 0x13, 0x1a, 0x09, 0xe6,
+	0x87, 0x6e, 0xca, 0x65, 0x2e, 0x7c, 0x04, 0x74, 0x4f, 0x93, 0x8b, 0x76, 0x6f, 0x18, 0x1c, 0x10,
+	0x61, 0x42, 0xe2, 0xf0, 0x6a, 0x93, 0xab, 0x1a, 0x58, 0x9f, 0x78, 0xf6, 0x67, 0xb0, 0x36, 0xa7,
+	0x5a, 0x71, 0x6c, 0x19, 0x17, 0xea, 0x1d, 0x99, 0xc6, 0x52, 0x3a, 0x16, 0xbd, 0x1e, 0x8a, 0xf5,
+	0xd2, 0x8a, 0x8e, 0xfc, 0x2d, 0x72, 0xf2, 0xe1, 0x84, 0x32, 0xb5, 0x88, 0xa2, 0xa3, 0x06, 0xf6,
+	0x03, 0x58, 0xd6, 0x1b, 0x3b, 0xdf, 0xa3, 0xda, 0x84, 0x65, 0x7d, 0x6f, 0xae, 0x5c, 0xb6, 0x1e,
+	0xd9, 0xbf, 0x53, 0x82, 0xb2, 0x79, 0x63, 0x77, 0xee, 0xcb, 0x8a, 0x9b, 0x50, 0x91, 0x0f, 0x34,
+	0x12, 0xec, 0xa9, 0x75, 0x54, 0x9c, 0x29, 0x40, 0x24, 0x57, 0x24, 0x3a, 0xce, 0xde, 0xf6, 0xaf,
+	0x90, 0xe8, 0x58, 0xe6, 0x4e, 0x9b, 0xb0, 0x4c, 0xc9, 0xc8, 0x3c, 0x50, 0xab, 0x38, 0x7a, 0xa4,
+	0xda, 0x94, 0x8c, 0xe3, 0xc8, 0x23, 0xba, 0xac, 0x48, 0xc7, 0xe2, 0x7b, 0x23, 0x51, 0x8b, 0x2c,
+	0xeb, 0xde, 0x96, 0xa8, 0x41, 0xde, 0x82, 0x86, 0x17, 0x47, 0x1c, 0x07, 0x91, 0xc9, 0xd3, 0x54,
+	0xfb, 0xb1, 0x9e, 0x42, 0x5f, 0xe8, 0x16, 0x98, 0x79, 0x01, 0xa5, 0x6a, 0x07, 0x33, 0xcc, 0xbd,
+	0xb3, 0xac, 0x5c, 0xfc, 0xce, 0x12, 0xe6, 0xde, 0x59, 0x36, 0xa1, 0x80, 0x93, 0x44, 0x46, 0xe2,
+	0x8a, 0x23, 0x7e, 0x8a, 0xef, 0xd2, 0xe7, 0xbf, 0xa6, 0xbe, 0x4b, 0x8d, 0x84, 0x2a, 0x18, 0xd1,
+	0x72, 0xea, 0x6a, 0x05, 0x8c, 0x28, 0x21, 0xaf, 0x01, 0x1c, 0x50, 0x3c, 0x26, 0xf2, 0x2e, 0x4b,
+	0x1e, 0xff, 0x8a, 0x53, 0x91, 0x10, 0x71, 0x81, 0x65, 0x6e, 0x7b, 0x03, 0x8f, 0x28, 0xee, 0x55,
+	0x95, 0x6e, 0x6b, 0x98, 0x94, 0x90, 0x7b, 0xfc, 0xd5, 0x9c, 0x79, 0xfc, 0x25, 0xf2, 0xe2, 0xb1,
+	0x3f, 0x14, 0xa8, 0x35, 0x9d, 0x17, 0x8f, 0xfd, 0xe1, 0xae, 0x2f, 0xbe, 0x4e, 0x3f, 0x4e, 0x91,
+	0x35, 0x15, 0x52, 0x41, 0x40, 0x81, 0xcc, 0x6d, 0x7c, 0x88, 0xa3, 0xd1, 0x04, 0x8f, 0x48, 0x6b,
+	0x5d, 0x49, 0x35, 0x63, 0xf9, 0x3d, 0xfe, 0x91, 0x5a, 0xd1, 0x86, 0xfe, 0x1e, 0xff, 0x48, 0xae,
+	0x46, 0x3c, 0x08, 0x14, 0xcd, 0xfa, 0x4d, 0xb5, 0x4d, 0xe2, 0xb7, 0xf8, 0x46, 0xec, 0x0b, 0x1f,
+	0x27, 0x1f, 0xc1, 0x6e, 0xdd, 0xb1, 0xee, 0xd6, 0x9d, 0x8a, 0x84, 0x88, 0x17, 0xb0, 0xea, 0x81,
+	0x5f, 0x48, 0x30, 0x23, 0xae, 0xd9, 0xa6, 0x96, 0x79, 0xe0, 0x27, 0xc1, 0xaf, 0x14, 0xd4, 0xfe,
+	0x8d, 0x25, 0xd3, 0xd2, 0xef, 0x7b, 0x87, 0x64, 0x8c, 0x2f, 0xf9, 0x94, 0x48, 0xdd, 0x71, 0xe6,
+	0x5e, 0xcb, 0x2a, 0xd0, 0x0c, 0x81, 0x54, 0x44, 0x21, 0x4b, 0x20, 0x15, 0x71, 0x07, 0xaa, 0x78,
+	0x34, 0xa2, 0x64, 0x84, 0xf9, 0xf4, 0xc4, 0x66, 0x41, 0x72, 0x19, 0x4a, 0x04, 0x0e, 0x03, 0xcc,
+	0xcc, 0x2b, 0x15, 0x05, 0x6b, 0x0b, 0x50, 0x66, 0x16, 0x9f, 0x30, 0xaf, 0xb5, 0x9c, 0x9d, 0x65,
+	0x87, 0x30, 0x4f, 0x1c, 0x1d, 0x79, 0x91, 0x25, 0x0a, 0x42, 0x69, 0x88, 0x6a, 0x24, 0x4c, 0x7a,
+	0xc2, 0xc4, 0x1e, 0xa8, 0x93, 0xab, 0x06, 0xf6, 0x47, 0x50, 0xe9, 0xc6, 0x23, 0xad, 0x85, 0x6b,
+	0x50, 0x16, 0x25, 0x72, 0x46, 0x03, 0x2b, 0x61, 0x3c, 0x32, 0x86, 0xb6, 0x48, 0xaa, 0xfd, 0x16,
+	0x54, 0x65, 0x46, 0xa9, 0x25, 0x9c, 0x47, 0xf6, 0x1c, 0xea, 0x3a, 0x1f, 0x99, 0x2a, 0x3c, 0x9b,
+	0xe8, 0x19, 0x85, 0x67, 0xf2, 0xbc, 0x73, 0x65, 0xfd, 0x74, 0x09, 0x36, 0xd3, 0x86, 0xb7, 0x12,
+	0x67, 0x5e, 0x3b, 0x67, 0x9f, 0xf9, 0x5a, 0x97, 0x7b, 0xe6, 0xfb, 0x86, 0xba, 0xf6, 0xc4, 0xa1,
+	0x1b, 0x4d, 0xc6, 0x43, 0x42, 0xb5, 0x1b, 0xac, 0x29, 0xe0, 0x0b, 0x09, 0x43, 0xbf, 0x64, 0xde,
+	0x74, 0xba, 0x4c, 0xce, 0x67, 0xde, 0x5e, 0xe5, 0x6f, 0x9e, 0xd4, 0x5a, 0xf2, 0x4f, 0x3a, 0x15,
+	0x4c, 0x5e, 0x53, 0xab, 0x56, 0x88, 0x11, 0x50, 0xcc, 0xc5, 0xd1, 0x8c, 0x0e, 0x73, 0x2f, 0x3a,
+	0x0d, 0xfb, 0x43, 0xf9, 0xa2, 0x33, 0x65, 0x2e, 0xe5, 0x2f, 0x32, 0xe3, 0x51, 0x8e, 0x15, 0x42,
+	0x03, 0x90, 0x77, 0xcb, 0x46, 0xcf, 0x86, 0x79, 0x39, 0x77, 0xb7, 0x9c, 0xdb, 0x96, 0x99, 0x87,
+	0x99, 0x5a, 0x88, 0xfd, 0x31, 0x6c, 0xcd, 0x29, 0xfc, 0x67, 0x79, 0xb6, 0x6b, 0x33, 0xa8, 0x66,
+	0x73, 0x8a, 0xf9, 0xe8, 0x71, 0x0d, 0xca, 0xc3, 0x40, 0xf7, 0x28, 0x96, 0xf4, 0xc3, 0xa9, 0x40,
+	0x35, 0x28, 0x6e, 0x43, 0xf5, 0x10, 0xb3, 0x43, 0xb3, 0x3d, 0x2a, 0x2a, 0x82, 0x00, 0xe9, 0xcd,
+	0xd9, 0x84, 0xe5, 0x61, 0xc0, 0xc7, 0x38, 0x91, 0x3a, 0x2d, 0x38, 0x7a, 0x24, 0x02, 0xe1, 0x5c,
+	0xd8, 0xcf, 0xe5, 0x6f, 0xd6, 0x4c, 0xfe, 0x76, 0x17, 0x0a, 0x34, 0xf1, 0x5a, 0x4b, 0x39, 0xe5,
+	0x3a, 0x89, 0x97, 0xcb, 0x18, 0x04, 0x89, 0xfd, 0x18, 0x2a, 0x29, 0x7c, 0xe1, 0x5d, 0xc9, 0x05,
+	0x69, 0xe2, 0xbd, 0xdf, 0xb7, 0xa0, 0x9e, 0x7b, 0xba, 0x8d, 0xae, 0xc3, 0xe6, 0xa0, 0xd3, 0xed,
+	0xec, 0x75, 0x06, 0xce, 0x67, 0xee, 0x4e, 0x7b, 0xd0, 0x76, 0x77, 0x5f, 0xbc, 0x6a, 0x77, 0x77,
+	0x77, 0x9a, 0x57, 0x16, 0xe0, 0xc4, 0xcf, 0xdd, 0xed, 0x7e, 0xd3, 0x42, 0x5b, 0x70, 0x75, 0x06,
+	0xd7, 0xdd, 0x7f, 0xda, 0x6f, 0x2e, 0xa1, 0x6b, 0xb0, 0x31, 0x83, 0x18, 0x38, 0xed, 0xed, 0x4e,
+	0xbf, 0x59, 0x40, 0x37, 0x60, 0x6b, 0x06, 0xd5, 0x73, 0xf6, 0x3f, 0xd9, 0xed, 0x76, 0xfa, 0xcd,
+	0xe2, 0xbd, 0x3f, 0xb6, 0xa0, 0x96, 0x7d, 0x19, 0x2e, 0x04, 0x19, 0x9a, 0xc1, 0xfe, 0xf6, 0x7e,
+	0x37, 0xb3, 0xb0, 0x4d, 0x40, 0x79, 0xd4, 0xfe, 0xa0, 0xdb, 0x6b, 0x5a, 0xe8, 0x26, 0xb4, 0xf2,
+	0xf0, 0x9e, 0xb3, 0xbf, 0xd7, 0x19, 0x3c, 0xeb, 0xbc, 0x14, 0x2b, 0x6b, 0xc1, 0x7a, 0x1e, 0xfb,
+	0xbc, 0xdd, 0x79, 0xda, 0x71, 0x9a, 0x85, 0x79, 0x79, 0x7b, 0xef, 0xbc, 0xf3, 0xb0, 0x59, 0x44,
+	0x1b, 0xb0, 0x36, 0x3b, 0x4f, 0xaf, 0x59, 0xba, 0xf7, 0x6b, 0x16, 0x34, 0x67, 0x9f, 0xa1, 0xa3,
+	0xd7, 0xe0, 0x9a, 0xf9, 0xda, 0x17, 0xfd, 0xbd, 0xdd, 0x7e, 0x7f, 0x77, 0xff, 0x45, 0x5e, 0x97,
+	0xf3, 0x68, 0xd1, 0xa7, 0x6a, 0x5a, 0x8b, 0x71, 0x23, 0xa7, 0xb7, 0xdd, 0x5c, 0x5a, 0x8c, 0xe3,
+	0x02, 0x57, 0xb8, 0x97, 0xc0, 0xda, 0xdc, 0x63, 0x34, 0x74, 0x1b, 0x6e, 0xe8, 0x5d, 0x72, 0xfb,
+	0xed, 0xbd, 0x5e, 0xb7, 0xe3, 0x0e, 0x3e, 0xeb, 0x75, 0x32, 0x2b, 0xb9, 0x09, 0xad, 0x45, 0x04,
+	0x4e, 0xfb, 0xc5, 0x4e, 0xd3, 0x3a, 0x17, 0xbb, 0xff, 0x69, 0xbf, 0xb9, 0x74, 0xef, 0x53, 0x40,
+	0xf3, 0x57, 0xd6, 0xe2, 0xd3, 0x0d, 0xcf, 0xa0, 0xb3, 0xd7, 0xdb, 0x77, 0xda, 0xdd, 0xdd, 0xc1,
+	0x67, 0xee, 0x4e, 0xa7, 0x3b, 0x68, 0x37, 0xaf, 0x20, 0x1b, 0x6e, 0x2d, 0x42, 0x6f, 0xbf, 0xdc,
+	0x7b, 0xd9, 0x6d, 0x0f, 0x76, 0x5f, 0x75, 0x9a, 0xd6, 0xbd, 0x3f, 0xb1, 0x60, 0x75, 0xe6, 0xcd,
+	0xa5, 0x10, 0xdb, 0x6d, 0x3f, 0xe9, 0x74, 0x5d, 0xe7, 0x65, 0xb7, 0xe3, 0xb6, 0xb7, 0x07, 0x79,
+	0x8d, 0x2e, 0x44, 0x3b, 0x9d, 0x5e, 0xb7, 0xbd, 0xdd, 0x69, 0x5a, 0xe8, 0x0e, 0xdc, 0x9c, 0x47,
+	0xb7, 0xbb, 0xdd, 0xfd, 0x4f, 0xdd, 0xee, 0x6e, 0x7f, 0xa0, 0x54, 0x3b, 0x4f, 0xf1, 0xac, 0xdd,
+	0x7f, 0xd6, 0x2c, 0x2c, 0xc6, 0xed, 0x38, 0xfb, 0xbd, 0x66, 0xf1, 0xde, 0x1f, 0x58, 0x50, 0xcd,
+	0xbc, 0x08, 0x17, 0xe7, 0xaa, 0xbd, 0xbd, 0xdd, 0xe9, 0xf7, 0xdd, 0xde, 0xfe, 0xee, 0x8b, 0x41,
+	0x7e, 0xd3, 0x73, 0x98, 0xfe, 0x53, 0xb7, 0xf7, 0xf2, 0x49, 0x77, 0x77, 0xbb, 0x69, 0x09, 0x63,
+	0x98, 0xc3, 0x39, 0xbb, 0xaf, 0xda, 0x83, 0x8e, 0x5a, 0x5a, 0x0e, 0xb9, 0xfd, 0xc2, 0x30, 0x16,
+	0xe6, 0x18, 0xb7, 0x5f, 0xa4, 0x8c, 0xc5, 0x27, 0x1f, 0x7e, 0xfe, 0xc5, 0x2d, 0xeb, 0x27, 0x5f,
+	0xdc, 0xb2, 0xfe, 0xe9, 0x8b, 0x5b, 0xd6, 0x0f, 0xbf, 0xbc, 0x75, 0xe5, 0x27, 0x5f, 0xde, 0xba,
+	0xf2, 0x0f, 0x5f, 0xde, 0xba, 0x02, 0xd7, 0xbc, 0x78, 0x7c, 0x9f, 0x93, 0xc8, 0x23, 0x11, 0xbf,
+	0x3f, 0xc2, 0x61, 0x10, 0x12, 0xfd, 0xe7, 0xa6, 0xef, 0xaa, 0x7f, 0x3e, 0x0d, 0x97, 0xe5, 0xe8,
+	0xdb, 0xff, 0x31, 0x00, 0xee, 0xb0, 0xd9, 0xe2, 0x14, 0x35, 0x00, 0x00,
 }
 
 func (m *Collector) Marshal() (dAtA []byte, err error) {
@@ -4412,7 +5713,7 @@ func (m *LabelIgnore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SecondGranularity) Marshal() (dAtA []byte, err error) {
+func (m *TargetPointLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4422,47 +5723,32 @@ func (m *SecondGranularity) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SecondGranularity) MarshalTo(dAtA []byte) (int, error) {
+func (m *TargetPointLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SecondGranularity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TargetPointLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TtlSeconds != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.TtlSeconds))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.WindowSeconds != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.WindowSeconds))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.EndSecond != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.EndSecond))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.BeginSecond != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.BeginSecond))
+	if m.PointLimit != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.PointLimit))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.MonitorName) > 0 {
-		i -= len(m.MonitorName)
-		copy(dAtA[i:], m.MonitorName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.MonitorName)))
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Target)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SampleMonitor) Marshal() (dAtA []byte, err error) {
+func (m *MultiTargetConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4472,38 +5758,49 @@ func (m *SampleMonitor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SampleMonitor) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiTargetConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SampleMonitor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiTargetConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Fraction != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Fraction))))
+	if m.BatchTargets != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.BatchTargets))
 		i--
-		dAtA[i] = 0x19
+		dAtA[i] = 0x20
 	}
-	if m.SampleType != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.SampleType))
+	if len(m.TargetPointLimits) > 0 {
+		for iNdEx := len(m.TargetPointLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetPointLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PointLimit != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.PointLimit))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.MonitorName) > 0 {
-		i -= len(m.MonitorName)
-		copy(dAtA[i:], m.MonitorName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.MonitorName)))
+	if m.MaxTargets != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.MaxTargets))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RPCHasTwoIP) Marshal() (dAtA []byte, err error) {
+func (m *LabelMatcher) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4513,37 +5810,34 @@ func (m *RPCHasTwoIP) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RPCHasTwoIP) MarshalTo(dAtA []byte) (int, error) {
+func (m *LabelMatcher) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RPCHasTwoIP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LabelMatcher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EndSecond != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.EndSecond))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.BeginSecond != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.BeginSecond))
+	if len(m.Regex) > 0 {
+		i -= len(m.Regex)
+		copy(dAtA[i:], m.Regex)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Regex)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.MonitorName) > 0 {
-		i -= len(m.MonitorName)
-		copy(dAtA[i:], m.MonitorName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.MonitorName)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MetricsProcessor) Marshal() (dAtA []byte, err error) {
+func (m *LabelRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4553,20 +5847,20 @@ func (m *MetricsProcessor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MetricsProcessor) MarshalTo(dAtA []byte) (int, error) {
+func (m *LabelRule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MetricsProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LabelRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RpcHasTwoIps) > 0 {
-		for iNdEx := len(m.RpcHasTwoIps) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RpcHasTwoIps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Selectors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4574,121 +5868,67 @@ func (m *MetricsProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintOcp(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x6a
+			dAtA[i] = 0x4a
 		}
 	}
-	if len(m.SampleMonitors) > 0 {
-		for iNdEx := len(m.SampleMonitors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SampleMonitors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOcp(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+	if m.Buckets != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.Buckets))
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.SecondGranularitys) > 0 {
-		for iNdEx := len(m.SecondGranularitys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SecondGranularitys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOcp(dAtA, i, uint64(size))
-			}
+	if len(m.OtherValue) > 0 {
+		i -= len(m.OtherValue)
+		copy(dAtA[i:], m.OtherValue)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.OtherValue)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AllowValues) > 0 {
+		for iNdEx := len(m.AllowValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowValues[iNdEx])
+			copy(dAtA[i:], m.AllowValues[iNdEx])
+			i = encodeVarintOcp(dAtA, i, uint64(len(m.AllowValues[iNdEx])))
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x32
 		}
 	}
-	if m.RetCodeAsException {
+	if len(m.Replacement) > 0 {
+		i -= len(m.Replacement)
+		copy(dAtA[i:], m.Replacement)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Replacement)))
 		i--
-		if m.RetCodeAsException {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x2a
+	}
+	if len(m.Regex) > 0 {
+		i -= len(m.Regex)
+		copy(dAtA[i:], m.Regex)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Regex)))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x22
 	}
-	if len(m.LabelIgnores) > 0 {
-		for iNdEx := len(m.LabelIgnores) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LabelIgnores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOcp(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.HistogramBuckets) > 0 {
-		for iNdEx := len(m.HistogramBuckets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HistogramBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOcp(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if m.ProcessMetricsSeconds != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.ProcessMetricsSeconds))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.EnableProcessMetrics {
-		i--
-		if m.EnableProcessMetrics {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.PointLimit != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.PointLimit))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ExpiresSeconds != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.ExpiresSeconds))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ClearSeconds != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.ClearSeconds))
+	if len(m.LabelName) > 0 {
+		i -= len(m.LabelName)
+		copy(dAtA[i:], m.LabelName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.LabelName)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.WindowSeconds != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.WindowSeconds))
+	if m.Action != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.Action))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Protocol) > 0 {
-		i -= len(m.Protocol)
-		copy(dAtA[i:], m.Protocol)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
+	if len(m.MonitorName) > 0 {
+		i -= len(m.MonitorName)
+		copy(dAtA[i:], m.MonitorName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.MonitorName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MetricsExporter) Marshal() (dAtA []byte, err error) {
+func (m *SecondGranularity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4698,77 +5938,47 @@ func (m *MetricsExporter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MetricsExporter) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecondGranularity) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MetricsExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SecondGranularity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExportToFile {
-		i--
-		if m.ExportToFile {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.MaxRetryCount != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.MaxRetryCount))
+	if m.TtlSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.TtlSeconds))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x28
 	}
 	if m.WindowSeconds != 0 {
 		i = encodeVarintOcp(dAtA, i, uint64(m.WindowSeconds))
 		i--
-		dAtA[i] = 0x38
-	}
-	if m.TimeoutMs != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.TimeoutMs))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.BufferSize != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.BufferSize))
-		i--
 		dAtA[i] = 0x20
 	}
-	if m.ThreadCount != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.ThreadCount))
+	if m.EndSecond != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.EndSecond))
 		i--
 		dAtA[i] = 0x18
 	}
-	{
-		size, err := m.Collector.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOcp(dAtA, i, uint64(size))
+	if m.BeginSecond != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.BeginSecond))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Protocol) > 0 {
-		i -= len(m.Protocol)
-		copy(dAtA[i:], m.Protocol)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
+	if len(m.MonitorName) > 0 {
+		i -= len(m.MonitorName)
+		copy(dAtA[i:], m.MonitorName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.MonitorName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PrometheusPushConfig) Marshal() (dAtA []byte, err error) {
+func (m *SampleMonitor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4778,111 +5988,38 @@ func (m *PrometheusPushConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PrometheusPushConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *SampleMonitor) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PrometheusPushConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SampleMonitor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.HttpHeaders) > 0 {
-		for k := range m.HttpHeaders {
-			v := m.HttpHeaders[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintOcp(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintOcp(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintOcp(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.Grouping) > 0 {
-		for k := range m.Grouping {
-			v := m.Grouping[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintOcp(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintOcp(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintOcp(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.UseBasicAuth {
-		i--
-		if m.UseBasicAuth {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Interval != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.Interval))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Job) > 0 {
-		i -= len(m.Job)
-		copy(dAtA[i:], m.Job)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Job)))
+	if m.Fraction != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Fraction))))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x19
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Url)))
+	if m.SampleType != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.SampleType))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Enable {
-		i--
-		if m.Enable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.MonitorName) > 0 {
+		i -= len(m.MonitorName)
+		copy(dAtA[i:], m.MonitorName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.MonitorName)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OpenTelemetryPushConfig) Marshal() (dAtA []byte, err error) {
+func (m *ExponentialHistogramConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4892,37 +6029,40 @@ func (m *OpenTelemetryPushConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OpenTelemetryPushConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExponentialHistogramConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OpenTelemetryPushConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExponentialHistogramConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Enable {
+	if m.MaxScaleSet {
 		i--
-		if m.Enable {
+		if m.MaxScaleSet {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxScale != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.MaxScale))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxSize != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.MaxSize))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HistogramBucket) Marshal() (dAtA []byte, err error) {
+func (m *SummaryConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4932,37 +6072,36 @@ func (m *HistogramBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistogramBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *SummaryConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistogramBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SummaryConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Buckets) > 0 {
-		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
-			f18 := math.Float64bits(float64(m.Buckets[iNdEx]))
+	if len(m.Quantiles) > 0 {
+		for iNdEx := len(m.Quantiles) - 1; iNdEx >= 0; iNdEx-- {
+			f17 := math.Float64bits(float64(m.Quantiles[iNdEx]))
 			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f18))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f17))
 		}
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Buckets)*8))
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Quantiles)*8))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Name)))
+	if m.RelativeAccuracy != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RelativeAccuracy))))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TracesConfig) Marshal() (dAtA []byte, err error) {
+func (m *SeriesLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4972,60 +6111,49 @@ func (m *TracesConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TracesConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *SeriesLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TracesConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SeriesLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EnableSse {
-		i--
-		if m.EnableSse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size, err := m.Exporter.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Monitors) > 0 {
+		for iNdEx := len(m.Monitors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Monitors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Processor.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOcp(dAtA, i, uint64(size))
+	if m.CustomLimit != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.CustomLimit))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Enable {
+	if m.ServerLimit != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.ServerLimit))
 		i--
-		if m.Enable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x10
+	}
+	if m.ClientLimit != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.ClientLimit))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TracesProcessor) Marshal() (dAtA []byte, err error) {
+func (m *MonitorSeriesLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5035,112 +6163,32 @@ func (m *TracesProcessor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TracesProcessor) MarshalTo(dAtA []byte) (int, error) {
+func (m *MonitorSeriesLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TracesProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MonitorSeriesLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DisableStreamTraceBody {
-		i--
-		if m.DisableStreamTraceBody {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.EnableProfile {
-		i--
-		if m.EnableProfile {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Limit != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.Limit))
 		i--
-		dAtA[i] = 0x50
-	}
-	{
-		size, err := m.WorkflowSampler.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOcp(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.DisableParentSampling {
-		i--
-		if m.DisableParentSampling {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.DeferredSampleSlowDurationMs != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.DeferredSampleSlowDurationMs))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.DeferredSampleError {
-		i--
-		if m.DeferredSampleError {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.EnableDeferredSample {
-		i--
-		if m.EnableDeferredSample {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.DisableTraceBody {
-		i--
-		if m.DisableTraceBody {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Sampler.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOcp(dAtA, i, uint64(size))
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Protocol) > 0 {
-		i -= len(m.Protocol)
-		copy(dAtA[i:], m.Protocol)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
+	if len(m.MonitorName) > 0 {
+		i -= len(m.MonitorName)
+		copy(dAtA[i:], m.MonitorName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.MonitorName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TracesExporter) Marshal() (dAtA []byte, err error) {
+func (m *RPCHasTwoIP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5150,67 +6198,37 @@ func (m *TracesExporter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TracesExporter) MarshalTo(dAtA []byte) (int, error) {
+func (m *RPCHasTwoIP) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TracesExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RPCHasTwoIP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExportToFile {
-		i--
-		if m.ExportToFile {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.PacketSize != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.PacketSize))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.WindowSeconds != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.WindowSeconds))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.PageSize))
+	if m.EndSecond != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.EndSecond))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x18
 	}
-	if m.BufferSize != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.BufferSize))
+	if m.BeginSecond != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.BeginSecond))
 		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Collector.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOcp(dAtA, i, uint64(size))
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Protocol) > 0 {
-		i -= len(m.Protocol)
-		copy(dAtA[i:], m.Protocol)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
+	if len(m.MonitorName) > 0 {
+		i -= len(m.MonitorName)
+		copy(dAtA[i:], m.MonitorName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.MonitorName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LogsConfig) Marshal() (dAtA []byte, err error) {
+func (m *MetricsProcessor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5220,18 +6238,18 @@ func (m *LogsConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LogsConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *MetricsProcessor) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LogsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MetricsProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Exporter.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MultiTarget.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5239,9 +6257,27 @@ func (m *LogsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.LabelRules) > 0 {
+		for iNdEx := len(m.LabelRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	{
-		size, err := m.Processor.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5249,60 +6285,117 @@ func (m *LogsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.Enable {
-		i--
-		if m.Enable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.ExponentialHistogram.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *LogsProcessor) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.SeriesLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LogsProcessor) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LogsProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LogTracedType) > 0 {
-		i -= len(m.LogTracedType)
-		copy(dAtA[i:], m.LogTracedType)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.LogTracedType)))
-		i--
-		dAtA[i] = 0x42
+	i--
+	dAtA[i] = 0x72
+	if len(m.RpcHasTwoIps) > 0 {
+		for iNdEx := len(m.RpcHasTwoIps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RpcHasTwoIps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	if m.MustLogTraced {
+	if len(m.SampleMonitors) > 0 {
+		for iNdEx := len(m.SampleMonitors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SampleMonitors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SecondGranularitys) > 0 {
+		for iNdEx := len(m.SecondGranularitys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SecondGranularitys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.RetCodeAsException {
 		i--
-		if m.MustLogTraced {
+		if m.RetCodeAsException {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.LabelIgnores) > 0 {
+		for iNdEx := len(m.LabelIgnores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelIgnores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.HistogramBuckets) > 0 {
+		for iNdEx := len(m.HistogramBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistogramBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ProcessMetricsSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.ProcessMetricsSeconds))
+		i--
 		dAtA[i] = 0x38
 	}
-	if m.EnableRecovery {
+	if m.EnableProcessMetrics {
 		i--
-		if m.EnableRecovery {
+		if m.EnableProcessMetrics {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -5310,25 +6403,23 @@ func (m *LogsProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	if len(m.Level) > 0 {
-		i -= len(m.Level)
-		copy(dAtA[i:], m.Level)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Level)))
+	if m.PointLimit != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.PointLimit))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if m.TraceLogMode != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.TraceLogMode))
+	if m.ExpiresSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.ExpiresSeconds))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.OnlyTraceLog {
+	if m.ClearSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.ClearSeconds))
 		i--
-		if m.OnlyTraceLog {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x18
+	}
+	if m.WindowSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.WindowSeconds))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -5342,7 +6433,7 @@ func (m *LogsProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *LogsExporter) Marshal() (dAtA []byte, err error) {
+func (m *MetricsExporter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5352,16 +6443,31 @@ func (m *LogsExporter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LogsExporter) MarshalTo(dAtA []byte) (int, error) {
+func (m *MetricsExporter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LogsExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MetricsExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Temporality != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.Temporality))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Spool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	if m.ExportToFile {
 		i--
 		if m.ExportToFile {
@@ -5372,8 +6478,8 @@ func (m *LogsExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x48
 	}
-	if m.PacketSize != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.PacketSize))
+	if m.MaxRetryCount != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.MaxRetryCount))
 		i--
 		dAtA[i] = 0x40
 	}
@@ -5382,6 +6488,11 @@ func (m *LogsExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x38
 	}
+	if m.TimeoutMs != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.TimeoutMs))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.PageSize != 0 {
 		i = encodeVarintOcp(dAtA, i, uint64(m.PageSize))
 		i--
@@ -5392,6 +6503,11 @@ func (m *LogsExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
+	if m.ThreadCount != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.ThreadCount))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Collector.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -5412,7 +6528,7 @@ func (m *LogsExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ProfilesConfig) Marshal() (dAtA []byte, err error) {
+func (m *MetricsSpool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5422,36 +6538,38 @@ func (m *ProfilesConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProfilesConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *MetricsSpool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProfilesConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MetricsSpool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Exporter.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOcp(dAtA, i, uint64(size))
+	if m.ReplayIntervalSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.ReplayIntervalSeconds))
+		i--
+		dAtA[i] = 0x28
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Processor.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOcp(dAtA, i, uint64(size))
+	if m.MaxAgeSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.MaxAgeSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x1a
 	if m.Enable {
 		i--
 		if m.Enable {
@@ -5465,7 +6583,7 @@ func (m *ProfilesConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ProfilesProcessor) Marshal() (dAtA []byte, err error) {
+func (m *PrometheusPushConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5475,81 +6593,111 @@ func (m *ProfilesProcessor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProfilesProcessor) MarshalTo(dAtA []byte) (int, error) {
+func (m *PrometheusPushConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProfilesProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PrometheusPushConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EnableLinkTrace {
-		i--
-		if m.EnableLinkTrace {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.HttpHeaders) > 0 {
+		for k := range m.HttpHeaders {
+			v := m.HttpHeaders[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintOcp(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOcp(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOcp(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Grouping) > 0 {
+		for k := range m.Grouping {
+			v := m.Grouping[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintOcp(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOcp(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOcp(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
 		}
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Password)))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x3a
 	}
-	if m.EnableDeltaProfiles {
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Username)))
 		i--
-		if m.EnableDeltaProfiles {
+		dAtA[i] = 0x32
+	}
+	if m.UseBasicAuth {
+		i--
+		if m.UseBasicAuth {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x28
 	}
-	if m.BlockProfileRate != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.BlockProfileRate))
+	if m.Interval != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.Interval))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x20
 	}
-	if m.MutexProfileFraction != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.MutexProfileFraction))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.CpuProfileRate != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.CpuProfileRate))
+	if len(m.Job) > 0 {
+		i -= len(m.Job)
+		copy(dAtA[i:], m.Job)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Job)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x1a
 	}
-	if m.CpuDurationSeconds != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.CpuDurationSeconds))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Url)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x12
 	}
-	if m.PeriodSeconds != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.PeriodSeconds))
+	if m.Enable {
 		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ProfileTypes) > 0 {
-		for iNdEx := len(m.ProfileTypes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ProfileTypes[iNdEx])
-			copy(dAtA[i:], m.ProfileTypes[iNdEx])
-			i = encodeVarintOcp(dAtA, i, uint64(len(m.ProfileTypes[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if len(m.Protocol) > 0 {
-		i -= len(m.Protocol)
-		copy(dAtA[i:], m.Protocol)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProfilesExporter) Marshal() (dAtA []byte, err error) {
+func (m *OpenTelemetryPushConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5559,43 +6707,108 @@ func (m *ProfilesExporter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProfilesExporter) MarshalTo(dAtA []byte) (int, error) {
+func (m *OpenTelemetryPushConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProfilesExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OpenTelemetryPushConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExportToFile {
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Url)))
 		i--
-		if m.ExportToFile {
+		dAtA[i] = 0x12
+	}
+	if m.Enable {
+		i--
+		if m.Enable {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x8
 	}
-	if m.MaxRetryCount != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.MaxRetryCount))
+	return len(dAtA) - i, nil
+}
+
+func (m *HistogramBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistogramBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistogramBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			f24 := math.Float64bits(float64(m.Buckets[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f24))
+		}
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Buckets)*8))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x12
 	}
-	if m.TimeoutMs != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.TimeoutMs))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0xa
 	}
-	if m.BufferSize != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.BufferSize))
+	return len(dAtA) - i, nil
+}
+
+func (m *TracesConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TracesConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TracesConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnableSse {
 		i--
-		dAtA[i] = 0x20
+		if m.EnableSse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
 	{
-		size, err := m.Collector.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Exporter.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5603,18 +6816,31 @@ func (m *ProfilesExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Protocol) > 0 {
-		i -= len(m.Protocol)
-		copy(dAtA[i:], m.Protocol)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
+	dAtA[i] = 0x22
+	{
+		size, err := m.Processor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Enable {
 		i--
-		dAtA[i] = 0xa
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SamplerConfig) Marshal() (dAtA []byte, err error) {
+func (m *TracesProcessor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5624,18 +6850,18 @@ func (m *SamplerConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SamplerConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *TracesProcessor) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SamplerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TracesProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.TailSampler.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5643,9 +6869,29 @@ func (m *SamplerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x72
+	dAtA[i] = 0x62
+	if m.DisableStreamTraceBody {
+		i--
+		if m.DisableStreamTraceBody {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.EnableProfile {
+		i--
+		if m.EnableProfile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	{
-		size, err := m.Server.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.WorkflowSampler.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5653,105 +6899,73 @@ func (m *SamplerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x6a
-	if len(m.RateLimit) > 0 {
-		for iNdEx := len(m.RateLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RateLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOcp(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
+	dAtA[i] = 0x4a
+	if m.DisableParentSampling {
+		i--
+		if m.DisableParentSampling {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.BloomDyeing) > 0 {
-		for iNdEx := len(m.BloomDyeing) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BloomDyeing[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOcp(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
+	if m.DeferredSampleSlowDurationMs != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.DeferredSampleSlowDurationMs))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.EnableBloomDyeing {
+	if m.DeferredSampleError {
 		i--
-		if m.EnableBloomDyeing {
+		if m.DeferredSampleError {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x30
 	}
-	if m.EnableMinSample {
+	if m.EnableDeferredSample {
 		i--
-		if m.EnableMinSample {
+		if m.EnableDeferredSample {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x28
 	}
-	if m.EnableDyeing {
+	if m.DisableTraceBody {
 		i--
-		if m.EnableDyeing {
+		if m.DisableTraceBody {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x20
 	}
-	if len(m.Dyeing) > 0 {
-		for iNdEx := len(m.Dyeing) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Dyeing[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOcp(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	{
+		size, err := m.Sampler.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
-	if m.ErrorFraction != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ErrorFraction))))
-		i--
-		dAtA[i] = 0x19
-	}
-	if m.Fraction != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Fraction))))
-		i--
-		dAtA[i] = 0x11
-	}
-	if m.Enable {
-		i--
-		if m.Enable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowSamplerConfig) Marshal() (dAtA []byte, err error) {
+func (m *TailSamplerConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5761,40 +6975,69 @@ func (m *WorkflowSamplerConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowSamplerConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *TailSamplerConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowSamplerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TailSamplerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LifetimeSec != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.LifetimeSec))
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SampleDyeing {
+		i--
+		if m.SampleDyeing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.MaxBytes))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.PathMaxCount != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.PathMaxCount))
+	if m.MaxSpans != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.MaxSpans))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.MaxCountPerMinute != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.MaxCountPerMinute))
+	if m.DecisionWaitMs != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.DecisionWaitMs))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.SampleCountPerMinute != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.SampleCountPerMinute))
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TokenBucketConfig) Marshal() (dAtA []byte, err error) {
+func (m *TracesExporter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5804,37 +7047,67 @@ func (m *TokenBucketConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TokenBucketConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *TracesExporter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TokenBucketConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TracesExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Burst != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.Burst))
+	if m.ExportToFile {
 		i--
-		dAtA[i] = 0x18
+		if m.ExportToFile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Rate != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.Rate))
+	if m.PacketSize != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.PacketSize))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x40
 	}
-	if len(m.Strategy) > 0 {
-		i -= len(m.Strategy)
-		copy(dAtA[i:], m.Strategy)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Strategy)))
+	if m.WindowSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BufferSize != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.BufferSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Collector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Dyeing) Marshal() (dAtA []byte, err error) {
+func (m *LogsConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5844,36 +7117,50 @@ func (m *Dyeing) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Dyeing) MarshalTo(dAtA []byte) (int, error) {
+func (m *LogsConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Dyeing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LogsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Values) > 0 {
-		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Values[iNdEx])
-			copy(dAtA[i:], m.Values[iNdEx])
-			i = encodeVarintOcp(dAtA, i, uint64(len(m.Values[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	{
+		size, err := m.Exporter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Processor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Enable {
 		i--
-		dAtA[i] = 0xa
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Resource) Marshal() (dAtA []byte, err error) {
+func (m *LogsProcessor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5883,193 +7170,156 @@ func (m *Resource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
+func (m *LogsProcessor) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LogsProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ReleaseVersion) > 0 {
-		i -= len(m.ReleaseVersion)
-		copy(dAtA[i:], m.ReleaseVersion)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.ReleaseVersion)))
-		i--
-		dAtA[i] = 0x1
+	if len(m.LogTracedType) > 0 {
+		i -= len(m.LogTracedType)
+		copy(dAtA[i:], m.LogTracedType)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.LogTracedType)))
 		i--
-		dAtA[i] = 0xc2
+		dAtA[i] = 0x42
 	}
-	if m.AdminPort != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.AdminPort))
+	if m.MustLogTraced {
 		i--
-		dAtA[i] = 0x1
+		if m.MustLogTraced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xb8
+		dAtA[i] = 0x38
 	}
-	if len(m.City) > 0 {
-		i -= len(m.City)
-		copy(dAtA[i:], m.City)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.City)))
+	if m.EnableRecovery {
 		i--
-		dAtA[i] = 0x1
+		if m.EnableRecovery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xb2
+		dAtA[i] = 0x30
 	}
-	if len(m.SdkName) > 0 {
-		i -= len(m.SdkName)
-		copy(dAtA[i:], m.SdkName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.SdkName)))
-		i--
-		dAtA[i] = 0x1
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Level)))
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0x2a
 	}
-	if len(m.Language) > 0 {
-		i -= len(m.Language)
-		copy(dAtA[i:], m.Language)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Language)))
-		i--
-		dAtA[i] = 0x1
+	if m.TraceLogMode != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.TraceLogMode))
 		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0x20
 	}
-	if m.TargetType != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.TargetType))
+	if m.OnlyTraceLog {
 		i--
-		dAtA[i] = 0x1
+		if m.OnlyTraceLog {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x90
+		dAtA[i] = 0x10
 	}
-	if len(m.CmdbId) > 0 {
-		i -= len(m.CmdbId)
-		copy(dAtA[i:], m.CmdbId)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.CmdbId)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	if len(m.TenantId) > 0 {
-		i -= len(m.TenantId)
-		copy(dAtA[i:], m.TenantId)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.TenantId)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if len(m.ServiceName) > 0 {
-		i -= len(m.ServiceName)
-		copy(dAtA[i:], m.ServiceName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.ServiceName)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.FrameCode) > 0 {
-		i -= len(m.FrameCode)
-		copy(dAtA[i:], m.FrameCode)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.FrameCode)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.SetName) > 0 {
-		i -= len(m.SetName)
-		copy(dAtA[i:], m.SetName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.SetName)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Server) > 0 {
-		i -= len(m.Server)
-		copy(dAtA[i:], m.Server)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Server)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.App) > 0 {
-		i -= len(m.App)
-		copy(dAtA[i:], m.App)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.App)))
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0xa
 	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x52
+	return len(dAtA) - i, nil
+}
+
+func (m *LogsExporter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Platform) > 0 {
-		i -= len(m.Platform)
-		copy(dAtA[i:], m.Platform)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Platform)))
-		i--
-		dAtA[i] = 0x4a
+	return dAtA[:n], nil
+}
+
+func (m *LogsExporter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogsExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Http.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x52
+	if m.ExportToFile {
 		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ContainerName) > 0 {
-		i -= len(m.ContainerName)
-		copy(dAtA[i:], m.ContainerName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.ContainerName)))
+		if m.ExportToFile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x48
 	}
-	if len(m.Node) > 0 {
-		i -= len(m.Node)
-		copy(dAtA[i:], m.Node)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Node)))
+	if m.PacketSize != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.PacketSize))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x40
 	}
-	if len(m.Instance) > 0 {
-		i -= len(m.Instance)
-		copy(dAtA[i:], m.Instance)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Instance)))
+	if m.WindowSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.WindowSeconds))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x38
 	}
-	if len(m.Region) > 0 {
-		i -= len(m.Region)
-		copy(dAtA[i:], m.Region)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Region)))
+	if m.PageSize != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.PageSize))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x28
 	}
-	if len(m.EnvName) > 0 {
-		i -= len(m.EnvName)
-		copy(dAtA[i:], m.EnvName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.EnvName)))
+	if m.BufferSize != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.BufferSize))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.Collector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Target)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MetricSchema) Marshal() (dAtA []byte, err error) {
+func (m *LogsHTTP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6079,78 +7329,56 @@ func (m *MetricSchema) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MetricSchema) MarshalTo(dAtA []byte) (int, error) {
+func (m *LogsHTTP) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MetricSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LogsHTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Usage) > 0 {
-		i -= len(m.Usage)
-		copy(dAtA[i:], m.Usage)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Usage)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Labels) > 0 {
-		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Labels[iNdEx])
-			copy(dAtA[i:], m.Labels[iNdEx])
-			i = encodeVarintOcp(dAtA, i, uint64(len(m.Labels[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+	{
+		size, err := m.Tls.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
-	if len(m.MetricDesc) > 0 {
-		i -= len(m.MetricDesc)
-		copy(dAtA[i:], m.MetricDesc)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.MetricDesc)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.MetricAlias) > 0 {
-		i -= len(m.MetricAlias)
-		copy(dAtA[i:], m.MetricAlias)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.MetricAlias)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Aggregation) > 0 {
-		i -= len(m.Aggregation)
-		copy(dAtA[i:], m.Aggregation)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Aggregation)))
+	i--
+	dAtA[i] = 0x2a
+	if m.TimeoutMs != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.TimeoutMs))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.MetricType) > 0 {
-		i -= len(m.MetricType)
-		copy(dAtA[i:], m.MetricType)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.MetricType)))
+	if len(m.ProxyUrl) > 0 {
+		i -= len(m.ProxyUrl)
+		copy(dAtA[i:], m.ProxyUrl)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.ProxyUrl)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.MetricName) > 0 {
-		i -= len(m.MetricName)
-		copy(dAtA[i:], m.MetricName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.MetricName)))
+	if len(m.Compression) > 0 {
+		i -= len(m.Compression)
+		copy(dAtA[i:], m.Compression)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Compression)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MonitorName) > 0 {
-		i -= len(m.MonitorName)
-		copy(dAtA[i:], m.MonitorName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.MonitorName)))
+	if len(m.Encoding) > 0 {
+		i -= len(m.Encoding)
+		copy(dAtA[i:], m.Encoding)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Encoding)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LogSchema) Marshal() (dAtA []byte, err error) {
+func (m *ClientTLS) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6160,36 +7388,58 @@ func (m *LogSchema) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LogSchema) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClientTLS) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LogSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClientTLS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Labels[iNdEx])
-			copy(dAtA[i:], m.Labels[iNdEx])
-			i = encodeVarintOcp(dAtA, i, uint64(len(m.Labels[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+	if m.InsecureSkipVerify {
+		i--
+		if m.InsecureSkipVerify {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.LogName) > 0 {
-		i -= len(m.LogName)
-		copy(dAtA[i:], m.LogName)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.LogName)))
+	if len(m.ServerName) > 0 {
+		i -= len(m.ServerName)
+		copy(dAtA[i:], m.ServerName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.ServerName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.KeyFile) > 0 {
+		i -= len(m.KeyFile)
+		copy(dAtA[i:], m.KeyFile)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.KeyFile)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CertFile) > 0 {
+		i -= len(m.CertFile)
+		copy(dAtA[i:], m.CertFile)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.CertFile)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CaFile) > 0 {
+		i -= len(m.CaFile)
+		copy(dAtA[i:], m.CaFile)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.CaFile)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TraceSchema) Marshal() (dAtA []byte, err error) {
+func (m *ProfilesConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6199,29 +7449,50 @@ func (m *TraceSchema) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TraceSchema) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProfilesConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TraceSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProfilesConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Labels[iNdEx])
-			copy(dAtA[i:], m.Labels[iNdEx])
-			i = encodeVarintOcp(dAtA, i, uint64(len(m.Labels[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+	{
+		size, err := m.Exporter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Processor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProfileSchema) Marshal() (dAtA []byte, err error) {
+func (m *ProfilesProcessor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6231,36 +7502,101 @@ func (m *ProfileSchema) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProfileSchema) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProfilesProcessor) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProfileSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProfilesProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Labels[iNdEx])
-			copy(dAtA[i:], m.Labels[iNdEx])
-			i = encodeVarintOcp(dAtA, i, uint64(len(m.Labels[iNdEx])))
+	{
+		size, err := m.Capture.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.Anomaly.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.EnableLinkTrace {
+		i--
+		if m.EnableLinkTrace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EnableDeltaProfiles {
+		i--
+		if m.EnableDeltaProfiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BlockProfileRate != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.BlockProfileRate))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MutexProfileFraction != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.MutexProfileFraction))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CpuProfileRate != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.CpuProfileRate))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CpuDurationSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.CpuDurationSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PeriodSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.PeriodSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProfileTypes) > 0 {
+		for iNdEx := len(m.ProfileTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProfileTypes[iNdEx])
+			copy(dAtA[i:], m.ProfileTypes[iNdEx])
+			i = encodeVarintOcp(dAtA, i, uint64(len(m.ProfileTypes[iNdEx])))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.ProfileType) > 0 {
-		i -= len(m.ProfileType)
-		copy(dAtA[i:], m.ProfileType)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.ProfileType)))
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TelemetrySchemaRequest) Marshal() (dAtA []byte, err error) {
+func (m *ProfileCapture) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6270,91 +7606,51 @@ func (m *TelemetrySchemaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TelemetrySchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProfileCapture) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TelemetrySchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProfileCapture) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ProfileSchemas) > 0 {
-		for iNdEx := len(m.ProfileSchemas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProfileSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOcp(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	if m.ExpireAt != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.ExpireAt))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.LogSchemas) > 0 {
-		for iNdEx := len(m.LogSchemas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOcp(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	if m.CpuProfileRate != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.CpuProfileRate))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.TraceSchemas) > 0 {
-		for iNdEx := len(m.TraceSchemas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TraceSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOcp(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	if m.DurationSeconds != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.DurationSeconds))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.MetricSchemas) > 0 {
-		for iNdEx := len(m.MetricSchemas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MetricSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOcp(dAtA, i, uint64(size))
-			}
+	if len(m.ProfileTypes) > 0 {
+		for iNdEx := len(m.ProfileTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProfileTypes[iNdEx])
+			copy(dAtA[i:], m.ProfileTypes[iNdEx])
+			i = encodeVarintOcp(dAtA, i, uint64(len(m.ProfileTypes[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if m.SerialNumber != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.SerialNumber))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Id)))
 		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOcp(dAtA, i, uint64(size))
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TelemetrySchemaResponse) Marshal() (dAtA []byte, err error) {
+func (m *ProfilesAnomalyConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6364,32 +7660,45 @@ func (m *TelemetrySchemaResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TelemetrySchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProfilesAnomalyConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TelemetrySchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProfilesAnomalyConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Msg)))
+	if m.HeapGrowthBytes != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.HeapGrowthBytes))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if m.Code != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.Code))
+	if m.GoroutineGrowth != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.GoroutineGrowth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GrowthPeriods != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.GrowthPeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BloomDyeing) Marshal() (dAtA []byte, err error) {
+func (m *ProfilesExporter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6399,56 +7708,62 @@ func (m *BloomDyeing) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BloomDyeing) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProfilesExporter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BloomDyeing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProfilesExporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Bitmap) > 0 {
-		dAtA34 := make([]byte, len(m.Bitmap)*10)
-		var j33 int
-		for _, num1 := range m.Bitmap {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j33++
-			}
-			dAtA34[j33] = uint8(num)
-			j33++
+	if m.ExportToFile {
+		i--
+		if m.ExportToFile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= j33
-		copy(dAtA[i:], dAtA34[:j33])
-		i = encodeVarintOcp(dAtA, i, uint64(j33))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x48
 	}
-	if m.HashNumber != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.HashNumber))
+	if m.MaxRetryCount != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.MaxRetryCount))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x40
 	}
-	if m.BitSize != 0 {
-		i = encodeVarintOcp(dAtA, i, uint64(m.BitSize))
+	if m.TimeoutMs != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.TimeoutMs))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x30
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Key)))
+	if m.BufferSize != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.BufferSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Collector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Protocol)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RpcSamplingConfig) Marshal() (dAtA []byte, err error) {
+func (m *SamplerConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6458,20 +7773,40 @@ func (m *RpcSamplingConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RpcSamplingConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *SamplerConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RpcSamplingConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SamplerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rpc) > 0 {
-		for iNdEx := len(m.Rpc) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size, err := m.Server.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.RateLimit) > 0 {
+		for iNdEx := len(m.RateLimit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Rpc[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RateLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6479,19 +7814,93 @@ func (m *RpcSamplingConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintOcp(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x62
 		}
 	}
+	if len(m.BloomDyeing) > 0 {
+		for iNdEx := len(m.BloomDyeing) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BloomDyeing[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.EnableBloomDyeing {
+		i--
+		if m.EnableBloomDyeing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EnableMinSample {
+		i--
+		if m.EnableMinSample {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EnableDyeing {
+		i--
+		if m.EnableDyeing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Dyeing) > 0 {
+		for iNdEx := len(m.Dyeing) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dyeing[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ErrorFraction != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ErrorFraction))))
+		i--
+		dAtA[i] = 0x19
+	}
 	if m.Fraction != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Fraction))))
 		i--
-		dAtA[i] = 0x9
+		dAtA[i] = 0x11
+	}
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RpcConfig) Marshal() (dAtA []byte, err error) {
+func (m *WorkflowSamplerConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6501,710 +7910,927 @@ func (m *RpcConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RpcConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkflowSamplerConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RpcConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WorkflowSamplerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Fraction != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Fraction))))
+	if m.LifetimeSec != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.LifetimeSec))
 		i--
-		dAtA[i] = 0x11
+		dAtA[i] = 0x20
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOcp(dAtA, i, uint64(len(m.Name)))
+	if m.PathMaxCount != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.PathMaxCount))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
+	}
+	if m.MaxCountPerMinute != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.MaxCountPerMinute))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SampleCountPerMinute != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.SampleCountPerMinute))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOcp(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOcp(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TokenBucketConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Collector) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TokenBucketConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenBucketConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	if m.TelemetryData != 0 {
-		n += 1 + sovOcp(uint64(m.TelemetryData))
-	}
-	if m.DataProtocol != 0 {
-		n += 1 + sovOcp(uint64(m.DataProtocol))
-	}
-	if m.DataTransmission != 0 {
-		n += 1 + sovOcp(uint64(m.DataTransmission))
+	if m.Burst != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.Burst))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Version != 0 {
-		n += 1 + sovOcp(uint64(m.Version))
+	if m.Rate != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.Rate))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.DirectIpPort) > 0 {
-		for _, s := range m.DirectIpPort {
-			l = len(s)
-			n += 1 + l + sovOcp(uint64(l))
-		}
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Dyeing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Dyeing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dyeing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Platform)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	l = m.Metrics.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = m.Traces.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = m.Logs.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = len(m.Env)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	l = len(m.Set)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintOcp(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.Resource.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = m.Profiles.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	if m.Local != nil {
-		l = m.Local.Size()
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CollectorProtocol) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TelemetryData != 0 {
-		n += 1 + sovOcp(uint64(m.TelemetryData))
-	}
-	if m.DataProtocol != 0 {
-		n += 1 + sovOcp(uint64(m.DataProtocol))
-	}
-	if m.DataTransmission != 0 {
-		n += 1 + sovOcp(uint64(m.DataTransmission))
-	}
-	if m.Version != 0 {
-		n += 1 + sovOcp(uint64(m.Version))
+func (m *Resource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Code != 0 {
-		n += 1 + sovOcp(uint64(m.Code))
+	if len(m.ReleaseVersion) > 0 {
+		i -= len(m.ReleaseVersion)
+		copy(dAtA[i:], m.ReleaseVersion)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.ReleaseVersion)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if m.AdminPort != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.AdminPort))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	l = len(m.ConfigServer)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.SdkName) > 0 {
+		i -= len(m.SdkName)
+		copy(dAtA[i:], m.SdkName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.SdkName)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	l = len(m.RegisterServer)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.Language) > 0 {
+		i -= len(m.Language)
+		copy(dAtA[i:], m.Language)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Language)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	l = m.SelfMonitor.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = m.MetricsConfig.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = m.TracesConfig.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = m.LogsConfig.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = len(m.TenantId)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if m.TargetType != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.TargetType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.Version != 0 {
-		n += 1 + sovOcp(uint64(m.Version))
+	if len(m.CmdbId) > 0 {
+		i -= len(m.CmdbId)
+		copy(dAtA[i:], m.CmdbId)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.CmdbId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	l = m.ProfilesConfig.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	if m.AccessPoint != 0 {
-		n += 1 + sovOcp(uint64(m.AccessPoint))
+	if len(m.TenantId) > 0 {
+		i -= len(m.TenantId)
+		copy(dAtA[i:], m.TenantId)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.TenantId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	l = m.PrometheusPush.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = m.OpentelemetryPush.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	return n
-}
-
-func (m *SelfMonitor) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ServiceName) > 0 {
+		i -= len(m.ServiceName)
+		copy(dAtA[i:], m.ServiceName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.ServiceName)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	var l int
-	_ = l
-	l = len(m.Protocol)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.FrameCode) > 0 {
+		i -= len(m.FrameCode)
+		copy(dAtA[i:], m.FrameCode)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.FrameCode)))
+		i--
+		dAtA[i] = 0x72
 	}
-	l = m.Collector.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	if m.ReportSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.ReportSeconds))
+	if len(m.SetName) > 0 {
+		i -= len(m.SetName)
+		copy(dAtA[i:], m.SetName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.SetName)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	return n
-}
-
-func (m *MetricsConfig) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Server) > 0 {
+		i -= len(m.Server)
+		copy(dAtA[i:], m.Server)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Server)))
+		i--
+		dAtA[i] = 0x62
 	}
-	var l int
-	_ = l
-	if m.Enable {
-		n += 2
+	if len(m.App) > 0 {
+		i -= len(m.App)
+		copy(dAtA[i:], m.App)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.App)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = m.Processor.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = m.Exporter.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	if m.EnableSse {
-		n += 2
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
-}
-
-func (m *LabelIgnore) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	l = len(m.MonitorName)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.LabelNames) > 0 {
-		for _, s := range m.LabelNames {
-			l = len(s)
-			n += 1 + l + sovOcp(uint64(l))
-		}
+	if len(m.ContainerName) > 0 {
+		i -= len(m.ContainerName)
+		copy(dAtA[i:], m.ContainerName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.ContainerName)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *SecondGranularity) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.MonitorName)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.BeginSecond != 0 {
-		n += 1 + sovOcp(uint64(m.BeginSecond))
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.EndSecond != 0 {
-		n += 1 + sovOcp(uint64(m.EndSecond))
+	if len(m.EnvName) > 0 {
+		i -= len(m.EnvName)
+		copy(dAtA[i:], m.EnvName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.EnvName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.WindowSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.WindowSeconds))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TtlSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.TtlSeconds))
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SampleMonitor) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MonitorName)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	if m.SampleType != 0 {
-		n += 1 + sovOcp(uint64(m.SampleType))
-	}
-	if m.Fraction != 0 {
-		n += 9
+func (m *MetricSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RPCHasTwoIP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MonitorName)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	if m.BeginSecond != 0 {
-		n += 1 + sovOcp(uint64(m.BeginSecond))
-	}
-	if m.EndSecond != 0 {
-		n += 1 + sovOcp(uint64(m.EndSecond))
-	}
-	return n
+func (m *MetricSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MetricsProcessor) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MetricSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Protocol)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	if m.WindowSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.WindowSeconds))
-	}
-	if m.ClearSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.ClearSeconds))
-	}
-	if m.ExpiresSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.ExpiresSeconds))
-	}
-	if m.PointLimit != 0 {
-		n += 1 + sovOcp(uint64(m.PointLimit))
-	}
-	if m.EnableProcessMetrics {
-		n += 2
-	}
-	if m.ProcessMetricsSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.ProcessMetricsSeconds))
+	if len(m.Usage) > 0 {
+		i -= len(m.Usage)
+		copy(dAtA[i:], m.Usage)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Usage)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.HistogramBuckets) > 0 {
-		for _, e := range m.HistogramBuckets {
-			l = e.Size()
-			n += 1 + l + sovOcp(uint64(l))
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintOcp(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.LabelIgnores) > 0 {
-		for _, e := range m.LabelIgnores {
-			l = e.Size()
-			n += 1 + l + sovOcp(uint64(l))
-		}
+	if len(m.MetricDesc) > 0 {
+		i -= len(m.MetricDesc)
+		copy(dAtA[i:], m.MetricDesc)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.MetricDesc)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.RetCodeAsException {
-		n += 2
+	if len(m.MetricAlias) > 0 {
+		i -= len(m.MetricAlias)
+		copy(dAtA[i:], m.MetricAlias)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.MetricAlias)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.SecondGranularitys) > 0 {
-		for _, e := range m.SecondGranularitys {
-			l = e.Size()
-			n += 1 + l + sovOcp(uint64(l))
-		}
+	if len(m.Aggregation) > 0 {
+		i -= len(m.Aggregation)
+		copy(dAtA[i:], m.Aggregation)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Aggregation)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.SampleMonitors) > 0 {
-		for _, e := range m.SampleMonitors {
-			l = e.Size()
-			n += 1 + l + sovOcp(uint64(l))
-		}
+	if len(m.MetricType) > 0 {
+		i -= len(m.MetricType)
+		copy(dAtA[i:], m.MetricType)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.MetricType)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.RpcHasTwoIps) > 0 {
-		for _, e := range m.RpcHasTwoIps {
-			l = e.Size()
-			n += 1 + l + sovOcp(uint64(l))
-		}
+	if len(m.MetricName) > 0 {
+		i -= len(m.MetricName)
+		copy(dAtA[i:], m.MetricName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.MetricName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.MonitorName) > 0 {
+		i -= len(m.MonitorName)
+		copy(dAtA[i:], m.MonitorName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.MonitorName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MetricsExporter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LogSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LogSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Protocol)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	l = m.Collector.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	if m.ThreadCount != 0 {
-		n += 1 + sovOcp(uint64(m.ThreadCount))
-	}
-	if m.BufferSize != 0 {
-		n += 1 + sovOcp(uint64(m.BufferSize))
-	}
-	if m.PageSize != 0 {
-		n += 1 + sovOcp(uint64(m.PageSize))
-	}
-	if m.TimeoutMs != 0 {
-		n += 1 + sovOcp(uint64(m.TimeoutMs))
-	}
-	if m.WindowSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.WindowSeconds))
-	}
-	if m.MaxRetryCount != 0 {
-		n += 1 + sovOcp(uint64(m.MaxRetryCount))
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintOcp(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.ExportToFile {
-		n += 2
+	if len(m.LogName) > 0 {
+		i -= len(m.LogName)
+		copy(dAtA[i:], m.LogName)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.LogName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PrometheusPushConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TraceSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraceSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Enable {
-		n += 2
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	l = len(m.Job)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	if m.Interval != 0 {
-		n += 1 + sovOcp(uint64(m.Interval))
-	}
-	if m.UseBasicAuth {
-		n += 2
-	}
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	if len(m.Grouping) > 0 {
-		for k, v := range m.Grouping {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovOcp(uint64(len(k))) + 1 + len(v) + sovOcp(uint64(len(v)))
-			n += mapEntrySize + 1 + sovOcp(uint64(mapEntrySize))
-		}
-	}
-	if len(m.HttpHeaders) > 0 {
-		for k, v := range m.HttpHeaders {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovOcp(uint64(len(k))) + 1 + len(v) + sovOcp(uint64(len(v)))
-			n += mapEntrySize + 1 + sovOcp(uint64(mapEntrySize))
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintOcp(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OpenTelemetryPushConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Enable {
-		n += 2
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+func (m *ProfileSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HistogramBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	if len(m.Buckets) > 0 {
-		n += 1 + sovOcp(uint64(len(m.Buckets)*8)) + len(m.Buckets)*8
-	}
-	return n
+func (m *ProfileSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TracesConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ProfileSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Enable {
-		n += 2
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintOcp(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = m.Processor.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = m.Exporter.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	if m.EnableSse {
-		n += 2
+	if len(m.ProfileType) > 0 {
+		i -= len(m.ProfileType)
+		copy(dAtA[i:], m.ProfileType)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.ProfileType)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TracesProcessor) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TelemetrySchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TelemetrySchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TelemetrySchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Protocol)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.ProfileSchemas) > 0 {
+		for iNdEx := len(m.ProfileSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfileSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = m.Sampler.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	if m.DisableTraceBody {
-		n += 2
+	if len(m.LogSchemas) > 0 {
+		for iNdEx := len(m.LogSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.EnableDeferredSample {
-		n += 2
+	if len(m.TraceSchemas) > 0 {
+		for iNdEx := len(m.TraceSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraceSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.DeferredSampleError {
-		n += 2
+	if len(m.MetricSchemas) > 0 {
+		for iNdEx := len(m.MetricSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetricSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.DeferredSampleSlowDurationMs != 0 {
-		n += 1 + sovOcp(uint64(m.DeferredSampleSlowDurationMs))
+	if m.SerialNumber != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.SerialNumber))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.DisableParentSampling {
-		n += 2
+	{
+		size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcp(dAtA, i, uint64(size))
 	}
-	l = m.WorkflowSampler.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	if m.EnableProfile {
-		n += 2
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TelemetrySchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.DisableStreamTraceBody {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *TelemetrySchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TelemetrySchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Code != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TracesExporter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BloomDyeing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BloomDyeing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BloomDyeing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Protocol)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.Bitmap) > 0 {
+		dAtA45 := make([]byte, len(m.Bitmap)*10)
+		var j44 int
+		for _, num1 := range m.Bitmap {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j44++
+			}
+			dAtA45[j44] = uint8(num)
+			j44++
+		}
+		i -= j44
+		copy(dAtA[i:], dAtA45[:j44])
+		i = encodeVarintOcp(dAtA, i, uint64(j44))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Collector.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	if m.BufferSize != 0 {
-		n += 1 + sovOcp(uint64(m.BufferSize))
+	if m.HashNumber != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.HashNumber))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovOcp(uint64(m.PageSize))
+	if m.BitSize != 0 {
+		i = encodeVarintOcp(dAtA, i, uint64(m.BitSize))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.WindowSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.WindowSeconds))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.PacketSize != 0 {
-		n += 1 + sovOcp(uint64(m.PacketSize))
+	return len(dAtA) - i, nil
+}
+
+func (m *RpcSamplingConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ExportToFile {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *RpcSamplingConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RpcSamplingConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rpc) > 0 {
+		for iNdEx := len(m.Rpc) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rpc[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.Fraction != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Fraction))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LogsConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RpcConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RpcConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RpcConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Enable {
-		n += 2
+	if m.Fraction != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Fraction))))
+		i--
+		dAtA[i] = 0x11
 	}
-	l = m.Processor.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = m.Exporter.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOcp(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LogsProcessor) Size() (n int) {
+func encodeVarintOcp(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOcp(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Collector) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Protocol)
+	l = len(m.Addr)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	if m.OnlyTraceLog {
-		n += 2
-	}
-	if m.TraceLogMode != 0 {
-		n += 1 + sovOcp(uint64(m.TraceLogMode))
+	if m.TelemetryData != 0 {
+		n += 1 + sovOcp(uint64(m.TelemetryData))
 	}
-	l = len(m.Level)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if m.DataProtocol != 0 {
+		n += 1 + sovOcp(uint64(m.DataProtocol))
 	}
-	if m.EnableRecovery {
-		n += 2
+	if m.DataTransmission != 0 {
+		n += 1 + sovOcp(uint64(m.DataTransmission))
 	}
-	if m.MustLogTraced {
-		n += 2
+	if m.Version != 0 {
+		n += 1 + sovOcp(uint64(m.Version))
 	}
-	l = len(m.LogTracedType)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.DirectIpPort) > 0 {
+		for _, s := range m.DirectIpPort {
+			l = len(s)
+			n += 1 + l + sovOcp(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *LogsExporter) Size() (n int) {
+func (m *GetConfigRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Protocol)
+	l = len(m.Platform)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	l = m.Collector.Size()
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = m.Metrics.Size()
 	n += 1 + l + sovOcp(uint64(l))
-	if m.BufferSize != 0 {
-		n += 1 + sovOcp(uint64(m.BufferSize))
+	l = m.Traces.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.Logs.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = len(m.Env)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovOcp(uint64(m.PageSize))
-	}
-	if m.WindowSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.WindowSeconds))
-	}
-	if m.PacketSize != 0 {
-		n += 1 + sovOcp(uint64(m.PacketSize))
+	l = len(m.Set)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
 	}
-	if m.ExportToFile {
-		n += 2
+	l = m.Resource.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.Profiles.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	if m.Local != nil {
+		l = m.Local.Size()
+		n += 1 + l + sovOcp(uint64(l))
 	}
 	return n
 }
 
-func (m *ProfilesConfig) Size() (n int) {
+func (m *CollectorProtocol) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Enable {
-		n += 2
+	if m.TelemetryData != 0 {
+		n += 1 + sovOcp(uint64(m.TelemetryData))
+	}
+	if m.DataProtocol != 0 {
+		n += 1 + sovOcp(uint64(m.DataProtocol))
+	}
+	if m.DataTransmission != 0 {
+		n += 1 + sovOcp(uint64(m.DataTransmission))
+	}
+	if m.Version != 0 {
+		n += 1 + sovOcp(uint64(m.Version))
 	}
-	l = m.Processor.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	l = m.Exporter.Size()
-	n += 1 + l + sovOcp(uint64(l))
 	return n
 }
 
-func (m *ProfilesProcessor) Size() (n int) {
+func (m *GetConfigResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Protocol)
+	if m.Code != 0 {
+		n += 1 + sovOcp(uint64(m.Code))
+	}
+	l = len(m.Msg)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	if len(m.ProfileTypes) > 0 {
-		for _, s := range m.ProfileTypes {
-			l = len(s)
-			n += 1 + l + sovOcp(uint64(l))
-		}
-	}
-	if m.PeriodSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.PeriodSeconds))
-	}
-	if m.CpuDurationSeconds != 0 {
-		n += 1 + sovOcp(uint64(m.CpuDurationSeconds))
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
 	}
-	if m.CpuProfileRate != 0 {
-		n += 1 + sovOcp(uint64(m.CpuProfileRate))
+	l = len(m.ConfigServer)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
 	}
-	if m.MutexProfileFraction != 0 {
-		n += 1 + sovOcp(uint64(m.MutexProfileFraction))
+	l = len(m.RegisterServer)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
 	}
-	if m.BlockProfileRate != 0 {
-		n += 1 + sovOcp(uint64(m.BlockProfileRate))
+	l = m.SelfMonitor.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.MetricsConfig.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.TracesConfig.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.LogsConfig.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = len(m.TenantId)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
 	}
-	if m.EnableDeltaProfiles {
-		n += 2
+	if m.Version != 0 {
+		n += 1 + sovOcp(uint64(m.Version))
 	}
-	if m.EnableLinkTrace {
-		n += 2
+	l = m.ProfilesConfig.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	if m.AccessPoint != 0 {
+		n += 1 + sovOcp(uint64(m.AccessPoint))
 	}
+	l = m.PrometheusPush.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.OpentelemetryPush.Size()
+	n += 1 + l + sovOcp(uint64(l))
 	return n
 }
 
-func (m *ProfilesExporter) Size() (n int) {
+func (m *SelfMonitor) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7216,22 +8842,13 @@ func (m *ProfilesExporter) Size() (n int) {
 	}
 	l = m.Collector.Size()
 	n += 1 + l + sovOcp(uint64(l))
-	if m.BufferSize != 0 {
-		n += 1 + sovOcp(uint64(m.BufferSize))
-	}
-	if m.TimeoutMs != 0 {
-		n += 1 + sovOcp(uint64(m.TimeoutMs))
-	}
-	if m.MaxRetryCount != 0 {
-		n += 1 + sovOcp(uint64(m.MaxRetryCount))
-	}
-	if m.ExportToFile {
-		n += 2
+	if m.ReportSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.ReportSeconds))
 	}
 	return n
 }
 
-func (m *SamplerConfig) Size() (n int) {
+func (m *MetricsConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7240,205 +8857,165 @@ func (m *SamplerConfig) Size() (n int) {
 	if m.Enable {
 		n += 2
 	}
-	if m.Fraction != 0 {
-		n += 9
-	}
-	if m.ErrorFraction != 0 {
-		n += 9
-	}
-	if len(m.Dyeing) > 0 {
-		for _, e := range m.Dyeing {
-			l = e.Size()
-			n += 1 + l + sovOcp(uint64(l))
-		}
-	}
-	if m.EnableDyeing {
-		n += 2
-	}
-	if m.EnableMinSample {
-		n += 2
-	}
-	if m.EnableBloomDyeing {
-		n += 2
-	}
-	if len(m.BloomDyeing) > 0 {
-		for _, e := range m.BloomDyeing {
-			l = e.Size()
-			n += 1 + l + sovOcp(uint64(l))
-		}
-	}
-	if len(m.RateLimit) > 0 {
-		for _, e := range m.RateLimit {
-			l = e.Size()
-			n += 1 + l + sovOcp(uint64(l))
-		}
-	}
-	l = m.Server.Size()
+	l = m.Processor.Size()
 	n += 1 + l + sovOcp(uint64(l))
-	l = m.Client.Size()
+	l = m.Exporter.Size()
 	n += 1 + l + sovOcp(uint64(l))
+	if m.EnableSse {
+		n += 2
+	}
 	return n
 }
 
-func (m *WorkflowSamplerConfig) Size() (n int) {
+func (m *LabelIgnore) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SampleCountPerMinute != 0 {
-		n += 1 + sovOcp(uint64(m.SampleCountPerMinute))
-	}
-	if m.MaxCountPerMinute != 0 {
-		n += 1 + sovOcp(uint64(m.MaxCountPerMinute))
-	}
-	if m.PathMaxCount != 0 {
-		n += 1 + sovOcp(uint64(m.PathMaxCount))
+	l = len(m.MonitorName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
 	}
-	if m.LifetimeSec != 0 {
-		n += 1 + sovOcp(uint64(m.LifetimeSec))
+	if len(m.LabelNames) > 0 {
+		for _, s := range m.LabelNames {
+			l = len(s)
+			n += 1 + l + sovOcp(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *TokenBucketConfig) Size() (n int) {
+func (m *TargetPointLimit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Strategy)
+	l = len(m.Target)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	if m.Rate != 0 {
-		n += 1 + sovOcp(uint64(m.Rate))
-	}
-	if m.Burst != 0 {
-		n += 1 + sovOcp(uint64(m.Burst))
+	if m.PointLimit != 0 {
+		n += 1 + sovOcp(uint64(m.PointLimit))
 	}
 	return n
 }
 
-func (m *Dyeing) Size() (n int) {
+func (m *MultiTargetConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if m.MaxTargets != 0 {
+		n += 1 + sovOcp(uint64(m.MaxTargets))
 	}
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			l = len(s)
+	if m.PointLimit != 0 {
+		n += 1 + sovOcp(uint64(m.PointLimit))
+	}
+	if len(m.TargetPointLimits) > 0 {
+		for _, e := range m.TargetPointLimits {
+			l = e.Size()
 			n += 1 + l + sovOcp(uint64(l))
 		}
 	}
+	if m.BatchTargets != 0 {
+		n += 1 + sovOcp(uint64(m.BatchTargets))
+	}
 	return n
 }
 
-func (m *Resource) Size() (n int) {
+func (m *LabelMatcher) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Target)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	l = len(m.Namespace)
+	l = len(m.Regex)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	l = len(m.EnvName)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	return n
+}
+
+func (m *LabelRule) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Region)
+	var l int
+	_ = l
+	l = len(m.MonitorName)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	l = len(m.Instance)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if m.Action != 0 {
+		n += 1 + sovOcp(uint64(m.Action))
 	}
-	l = len(m.Node)
+	l = len(m.LabelName)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	l = len(m.ContainerName)
+	l = len(m.Regex)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	l = len(m.Version)
+	l = len(m.Replacement)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	l = len(m.Platform)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.AllowValues) > 0 {
+		for _, s := range m.AllowValues {
+			l = len(s)
+			n += 1 + l + sovOcp(uint64(l))
+		}
 	}
-	l = len(m.ObjectName)
+	l = len(m.OtherValue)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	l = len(m.App)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if m.Buckets != 0 {
+		n += 1 + sovOcp(uint64(m.Buckets))
 	}
-	l = len(m.Server)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if len(m.Selectors) > 0 {
+		for _, e := range m.Selectors {
+			l = e.Size()
+			n += 1 + l + sovOcp(uint64(l))
+		}
 	}
-	l = len(m.SetName)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	return n
+}
+
+func (m *SecondGranularity) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.FrameCode)
+	var l int
+	_ = l
+	l = len(m.MonitorName)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	l = len(m.ServiceName)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if m.BeginSecond != 0 {
+		n += 1 + sovOcp(uint64(m.BeginSecond))
 	}
-	l = len(m.TenantId)
-	if l > 0 {
-		n += 2 + l + sovOcp(uint64(l))
+	if m.EndSecond != 0 {
+		n += 1 + sovOcp(uint64(m.EndSecond))
 	}
-	l = len(m.CmdbId)
-	if l > 0 {
-		n += 2 + l + sovOcp(uint64(l))
-	}
-	if m.TargetType != 0 {
-		n += 2 + sovOcp(uint64(m.TargetType))
-	}
-	l = len(m.Language)
-	if l > 0 {
-		n += 2 + l + sovOcp(uint64(l))
-	}
-	l = len(m.SdkName)
-	if l > 0 {
-		n += 2 + l + sovOcp(uint64(l))
-	}
-	l = len(m.City)
-	if l > 0 {
-		n += 2 + l + sovOcp(uint64(l))
-	}
-	if m.AdminPort != 0 {
-		n += 2 + sovOcp(uint64(m.AdminPort))
+	if m.WindowSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.WindowSeconds))
 	}
-	l = len(m.ReleaseVersion)
-	if l > 0 {
-		n += 2 + l + sovOcp(uint64(l))
+	if m.TtlSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.TtlSeconds))
 	}
 	return n
 }
 
-func (m *MetricSchema) Size() (n int) {
+func (m *SampleMonitor) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7448,213 +9025,3414 @@ func (m *MetricSchema) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	l = len(m.MetricName)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if m.SampleType != 0 {
+		n += 1 + sovOcp(uint64(m.SampleType))
 	}
-	l = len(m.MetricType)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if m.Fraction != 0 {
+		n += 9
 	}
-	l = len(m.Aggregation)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	return n
+}
+
+func (m *ExponentialHistogramConfig) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.MetricAlias)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	var l int
+	_ = l
+	if m.MaxSize != 0 {
+		n += 1 + sovOcp(uint64(m.MaxSize))
 	}
-	l = len(m.MetricDesc)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if m.MaxScale != 0 {
+		n += 1 + sovOcp(uint64(m.MaxScale))
 	}
-	if len(m.Labels) > 0 {
-		for _, s := range m.Labels {
-			l = len(s)
-			n += 1 + l + sovOcp(uint64(l))
-		}
+	if m.MaxScaleSet {
+		n += 2
 	}
-	l = len(m.Usage)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	return n
+}
+
+func (m *SummaryConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelativeAccuracy != 0 {
+		n += 9
+	}
+	if len(m.Quantiles) > 0 {
+		n += 1 + sovOcp(uint64(len(m.Quantiles)*8)) + len(m.Quantiles)*8
 	}
 	return n
 }
 
-func (m *LogSchema) Size() (n int) {
+func (m *SeriesLimits) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.LogName)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
+	if m.ClientLimit != 0 {
+		n += 1 + sovOcp(uint64(m.ClientLimit))
 	}
-	if len(m.Labels) > 0 {
-		for _, s := range m.Labels {
-			l = len(s)
+	if m.ServerLimit != 0 {
+		n += 1 + sovOcp(uint64(m.ServerLimit))
+	}
+	if m.CustomLimit != 0 {
+		n += 1 + sovOcp(uint64(m.CustomLimit))
+	}
+	if len(m.Monitors) > 0 {
+		for _, e := range m.Monitors {
+			l = e.Size()
 			n += 1 + l + sovOcp(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *TraceSchema) Size() (n int) {
+func (m *MonitorSeriesLimit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for _, s := range m.Labels {
-			l = len(s)
-			n += 1 + l + sovOcp(uint64(l))
-		}
+	l = len(m.MonitorName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovOcp(uint64(m.Limit))
 	}
 	return n
 }
 
-func (m *ProfileSchema) Size() (n int) {
+func (m *RPCHasTwoIP) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ProfileType)
+	l = len(m.MonitorName)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	if len(m.Labels) > 0 {
-		for _, s := range m.Labels {
-			l = len(s)
-			n += 1 + l + sovOcp(uint64(l))
-		}
+	if m.BeginSecond != 0 {
+		n += 1 + sovOcp(uint64(m.BeginSecond))
+	}
+	if m.EndSecond != 0 {
+		n += 1 + sovOcp(uint64(m.EndSecond))
 	}
 	return n
 }
 
-func (m *TelemetrySchemaRequest) Size() (n int) {
+func (m *MetricsProcessor) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Resource.Size()
-	n += 1 + l + sovOcp(uint64(l))
-	if m.SerialNumber != 0 {
-		n += 1 + sovOcp(uint64(m.SerialNumber))
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
 	}
-	if len(m.MetricSchemas) > 0 {
-		for _, e := range m.MetricSchemas {
+	if m.WindowSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.WindowSeconds))
+	}
+	if m.ClearSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.ClearSeconds))
+	}
+	if m.ExpiresSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.ExpiresSeconds))
+	}
+	if m.PointLimit != 0 {
+		n += 1 + sovOcp(uint64(m.PointLimit))
+	}
+	if m.EnableProcessMetrics {
+		n += 2
+	}
+	if m.ProcessMetricsSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.ProcessMetricsSeconds))
+	}
+	if len(m.HistogramBuckets) > 0 {
+		for _, e := range m.HistogramBuckets {
 			l = e.Size()
 			n += 1 + l + sovOcp(uint64(l))
 		}
 	}
-	if len(m.TraceSchemas) > 0 {
-		for _, e := range m.TraceSchemas {
+	if len(m.LabelIgnores) > 0 {
+		for _, e := range m.LabelIgnores {
 			l = e.Size()
 			n += 1 + l + sovOcp(uint64(l))
 		}
 	}
-	if len(m.LogSchemas) > 0 {
-		for _, e := range m.LogSchemas {
+	if m.RetCodeAsException {
+		n += 2
+	}
+	if len(m.SecondGranularitys) > 0 {
+		for _, e := range m.SecondGranularitys {
 			l = e.Size()
 			n += 1 + l + sovOcp(uint64(l))
 		}
 	}
-	if len(m.ProfileSchemas) > 0 {
-		for _, e := range m.ProfileSchemas {
+	if len(m.SampleMonitors) > 0 {
+		for _, e := range m.SampleMonitors {
+			l = e.Size()
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	if len(m.RpcHasTwoIps) > 0 {
+		for _, e := range m.RpcHasTwoIps {
 			l = e.Size()
 			n += 1 + l + sovOcp(uint64(l))
 		}
 	}
+	l = m.SeriesLimits.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.ExponentialHistogram.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.Summary.Size()
+	n += 2 + l + sovOcp(uint64(l))
+	if len(m.LabelRules) > 0 {
+		for _, e := range m.LabelRules {
+			l = e.Size()
+			n += 2 + l + sovOcp(uint64(l))
+		}
+	}
+	l = m.MultiTarget.Size()
+	n += 2 + l + sovOcp(uint64(l))
 	return n
 }
 
-func (m *TelemetrySchemaResponse) Size() (n int) {
+func (m *MetricsExporter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Code != 0 {
-		n += 1 + sovOcp(uint64(m.Code))
-	}
-	l = len(m.Msg)
+	l = len(m.Protocol)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
+	l = m.Collector.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	if m.ThreadCount != 0 {
+		n += 1 + sovOcp(uint64(m.ThreadCount))
+	}
+	if m.BufferSize != 0 {
+		n += 1 + sovOcp(uint64(m.BufferSize))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovOcp(uint64(m.PageSize))
+	}
+	if m.TimeoutMs != 0 {
+		n += 1 + sovOcp(uint64(m.TimeoutMs))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.WindowSeconds))
+	}
+	if m.MaxRetryCount != 0 {
+		n += 1 + sovOcp(uint64(m.MaxRetryCount))
+	}
+	if m.ExportToFile {
+		n += 2
+	}
+	l = m.Spool.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	if m.Temporality != 0 {
+		n += 1 + sovOcp(uint64(m.Temporality))
+	}
 	return n
 }
 
-func (m *BloomDyeing) Size() (n int) {
+func (m *MetricsSpool) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
+	if m.Enable {
+		n += 2
+	}
+	l = len(m.Dir)
 	if l > 0 {
 		n += 1 + l + sovOcp(uint64(l))
 	}
-	if m.BitSize != 0 {
-		n += 1 + sovOcp(uint64(m.BitSize))
+	if m.MaxBytes != 0 {
+		n += 1 + sovOcp(uint64(m.MaxBytes))
 	}
-	if m.HashNumber != 0 {
-		n += 1 + sovOcp(uint64(m.HashNumber))
+	if m.MaxAgeSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.MaxAgeSeconds))
 	}
-	if len(m.Bitmap) > 0 {
-		l = 0
-		for _, e := range m.Bitmap {
-			l += sovOcp(uint64(e))
-		}
-		n += 1 + sovOcp(uint64(l)) + l
+	if m.ReplayIntervalSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.ReplayIntervalSeconds))
 	}
 	return n
 }
 
-func (m *RpcSamplingConfig) Size() (n int) {
+func (m *PrometheusPushConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Fraction != 0 {
-		n += 9
+	if m.Enable {
+		n += 2
 	}
-	if len(m.Rpc) > 0 {
-		for _, e := range m.Rpc {
-			l = e.Size()
-			n += 1 + l + sovOcp(uint64(l))
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.Job)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovOcp(uint64(m.Interval))
+	}
+	if m.UseBasicAuth {
+		n += 2
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if len(m.Grouping) > 0 {
+		for k, v := range m.Grouping {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOcp(uint64(len(k))) + 1 + len(v) + sovOcp(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOcp(uint64(mapEntrySize))
+		}
+	}
+	if len(m.HttpHeaders) > 0 {
+		for k, v := range m.HttpHeaders {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOcp(uint64(len(k))) + 1 + len(v) + sovOcp(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOcp(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *OpenTelemetryPushConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	return n
+}
+
+func (m *HistogramBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		n += 1 + sovOcp(uint64(len(m.Buckets)*8)) + len(m.Buckets)*8
+	}
+	return n
+}
+
+func (m *TracesConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	l = m.Processor.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.Exporter.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	if m.EnableSse {
+		n += 2
+	}
+	return n
+}
+
+func (m *TracesProcessor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = m.Sampler.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	if m.DisableTraceBody {
+		n += 2
+	}
+	if m.EnableDeferredSample {
+		n += 2
+	}
+	if m.DeferredSampleError {
+		n += 2
+	}
+	if m.DeferredSampleSlowDurationMs != 0 {
+		n += 1 + sovOcp(uint64(m.DeferredSampleSlowDurationMs))
+	}
+	if m.DisableParentSampling {
+		n += 2
+	}
+	l = m.WorkflowSampler.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	if m.EnableProfile {
+		n += 2
+	}
+	if m.DisableStreamTraceBody {
+		n += 2
+	}
+	l = m.TailSampler.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	return n
+}
+
+func (m *TailSamplerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	if m.DecisionWaitMs != 0 {
+		n += 1 + sovOcp(uint64(m.DecisionWaitMs))
+	}
+	if m.MaxSpans != 0 {
+		n += 1 + sovOcp(uint64(m.MaxSpans))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovOcp(uint64(m.MaxBytes))
+	}
+	if m.SampleDyeing {
+		n += 2
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TracesExporter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = m.Collector.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	if m.BufferSize != 0 {
+		n += 1 + sovOcp(uint64(m.BufferSize))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovOcp(uint64(m.PageSize))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.WindowSeconds))
+	}
+	if m.PacketSize != 0 {
+		n += 1 + sovOcp(uint64(m.PacketSize))
+	}
+	if m.ExportToFile {
+		n += 2
+	}
+	return n
+}
+
+func (m *LogsConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	l = m.Processor.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.Exporter.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	return n
+}
+
+func (m *LogsProcessor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if m.OnlyTraceLog {
+		n += 2
+	}
+	if m.TraceLogMode != 0 {
+		n += 1 + sovOcp(uint64(m.TraceLogMode))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if m.EnableRecovery {
+		n += 2
+	}
+	if m.MustLogTraced {
+		n += 2
+	}
+	l = len(m.LogTracedType)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	return n
+}
+
+func (m *LogsExporter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = m.Collector.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	if m.BufferSize != 0 {
+		n += 1 + sovOcp(uint64(m.BufferSize))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovOcp(uint64(m.PageSize))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.WindowSeconds))
+	}
+	if m.PacketSize != 0 {
+		n += 1 + sovOcp(uint64(m.PacketSize))
+	}
+	if m.ExportToFile {
+		n += 2
+	}
+	l = m.Http.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	return n
+}
+
+func (m *LogsHTTP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Encoding)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.Compression)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.ProxyUrl)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if m.TimeoutMs != 0 {
+		n += 1 + sovOcp(uint64(m.TimeoutMs))
+	}
+	l = m.Tls.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	return n
+}
+
+func (m *ClientTLS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CaFile)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.CertFile)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.KeyFile)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.ServerName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if m.InsecureSkipVerify {
+		n += 2
+	}
+	return n
+}
+
+func (m *ProfilesConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	l = m.Processor.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.Exporter.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	return n
+}
+
+func (m *ProfilesProcessor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if len(m.ProfileTypes) > 0 {
+		for _, s := range m.ProfileTypes {
+			l = len(s)
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	if m.PeriodSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.PeriodSeconds))
+	}
+	if m.CpuDurationSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.CpuDurationSeconds))
+	}
+	if m.CpuProfileRate != 0 {
+		n += 1 + sovOcp(uint64(m.CpuProfileRate))
+	}
+	if m.MutexProfileFraction != 0 {
+		n += 1 + sovOcp(uint64(m.MutexProfileFraction))
+	}
+	if m.BlockProfileRate != 0 {
+		n += 1 + sovOcp(uint64(m.BlockProfileRate))
+	}
+	if m.EnableDeltaProfiles {
+		n += 2
+	}
+	if m.EnableLinkTrace {
+		n += 2
+	}
+	l = m.Anomaly.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.Capture.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	return n
+}
+
+func (m *ProfileCapture) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if len(m.ProfileTypes) > 0 {
+		for _, s := range m.ProfileTypes {
+			l = len(s)
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	if m.DurationSeconds != 0 {
+		n += 1 + sovOcp(uint64(m.DurationSeconds))
+	}
+	if m.CpuProfileRate != 0 {
+		n += 1 + sovOcp(uint64(m.CpuProfileRate))
+	}
+	if m.ExpireAt != 0 {
+		n += 1 + sovOcp(uint64(m.ExpireAt))
+	}
+	return n
+}
+
+func (m *ProfilesAnomalyConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	if m.GrowthPeriods != 0 {
+		n += 1 + sovOcp(uint64(m.GrowthPeriods))
+	}
+	if m.GoroutineGrowth != 0 {
+		n += 1 + sovOcp(uint64(m.GoroutineGrowth))
+	}
+	if m.HeapGrowthBytes != 0 {
+		n += 1 + sovOcp(uint64(m.HeapGrowthBytes))
+	}
+	return n
+}
+
+func (m *ProfilesExporter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = m.Collector.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	if m.BufferSize != 0 {
+		n += 1 + sovOcp(uint64(m.BufferSize))
+	}
+	if m.TimeoutMs != 0 {
+		n += 1 + sovOcp(uint64(m.TimeoutMs))
+	}
+	if m.MaxRetryCount != 0 {
+		n += 1 + sovOcp(uint64(m.MaxRetryCount))
+	}
+	if m.ExportToFile {
+		n += 2
+	}
+	return n
+}
+
+func (m *SamplerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	if m.Fraction != 0 {
+		n += 9
+	}
+	if m.ErrorFraction != 0 {
+		n += 9
+	}
+	if len(m.Dyeing) > 0 {
+		for _, e := range m.Dyeing {
+			l = e.Size()
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	if m.EnableDyeing {
+		n += 2
+	}
+	if m.EnableMinSample {
+		n += 2
+	}
+	if m.EnableBloomDyeing {
+		n += 2
+	}
+	if len(m.BloomDyeing) > 0 {
+		for _, e := range m.BloomDyeing {
+			l = e.Size()
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	if len(m.RateLimit) > 0 {
+		for _, e := range m.RateLimit {
+			l = e.Size()
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	l = m.Server.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	l = m.Client.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	return n
+}
+
+func (m *WorkflowSamplerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SampleCountPerMinute != 0 {
+		n += 1 + sovOcp(uint64(m.SampleCountPerMinute))
+	}
+	if m.MaxCountPerMinute != 0 {
+		n += 1 + sovOcp(uint64(m.MaxCountPerMinute))
+	}
+	if m.PathMaxCount != 0 {
+		n += 1 + sovOcp(uint64(m.PathMaxCount))
+	}
+	if m.LifetimeSec != 0 {
+		n += 1 + sovOcp(uint64(m.LifetimeSec))
+	}
+	return n
+}
+
+func (m *TokenBucketConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if m.Rate != 0 {
+		n += 1 + sovOcp(uint64(m.Rate))
+	}
+	if m.Burst != 0 {
+		n += 1 + sovOcp(uint64(m.Burst))
+	}
+	return n
+}
+
+func (m *Dyeing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Resource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.EnvName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.ContainerName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.App)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.Server)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.SetName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.FrameCode)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.TenantId)
+	if l > 0 {
+		n += 2 + l + sovOcp(uint64(l))
+	}
+	l = len(m.CmdbId)
+	if l > 0 {
+		n += 2 + l + sovOcp(uint64(l))
+	}
+	if m.TargetType != 0 {
+		n += 2 + sovOcp(uint64(m.TargetType))
+	}
+	l = len(m.Language)
+	if l > 0 {
+		n += 2 + l + sovOcp(uint64(l))
+	}
+	l = len(m.SdkName)
+	if l > 0 {
+		n += 2 + l + sovOcp(uint64(l))
+	}
+	l = len(m.City)
+	if l > 0 {
+		n += 2 + l + sovOcp(uint64(l))
+	}
+	if m.AdminPort != 0 {
+		n += 2 + sovOcp(uint64(m.AdminPort))
+	}
+	l = len(m.ReleaseVersion)
+	if l > 0 {
+		n += 2 + l + sovOcp(uint64(l))
+	}
+	return n
+}
+
+func (m *MetricSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MonitorName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.MetricName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.MetricType)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.Aggregation)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.MetricAlias)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	l = len(m.MetricDesc)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	l = len(m.Usage)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	return n
+}
+
+func (m *LogSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LogName)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TraceSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProfileSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProfileType)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TelemetrySchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Resource.Size()
+	n += 1 + l + sovOcp(uint64(l))
+	if m.SerialNumber != 0 {
+		n += 1 + sovOcp(uint64(m.SerialNumber))
+	}
+	if len(m.MetricSchemas) > 0 {
+		for _, e := range m.MetricSchemas {
+			l = e.Size()
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	if len(m.TraceSchemas) > 0 {
+		for _, e := range m.TraceSchemas {
+			l = e.Size()
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	if len(m.LogSchemas) > 0 {
+		for _, e := range m.LogSchemas {
+			l = e.Size()
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	if len(m.ProfileSchemas) > 0 {
+		for _, e := range m.ProfileSchemas {
+			l = e.Size()
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TelemetrySchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovOcp(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	return n
+}
+
+func (m *BloomDyeing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if m.BitSize != 0 {
+		n += 1 + sovOcp(uint64(m.BitSize))
+	}
+	if m.HashNumber != 0 {
+		n += 1 + sovOcp(uint64(m.HashNumber))
+	}
+	if len(m.Bitmap) > 0 {
+		l = 0
+		for _, e := range m.Bitmap {
+			l += sovOcp(uint64(e))
+		}
+		n += 1 + sovOcp(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *RpcSamplingConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fraction != 0 {
+		n += 9
+	}
+	if len(m.Rpc) > 0 {
+		for _, e := range m.Rpc {
+			l = e.Size()
+			n += 1 + l + sovOcp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RpcConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOcp(uint64(l))
+	}
+	if m.Fraction != 0 {
+		n += 9
+	}
+	return n
+}
+
+func sovOcp(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOcp(x uint64) (n int) {
+	return sovOcp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Collector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Collector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Collector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TelemetryData", wireType)
+			}
+			m.TelemetryData = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TelemetryData |= TelemetryData(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProtocol", wireType)
+			}
+			m.DataProtocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataProtocol |= DataProtocol(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTransmission", wireType)
+			}
+			m.DataTransmission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataTransmission |= DataTransmission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectIpPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectIpPort = append(m.DirectIpPort, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Traces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Logs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Set = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profiles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Local == nil {
+				m.Local = &GetConfigResponse{}
+			}
+			if err := m.Local.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectorProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectorProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectorProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TelemetryData", wireType)
+			}
+			m.TelemetryData = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TelemetryData |= TelemetryData(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProtocol", wireType)
+			}
+			m.DataProtocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataProtocol |= DataProtocol(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTransmission", wireType)
+			}
+			m.DataTransmission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataTransmission |= DataTransmission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigServer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigServer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterServer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisterServer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfMonitor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SelfMonitor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MetricsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TracesConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TracesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogsConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LogsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfilesConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProfilesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessPoint", wireType)
+			}
+			m.AccessPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessPoint |= AccessPoint(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrometheusPush", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrometheusPush.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpentelemetryPush", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpentelemetryPush.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SelfMonitor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SelfMonitor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SelfMonitor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportSeconds", wireType)
+			}
+			m.ReportSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricsConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricsConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enable = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Processor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exporter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Exporter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableSse = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelIgnore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelIgnore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelIgnore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MonitorName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelNames = append(m.LabelNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TargetPointLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetPointLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetPointLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointLimit", wireType)
+			}
+			m.PointLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiTargetConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiTargetConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiTargetConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTargets", wireType)
+			}
+			m.MaxTargets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTargets |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointLimit", wireType)
+			}
+			m.PointLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPointLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetPointLimits = append(m.TargetPointLimits, TargetPointLimit{})
+			if err := m.TargetPointLimits[len(m.TargetPointLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTargets", wireType)
+			}
+			m.BatchTargets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTargets |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelMatcher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelMatcher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelMatcher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MonitorName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= LabelRuleAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replacement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowValues = append(m.AllowValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			m.Buckets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Buckets |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, LabelMatcher{})
+			if err := m.Selectors[len(m.Selectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *RpcConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOcp(uint64(l))
-	}
-	if m.Fraction != 0 {
-		n += 9
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovOcp(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOcp(x uint64) (n int) {
-	return sovOcp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Collector) Unmarshal(dAtA []byte) error {
+func (m *SecondGranularity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7677,15 +12455,15 @@ func (m *Collector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Collector: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecondGranularity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Collector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecondGranularity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7713,13 +12491,13 @@ func (m *Collector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.MonitorName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TelemetryData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginSecond", wireType)
 			}
-			m.TelemetryData = 0
+			m.BeginSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -7729,16 +12507,16 @@ func (m *Collector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TelemetryData |= TelemetryData(b&0x7F) << shift
+				m.BeginSecond |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProtocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSecond", wireType)
 			}
-			m.DataProtocol = 0
+			m.EndSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -7748,16 +12526,16 @@ func (m *Collector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataProtocol |= DataProtocol(b&0x7F) << shift
+				m.EndSecond |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTransmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
 			}
-			m.DataTransmission = 0
+			m.WindowSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -7767,16 +12545,16 @@ func (m *Collector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataTransmission |= DataTransmission(b&0x7F) << shift
+				m.WindowSeconds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlSeconds", wireType)
 			}
-			m.Version = 0
+			m.TtlSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -7786,16 +12564,98 @@ func (m *Collector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int32(b&0x7F) << shift
+				m.TtlSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SampleMonitor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SampleMonitor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SampleMonitor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DirectIpPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MonitorName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleType", wireType)
 			}
-			var stringLen uint64
+			m.SampleType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -7805,24 +12665,22 @@ func (m *Collector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SampleType |= MetricsSampleType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DirectIpPort = append(m.DirectIpPort, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Fraction = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcp(dAtA[iNdEx:])
@@ -7844,7 +12702,7 @@ func (m *Collector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *ExponentialHistogramConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7867,17 +12725,17 @@ func (m *GetConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExponentialHistogramConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExponentialHistogramConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -7887,29 +12745,16 @@ func (m *GetConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScale", wireType)
 			}
-			var stringLen uint64
+			m.MaxScale = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -7919,29 +12764,16 @@ func (m *GetConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxScale |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScaleSet", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -7951,128 +12783,182 @@ func (m *GetConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
+			m.MaxScaleSet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOcp
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Traces", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SummaryConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SummaryConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SummaryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeAccuracy", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Traces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RelativeAccuracy = float64(math.Float64frombits(v))
+		case 2:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Quantiles = append(m.Quantiles, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOcp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Logs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
+				if packedLen < 0 {
+					return ErrInvalidLengthOcp
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOcp
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Quantiles) == 0 {
+					m.Quantiles = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Quantiles = append(m.Quantiles, v2)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantiles", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOcp
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeriesLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeriesLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeriesLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLimit", wireType)
+			}
+			m.ClientLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8082,29 +12968,16 @@ func (m *GetConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ClientLimit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Set = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerLimit", wireType)
 			}
-			var msglen int
+			m.ServerLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8114,30 +12987,16 @@ func (m *GetConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ServerLimit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomLimit", wireType)
 			}
-			var msglen int
+			m.CustomLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8147,28 +13006,14 @@ func (m *GetConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CustomLimit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Profiles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Monitors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8195,10 +13040,8 @@ func (m *GetConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Local == nil {
-				m.Local = &GetConfigResponse{}
-			}
-			if err := m.Local.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Monitors = append(m.Monitors, MonitorSeriesLimit{})
+			if err := m.Monitors[len(m.Monitors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8223,7 +13066,7 @@ func (m *GetConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CollectorProtocol) Unmarshal(dAtA []byte) error {
+func (m *MonitorSeriesLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8246,17 +13089,17 @@ func (m *CollectorProtocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollectorProtocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: MonitorSeriesLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectorProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MonitorSeriesLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TelemetryData", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorName", wireType)
 			}
-			m.TelemetryData = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8266,54 +13109,29 @@ func (m *CollectorProtocol) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TelemetryData |= TelemetryData(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProtocol", wireType)
-			}
-			m.DataProtocol = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataProtocol |= DataProtocol(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTransmission", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
 			}
-			m.DataTransmission = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataTransmission |= DataTransmission(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			m.MonitorName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Version = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8323,7 +13141,7 @@ func (m *CollectorProtocol) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int32(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8349,7 +13167,7 @@ func (m *CollectorProtocol) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *RPCHasTwoIP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8372,34 +13190,15 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RPCHasTwoIP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RPCHasTwoIP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8427,13 +13226,13 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.MonitorName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginSecond", wireType)
 			}
-			var stringLen uint64
+			m.BeginSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8443,29 +13242,16 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BeginSecond |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Target = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigServer", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSecond", wireType)
 			}
-			var stringLen uint64
+			m.EndSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8475,27 +13261,64 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EndSecond |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOcp
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigServer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricsProcessor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricsProcessor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisterServer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8523,13 +13346,13 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegisterServer = string(dAtA[iNdEx:postIndex])
+			m.Protocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfMonitor", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
 			}
-			var msglen int
+			m.WindowSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8539,30 +13362,16 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WindowSeconds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SelfMonitor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsConfig", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearSeconds", wireType)
 			}
-			var msglen int
+			m.ClearSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8572,30 +13381,16 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClearSeconds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MetricsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TracesConfig", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresSeconds", wireType)
 			}
-			var msglen int
+			m.ExpiresSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8605,30 +13400,16 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExpiresSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TracesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogsConfig", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointLimit", wireType)
 			}
-			var msglen int
+			m.PointLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8638,30 +13419,16 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PointLimit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LogsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantId", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableProcessMetrics", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8671,29 +13438,17 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TenantId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			m.EnableProcessMetrics = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessMetricsSeconds", wireType)
 			}
-			m.Version = 0
+			m.ProcessMetricsSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8703,14 +13458,14 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int32(b&0x7F) << shift
+				m.ProcessMetricsSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfilesConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistogramBuckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8737,32 +13492,14 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProfilesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HistogramBuckets = append(m.HistogramBuckets, HistogramBucket{})
+			if err := m.HistogramBuckets[len(m.HistogramBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessPoint", wireType)
-			}
-			m.AccessPoint = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AccessPoint |= AccessPoint(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrometheusPush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelIgnores", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8789,13 +13526,34 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrometheusPush.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LabelIgnores = append(m.LabelIgnores, LabelIgnore{})
+			if err := m.LabelIgnores[len(m.LabelIgnores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetCodeAsException", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetCodeAsException = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpentelemetryPush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondGranularitys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8815,72 +13573,23 @@ func (m *GetConfigResponse) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OpentelemetryPush.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcp(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SelfMonitor) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOcp
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SecondGranularitys = append(m.SecondGranularitys, SecondGranularity{})
+			if err := m.SecondGranularitys[len(m.SecondGranularitys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SelfMonitor: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SelfMonitor: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleMonitors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8890,27 +13599,29 @@ func (m *SelfMonitor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Protocol = string(dAtA[iNdEx:postIndex])
+			m.SampleMonitors = append(m.SampleMonitors, SampleMonitor{})
+			if err := m.SampleMonitors[len(m.SampleMonitors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RpcHasTwoIps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8937,15 +13648,16 @@ func (m *SelfMonitor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RpcHasTwoIps = append(m.RpcHasTwoIps, RPCHasTwoIP{})
+			if err := m.RpcHasTwoIps[len(m.RpcHasTwoIps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportSeconds", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesLimits", wireType)
 			}
-			m.ReportSeconds = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -8955,66 +13667,30 @@ func (m *SelfMonitor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReportSeconds |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcp(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MetricsConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOcp
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SeriesLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MetricsConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExponentialHistogram", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9024,15 +13700,28 @@ func (m *MetricsConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enable = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExponentialHistogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Processor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9059,13 +13748,13 @@ func (m *MetricsConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Processor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exporter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9092,15 +13781,16 @@ func (m *MetricsConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Exporter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LabelRules = append(m.LabelRules, LabelRule{})
+			if err := m.LabelRules[len(m.LabelRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableSse", wireType)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiTarget", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9110,12 +13800,25 @@ func (m *MetricsConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableSse = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MultiTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcp(dAtA[iNdEx:])
@@ -9137,7 +13840,7 @@ func (m *MetricsConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabelIgnore) Unmarshal(dAtA []byte) error {
+func (m *MetricsExporter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9160,15 +13863,15 @@ func (m *LabelIgnore) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabelIgnore: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricsExporter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelIgnore: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricsExporter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9196,13 +13899,13 @@ func (m *LabelIgnore) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MonitorName = string(dAtA[iNdEx:postIndex])
+			m.Protocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9212,79 +13915,49 @@ func (m *LabelIgnore) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LabelNames = append(m.LabelNames, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcp(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Collector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SecondGranularity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOcp
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadCount", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SecondGranularity: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecondGranularity: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorName", wireType)
+			m.ThreadCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThreadCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferSize", wireType)
+			}
+			m.BufferSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9294,29 +13967,16 @@ func (m *SecondGranularity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BufferSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MonitorName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginSecond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			m.BeginSecond = 0
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9326,16 +13986,16 @@ func (m *SecondGranularity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BeginSecond |= int64(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndSecond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMs", wireType)
 			}
-			m.EndSecond = 0
+			m.TimeoutMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9345,12 +14005,12 @@ func (m *SecondGranularity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndSecond |= int64(b&0x7F) << shift
+				m.TimeoutMs |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
 			}
@@ -9369,11 +14029,11 @@ func (m *SecondGranularity) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TtlSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryCount", wireType)
 			}
-			m.TtlSeconds = 0
+			m.MaxRetryCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9383,66 +14043,36 @@ func (m *SecondGranularity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TtlSeconds |= int64(b&0x7F) << shift
+				m.MaxRetryCount |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcp(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SampleMonitor) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOcp
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportToFile", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SampleMonitor: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SampleMonitor: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ExportToFile = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9452,29 +14082,30 @@ func (m *SampleMonitor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MonitorName = string(dAtA[iNdEx:postIndex])
+			if err := m.Spool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SampleType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Temporality", wireType)
 			}
-			m.SampleType = 0
+			m.Temporality = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9484,22 +14115,11 @@ func (m *SampleMonitor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SampleType |= MetricsSampleType(b&0x7F) << shift
+				m.Temporality |= MetricsTemporality(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Fraction = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcp(dAtA[iNdEx:])
@@ -9521,7 +14141,7 @@ func (m *SampleMonitor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RPCHasTwoIP) Unmarshal(dAtA []byte) error {
+func (m *MetricsSpool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9544,15 +14164,35 @@ func (m *RPCHasTwoIP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RPCHasTwoIP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricsSpool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RPCHasTwoIP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricsSpool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enable = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9580,13 +14220,13 @@ func (m *RPCHasTwoIP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MonitorName = string(dAtA[iNdEx:postIndex])
+			m.Dir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginSecond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
 			}
-			m.BeginSecond = 0
+			m.MaxBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9596,16 +14236,16 @@ func (m *RPCHasTwoIP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BeginSecond |= int64(b&0x7F) << shift
+				m.MaxBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndSecond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeSeconds", wireType)
 			}
-			m.EndSecond = 0
+			m.MaxAgeSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9615,7 +14255,26 @@ func (m *RPCHasTwoIP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndSecond |= int64(b&0x7F) << shift
+				m.MaxAgeSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplayIntervalSeconds", wireType)
+			}
+			m.ReplayIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplayIntervalSeconds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9641,7 +14300,7 @@ func (m *RPCHasTwoIP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
+func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9664,15 +14323,35 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricsProcessor: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrometheusPushConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricsProcessor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrometheusPushConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enable = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9700,13 +14379,13 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Protocol = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			m.WindowSeconds = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9716,16 +14395,29 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowSeconds |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Job = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClearSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.ClearSeconds = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9735,16 +14427,16 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClearSeconds |= int32(b&0x7F) << shift
+				m.Interval |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UseBasicAuth", wireType)
 			}
-			m.ExpiresSeconds = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9754,16 +14446,17 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresSeconds |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PointLimit", wireType)
+			m.UseBasicAuth = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			m.PointLimit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9773,16 +14466,29 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PointLimit |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableProcessMetrics", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9792,17 +14498,29 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableProcessMetrics = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessMetricsSeconds", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
 			}
-			m.ProcessMetricsSeconds = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grouping", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9812,14 +14530,122 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProcessMetricsSeconds |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Grouping == nil {
+				m.Grouping = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOcp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOcp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOcp
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOcp
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOcp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOcp
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOcp
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOcp(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOcp
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Grouping[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistogramBuckets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpHeaders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9836,58 +14662,167 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HttpHeaders == nil {
+				m.HttpHeaders = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOcp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOcp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOcp
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOcp
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOcp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOcp
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOcp
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOcp(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOcp
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HttpHeaders[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOcp
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HistogramBuckets = append(m.HistogramBuckets, HistogramBucket{})
-			if err := m.HistogramBuckets[len(m.HistogramBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelIgnores", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenTelemetryPushConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LabelIgnores = append(m.LabelIgnores, LabelIgnore{})
-			if err := m.LabelIgnores[len(m.LabelIgnores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenTelemetryPushConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenTelemetryPushConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetCodeAsException", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9904,12 +14839,12 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.RetCodeAsException = bool(v != 0)
-		case 11:
+			m.Enable = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondGranularitys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9919,31 +14854,79 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecondGranularitys = append(m.SecondGranularitys, SecondGranularity{})
-			if err := m.SecondGranularitys[len(m.SecondGranularitys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 12:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistogramBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistogramBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistogramBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SampleMonitors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -9953,60 +14936,78 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SampleMonitors = append(m.SampleMonitors, SampleMonitor{})
-			if err := m.SampleMonitors[len(m.SampleMonitors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RpcHasTwoIps", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
+		case 2:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
 				}
-				if iNdEx >= l {
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Buckets = append(m.Buckets, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOcp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOcp
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOcp
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Buckets) == 0 {
+					m.Buckets = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Buckets = append(m.Buckets, v2)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RpcHasTwoIps = append(m.RpcHasTwoIps, RPCHasTwoIP{})
-			if err := m.RpcHasTwoIps[len(m.RpcHasTwoIps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcp(dAtA[iNdEx:])
@@ -10028,7 +15029,7 @@ func (m *MetricsProcessor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricsExporter) Unmarshal(dAtA []byte) error {
+func (m *TracesConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10051,17 +15052,17 @@ func (m *MetricsExporter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricsExporter: wiretype end group for non-group")
+			return fmt.Errorf("proto: TracesConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricsExporter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TracesConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -10071,27 +15072,15 @@ func (m *MetricsExporter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Protocol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Enable = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Processor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10118,72 +15107,15 @@ func (m *MetricsExporter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Processor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadCount", wireType)
-			}
-			m.ThreadCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ThreadCount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferSize", wireType)
-			}
-			m.BufferSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BufferSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exporter", wireType)
 			}
-			m.TimeoutMs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -10193,52 +15125,28 @@ func (m *MetricsExporter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutMs |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
 			}
-			m.WindowSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WindowSeconds |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryCount", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaxRetryCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxRetryCount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Exporter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 9:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExportToFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSse", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10255,7 +15163,7 @@ func (m *MetricsExporter) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ExportToFile = bool(v != 0)
+			m.EnableSse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcp(dAtA[iNdEx:])
@@ -10277,7 +15185,7 @@ func (m *MetricsExporter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
+func (m *TracesProcessor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10300,35 +15208,15 @@ func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusPushConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: TracesProcessor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusPushConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TracesProcessor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Enable = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10356,13 +15244,13 @@ func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Protocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sampler", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -10372,29 +15260,30 @@ func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Job = string(dAtA[iNdEx:postIndex])
+			if err := m.Sampler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableTraceBody", wireType)
 			}
-			m.Interval = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -10404,14 +15293,15 @@ func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DisableTraceBody = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseBasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableDeferredSample", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10428,12 +15318,12 @@ func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.UseBasicAuth = bool(v != 0)
+			m.EnableDeferredSample = bool(v != 0)
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredSampleError", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -10443,29 +15333,36 @@ func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeferredSampleError = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredSampleSlowDurationMs", wireType)
+			}
+			m.DeferredSampleSlowDurationMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeferredSampleSlowDurationMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableParentSampling", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -10475,27 +15372,15 @@ func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			m.DisableParentSampling = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grouping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSampler", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10522,109 +15407,15 @@ func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Grouping == nil {
-				m.Grouping = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOcp
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOcp
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthOcp
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthOcp
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOcp
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthOcp
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthOcp
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipOcp(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthOcp
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.WorkflowSampler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Grouping[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HttpHeaders", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableProfile", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -10634,118 +15425,64 @@ func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.HttpHeaders == nil {
-				m.HttpHeaders = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOcp
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOcp
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthOcp
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthOcp
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOcp
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthOcp
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthOcp
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipOcp(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthOcp
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			m.EnableProfile = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableStreamTraceBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.HttpHeaders[mapkey] = mapvalue
+			m.DisableStreamTraceBody = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailSampler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TailSampler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10768,7 +15505,7 @@ func (m *PrometheusPushConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OpenTelemetryPushConfig) Unmarshal(dAtA []byte) error {
+func (m *TailSamplerConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10791,10 +15528,10 @@ func (m *OpenTelemetryPushConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OpenTelemetryPushConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: TailSamplerConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpenTelemetryPushConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TailSamplerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10818,10 +15555,87 @@ func (m *OpenTelemetryPushConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Enable = bool(v != 0)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionWaitMs", wireType)
+			}
+			m.DecisionWaitMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionWaitMs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpans", wireType)
+			}
+			m.MaxSpans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSpans |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleDyeing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SampleDyeing = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -10831,23 +15645,25 @@ func (m *OpenTelemetryPushConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Attributes = append(m.Attributes, Dyeing{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10870,7 +15686,7 @@ func (m *OpenTelemetryPushConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistogramBucket) Unmarshal(dAtA []byte) error {
+func (m *TracesExporter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10893,17 +15709,139 @@ func (m *HistogramBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistogramBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: TracesExporter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistogramBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TracesExporter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferSize", wireType)
+			}
+			m.BufferSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BufferSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSize", wireType)
 			}
-			var stringLen uint64
+			m.PacketSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -10913,78 +15851,31 @@ func (m *HistogramBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PacketSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportToFile", wireType)
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.Buckets = append(m.Buckets, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOcp
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthOcp
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthOcp
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Buckets) == 0 {
-					m.Buckets = make([]float64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.Buckets = append(m.Buckets, v2)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
+			m.ExportToFile = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcp(dAtA[iNdEx:])
@@ -11006,7 +15897,7 @@ func (m *HistogramBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TracesConfig) Unmarshal(dAtA []byte) error {
+func (m *LogsConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11029,10 +15920,10 @@ func (m *TracesConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TracesConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogsConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TracesConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11121,26 +16012,6 @@ func (m *TracesConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableSse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableSse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcp(dAtA[iNdEx:])
@@ -11162,7 +16033,7 @@ func (m *TracesConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TracesProcessor) Unmarshal(dAtA []byte) error {
+func (m *LogsProcessor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11185,10 +16056,10 @@ func (m *TracesProcessor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TracesProcessor: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogsProcessor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TracesProcessor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogsProcessor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11223,11 +16094,11 @@ func (m *TracesProcessor) Unmarshal(dAtA []byte) error {
 			}
 			m.Protocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sampler", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyTraceLog", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -11237,30 +16108,17 @@ func (m *TracesProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcp
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Sampler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.OnlyTraceLog = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableTraceBody", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceLogMode", wireType)
 			}
-			var v int
+			m.TraceLogMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -11270,17 +16128,16 @@ func (m *TracesProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TraceLogMode |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableTraceBody = bool(v != 0)
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableDeferredSample", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -11290,15 +16147,27 @@ func (m *TracesProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableDeferredSample = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeferredSampleError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableRecovery", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11315,29 +16184,10 @@ func (m *TracesProcessor) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DeferredSampleError = bool(v != 0)
+			m.EnableRecovery = bool(v != 0)
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeferredSampleSlowDurationMs", wireType)
-			}
-			m.DeferredSampleSlowDurationMs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DeferredSampleSlowDurationMs |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableParentSampling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MustLogTraced", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11354,12 +16204,12 @@ func (m *TracesProcessor) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DisableParentSampling = bool(v != 0)
-		case 9:
+			m.MustLogTraced = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSampler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogTracedType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -11369,65 +16219,24 @@ func (m *TracesProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WorkflowSampler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LogTracedType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableProfile", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableProfile = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableStreamTraceBody", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcp
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DisableStreamTraceBody = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcp(dAtA[iNdEx:])
@@ -11449,7 +16258,7 @@ func (m *TracesProcessor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TracesExporter) Unmarshal(dAtA []byte) error {
+func (m *LogsExporter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11472,10 +16281,10 @@ func (m *TracesExporter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TracesExporter: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogsExporter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TracesExporter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogsExporter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11639,6 +16448,39 @@ func (m *TracesExporter) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ExportToFile = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Http", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Http.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcp(dAtA[iNdEx:])
@@ -11660,7 +16502,7 @@ func (m *TracesExporter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogsConfig) Unmarshal(dAtA []byte) error {
+func (m *LogsHTTP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11683,17 +16525,17 @@ func (m *LogsConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogsConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogsHTTP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogsHTTP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -11703,17 +16545,29 @@ func (m *LogsConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enable = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Processor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -11723,28 +16577,78 @@ func (m *LogsConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Processor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Compression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMs", wireType)
+			}
+			m.TimeoutMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutMs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exporter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11771,7 +16675,7 @@ func (m *LogsConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Exporter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tls.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11796,7 +16700,7 @@ func (m *LogsConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogsProcessor) Unmarshal(dAtA []byte) error {
+func (m *ClientTLS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11819,15 +16723,15 @@ func (m *LogsProcessor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogsProcessor: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientTLS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogsProcessor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientTLS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CaFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11855,13 +16759,13 @@ func (m *LogsProcessor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Protocol = string(dAtA[iNdEx:postIndex])
+			m.CaFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnlyTraceLog", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertFile", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -11871,17 +16775,29 @@ func (m *LogsProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OnlyTraceLog = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceLogMode", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
 			}
-			m.TraceLogMode = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFile", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -11891,14 +16807,27 @@ func (m *LogsProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TraceLogMode |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11926,11 +16855,11 @@ func (m *LogsProcessor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Level = string(dAtA[iNdEx:postIndex])
+			m.ServerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableRecovery", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11947,10 +16876,60 @@ func (m *LogsProcessor) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableRecovery = bool(v != 0)
-		case 7:
+			m.InsecureSkipVerify = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfilesConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfilesConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfilesConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MustLogTraced", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11967,12 +16946,45 @@ func (m *LogsProcessor) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.MustLogTraced = bool(v != 0)
-		case 8:
+			m.Enable = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Processor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogTracedType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exporter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -11982,23 +16994,24 @@ func (m *LogsProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogTracedType = string(dAtA[iNdEx:postIndex])
+			if err := m.Exporter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12021,7 +17034,7 @@ func (m *LogsProcessor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogsExporter) Unmarshal(dAtA []byte) error {
+func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12044,10 +17057,10 @@ func (m *LogsExporter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogsExporter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProfilesProcessor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogsExporter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProfilesProcessor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12082,11 +17095,11 @@ func (m *LogsExporter) Unmarshal(dAtA []byte) error {
 			}
 			m.Protocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12096,30 +17109,29 @@ func (m *LogsExporter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProfileTypes = append(m.ProfileTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
 			}
-			m.BufferSize = 0
+			m.PeriodSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12129,16 +17141,16 @@ func (m *LogsExporter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BufferSize |= int32(b&0x7F) << shift
+				m.PeriodSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuDurationSeconds", wireType)
 			}
-			m.PageSize = 0
+			m.CpuDurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12148,16 +17160,35 @@ func (m *LogsExporter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				m.CpuDurationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuProfileRate", wireType)
+			}
+			m.CpuProfileRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CpuProfileRate |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MutexProfileFraction", wireType)
 			}
-			m.WindowSeconds = 0
+			m.MutexProfileFraction = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12167,16 +17198,16 @@ func (m *LogsExporter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowSeconds |= int32(b&0x7F) << shift
+				m.MutexProfileFraction |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProfileRate", wireType)
 			}
-			m.PacketSize = 0
+			m.BlockProfileRate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12186,14 +17217,14 @@ func (m *LogsExporter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PacketSize |= int32(b&0x7F) << shift
+				m.BlockProfileRate |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExportToFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableDeltaProfiles", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12210,60 +17241,10 @@ func (m *LogsExporter) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ExportToFile = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcp(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOcp
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProfilesConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOcp
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProfilesConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProfilesConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EnableDeltaProfiles = bool(v != 0)
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableLinkTrace", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12280,10 +17261,10 @@ func (m *ProfilesConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Enable = bool(v != 0)
-		case 3:
+			m.EnableLinkTrace = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Processor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Anomaly", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12310,13 +17291,13 @@ func (m *ProfilesConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Processor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Anomaly.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exporter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capture", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12343,7 +17324,7 @@ func (m *ProfilesConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Exporter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Capture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12368,7 +17349,7 @@ func (m *ProfilesConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
+func (m *ProfileCapture) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12391,15 +17372,15 @@ func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProfilesProcessor: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProfileCapture: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProfilesProcessor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProfileCapture: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12427,9 +17408,9 @@ func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Protocol = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProfileTypes", wireType)
 			}
@@ -12461,11 +17442,11 @@ func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
 			}
 			m.ProfileTypes = append(m.ProfileTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
 			}
-			m.PeriodSeconds = 0
+			m.DurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12475,16 +17456,16 @@ func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeriodSeconds |= int64(b&0x7F) << shift
+				m.DurationSeconds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuDurationSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuProfileRate", wireType)
 			}
-			m.CpuDurationSeconds = 0
+			m.CpuProfileRate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12494,16 +17475,16 @@ func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CpuDurationSeconds |= int64(b&0x7F) << shift
+				m.CpuProfileRate |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuProfileRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
 			}
-			m.CpuProfileRate = 0
+			m.ExpireAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12513,16 +17494,66 @@ func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CpuProfileRate |= int32(b&0x7F) << shift
+				m.ExpireAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfilesAnomalyConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfilesAnomalyConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfilesAnomalyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MutexProfileFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
 			}
-			m.MutexProfileFraction = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12532,16 +17563,17 @@ func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MutexProfileFraction |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			m.Enable = bool(v != 0)
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockProfileRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrowthPeriods", wireType)
 			}
-			m.BlockProfileRate = 0
+			m.GrowthPeriods = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12551,16 +17583,16 @@ func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockProfileRate |= int32(b&0x7F) << shift
+				m.GrowthPeriods |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableDeltaProfiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoroutineGrowth", wireType)
 			}
-			var v int
+			m.GoroutineGrowth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12570,17 +17602,16 @@ func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GoroutineGrowth |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableDeltaProfiles = bool(v != 0)
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableLinkTrace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeapGrowthBytes", wireType)
 			}
-			var v int
+			m.HeapGrowthBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcp
@@ -12590,12 +17621,11 @@ func (m *ProfilesProcessor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.HeapGrowthBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableLinkTrace = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcp(dAtA[iNdEx:])
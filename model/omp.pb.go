@@ -40,7 +40,11 @@ const (
 	Aggregation_AGGREGATION_PROMETHEUS_HISTOGRAM Aggregation = 8
 	// Deprecated: 仅限伽利略内部兼容 prometheus 用，其他任何地方不能使用此类型，请使用 AGGREGATION_COUNTER
 	Aggregation_AGGREGATION_PROMETHEUS_COUNTER Aggregation = 9
-	Aggregation_MAX_AGGREGATION                Aggregation = 10
+	// 指数直方图统计，自动分桶，与 OpenTelemetry ExponentialHistogram 兼容
+	Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM Aggregation = 10
+	// 分位值统计，使用可合并的 DDSketch 计算，不需要预先配置分桶
+	Aggregation_AGGREGATION_SUMMARY Aggregation = 11
+	Aggregation_MAX_AGGREGATION     Aggregation = 12
 )
 
 var Aggregation_name = map[int32]string{
@@ -54,21 +58,25 @@ var Aggregation_name = map[int32]string{
 	7:  "AGGREGATION_COUNTER",
 	8:  "AGGREGATION_PROMETHEUS_HISTOGRAM",
 	9:  "AGGREGATION_PROMETHEUS_COUNTER",
-	10: "MAX_AGGREGATION",
+	10: "AGGREGATION_EXPONENTIAL_HISTOGRAM",
+	11: "AGGREGATION_SUMMARY",
+	12: "MAX_AGGREGATION",
 }
 
 var Aggregation_value = map[string]int32{
-	"AGGREGATION_NONE":                 0,
-	"AGGREGATION_SET":                  1,
-	"AGGREGATION_SUM":                  2,
-	"AGGREGATION_AVG":                  3,
-	"AGGREGATION_MAX":                  4,
-	"AGGREGATION_MIN":                  5,
-	"AGGREGATION_HISTOGRAM":            6,
-	"AGGREGATION_COUNTER":              7,
-	"AGGREGATION_PROMETHEUS_HISTOGRAM": 8,
-	"AGGREGATION_PROMETHEUS_COUNTER":   9,
-	"MAX_AGGREGATION":                  10,
+	"AGGREGATION_NONE":                  0,
+	"AGGREGATION_SET":                   1,
+	"AGGREGATION_SUM":                   2,
+	"AGGREGATION_AVG":                   3,
+	"AGGREGATION_MAX":                   4,
+	"AGGREGATION_MIN":                   5,
+	"AGGREGATION_HISTOGRAM":             6,
+	"AGGREGATION_COUNTER":               7,
+	"AGGREGATION_PROMETHEUS_HISTOGRAM":  8,
+	"AGGREGATION_PROMETHEUS_COUNTER":    9,
+	"AGGREGATION_EXPONENTIAL_HISTOGRAM": 10,
+	"AGGREGATION_SUMMARY":               11,
+	"MAX_AGGREGATION":                   12,
 }
 
 func (x Aggregation) String() string {
@@ -237,7 +245,7 @@ func (x RPCLabels_FieldName) String() string {
 }
 
 func (RPCLabels_FieldName) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_67943c9084134dd5, []int{2, 0}
+	return fileDescriptor_67943c9084134dd5, []int{3, 0}
 }
 
 type NormalLabels_FieldName int32
@@ -303,13 +311,15 @@ func (x NormalLabels_FieldName) String() string {
 }
 
 func (NormalLabels_FieldName) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_67943c9084134dd5, []int{3, 0}
+	return fileDescriptor_67943c9084134dd5, []int{4, 0}
 }
 
 // ClientMetrics 客户端 (主调方上报) 的指标。
 type ClientMetrics struct {
 	Metrics   []ClientMetrics_Metric `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics"`
 	RpcLabels RPCLabels              `protobuf:"bytes,2,opt,name=rpc_labels,json=rpcLabels,proto3" json:"rpc_labels"`
+	// 当前 span 上下文，可选，用于为耗时分布生成 exemplar。
+	SpanContext SpanContext `protobuf:"bytes,3,opt,name=span_context,json=spanContext,proto3" json:"span_context"`
 }
 
 func (m *ClientMetrics) Reset()         { *m = ClientMetrics{} }
@@ -359,6 +369,13 @@ func (m *ClientMetrics) GetRpcLabels() RPCLabels {
 	return RPCLabels{}
 }
 
+func (m *ClientMetrics) GetSpanContext() SpanContext {
+	if m != nil {
+		return m.SpanContext
+	}
+	return SpanContext{}
+}
+
 type ClientMetrics_Metric struct {
 	Name        ClientMetrics_MetricName `protobuf:"varint,1,opt,name=name,proto3,enum=model.ClientMetrics_MetricName" json:"name,omitempty"`
 	Value       float64                  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -423,6 +440,8 @@ func (m *ClientMetrics_Metric) GetAggregation() Aggregation {
 type ServerMetrics struct {
 	Metrics   []ServerMetrics_Metric `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics"`
 	RpcLabels RPCLabels              `protobuf:"bytes,2,opt,name=rpc_labels,json=rpcLabels,proto3" json:"rpc_labels"`
+	// 当前 span 上下文，可选，用于为耗时分布生成 exemplar。
+	SpanContext SpanContext `protobuf:"bytes,3,opt,name=span_context,json=spanContext,proto3" json:"span_context"`
 }
 
 func (m *ServerMetrics) Reset()         { *m = ServerMetrics{} }
@@ -472,6 +491,13 @@ func (m *ServerMetrics) GetRpcLabels() RPCLabels {
 	return RPCLabels{}
 }
 
+func (m *ServerMetrics) GetSpanContext() SpanContext {
+	if m != nil {
+		return m.SpanContext
+	}
+	return SpanContext{}
+}
+
 type ServerMetrics_Metric struct {
 	Name        ServerMetrics_MetricName `protobuf:"varint,1,opt,name=name,proto3,enum=model.ServerMetrics_MetricName" json:"name,omitempty"`
 	Value       float64                  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -532,6 +558,67 @@ func (m *ServerMetrics_Metric) GetAggregation() Aggregation {
 	return Aggregation_AGGREGATION_NONE
 }
 
+// SpanContext 指标关联的 span 上下文。
+type SpanContext struct {
+	TraceId []byte `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	SpanId  []byte `protobuf:"bytes,2,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	Sampled bool   `protobuf:"varint,3,opt,name=sampled,proto3" json:"sampled,omitempty"`
+}
+
+func (m *SpanContext) Reset()         { *m = SpanContext{} }
+func (m *SpanContext) String() string { return proto.CompactTextString(m) }
+func (*SpanContext) ProtoMessage()    {}
+func (*SpanContext) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67943c9084134dd5, []int{2}
+}
+func (m *SpanContext) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpanContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpanContext.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpanContext) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpanContext.Merge(m, src)
+}
+func (m *SpanContext) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpanContext) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpanContext.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpanContext proto.InternalMessageInfo
+
+func (m *SpanContext) GetTraceId() []byte {
+	if m != nil {
+		return m.TraceId
+	}
+	return nil
+}
+
+func (m *SpanContext) GetSpanId() []byte {
+	if m != nil {
+		return m.SpanId
+	}
+	return nil
+}
+
+func (m *SpanContext) GetSampled() bool {
+	if m != nil {
+		return m.Sampled
+	}
+	return false
+}
+
 // RPCLabels RPC 指标的标签。
 type RPCLabels struct {
 	Fields []RPCLabels_Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields"`
@@ -541,7 +628,7 @@ func (m *RPCLabels) Reset()         { *m = RPCLabels{} }
 func (m *RPCLabels) String() string { return proto.CompactTextString(m) }
 func (*RPCLabels) ProtoMessage()    {}
 func (*RPCLabels) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67943c9084134dd5, []int{2}
+	return fileDescriptor_67943c9084134dd5, []int{3}
 }
 func (m *RPCLabels) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +673,7 @@ func (m *RPCLabels_Field) Reset()         { *m = RPCLabels_Field{} }
 func (m *RPCLabels_Field) String() string { return proto.CompactTextString(m) }
 func (*RPCLabels_Field) ProtoMessage()    {}
 func (*RPCLabels_Field) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67943c9084134dd5, []int{2, 0}
+	return fileDescriptor_67943c9084134dd5, []int{3, 0}
 }
 func (m *RPCLabels_Field) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +725,7 @@ func (m *NormalLabels) Reset()         { *m = NormalLabels{} }
 func (m *NormalLabels) String() string { return proto.CompactTextString(m) }
 func (*NormalLabels) ProtoMessage()    {}
 func (*NormalLabels) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67943c9084134dd5, []int{3}
+	return fileDescriptor_67943c9084134dd5, []int{4}
 }
 func (m *NormalLabels) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +770,7 @@ func (m *NormalLabels_Field) Reset()         { *m = NormalLabels_Field{} }
 func (m *NormalLabels_Field) String() string { return proto.CompactTextString(m) }
 func (*NormalLabels_Field) ProtoMessage()    {}
 func (*NormalLabels_Field) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67943c9084134dd5, []int{3, 0}
+	return fileDescriptor_67943c9084134dd5, []int{4, 0}
 }
 func (m *NormalLabels_Field) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,7 +822,7 @@ func (m *NormalMetric) Reset()         { *m = NormalMetric{} }
 func (m *NormalMetric) String() string { return proto.CompactTextString(m) }
 func (*NormalMetric) ProtoMessage()    {}
 func (*NormalMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67943c9084134dd5, []int{4}
+	return fileDescriptor_67943c9084134dd5, []int{5}
 }
 func (m *NormalMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,13 +863,17 @@ type Metric struct {
 	Name        string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Value       float64     `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
 	Aggregation Aggregation `protobuf:"varint,3,opt,name=aggregation,proto3,enum=model.Aggregation" json:"aggregation,omitempty"`
+	// 观测次数，仅 histogram 使用，用于批量写入。为 0 时表示 1 次观测值 value；
+	// 大于 0 时表示 count 次落在 value 所在分桶的观测值，观测值总和为 sum。
+	Count uint64  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Sum   float64 `protobuf:"fixed64,5,opt,name=sum,proto3" json:"sum,omitempty"`
 }
 
 func (m *Metric) Reset()         { *m = Metric{} }
 func (m *Metric) String() string { return proto.CompactTextString(m) }
 func (*Metric) ProtoMessage()    {}
 func (*Metric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67943c9084134dd5, []int{5}
+	return fileDescriptor_67943c9084134dd5, []int{6}
 }
 func (m *Metric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -832,6 +923,20 @@ func (m *Metric) GetAggregation() Aggregation {
 	return Aggregation_AGGREGATION_NONE
 }
 
+func (m *Metric) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Metric) GetSum() float64 {
+	if m != nil {
+		return m.Sum
+	}
+	return 0
+}
+
 // Label 标签
 type Label struct {
 	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -842,7 +947,7 @@ func (m *Label) Reset()         { *m = Label{} }
 func (m *Label) String() string { return proto.CompactTextString(m) }
 func (*Label) ProtoMessage()    {}
 func (*Label) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67943c9084134dd5, []int{6}
+	return fileDescriptor_67943c9084134dd5, []int{7}
 }
 func (m *Label) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -902,7 +1007,7 @@ func (m *CustomMetrics) Reset()         { *m = CustomMetrics{} }
 func (m *CustomMetrics) String() string { return proto.CompactTextString(m) }
 func (*CustomMetrics) ProtoMessage()    {}
 func (*CustomMetrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67943c9084134dd5, []int{7}
+	return fileDescriptor_67943c9084134dd5, []int{8}
 }
 func (m *CustomMetrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -962,6 +1067,7 @@ func init() {
 	proto.RegisterType((*ClientMetrics_Metric)(nil), "model.ClientMetrics.Metric")
 	proto.RegisterType((*ServerMetrics)(nil), "model.ServerMetrics")
 	proto.RegisterType((*ServerMetrics_Metric)(nil), "model.ServerMetrics.Metric")
+	proto.RegisterType((*SpanContext)(nil), "model.SpanContext")
 	proto.RegisterType((*RPCLabels)(nil), "model.RPCLabels")
 	proto.RegisterType((*RPCLabels_Field)(nil), "model.RPCLabels.Field")
 	proto.RegisterType((*NormalLabels)(nil), "model.NormalLabels")
@@ -975,69 +1081,77 @@ func init() {
 func init() { proto.RegisterFile("omp.proto", fileDescriptor_67943c9084134dd5) }
 
 var fileDescriptor_67943c9084134dd5 = []byte{
-	// 988 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xfa, 0x5f, 0xb2, 0xcf, 0x76, 0x3b, 0x9d, 0xa4, 0x6d, 0x12, 0xc0, 0x0d, 0x16, 0x87,
-	0x08, 0x84, 0x51, 0x92, 0xa2, 0x4a, 0xf4, 0xe4, 0x46, 0xc6, 0x8d, 0x84, 0xed, 0x68, 0xe3, 0xa0,
-	0x8a, 0xcb, 0x6a, 0xba, 0xfb, 0xba, 0x59, 0xb1, 0xbb, 0x63, 0xed, 0x8e, 0x4d, 0xc3, 0x37, 0x40,
-	0xe2, 0xc0, 0x19, 0x21, 0xf8, 0x08, 0x7c, 0x8d, 0x8a, 0x53, 0x8f, 0x9c, 0x10, 0x4a, 0x24, 0x3e,
-	0x07, 0x9a, 0xd9, 0xf1, 0x66, 0xed, 0xd8, 0xe4, 0x44, 0x4f, 0xf6, 0xfc, 0xde, 0xef, 0xcd, 0x9b,
-	0xf7, 0xfb, 0x3d, 0xcf, 0x18, 0x4c, 0x1e, 0x8e, 0xdb, 0xe3, 0x98, 0x0b, 0x4e, 0x2b, 0x21, 0x77,
-	0x31, 0xd8, 0xd9, 0xf4, 0xb8, 0xc7, 0x15, 0xf2, 0x99, 0xfc, 0x96, 0x06, 0x5b, 0xbf, 0x96, 0xa0,
-	0x71, 0x14, 0xf8, 0x18, 0x89, 0x3e, 0x8a, 0xd8, 0x77, 0x12, 0xfa, 0x14, 0xd6, 0xc2, 0xf4, 0xeb,
-	0x96, 0xb1, 0x5b, 0xda, 0xab, 0x1d, 0xbc, 0xd7, 0x56, 0x1b, 0xb4, 0xe7, 0x68, 0xed, 0xf4, 0xf3,
-	0x59, 0xf9, 0xcd, 0x5f, 0x8f, 0x0a, 0xd6, 0x2c, 0x83, 0x7e, 0x0e, 0x10, 0x8f, 0x1d, 0x3b, 0x60,
-	0x2f, 0x31, 0x48, 0xb6, 0x8a, 0xbb, 0xc6, 0x5e, 0xed, 0x80, 0xe8, 0x7c, 0xeb, 0xe4, 0xe8, 0x2b,
-	0x85, 0xeb, 0x24, 0x33, 0x1e, 0x3b, 0x29, 0xb0, 0xf3, 0x83, 0x01, 0xd5, 0x74, 0x43, 0x7a, 0x08,
-	0xe5, 0x88, 0x85, 0xb8, 0x65, 0xec, 0x1a, 0x7b, 0x77, 0x0e, 0x1e, 0xfd, 0x47, 0xed, 0x01, 0x0b,
-	0xd1, 0x52, 0x64, 0xba, 0x09, 0x95, 0x29, 0x0b, 0x26, 0xa8, 0x2a, 0x1a, 0x56, 0xba, 0xa0, 0x8f,
-	0xa1, 0xc6, 0x3c, 0x2f, 0x46, 0x8f, 0x09, 0x9f, 0x47, 0x5b, 0x25, 0xb5, 0x23, 0xd5, 0x3b, 0x76,
-	0xae, 0x23, 0x56, 0x9e, 0xd6, 0xfa, 0xd1, 0x00, 0xb8, 0x2e, 0x40, 0xdf, 0x87, 0x2d, 0xd9, 0x91,
-	0xa3, 0x0e, 0x60, 0x27, 0x82, 0xc5, 0x02, 0x5d, 0x5b, 0x70, 0xc1, 0x02, 0x52, 0x58, 0x88, 0x9e,
-	0xb3, 0xc8, 0x0d, 0xb2, 0xa8, 0x41, 0x9b, 0xb0, 0xb3, 0x24, 0x9a, 0xa0, 0xc3, 0x23, 0x37, 0x21,
-	0x45, 0xda, 0x82, 0x66, 0x2e, 0xae, 0x35, 0xb4, 0xc7, 0xdc, 0x8f, 0x84, 0xed, 0xf0, 0x49, 0x24,
-	0x48, 0x49, 0x19, 0x74, 0x8a, 0xf1, 0x14, 0xe3, 0x5b, 0x0d, 0x9a, 0xa3, 0xbd, 0x63, 0x83, 0x96,
-	0xd5, 0x7e, 0xa7, 0x06, 0x25, 0xea, 0x00, 0xab, 0x0c, 0xd2, 0xd1, 0x15, 0x06, 0x2d, 0x44, 0x6f,
-	0x18, 0xa4, 0xe3, 0xcb, 0x0d, 0xfa, 0xbd, 0x0c, 0x66, 0xa6, 0x1c, 0x7d, 0x0c, 0xd5, 0x57, 0x3e,
-	0x06, 0xee, 0xcc, 0x9b, 0x07, 0x8b, 0xda, 0xb6, 0xbf, 0x94, 0x61, 0xad, 0xb0, 0xe6, 0xee, 0xf4,
-	0xa1, 0xa2, 0x60, 0xda, 0x9e, 0x13, 0x77, 0x67, 0x79, 0xf2, 0x2a, 0x5d, 0x4d, 0xad, 0x6b, 0xeb,
-	0x97, 0x12, 0x98, 0x19, 0x93, 0x52, 0xb8, 0xe3, 0xb0, 0x20, 0x90, 0xe2, 0x60, 0x3c, 0xf5, 0x1d,
-	0x24, 0x05, 0x7a, 0x0f, 0x1a, 0x1a, 0x0b, 0x51, 0x9c, 0x73, 0x97, 0x18, 0x74, 0x13, 0x88, 0x86,
-	0x1c, 0x1e, 0xd9, 0x09, 0x0a, 0xdf, 0x25, 0x45, 0xda, 0x00, 0x53, 0xa3, 0xfe, 0x98, 0x94, 0xe6,
-	0x49, 0x82, 0xf9, 0x11, 0xc6, 0xa4, 0x9c, 0x55, 0xc0, 0xac, 0x42, 0x25, 0xab, 0x80, 0xb3, 0x0a,
-	0xd5, 0x2c, 0x19, 0x73, 0x15, 0xd6, 0xb2, 0x0a, 0x28, 0x2b, 0xac, 0xcf, 0x93, 0x74, 0x05, 0x93,
-	0xae, 0x43, 0xd9, 0xe1, 0x2e, 0x12, 0x50, 0x74, 0xee, 0xa2, 0x2d, 0x2e, 0xc6, 0x48, 0x6a, 0x94,
-	0x40, 0x5d, 0x1f, 0xc8, 0x8b, 0xf9, 0x64, 0x4c, 0x1a, 0x92, 0x30, 0x49, 0x30, 0xb6, 0xf1, 0xb5,
-	0xd8, 0x27, 0x77, 0xf2, 0xcb, 0x03, 0x72, 0x37, 0xbf, 0x3c, 0x24, 0x24, 0xa7, 0x83, 0x60, 0xb1,
-	0x87, 0x82, 0xdc, 0xcb, 0x41, 0xa9, 0xed, 0x84, 0xe6, 0x7a, 0xd1, 0xac, 0x8d, 0x1c, 0xa4, 0x59,
-	0x9b, 0x14, 0xa0, 0xea, 0xb0, 0x88, 0xc5, 0x17, 0xe4, 0x3e, 0xad, 0xc3, 0xfa, 0xab, 0x80, 0x7f,
-	0x67, 0x0b, 0xe6, 0x91, 0x07, 0xb2, 0x68, 0xc8, 0x5e, 0xdb, 0xca, 0x6c, 0xf2, 0xb0, 0xf5, 0x4f,
-	0x11, 0xea, 0x03, 0x1e, 0x87, 0x2c, 0xd0, 0x43, 0xf3, 0x64, 0x61, 0x68, 0xb6, 0xb5, 0xef, 0x79,
-	0xd2, 0xd2, 0xb9, 0x39, 0x99, 0xcd, 0xcd, 0xfe, 0xdc, 0xdc, 0x7c, 0xb0, 0x32, 0xff, 0xd6, 0xd1,
-	0xf9, 0xc3, 0xc8, 0x8f, 0x0e, 0x40, 0x55, 0x77, 0x5c, 0x90, 0x4d, 0xc8, 0xbc, 0x64, 0xcc, 0x1c,
-	0x24, 0x86, 0xec, 0x10, 0xa3, 0xa9, 0x2d, 0x21, 0x52, 0x94, 0xc4, 0x18, 0x3d, 0x9f, 0x47, 0xa4,
-	0x24, 0x23, 0x7e, 0x94, 0x08, 0x16, 0x39, 0x48, 0xca, 0xd2, 0xb9, 0x48, 0x3a, 0x57, 0x51, 0x53,
-	0x32, 0xb3, 0x34, 0xcd, 0xab, 0xd2, 0x1a, 0xac, 0x4d, 0x31, 0x4e, 0x64, 0xe2, 0x9a, 0x32, 0xd9,
-	0x17, 0x17, 0x64, 0x5d, 0x6e, 0x91, 0xb8, 0xdf, 0xa6, 0x24, 0x93, 0x6e, 0xc0, 0xdd, 0x18, 0x03,
-	0x64, 0x09, 0xda, 0x33, 0x32, 0x48, 0x90, 0xc7, 0x1e, 0x8b, 0xfc, 0xef, 0xd5, 0xad, 0x60, 0xfb,
-	0x2e, 0xa9, 0xcd, 0x0b, 0x5d, 0x6f, 0x3d, 0x9d, 0xe9, 0xac, 0xaf, 0xae, 0x4f, 0xa0, 0x9a, 0xfe,
-	0x86, 0x95, 0x4e, 0xb5, 0x83, 0x86, 0xd6, 0x69, 0xee, 0xaa, 0xd4, 0x94, 0xd6, 0x79, 0x76, 0xe3,
-	0xd1, 0x9c, 0xb8, 0xe6, 0xff, 0x70, 0xa1, 0xed, 0x43, 0x45, 0x79, 0x74, 0x7b, 0xa1, 0xcc, 0xa6,
-	0x9f, 0x0d, 0x68, 0x1c, 0x4d, 0x12, 0xc1, 0xc3, 0xd9, 0xab, 0xf0, 0xe9, 0xe2, 0xab, 0xb0, 0xb4,
-	0xb9, 0xec, 0x1d, 0x78, 0x02, 0x0d, 0x47, 0xe5, 0x5f, 0x3f, 0x05, 0x32, 0xa9, 0xae, 0x93, 0xd4,
-	0x79, 0x74, 0x4e, 0x3d, 0x25, 0xea, 0x59, 0xfd, 0x10, 0xea, 0x21, 0x8f, 0x7c, 0xc1, 0x53, 0x0f,
-	0x55, 0x8f, 0xa6, 0x55, 0xd3, 0x98, 0x9c, 0x9a, 0x8f, 0x7f, 0x2b, 0x42, 0x2d, 0xd7, 0xac, 0xfc,
-	0x49, 0x77, 0x7a, 0x3d, 0xab, 0xdb, 0xeb, 0x8c, 0x8e, 0x87, 0x03, 0x7b, 0x30, 0x1c, 0x74, 0x49,
-	0x41, 0x1a, 0x98, 0x47, 0x4f, 0xbb, 0x23, 0x62, 0xdc, 0x00, 0xcf, 0xfa, 0xa4, 0xb8, 0x08, 0x76,
-	0xbe, 0xee, 0x91, 0xd2, 0x22, 0xd8, 0xef, 0xbc, 0x20, 0xe5, 0x1b, 0xe0, 0xf1, 0x80, 0x54, 0xe8,
-	0x36, 0xdc, 0xcf, 0x83, 0xcf, 0x8f, 0x4f, 0x47, 0xc3, 0x9e, 0xd5, 0xe9, 0x93, 0x2a, 0x7d, 0x08,
-	0x1b, 0xf9, 0xd0, 0xd1, 0xf0, 0x6c, 0x30, 0xea, 0x5a, 0x64, 0x8d, 0x7e, 0x04, 0xbb, 0xf9, 0xc0,
-	0x89, 0x35, 0xec, 0x77, 0x47, 0xcf, 0xbb, 0x67, 0xa7, 0xb9, 0xf4, 0x75, 0xf9, 0x3c, 0xac, 0x60,
-	0xcd, 0x76, 0x52, 0xc3, 0xdb, 0xef, 0xbc, 0xb0, 0x73, 0x3c, 0x02, 0xcf, 0xbe, 0x78, 0x73, 0xd9,
-	0x34, 0xde, 0x5e, 0x36, 0x8d, 0xbf, 0x2f, 0x9b, 0xc6, 0x4f, 0x57, 0xcd, 0xc2, 0xdb, 0xab, 0x66,
-	0xe1, 0xcf, 0xab, 0x66, 0x01, 0xb6, 0x1d, 0x1e, 0xb6, 0x05, 0x46, 0x0e, 0x46, 0xa2, 0xed, 0xb1,
-	0xc0, 0x0f, 0x50, 0xff, 0x55, 0xfb, 0x26, 0xfd, 0x1f, 0xf7, 0xb2, 0xaa, 0x56, 0x87, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0xe3, 0x2e, 0x06, 0x05, 0xe2, 0x09, 0x00, 0x00,
+	// 1115 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xf6, 0xfa, 0xdf, 0x67, 0xed, 0x74, 0x3a, 0x4d, 0x5b, 0x27, 0x80, 0x9b, 0x5a, 0x20, 0x45,
+	0x20, 0x8c, 0x9a, 0x14, 0x55, 0x22, 0x57, 0x6e, 0x64, 0x52, 0x4b, 0xb5, 0x1d, 0xad, 0x1d, 0x14,
+	0xe0, 0x62, 0x35, 0xdd, 0x9d, 0xba, 0x2b, 0x76, 0x67, 0x56, 0xbb, 0xe3, 0x90, 0xf0, 0x06, 0x48,
+	0x5c, 0xf4, 0x1a, 0xf1, 0x0e, 0xbc, 0x46, 0xc5, 0x55, 0x2f, 0x91, 0x90, 0x00, 0x25, 0x12, 0xcf,
+	0x81, 0x66, 0x76, 0xd6, 0x59, 0x3b, 0x0e, 0xb9, 0x41, 0x48, 0x5c, 0x79, 0xe7, 0x3b, 0xdf, 0x37,
+	0xe7, 0xcc, 0xf9, 0x8e, 0x66, 0x0c, 0x35, 0x1e, 0x84, 0x9d, 0x30, 0xe2, 0x82, 0xe3, 0x52, 0xc0,
+	0x5d, 0xea, 0x6f, 0xae, 0x4f, 0xf9, 0x94, 0x2b, 0xe4, 0x13, 0xf9, 0x95, 0x04, 0xdb, 0xbf, 0x15,
+	0xa0, 0xb1, 0xef, 0x7b, 0x94, 0x89, 0x01, 0x15, 0x91, 0xe7, 0xc4, 0x78, 0x0f, 0x2a, 0x41, 0xf2,
+	0xd9, 0x34, 0xb6, 0x0a, 0xdb, 0xe6, 0xce, 0x3b, 0x1d, 0xb5, 0x41, 0x67, 0x81, 0xd6, 0x49, 0x7e,
+	0x9f, 0x16, 0xdf, 0xfc, 0xfe, 0x20, 0x67, 0xa5, 0x0a, 0xfc, 0x29, 0x40, 0x14, 0x3a, 0xb6, 0x4f,
+	0x5e, 0x50, 0x3f, 0x6e, 0xe6, 0xb7, 0x8c, 0x6d, 0x73, 0x07, 0x69, 0xbd, 0x75, 0xb8, 0xff, 0x5c,
+	0xe1, 0x5a, 0x54, 0x8b, 0x42, 0x27, 0x01, 0xf0, 0x1e, 0xd4, 0xe3, 0x90, 0x30, 0xdb, 0xe1, 0x4c,
+	0xd0, 0x53, 0xd1, 0x2c, 0x28, 0x21, 0xd6, 0xc2, 0x71, 0x48, 0xd8, 0x7e, 0x12, 0xd1, 0x52, 0x33,
+	0xbe, 0x84, 0x36, 0xbf, 0x37, 0xa0, 0x9c, 0x54, 0x83, 0x77, 0xa1, 0xc8, 0x48, 0x40, 0x9b, 0xc6,
+	0x96, 0xb1, 0xbd, 0xb6, 0xf3, 0xe0, 0x1f, 0x0a, 0x1f, 0x92, 0x80, 0x5a, 0x8a, 0x8c, 0xd7, 0xa1,
+	0x74, 0x42, 0xfc, 0x19, 0x55, 0xe5, 0x1a, 0x56, 0xb2, 0xc0, 0x8f, 0xc1, 0x24, 0xd3, 0x69, 0x44,
+	0xa7, 0x44, 0x78, 0x9c, 0xa9, 0x8a, 0xd6, 0xe6, 0x15, 0x75, 0x2f, 0x23, 0x56, 0x96, 0xd6, 0xfe,
+	0xc1, 0x00, 0xb8, 0x4c, 0x80, 0xdf, 0x85, 0xa6, 0x6c, 0x87, 0xa3, 0x0a, 0xb0, 0x63, 0x41, 0x22,
+	0x41, 0x5d, 0x5b, 0x70, 0x41, 0x7c, 0x94, 0x5b, 0x8a, 0xbe, 0x22, 0xcc, 0xf5, 0xe7, 0x51, 0x03,
+	0xb7, 0x60, 0x73, 0x45, 0x34, 0xa6, 0x0e, 0x67, 0x6e, 0x8c, 0xf2, 0xb8, 0x0d, 0xad, 0x4c, 0x5c,
+	0x1b, 0x60, 0x87, 0xdc, 0x63, 0xc2, 0x76, 0xf8, 0x8c, 0x09, 0x54, 0x50, 0xee, 0x8e, 0x69, 0x74,
+	0x42, 0xa3, 0x1b, 0xdd, 0x5d, 0xa0, 0xfd, 0x9f, 0xdc, 0x5d, 0x55, 0xf8, 0x7f, 0xea, 0x6e, 0xac,
+	0x0a, 0xb8, 0xce, 0x5d, 0x1d, 0xbd, 0xc6, 0xdd, 0xa5, 0xe8, 0x15, 0x77, 0x75, 0x7c, 0xb5, 0xbb,
+	0x5f, 0x83, 0x99, 0x69, 0x1e, 0xde, 0x80, 0xaa, 0x88, 0x88, 0x43, 0x6d, 0xcf, 0x55, 0x2d, 0xaa,
+	0x5b, 0x15, 0xb5, 0xee, 0xbb, 0xf8, 0x3e, 0x54, 0x94, 0x03, 0x9e, 0xab, 0xda, 0x50, 0xb7, 0xca,
+	0x72, 0xd9, 0x77, 0x71, 0x13, 0x2a, 0x31, 0x09, 0x42, 0x9f, 0xba, 0xaa, 0x07, 0x55, 0x2b, 0x5d,
+	0xb6, 0x7f, 0x2e, 0x42, 0x6d, 0xee, 0x29, 0x7e, 0x0c, 0xe5, 0x97, 0x1e, 0xf5, 0xdd, 0x74, 0x6a,
+	0xee, 0x2d, 0xbb, 0xde, 0xf9, 0x5c, 0x86, 0xb5, 0x81, 0x9a, 0xbb, 0x39, 0x80, 0x92, 0x82, 0x71,
+	0x67, 0xc1, 0xb9, 0xcd, 0xd5, 0xe2, 0xeb, 0x4c, 0xab, 0x69, 0xd3, 0xda, 0x3f, 0x15, 0xa0, 0x36,
+	0x67, 0x62, 0x0c, 0x6b, 0x0e, 0xf1, 0x7d, 0xd9, 0x79, 0x1a, 0x9d, 0x78, 0x0e, 0x45, 0x39, 0x7c,
+	0x1b, 0x1a, 0x1a, 0x0b, 0xa8, 0x78, 0xc5, 0x5d, 0x64, 0xe0, 0x75, 0x40, 0x1a, 0x72, 0x38, 0xb3,
+	0x63, 0x2a, 0x3c, 0x17, 0xe5, 0x71, 0x03, 0x6a, 0x1a, 0xf5, 0x42, 0x54, 0x58, 0x24, 0x09, 0xe2,
+	0x31, 0x1a, 0xa1, 0xe2, 0x3c, 0x03, 0x9d, 0x67, 0x28, 0xcd, 0x33, 0xd0, 0x34, 0x43, 0x79, 0x2e,
+	0xa6, 0x99, 0x0c, 0x95, 0x79, 0x06, 0x2a, 0x33, 0x54, 0x17, 0x49, 0x3a, 0x43, 0x0d, 0x57, 0xa1,
+	0xe8, 0x70, 0x97, 0x22, 0x50, 0x74, 0xee, 0x52, 0x5b, 0x9c, 0x85, 0x14, 0x99, 0x18, 0x41, 0x5d,
+	0x17, 0x34, 0x8d, 0xf8, 0x2c, 0x44, 0x0d, 0x49, 0x98, 0xc5, 0x34, 0xb2, 0xe9, 0xa9, 0x78, 0x84,
+	0xd6, 0xb2, 0xcb, 0x1d, 0x74, 0x2b, 0xbb, 0xdc, 0x45, 0x28, 0xd3, 0x07, 0x41, 0xa2, 0x29, 0x15,
+	0xe8, 0x76, 0x06, 0x4a, 0x66, 0x0a, 0xe1, 0xcc, 0x59, 0x34, 0xeb, 0x4e, 0x06, 0xd2, 0xac, 0x75,
+	0x0c, 0x50, 0x76, 0x08, 0x23, 0xd1, 0x19, 0xba, 0x8b, 0xeb, 0x50, 0x7d, 0xe9, 0xf3, 0x6f, 0x6d,
+	0x41, 0xa6, 0xe8, 0x9e, 0x4c, 0x1a, 0x90, 0x53, 0x5b, 0x99, 0x8d, 0xee, 0xb7, 0xff, 0xca, 0x43,
+	0x7d, 0xc8, 0xa3, 0x80, 0xf8, 0x7a, 0x68, 0x9e, 0x2c, 0x0d, 0xcd, 0x86, 0xf6, 0x3d, 0x4b, 0x5a,
+	0x39, 0x37, 0x87, 0xe9, 0xdc, 0x3c, 0x5a, 0x98, 0x9b, 0xf7, 0xae, 0xd5, 0xdf, 0x38, 0x3a, 0xbf,
+	0x18, 0xd9, 0xd1, 0x01, 0x28, 0xeb, 0x13, 0xe7, 0xe4, 0x21, 0xa4, 0x2e, 0x0e, 0x89, 0x43, 0x91,
+	0x21, 0x4f, 0x48, 0xd9, 0x89, 0x2d, 0x21, 0x94, 0x97, 0xc4, 0x88, 0x4e, 0x3d, 0xce, 0x50, 0x41,
+	0x46, 0x3c, 0x16, 0x0b, 0xc2, 0x1c, 0x8a, 0x8a, 0xd2, 0x39, 0x26, 0x9d, 0x2b, 0xa9, 0x29, 0x49,
+	0x2d, 0x4d, 0x74, 0x65, 0x6c, 0x42, 0xe5, 0x84, 0x46, 0xb1, 0x14, 0x56, 0x94, 0xc9, 0x9e, 0x38,
+	0x43, 0x55, 0xb9, 0x45, 0xec, 0x7e, 0x93, 0x90, 0x6a, 0xf8, 0x0e, 0xdc, 0x8a, 0xa8, 0x4f, 0x49,
+	0x4c, 0xed, 0x94, 0x0c, 0x12, 0xe4, 0xd1, 0x94, 0x30, 0xef, 0x3b, 0x75, 0xe5, 0xd8, 0x9e, 0x8b,
+	0xcc, 0xc5, 0x46, 0xd7, 0xdb, 0x7b, 0x69, 0x9f, 0xf5, 0xbd, 0xf8, 0x11, 0x94, 0x93, 0x0b, 0x42,
+	0xf5, 0xc9, 0xdc, 0x69, 0xe8, 0x3e, 0x2d, 0x5c, 0xe2, 0x9a, 0xd2, 0x7e, 0x7d, 0x79, 0x9f, 0xe2,
+	0x4c, 0x77, 0x6b, 0xff, 0xfe, 0x75, 0x29, 0xf7, 0x52, 0x57, 0x55, 0xb3, 0xb8, 0x65, 0x6c, 0x17,
+	0xad, 0x64, 0x81, 0x11, 0x14, 0xe2, 0x59, 0xd0, 0x2c, 0xa9, 0xfd, 0xe5, 0x67, 0xfb, 0x11, 0x94,
+	0x94, 0x99, 0x37, 0x17, 0x34, 0xf7, 0xf3, 0x47, 0x03, 0x1a, 0xfb, 0xb3, 0x58, 0xf0, 0x20, 0x7d,
+	0xd8, 0x3e, 0x5e, 0x7e, 0xd8, 0x56, 0x76, 0x21, 0xe5, 0xe0, 0x27, 0xd0, 0x70, 0x94, 0xfe, 0xf2,
+	0x35, 0x93, 0xa2, 0xba, 0x16, 0xa9, 0x7a, 0xb4, 0xa6, 0x9e, 0x10, 0xf5, 0x50, 0x3f, 0x84, 0x7a,
+	0xc0, 0x99, 0x27, 0x78, 0x62, 0xb6, 0xea, 0x45, 0xcd, 0x32, 0x35, 0x26, 0xc7, 0xeb, 0xc3, 0x3f,
+	0xf2, 0x60, 0x76, 0x17, 0xfa, 0x80, 0xba, 0x07, 0x07, 0x56, 0xef, 0xa0, 0x3b, 0xe9, 0x8f, 0x86,
+	0xf6, 0x70, 0x34, 0xec, 0xa1, 0x9c, 0x74, 0x3a, 0x8b, 0x8e, 0x7b, 0x13, 0x64, 0x5c, 0x01, 0x8f,
+	0x06, 0x28, 0xbf, 0x0c, 0x76, 0xbf, 0x38, 0x40, 0x85, 0x65, 0x70, 0xd0, 0x3d, 0x46, 0xc5, 0x2b,
+	0x60, 0x7f, 0x88, 0x4a, 0x78, 0x03, 0xee, 0x66, 0xc1, 0x67, 0xfd, 0xf1, 0x64, 0x74, 0x60, 0x75,
+	0x07, 0xa8, 0x8c, 0xef, 0xc3, 0x9d, 0x6c, 0x68, 0x7f, 0x74, 0x34, 0x9c, 0xf4, 0x2c, 0x54, 0xc1,
+	0xef, 0xc3, 0x56, 0x36, 0x70, 0x68, 0x8d, 0x06, 0xbd, 0xc9, 0xb3, 0xde, 0xd1, 0x38, 0x23, 0xaf,
+	0xca, 0x47, 0xea, 0x1a, 0x56, 0xba, 0x53, 0x0d, 0x7f, 0x00, 0x0f, 0xb3, 0x9c, 0xde, 0xf1, 0xe1,
+	0x68, 0xd8, 0x1b, 0x4e, 0xfa, 0xdd, 0xe7, 0x99, 0xad, 0x60, 0xb9, 0x92, 0xf1, 0xd1, 0x60, 0xd0,
+	0xb5, 0xbe, 0x44, 0xa6, 0x3c, 0xd2, 0xa0, 0x7b, 0x6c, 0x67, 0x82, 0xa8, 0xfe, 0xf4, 0xb3, 0x37,
+	0xe7, 0x2d, 0xe3, 0xed, 0x79, 0xcb, 0xf8, 0xf3, 0xbc, 0x65, 0xbc, 0xbe, 0x68, 0xe5, 0xde, 0x5e,
+	0xb4, 0x72, 0xbf, 0x5e, 0xb4, 0x72, 0xb0, 0xe1, 0xf0, 0xa0, 0x23, 0x28, 0x73, 0x28, 0x13, 0x9d,
+	0x29, 0xf1, 0x3d, 0x9f, 0xea, 0xbf, 0xba, 0x5f, 0x25, 0xff, 0x83, 0x5f, 0x94, 0xd5, 0x6a, 0xf7,
+	0xef, 0x01, 0x00, 0xd1, 0x0d, 0x6c, 0x03, 0x22, 0x0b, 0x00, 0x00,
 }
 
 func (m *ClientMetrics) Marshal() (dAtA []byte, err error) {
@@ -1060,6 +1174,16 @@ func (m *ClientMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SpanContext.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOmp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.RpcLabels.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1146,6 +1270,16 @@ func (m *ServerMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SpanContext.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOmp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.RpcLabels.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1212,6 +1346,53 @@ func (m *ServerMetrics_Metric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpanContext) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpanContext) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpanContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sampled {
+		i--
+		if m.Sampled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SpanId) > 0 {
+		i -= len(m.SpanId)
+		copy(dAtA[i:], m.SpanId)
+		i = encodeVarintOmp(dAtA, i, uint64(len(m.SpanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TraceId) > 0 {
+		i -= len(m.TraceId)
+		copy(dAtA[i:], m.TraceId)
+		i = encodeVarintOmp(dAtA, i, uint64(len(m.TraceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RPCLabels) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1409,6 +1590,17 @@ func (m *Metric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Sum != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Sum))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Count != 0 {
+		i = encodeVarintOmp(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Aggregation != 0 {
 		i = encodeVarintOmp(dAtA, i, uint64(m.Aggregation))
 		i--
@@ -1550,6 +1742,8 @@ func (m *ClientMetrics) Size() (n int) {
 	}
 	l = m.RpcLabels.Size()
 	n += 1 + l + sovOmp(uint64(l))
+	l = m.SpanContext.Size()
+	n += 1 + l + sovOmp(uint64(l))
 	return n
 }
 
@@ -1585,6 +1779,8 @@ func (m *ServerMetrics) Size() (n int) {
 	}
 	l = m.RpcLabels.Size()
 	n += 1 + l + sovOmp(uint64(l))
+	l = m.SpanContext.Size()
+	n += 1 + l + sovOmp(uint64(l))
 	return n
 }
 
@@ -1606,6 +1802,26 @@ func (m *ServerMetrics_Metric) Size() (n int) {
 	return n
 }
 
+func (m *SpanContext) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraceId)
+	if l > 0 {
+		n += 1 + l + sovOmp(uint64(l))
+	}
+	l = len(m.SpanId)
+	if l > 0 {
+		n += 1 + l + sovOmp(uint64(l))
+	}
+	if m.Sampled {
+		n += 2
+	}
+	return n
+}
+
 func (m *RPCLabels) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1695,6 +1911,12 @@ func (m *Metric) Size() (n int) {
 	if m.Aggregation != 0 {
 		n += 1 + sovOmp(uint64(m.Aggregation))
 	}
+	if m.Count != 0 {
+		n += 1 + sovOmp(uint64(m.Count))
+	}
+	if m.Sum != 0 {
+		n += 9
+	}
 	return n
 }
 
@@ -1842,6 +2064,39 @@ func (m *ClientMetrics) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpanContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOmp(dAtA[iNdEx:])
@@ -2058,6 +2313,39 @@ func (m *ServerMetrics) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOmp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpanContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOmp(dAtA[iNdEx:])
@@ -2178,6 +2466,144 @@ func (m *ServerMetrics_Metric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SpanContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOmp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpanContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpanContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceId = append(m.TraceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TraceId == nil {
+				m.TraceId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOmp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOmp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpanId = append(m.SpanId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SpanId == nil {
+				m.SpanId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sampled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sampled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOmp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOmp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RPCLabels) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2722,6 +3148,36 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOmp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Sum = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOmp(dAtA[iNdEx:])
@@ -42,3 +42,10 @@ type OMPMetric interface {
 	// LabelValue 第 i 个标签的值。
 	LabelValue(i int) string
 }
+
+// OMPWeight 可选接口，支持批量写入 histogram 观测值的监控数据实现此接口。
+type OMPWeight interface {
+	// PointWeight 第 i 个监控点的观测次数及观测值总和。
+	// 次数为 0 表示 1 次观测值 PointValue；大于 0 表示 count 次落在 PointValue 所在分桶的观测值，总和为 sum。
+	PointWeight(i int) (count uint64, sum float64)
+}
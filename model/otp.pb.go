@@ -32,6 +32,8 @@ type Metrics struct {
 	ServerMetrics []*ServerMetricsOTP `protobuf:"bytes,4,rep,name=server_metrics,json=serverMetrics,proto3" json:"server_metrics,omitempty"`
 	NormalMetrics []*NormalMetricOTP  `protobuf:"bytes,5,rep,name=normal_metrics,json=normalMetrics,proto3" json:"normal_metrics,omitempty"`
 	CustomMetrics []*CustomMetricsOTP `protobuf:"bytes,6,rep,name=custom_metrics,json=customMetrics,proto3" json:"custom_metrics,omitempty"`
+	// 指标时间性，累计值时 COUNTER、SUM、HISTOGRAM 为从各时间线开始时间起的累计值。
+	Temporality MetricsTemporality `protobuf:"varint,7,opt,name=temporality,proto3,enum=model.MetricsTemporality" json:"temporality,omitempty"`
 }
 
 func (m *Metrics) Reset()         { *m = Metrics{} }
@@ -109,10 +111,18 @@ func (m *Metrics) GetCustomMetrics() []*CustomMetricsOTP {
 	return nil
 }
 
+func (m *Metrics) GetTemporality() MetricsTemporality {
+	if m != nil {
+		return m.Temporality
+	}
+	return MetricsTemporality_METRICS_TEMPORALITY_DELTA
+}
+
 // Bucket 直方图的桶
 type Bucket struct {
-	Range string `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
-	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Range    string    `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	Count    int64     `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Exemplar *Exemplar `protobuf:"bytes,3,opt,name=exemplar,proto3" json:"exemplar,omitempty"`
 }
 
 func (m *Bucket) Reset()         { *m = Bucket{} }
@@ -162,6 +172,13 @@ func (m *Bucket) GetCount() int64 {
 	return 0
 }
 
+func (m *Bucket) GetExemplar() *Exemplar {
+	if m != nil {
+		return m.Exemplar
+	}
+	return nil
+}
+
 // Histogram 直方图统计指标。
 // histogram 指标，实际存储时是 3 个指标：
 // {name}_sum
@@ -227,6 +244,145 @@ func (m *Histogram) GetBuckets() []*Bucket {
 	return nil
 }
 
+// ExponentialHistogram 指数直方图，与 OpenTelemetry ExponentialHistogram 定义一致。
+// 分桶边界为 base 的整数次幂，base = 2^(2^-scale)，第 index 个分桶范围为 (base^index, base^(index+1)]。
+type ExponentialHistogram struct {
+	Sum       float64             `protobuf:"fixed64,1,opt,name=sum,proto3" json:"sum,omitempty"`
+	Count     int64               `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Scale     int32               `protobuf:"varint,3,opt,name=scale,proto3" json:"scale,omitempty"`
+	ZeroCount int64               `protobuf:"varint,4,opt,name=zero_count,json=zeroCount,proto3" json:"zero_count,omitempty"`
+	Positive  *ExponentialBuckets `protobuf:"bytes,5,opt,name=positive,proto3" json:"positive,omitempty"`
+	Negative  *ExponentialBuckets `protobuf:"bytes,6,opt,name=negative,proto3" json:"negative,omitempty"`
+}
+
+func (m *ExponentialHistogram) Reset()         { *m = ExponentialHistogram{} }
+func (m *ExponentialHistogram) String() string { return proto.CompactTextString(m) }
+func (*ExponentialHistogram) ProtoMessage()    {}
+func (*ExponentialHistogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a06e9d3d924ad8, []int{3}
+}
+func (m *ExponentialHistogram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExponentialHistogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExponentialHistogram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExponentialHistogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExponentialHistogram.Merge(m, src)
+}
+func (m *ExponentialHistogram) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExponentialHistogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExponentialHistogram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExponentialHistogram proto.InternalMessageInfo
+
+func (m *ExponentialHistogram) GetSum() float64 {
+	if m != nil {
+		return m.Sum
+	}
+	return 0
+}
+
+func (m *ExponentialHistogram) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ExponentialHistogram) GetScale() int32 {
+	if m != nil {
+		return m.Scale
+	}
+	return 0
+}
+
+func (m *ExponentialHistogram) GetZeroCount() int64 {
+	if m != nil {
+		return m.ZeroCount
+	}
+	return 0
+}
+
+func (m *ExponentialHistogram) GetPositive() *ExponentialBuckets {
+	if m != nil {
+		return m.Positive
+	}
+	return nil
+}
+
+func (m *ExponentialHistogram) GetNegative() *ExponentialBuckets {
+	if m != nil {
+		return m.Negative
+	}
+	return nil
+}
+
+// ExponentialBuckets 指数直方图连续分桶。
+type ExponentialBuckets struct {
+	Offset       int32   `protobuf:"zigzag32,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	BucketCounts []int64 `protobuf:"varint,2,rep,packed,name=bucket_counts,json=bucketCounts,proto3" json:"bucket_counts,omitempty"`
+}
+
+func (m *ExponentialBuckets) Reset()         { *m = ExponentialBuckets{} }
+func (m *ExponentialBuckets) String() string { return proto.CompactTextString(m) }
+func (*ExponentialBuckets) ProtoMessage()    {}
+func (*ExponentialBuckets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a06e9d3d924ad8, []int{4}
+}
+func (m *ExponentialBuckets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExponentialBuckets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExponentialBuckets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExponentialBuckets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExponentialBuckets.Merge(m, src)
+}
+func (m *ExponentialBuckets) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExponentialBuckets) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExponentialBuckets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExponentialBuckets proto.InternalMessageInfo
+
+func (m *ExponentialBuckets) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ExponentialBuckets) GetBucketCounts() []int64 {
+	if m != nil {
+		return m.BucketCounts
+	}
+	return nil
+}
+
 // Avg 平均值指标，实际存储的时候是 2 个指标
 // {name}_sum
 // {name}_count
@@ -240,7 +396,7 @@ func (m *Avg) Reset()         { *m = Avg{} }
 func (m *Avg) String() string { return proto.CompactTextString(m) }
 func (*Avg) ProtoMessage()    {}
 func (*Avg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a06e9d3d924ad8, []int{3}
+	return fileDescriptor_54a06e9d3d924ad8, []int{5}
 }
 func (m *Avg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,6 +439,200 @@ func (m *Avg) GetCount() int64 {
 	return 0
 }
 
+// Summary 分位值指标，存储的时候拆成多个指标
+// {name}{quantile="0.5"}
+// {name}_sum
+// {name}_count
+type Summary struct {
+	Sum       float64     `protobuf:"fixed64,1,opt,name=sum,proto3" json:"sum,omitempty"`
+	Count     int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Quantiles []*Quantile `protobuf:"bytes,3,rep,name=quantiles,proto3" json:"quantiles,omitempty"`
+}
+
+func (m *Summary) Reset()         { *m = Summary{} }
+func (m *Summary) String() string { return proto.CompactTextString(m) }
+func (*Summary) ProtoMessage()    {}
+func (*Summary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a06e9d3d924ad8, []int{6}
+}
+func (m *Summary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Summary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Summary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Summary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Summary.Merge(m, src)
+}
+func (m *Summary) XXX_Size() int {
+	return m.Size()
+}
+func (m *Summary) XXX_DiscardUnknown() {
+	xxx_messageInfo_Summary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Summary proto.InternalMessageInfo
+
+func (m *Summary) GetSum() float64 {
+	if m != nil {
+		return m.Sum
+	}
+	return 0
+}
+
+func (m *Summary) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Summary) GetQuantiles() []*Quantile {
+	if m != nil {
+		return m.Quantiles
+	}
+	return nil
+}
+
+// Quantile 分位值。
+type Quantile struct {
+	Quantile float64 `protobuf:"fixed64,1,opt,name=quantile,proto3" json:"quantile,omitempty"`
+	Value    float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Quantile) Reset()         { *m = Quantile{} }
+func (m *Quantile) String() string { return proto.CompactTextString(m) }
+func (*Quantile) ProtoMessage()    {}
+func (*Quantile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a06e9d3d924ad8, []int{7}
+}
+func (m *Quantile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Quantile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Quantile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Quantile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quantile.Merge(m, src)
+}
+func (m *Quantile) XXX_Size() int {
+	return m.Size()
+}
+func (m *Quantile) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quantile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quantile proto.InternalMessageInfo
+
+func (m *Quantile) GetQuantile() float64 {
+	if m != nil {
+		return m.Quantile
+	}
+	return 0
+}
+
+func (m *Quantile) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// Exemplar 直方图分桶的样例数据，关联到具体的 trace，每个窗口每个分桶最多一个。
+type Exemplar struct {
+	Value       float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	TimestampMs int64   `protobuf:"varint,2,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	TraceId     []byte  `protobuf:"bytes,3,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	SpanId      []byte  `protobuf:"bytes,4,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	Sampled     bool    `protobuf:"varint,5,opt,name=sampled,proto3" json:"sampled,omitempty"`
+}
+
+func (m *Exemplar) Reset()         { *m = Exemplar{} }
+func (m *Exemplar) String() string { return proto.CompactTextString(m) }
+func (*Exemplar) ProtoMessage()    {}
+func (*Exemplar) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a06e9d3d924ad8, []int{8}
+}
+func (m *Exemplar) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Exemplar) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Exemplar.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Exemplar) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Exemplar.Merge(m, src)
+}
+func (m *Exemplar) XXX_Size() int {
+	return m.Size()
+}
+func (m *Exemplar) XXX_DiscardUnknown() {
+	xxx_messageInfo_Exemplar.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Exemplar proto.InternalMessageInfo
+
+func (m *Exemplar) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Exemplar) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+func (m *Exemplar) GetTraceId() []byte {
+	if m != nil {
+		return m.TraceId
+	}
+	return nil
+}
+
+func (m *Exemplar) GetSpanId() []byte {
+	if m != nil {
+		return m.SpanId
+	}
+	return nil
+}
+
+func (m *Exemplar) GetSampled() bool {
+	if m != nil {
+		return m.Sampled
+	}
+	return false
+}
+
 // MetricOTP otp 协议所用的指标。
 type MetricOTP struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -290,6 +640,8 @@ type MetricOTP struct {
 	//	*MetricOTP_Value
 	//	*MetricOTP_Avg
 	//	*MetricOTP_Histogram
+	//	*MetricOTP_ExponentialHistogram
+	//	*MetricOTP_Summary
 	V           isMetricOTP_V `protobuf_oneof:"v"`
 	Aggregation Aggregation   `protobuf:"varint,5,opt,name=aggregation,proto3,enum=model.Aggregation" json:"aggregation,omitempty"`
 }
@@ -298,7 +650,7 @@ func (m *MetricOTP) Reset()         { *m = MetricOTP{} }
 func (m *MetricOTP) String() string { return proto.CompactTextString(m) }
 func (*MetricOTP) ProtoMessage()    {}
 func (*MetricOTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a06e9d3d924ad8, []int{4}
+	return fileDescriptor_54a06e9d3d924ad8, []int{9}
 }
 func (m *MetricOTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,10 +694,18 @@ type MetricOTP_Avg struct {
 type MetricOTP_Histogram struct {
 	Histogram *Histogram `protobuf:"bytes,4,opt,name=histogram,proto3,oneof" json:"histogram,omitempty"`
 }
+type MetricOTP_ExponentialHistogram struct {
+	ExponentialHistogram *ExponentialHistogram `protobuf:"bytes,6,opt,name=exponential_histogram,json=exponentialHistogram,proto3,oneof" json:"exponential_histogram,omitempty"`
+}
+type MetricOTP_Summary struct {
+	Summary *Summary `protobuf:"bytes,7,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+}
 
-func (*MetricOTP_Value) isMetricOTP_V()     {}
-func (*MetricOTP_Avg) isMetricOTP_V()       {}
-func (*MetricOTP_Histogram) isMetricOTP_V() {}
+func (*MetricOTP_Value) isMetricOTP_V()                {}
+func (*MetricOTP_Avg) isMetricOTP_V()                  {}
+func (*MetricOTP_Histogram) isMetricOTP_V()            {}
+func (*MetricOTP_ExponentialHistogram) isMetricOTP_V() {}
+func (*MetricOTP_Summary) isMetricOTP_V()              {}
 
 func (m *MetricOTP) GetV() isMetricOTP_V {
 	if m != nil {
@@ -382,6 +742,20 @@ func (m *MetricOTP) GetHistogram() *Histogram {
 	return nil
 }
 
+func (m *MetricOTP) GetExponentialHistogram() *ExponentialHistogram {
+	if x, ok := m.GetV().(*MetricOTP_ExponentialHistogram); ok {
+		return x.ExponentialHistogram
+	}
+	return nil
+}
+
+func (m *MetricOTP) GetSummary() *Summary {
+	if x, ok := m.GetV().(*MetricOTP_Summary); ok {
+		return x.Summary
+	}
+	return nil
+}
+
 func (m *MetricOTP) GetAggregation() Aggregation {
 	if m != nil {
 		return m.Aggregation
@@ -395,6 +769,8 @@ func (*MetricOTP) XXX_OneofWrappers() []interface{} {
 		(*MetricOTP_Value)(nil),
 		(*MetricOTP_Avg)(nil),
 		(*MetricOTP_Histogram)(nil),
+		(*MetricOTP_ExponentialHistogram)(nil),
+		(*MetricOTP_Summary)(nil),
 	}
 }
 
@@ -404,13 +780,14 @@ type ClientMetricsOTP struct {
 	RpcClientHandledTotal   int64      `protobuf:"varint,2,opt,name=rpc_client_handled_total,json=rpcClientHandledTotal,proto3" json:"rpc_client_handled_total,omitempty"`
 	RpcClientHandledSeconds *Histogram `protobuf:"bytes,3,opt,name=rpc_client_handled_seconds,json=rpcClientHandledSeconds,proto3" json:"rpc_client_handled_seconds,omitempty"`
 	RpcLabels               *RPCLabels `protobuf:"bytes,4,opt,name=rpc_labels,json=rpcLabels,proto3" json:"rpc_labels,omitempty"`
+	StartTimestampMs        int64      `protobuf:"varint,5,opt,name=start_timestamp_ms,json=startTimestampMs,proto3" json:"start_timestamp_ms,omitempty"`
 }
 
 func (m *ClientMetricsOTP) Reset()         { *m = ClientMetricsOTP{} }
 func (m *ClientMetricsOTP) String() string { return proto.CompactTextString(m) }
 func (*ClientMetricsOTP) ProtoMessage()    {}
 func (*ClientMetricsOTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a06e9d3d924ad8, []int{5}
+	return fileDescriptor_54a06e9d3d924ad8, []int{10}
 }
 func (m *ClientMetricsOTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,19 +844,27 @@ func (m *ClientMetricsOTP) GetRpcLabels() *RPCLabels {
 	return nil
 }
 
+func (m *ClientMetricsOTP) GetStartTimestampMs() int64 {
+	if m != nil {
+		return m.StartTimestampMs
+	}
+	return 0
+}
+
 // ServerMetricsOTP otp 协议服务端上报指标。
 type ServerMetricsOTP struct {
 	RpcServerStartedTotal   int64      `protobuf:"varint,1,opt,name=rpc_server_started_total,json=rpcServerStartedTotal,proto3" json:"rpc_server_started_total,omitempty"`
 	RpcServerHandledTotal   int64      `protobuf:"varint,2,opt,name=rpc_server_handled_total,json=rpcServerHandledTotal,proto3" json:"rpc_server_handled_total,omitempty"`
 	RpcServerHandledSeconds *Histogram `protobuf:"bytes,3,opt,name=rpc_server_handled_seconds,json=rpcServerHandledSeconds,proto3" json:"rpc_server_handled_seconds,omitempty"`
 	RpcLabels               *RPCLabels `protobuf:"bytes,4,opt,name=rpc_labels,json=rpcLabels,proto3" json:"rpc_labels,omitempty"`
+	StartTimestampMs        int64      `protobuf:"varint,5,opt,name=start_timestamp_ms,json=startTimestampMs,proto3" json:"start_timestamp_ms,omitempty"`
 }
 
 func (m *ServerMetricsOTP) Reset()         { *m = ServerMetricsOTP{} }
 func (m *ServerMetricsOTP) String() string { return proto.CompactTextString(m) }
 func (*ServerMetricsOTP) ProtoMessage()    {}
 func (*ServerMetricsOTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a06e9d3d924ad8, []int{6}
+	return fileDescriptor_54a06e9d3d924ad8, []int{11}
 }
 func (m *ServerMetricsOTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,16 +921,24 @@ func (m *ServerMetricsOTP) GetRpcLabels() *RPCLabels {
 	return nil
 }
 
+func (m *ServerMetricsOTP) GetStartTimestampMs() int64 {
+	if m != nil {
+		return m.StartTimestampMs
+	}
+	return 0
+}
+
 // NormalMetricOTP otp 协议一般指标。
 type NormalMetricOTP struct {
-	Metric *MetricOTP `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	Metric           *MetricOTP `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	StartTimestampMs int64      `protobuf:"varint,2,opt,name=start_timestamp_ms,json=startTimestampMs,proto3" json:"start_timestamp_ms,omitempty"`
 }
 
 func (m *NormalMetricOTP) Reset()         { *m = NormalMetricOTP{} }
 func (m *NormalMetricOTP) String() string { return proto.CompactTextString(m) }
 func (*NormalMetricOTP) ProtoMessage()    {}
 func (*NormalMetricOTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a06e9d3d924ad8, []int{7}
+	return fileDescriptor_54a06e9d3d924ad8, []int{12}
 }
 func (m *NormalMetricOTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,6 +974,13 @@ func (m *NormalMetricOTP) GetMetric() *MetricOTP {
 	return nil
 }
 
+func (m *NormalMetricOTP) GetStartTimestampMs() int64 {
+	if m != nil {
+		return m.StartTimestampMs
+	}
+	return 0
+}
+
 // CustomMetricOTP otp 协议自定义指标。与 NormalMetricOTP 的区别是，多了
 // custom_labels。
 type CustomMetricsOTP struct {
@@ -592,13 +992,15 @@ type CustomMetricsOTP struct {
 	CustomLabels []*Label `protobuf:"bytes,2,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty"`
 	// 监控项名
 	MonitorName string `protobuf:"bytes,3,opt,name=monitor_name,json=monitorName,proto3" json:"monitor_name,omitempty"`
+	// 累计值的开始时间，毫秒，差值时为 0。
+	StartTimestampMs int64 `protobuf:"varint,4,opt,name=start_timestamp_ms,json=startTimestampMs,proto3" json:"start_timestamp_ms,omitempty"`
 }
 
 func (m *CustomMetricsOTP) Reset()         { *m = CustomMetricsOTP{} }
 func (m *CustomMetricsOTP) String() string { return proto.CompactTextString(m) }
 func (*CustomMetricsOTP) ProtoMessage()    {}
 func (*CustomMetricsOTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a06e9d3d924ad8, []int{8}
+	return fileDescriptor_54a06e9d3d924ad8, []int{13}
 }
 func (m *CustomMetricsOTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,6 +1050,13 @@ func (m *CustomMetricsOTP) GetMonitorName() string {
 	return ""
 }
 
+func (m *CustomMetricsOTP) GetStartTimestampMs() int64 {
+	if m != nil {
+		return m.StartTimestampMs
+	}
+	return 0
+}
+
 // MultiTargetMetrics 多服务指标合集（不同 NormalLabels 指标合集）。
 type MultiTargetMetrics struct {
 	Metrics []*Metrics `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
@@ -657,7 +1066,7 @@ func (m *MultiTargetMetrics) Reset()         { *m = MultiTargetMetrics{} }
 func (m *MultiTargetMetrics) String() string { return proto.CompactTextString(m) }
 func (*MultiTargetMetrics) ProtoMessage()    {}
 func (*MultiTargetMetrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a06e9d3d924ad8, []int{9}
+	return fileDescriptor_54a06e9d3d924ad8, []int{14}
 }
 func (m *MultiTargetMetrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -706,13 +1115,15 @@ type ProfilesBatch struct {
 	Profiles []*Profile `protobuf:"bytes,4,rep,name=profiles,proto3" json:"profiles,omitempty"`
 	// 对应 resource 信息
 	Resource *Resource `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	// batch 标签，按需采集时带上 capture_id、trigger，周期采集时为空。
+	Tags map[string]string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *ProfilesBatch) Reset()         { *m = ProfilesBatch{} }
 func (m *ProfilesBatch) String() string { return proto.CompactTextString(m) }
 func (*ProfilesBatch) ProtoMessage()    {}
 func (*ProfilesBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a06e9d3d924ad8, []int{10}
+	return fileDescriptor_54a06e9d3d924ad8, []int{15}
 }
 func (m *ProfilesBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,6 +1187,13 @@ func (m *ProfilesBatch) GetResource() *Resource {
 	return nil
 }
 
+func (m *ProfilesBatch) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 // Profile 性能数据
 type Profile struct {
 	// profile 名称，如 cpu.pprof、delta-heap.pprof 等。
@@ -790,7 +1208,7 @@ func (m *Profile) Reset()         { *m = Profile{} }
 func (m *Profile) String() string { return proto.CompactTextString(m) }
 func (*Profile) ProtoMessage()    {}
 func (*Profile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a06e9d3d924ad8, []int{11}
+	return fileDescriptor_54a06e9d3d924ad8, []int{16}
 }
 func (m *Profile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,11 +1258,71 @@ func (m *Profile) GetData() []byte {
 	return nil
 }
 
+// ExportResponse otp 数据上报响应。
+type ExportResponse struct {
+	// 返回码，0 表示成功。
+	Code int32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// 错误信息。
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a06e9d3d924ad8, []int{17}
+}
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ExportResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Metrics)(nil), "model.Metrics")
 	proto.RegisterType((*Bucket)(nil), "model.Bucket")
 	proto.RegisterType((*Histogram)(nil), "model.Histogram")
+	proto.RegisterType((*ExponentialHistogram)(nil), "model.ExponentialHistogram")
+	proto.RegisterType((*ExponentialBuckets)(nil), "model.ExponentialBuckets")
 	proto.RegisterType((*Avg)(nil), "model.Avg")
+	proto.RegisterType((*Summary)(nil), "model.Summary")
+	proto.RegisterType((*Quantile)(nil), "model.Quantile")
+	proto.RegisterType((*Exemplar)(nil), "model.Exemplar")
 	proto.RegisterType((*MetricOTP)(nil), "model.MetricOTP")
 	proto.RegisterType((*ClientMetricsOTP)(nil), "model.ClientMetricsOTP")
 	proto.RegisterType((*ServerMetricsOTP)(nil), "model.ServerMetricsOTP")
@@ -852,64 +1330,94 @@ func init() {
 	proto.RegisterType((*CustomMetricsOTP)(nil), "model.CustomMetricsOTP")
 	proto.RegisterType((*MultiTargetMetrics)(nil), "model.MultiTargetMetrics")
 	proto.RegisterType((*ProfilesBatch)(nil), "model.ProfilesBatch")
+	proto.RegisterMapType((map[string]string)(nil), "model.ProfilesBatch.TagsEntry")
 	proto.RegisterType((*Profile)(nil), "model.Profile")
+	proto.RegisterType((*ExportResponse)(nil), "model.ExportResponse")
 }
 
 func init() { proto.RegisterFile("otp.proto", fileDescriptor_54a06e9d3d924ad8) }
 
 var fileDescriptor_54a06e9d3d924ad8 = []byte{
-	// 811 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6e, 0xfb, 0x44,
-	0x10, 0x8e, 0xe3, 0xfc, 0xa9, 0x27, 0x7f, 0x7e, 0xd1, 0x52, 0xda, 0x90, 0x43, 0x94, 0xfa, 0x42,
-	0x54, 0x44, 0x0a, 0xa1, 0x12, 0x08, 0x44, 0xa5, 0xa6, 0x42, 0xca, 0x81, 0x94, 0x6a, 0xdb, 0x13,
-	0x42, 0x8a, 0xb6, 0xce, 0xe2, 0x5a, 0xd8, 0x5e, 0xb3, 0xbb, 0x8e, 0xc4, 0x9d, 0x07, 0xe0, 0xc0,
-	0x63, 0xf0, 0x18, 0x1c, 0x38, 0xf6, 0xc8, 0x11, 0xb5, 0xe2, 0x3d, 0x90, 0x77, 0xd7, 0x4e, 0xe2,
-	0xa6, 0x52, 0xc5, 0x6d, 0x76, 0xbe, 0xf9, 0xc6, 0xdf, 0x7e, 0xd9, 0x99, 0x80, 0xc3, 0x64, 0x32,
-	0x49, 0x38, 0x93, 0x0c, 0xd5, 0x23, 0xb6, 0xa2, 0xe1, 0xe0, 0xd0, 0x67, 0x3e, 0x53, 0x99, 0xb3,
-	0x2c, 0xd2, 0xe0, 0xc0, 0x61, 0x51, 0x52, 0x84, 0x9e, 0x09, 0xdd, 0x7f, 0xab, 0xd0, 0x5c, 0x50,
-	0xc9, 0x03, 0x4f, 0xa0, 0x13, 0x68, 0xcb, 0x20, 0xa2, 0x42, 0x92, 0x28, 0x59, 0x46, 0xa2, 0x6f,
-	0x8d, 0xac, 0xb1, 0x8d, 0x5b, 0x45, 0x6e, 0x21, 0xd0, 0x17, 0xd0, 0x89, 0x19, 0x8f, 0x48, 0xb8,
-	0x0c, 0xc9, 0x3d, 0x0d, 0x45, 0xbf, 0x3a, 0xb2, 0xc6, 0xad, 0xe9, 0x7b, 0x13, 0xf5, 0xe5, 0xc9,
-	0xb5, 0xc2, 0xbe, 0x55, 0x10, 0x6e, 0xc7, 0x5b, 0x27, 0x74, 0x01, 0x5d, 0x2f, 0x0c, 0x68, 0x2c,
-	0x97, 0x91, 0xfe, 0x5c, 0xdf, 0x1e, 0xd9, 0xe3, 0xd6, 0xf4, 0xd8, 0x50, 0xaf, 0x14, 0x68, 0xa4,
-	0x7c, 0x77, 0x77, 0x83, 0x3b, 0xde, 0x76, 0x26, 0xe3, 0x0b, 0xca, 0xd7, 0x94, 0x17, 0xfc, 0xda,
-	0x0e, 0xff, 0x56, 0x81, 0xdb, 0x7c, 0xb1, 0x9d, 0x41, 0x5f, 0x43, 0xd7, 0x28, 0xcf, 0xf9, 0x75,
-	0xc5, 0x3f, 0xda, 0x91, 0xae, 0xab, 0x15, 0x3d, 0xde, 0x4a, 0x68, 0xf9, 0xa9, 0x90, 0x2c, 0x2a,
-	0xe8, 0x8d, 0x5d, 0xf9, 0x0a, 0xdc, 0x91, 0xbf, 0x9d, 0x71, 0xcf, 0xa1, 0x31, 0x4b, 0xbd, 0x9f,
-	0xa8, 0x44, 0x87, 0x50, 0xe7, 0x24, 0xf6, 0xa9, 0xb2, 0xd7, 0xc1, 0xfa, 0x90, 0x65, 0x3d, 0x96,
-	0xc6, 0x52, 0x19, 0x6a, 0x63, 0x7d, 0x70, 0x7f, 0x00, 0x67, 0x1e, 0x08, 0xc9, 0x7c, 0x4e, 0x22,
-	0xd4, 0x03, 0x5b, 0xa4, 0x91, 0xa2, 0x59, 0x38, 0x0b, 0xf7, 0x93, 0xd0, 0x87, 0xd0, 0xbc, 0x57,
-	0x9f, 0xca, 0x2d, 0xee, 0x18, 0x8d, 0x5a, 0x00, 0xce, 0x51, 0xf7, 0x63, 0xb0, 0x2f, 0xd7, 0xfe,
-	0x5b, 0xfb, 0xba, 0x7f, 0x5a, 0xe0, 0x14, 0xfe, 0x20, 0x04, 0xb5, 0x98, 0x44, 0xf9, 0x2d, 0x54,
-	0x8c, 0x8e, 0xa0, 0xbe, 0x26, 0x61, 0x4a, 0x15, 0xcf, 0x9a, 0x57, 0xb0, 0x3e, 0xa2, 0x21, 0xd8,
-	0x64, 0xed, 0xf7, 0x6d, 0xf5, 0x56, 0xc0, 0xa8, 0xb9, 0x5c, 0xfb, 0xf3, 0x0a, 0xce, 0x00, 0xf4,
-	0x09, 0x38, 0x0f, 0xf9, 0x35, 0xfb, 0x35, 0x55, 0xd5, 0x33, 0x55, 0xc5, 0xf5, 0xe7, 0x15, 0xbc,
-	0x29, 0x42, 0xe7, 0xd0, 0x22, 0xbe, 0xcf, 0xa9, 0x4f, 0x64, 0xc0, 0xe2, 0x7e, 0x7d, 0x64, 0x8d,
-	0xbb, 0x53, 0x94, 0x77, 0xde, 0x20, 0x78, 0xbb, 0x6c, 0x66, 0x83, 0xb5, 0x76, 0x7f, 0xad, 0x42,
-	0xaf, 0xfc, 0xd8, 0xd0, 0xe7, 0xd0, 0xe7, 0x89, 0xb7, 0x34, 0x2f, 0x54, 0x48, 0xc2, 0x25, 0x5d,
-	0x2d, 0x25, 0x93, 0x24, 0x34, 0x63, 0xf0, 0x3e, 0x4f, 0x3c, 0x4d, 0xbb, 0xd5, 0xe8, 0x5d, 0x06,
-	0x96, 0x88, 0x0f, 0x24, 0x5e, 0x85, 0x05, 0xb1, 0x5a, 0x22, 0xce, 0x35, 0xaa, 0x89, 0x0b, 0x18,
-	0xec, 0x21, 0x0a, 0xea, 0xb1, 0x78, 0x25, 0x8c, 0x55, 0x2f, 0x4c, 0xc0, 0xc7, 0xe5, 0x66, 0xb7,
-	0x9a, 0x80, 0xce, 0x00, 0xb2, 0x76, 0x66, 0x2a, 0x77, 0x3d, 0xc4, 0x37, 0x57, 0x66, 0x24, 0x1d,
-	0x9e, 0x78, 0x3a, 0x54, 0x36, 0x94, 0x67, 0x26, 0xbf, 0x8d, 0x19, 0xb4, 0xd7, 0x6c, 0xd0, 0xb4,
-	0x7d, 0x36, 0x18, 0xe2, 0x6b, 0x36, 0x68, 0xe2, 0x3e, 0x1b, 0x4a, 0xc4, 0xb7, 0xd8, 0xb0, 0xd3,
-	0xec, 0x7f, 0xdb, 0xf0, 0x15, 0xbc, 0x2b, 0x4d, 0x3e, 0x1a, 0x43, 0x43, 0xcf, 0xb8, 0xba, 0xf2,
-	0x86, 0xbf, 0xd9, 0x0d, 0x06, 0x77, 0x7f, 0xb7, 0xa0, 0x57, 0x1e, 0x7c, 0x74, 0x0a, 0xcd, 0x7c,
-	0x45, 0x58, 0x6a, 0xfc, 0x5e, 0xf2, 0xf3, 0x02, 0xf4, 0x29, 0x98, 0x35, 0xb1, 0x59, 0xa7, 0x19,
-	0xa3, 0x6d, 0x18, 0x4a, 0x23, 0x6e, 0xeb, 0x12, 0xb3, 0x47, 0x4f, 0xa0, 0x1d, 0xb1, 0x38, 0x90,
-	0x8c, 0x2f, 0xd5, 0xfc, 0xd9, 0x6a, 0xfe, 0x5a, 0x26, 0x77, 0x4d, 0x22, 0xea, 0x5e, 0x00, 0x5a,
-	0xa4, 0xa1, 0x0c, 0xee, 0x08, 0xf7, 0x69, 0xb1, 0x40, 0xc7, 0x65, 0x5d, 0xdd, 0x1d, 0x5d, 0xa2,
-	0x50, 0xe5, 0xfe, 0x61, 0x41, 0xe7, 0x86, 0xb3, 0x1f, 0x83, 0x90, 0x8a, 0x19, 0x91, 0xde, 0x03,
-	0x1a, 0xc0, 0x81, 0xa0, 0x3f, 0xa7, 0x34, 0xf6, 0xa8, 0x79, 0x07, 0xc5, 0x39, 0x5b, 0x16, 0xea,
-	0xa1, 0xe4, 0xcb, 0x42, 0x1d, 0xb2, 0xa5, 0x42, 0xe3, 0x95, 0x52, 0x67, 0xe3, 0x2c, 0x44, 0xa7,
-	0x70, 0x90, 0x98, 0xa6, 0x66, 0x75, 0xe7, 0x02, 0xcc, 0xb7, 0x70, 0x81, 0xa3, 0x8f, 0xe0, 0x80,
-	0x53, 0xc1, 0x52, 0xee, 0x51, 0x35, 0xdb, 0xad, 0xe9, 0xbb, 0xfc, 0x47, 0x34, 0x69, 0x5c, 0x14,
-	0xb8, 0xdf, 0x40, 0xd3, 0x74, 0xd8, 0xbb, 0x94, 0x10, 0xd4, 0xe4, 0x2f, 0x89, 0xde, 0x49, 0x0e,
-	0x56, 0x71, 0x96, 0x5b, 0x11, 0x49, 0x94, 0xbc, 0x36, 0x56, 0xf1, 0xec, 0xcb, 0xbf, 0x9e, 0x86,
-	0xd6, 0xe3, 0xd3, 0xd0, 0xfa, 0xe7, 0x69, 0x68, 0xfd, 0xf6, 0x3c, 0xac, 0x3c, 0x3e, 0x0f, 0x2b,
-	0x7f, 0x3f, 0x0f, 0x2b, 0xf0, 0x81, 0xc7, 0xa2, 0x89, 0xcc, 0x2e, 0x1b, 0xcb, 0x89, 0x4f, 0xc2,
-	0x20, 0xa4, 0xe6, 0x4f, 0xf5, 0x7b, 0xfd, 0x8f, 0x7b, 0xdf, 0x50, 0xa7, 0xcf, 0xfe, 0x0b, 0x00,
-	0x00, 0xff, 0xff, 0x55, 0x24, 0xf3, 0x9e, 0x8c, 0x07, 0x00, 0x00,
+	// 1255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x7a, 0xfd, 0xf9, 0xec, 0xb8, 0x61, 0x48, 0x5b, 0x27, 0x08, 0x2b, 0x5d, 0x0e, 0x58,
+	0x85, 0xa6, 0xc5, 0x7c, 0xb4, 0x2a, 0x50, 0xa9, 0xa9, 0x22, 0xa5, 0x12, 0x69, 0xd3, 0x89, 0x4f,
+	0x08, 0xc9, 0x4c, 0xd7, 0xd3, 0xed, 0xaa, 0xbb, 0x3b, 0xdb, 0x99, 0xb1, 0xd5, 0xf0, 0x2f, 0x70,
+	0x81, 0x23, 0x57, 0xfe, 0x04, 0xfe, 0x04, 0xc4, 0x81, 0x63, 0x8f, 0x1c, 0xa1, 0xfd, 0x47, 0xd0,
+	0x7c, 0xad, 0xbd, 0x8e, 0x03, 0xe5, 0xc2, 0xed, 0x7d, 0xfd, 0xe6, 0x7d, 0xcc, 0xdb, 0xf7, 0x66,
+	0xa1, 0xcd, 0x64, 0xbe, 0x97, 0x73, 0x26, 0x19, 0xaa, 0xa7, 0x6c, 0x4a, 0x93, 0x9d, 0xad, 0x88,
+	0x45, 0x4c, 0x4b, 0xae, 0x2b, 0xca, 0x28, 0x77, 0xda, 0x2c, 0xcd, 0x0b, 0x32, 0xb4, 0x64, 0xf0,
+	0xb3, 0x0f, 0xcd, 0x23, 0x2a, 0x79, 0x1c, 0x0a, 0x74, 0x05, 0xba, 0x32, 0x4e, 0xa9, 0x90, 0x24,
+	0xcd, 0x27, 0xa9, 0xe8, 0x7b, 0xbb, 0xde, 0xd0, 0xc7, 0x9d, 0x42, 0x76, 0x24, 0xd0, 0x2d, 0xd8,
+	0xc8, 0x18, 0x4f, 0x49, 0x32, 0x49, 0xc8, 0x63, 0x9a, 0x88, 0x7e, 0x75, 0xd7, 0x1b, 0x76, 0x46,
+	0x6f, 0xef, 0x69, 0xcf, 0x7b, 0x0f, 0xb4, 0xee, 0x2b, 0xad, 0xc2, 0xdd, 0x6c, 0x89, 0x43, 0x77,
+	0xa0, 0x17, 0x26, 0x31, 0xcd, 0xe4, 0x24, 0x35, 0xee, 0xfa, 0xfe, 0xae, 0x3f, 0xec, 0x8c, 0x2e,
+	0x5b, 0xe8, 0x3d, 0xad, 0xb4, 0xa1, 0x3c, 0x1c, 0x1f, 0xe3, 0x8d, 0x70, 0x59, 0xa2, 0xf0, 0x82,
+	0xf2, 0x39, 0xe5, 0x05, 0xbe, 0x56, 0xc2, 0x9f, 0x68, 0xe5, 0x32, 0x5e, 0x2c, 0x4b, 0xd0, 0x97,
+	0xd0, 0xb3, 0x91, 0x3b, 0x7c, 0x5d, 0xe3, 0x2f, 0x95, 0x42, 0x37, 0xd6, 0x1a, 0x9e, 0x2d, 0x09,
+	0x4c, 0xf8, 0x33, 0x21, 0x59, 0x5a, 0xc0, 0x1b, 0xe5, 0xf0, 0xb5, 0xb2, 0x14, 0xfe, 0xb2, 0x04,
+	0x7d, 0x0e, 0x1d, 0x49, 0xd3, 0x9c, 0x71, 0x92, 0xc4, 0xf2, 0xb4, 0xdf, 0xdc, 0xf5, 0x86, 0xbd,
+	0xd1, 0xb6, 0x05, 0x5b, 0xa3, 0xf1, 0xc2, 0x00, 0x2f, 0x5b, 0x07, 0x04, 0x1a, 0xfb, 0xb3, 0xf0,
+	0x19, 0x95, 0x68, 0x0b, 0xea, 0x9c, 0x64, 0x11, 0xd5, 0x77, 0xd3, 0xc6, 0x86, 0x51, 0xd2, 0x90,
+	0xcd, 0x32, 0xa9, 0x6f, 0xc3, 0xc7, 0x86, 0x41, 0x1f, 0x40, 0x8b, 0xbe, 0xa0, 0x69, 0x9e, 0x10,
+	0xde, 0xf7, 0xf5, 0x35, 0x5d, 0xb0, 0xfe, 0x0e, 0xac, 0x18, 0x17, 0x06, 0xc1, 0x37, 0xd0, 0x3e,
+	0x8c, 0x85, 0x64, 0x11, 0x27, 0x29, 0xda, 0x04, 0x5f, 0xcc, 0x52, 0xed, 0xc3, 0xc3, 0x8a, 0x3c,
+	0xc7, 0xc3, 0xfb, 0xd0, 0x7c, 0xac, 0xe3, 0x72, 0x97, 0xb9, 0x61, 0x1d, 0x98, 0x68, 0xb1, 0xd3,
+	0x06, 0x7f, 0x79, 0xb0, 0x75, 0xf0, 0x22, 0x67, 0x19, 0xcd, 0x64, 0x4c, 0x92, 0xff, 0xee, 0x69,
+	0x0b, 0xea, 0x22, 0x24, 0x09, 0xd5, 0x89, 0xd4, 0xb1, 0x61, 0xd0, 0xbb, 0x00, 0xdf, 0x51, 0xce,
+	0x26, 0x06, 0x50, 0xd3, 0x80, 0xb6, 0x92, 0xdc, 0xd3, 0xa0, 0x4f, 0xa1, 0x95, 0x33, 0x11, 0xcb,
+	0x78, 0x4e, 0xfb, 0x75, 0x5d, 0x80, 0xed, 0xa2, 0x00, 0x45, 0x2c, 0x26, 0x54, 0x81, 0x0b, 0x53,
+	0x05, 0xcb, 0x68, 0x44, 0x34, 0xac, 0xf1, 0xaf, 0x30, 0x67, 0x1a, 0x3c, 0x02, 0x74, 0x56, 0x8f,
+	0x2e, 0x41, 0x83, 0x3d, 0x79, 0x22, 0xa8, 0xd4, 0x39, 0xbe, 0x85, 0x2d, 0x87, 0xde, 0x83, 0x0d,
+	0x53, 0x1c, 0x13, 0xbc, 0xfa, 0x90, 0xfc, 0xa1, 0x8f, 0xbb, 0x46, 0xa8, 0xe3, 0x17, 0xc1, 0x35,
+	0xf0, 0xef, 0xce, 0xa3, 0x37, 0x2d, 0x52, 0xf0, 0x2d, 0x34, 0x4f, 0x66, 0x69, 0x4a, 0xf8, 0xe9,
+	0x1b, 0xd7, 0xf5, 0x1a, 0xb4, 0x9f, 0xcf, 0x48, 0x26, 0xe3, 0x84, 0xba, 0x3b, 0x74, 0x4d, 0xf2,
+	0xc8, 0xca, 0xf1, 0xc2, 0x22, 0xf8, 0x02, 0x5a, 0x4e, 0x8c, 0x76, 0xa0, 0xe5, 0x14, 0xd6, 0x4f,
+	0xc1, 0x2b, 0x67, 0x73, 0x92, 0xcc, 0xa8, 0x76, 0xe6, 0x61, 0xc3, 0x04, 0x3f, 0x7a, 0xd0, 0x72,
+	0xad, 0xb7, 0x30, 0xf1, 0x96, 0x4c, 0xce, 0x8c, 0xa0, 0xea, 0xd9, 0x11, 0xb4, 0x0d, 0x2d, 0xc9,
+	0x49, 0x48, 0x27, 0xf1, 0x54, 0x77, 0x43, 0x17, 0x37, 0x35, 0x7f, 0x7f, 0x8a, 0x2e, 0x43, 0x53,
+	0xe4, 0x24, 0x53, 0x9a, 0x9a, 0xd6, 0x34, 0x14, 0x7b, 0x7f, 0x8a, 0xfa, 0xd0, 0x14, 0x24, 0xcd,
+	0x13, 0x3a, 0xd5, 0x8d, 0xd0, 0xc2, 0x8e, 0x0d, 0x7e, 0xab, 0x42, 0xbb, 0xf8, 0xe8, 0x11, 0x82,
+	0x5a, 0x46, 0x52, 0xf7, 0x75, 0x69, 0x1a, 0x5d, 0x2a, 0xe5, 0x72, 0x58, 0x71, 0xa1, 0x0e, 0xc0,
+	0x27, 0xf3, 0xc8, 0x7e, 0x59, 0x60, 0x8b, 0x76, 0x77, 0x1e, 0x1d, 0x56, 0xb0, 0x52, 0xa0, 0x1b,
+	0xd0, 0x7e, 0xea, 0xfa, 0x5c, 0x87, 0xd3, 0x19, 0x6d, 0x5a, 0xab, 0xa2, 0xff, 0x0f, 0x2b, 0x78,
+	0x61, 0x84, 0x30, 0x5c, 0xa4, 0x8b, 0x0e, 0x9a, 0x2c, 0xd0, 0xa6, 0x0b, 0xdf, 0x39, 0xdb, 0x85,
+	0xcb, 0x07, 0x6d, 0xd1, 0x35, 0x72, 0x74, 0x15, 0x9a, 0xc2, 0xf4, 0x84, 0x9e, 0x39, 0x9d, 0x51,
+	0xcf, 0xcd, 0x4b, 0x23, 0x3d, 0xac, 0x60, 0x67, 0x80, 0x3e, 0x81, 0x0e, 0x89, 0x22, 0xae, 0x1b,
+	0x9a, 0x65, 0xba, 0x52, 0xbd, 0x11, 0x72, 0x99, 0x2d, 0x34, 0x78, 0xd9, 0x6c, 0xdf, 0x07, 0x6f,
+	0x1e, 0xfc, 0x52, 0x85, 0xcd, 0xd5, 0x09, 0x8e, 0x6e, 0x42, 0x9f, 0xe7, 0xe1, 0xc4, 0x8e, 0x7d,
+	0x21, 0x09, 0x97, 0x74, 0x3a, 0x91, 0x4c, 0x92, 0xc4, 0xee, 0x96, 0x8b, 0x3c, 0x0f, 0x0d, 0xec,
+	0xc4, 0x68, 0xc7, 0x4a, 0xb9, 0x02, 0x7c, 0x4a, 0xb2, 0x69, 0x52, 0x00, 0xab, 0x2b, 0xc0, 0x43,
+	0xa3, 0x35, 0xc0, 0x23, 0xd8, 0x59, 0x03, 0x14, 0x34, 0x64, 0xd9, 0x54, 0xf4, 0xfd, 0xf5, 0x97,
+	0x80, 0x2f, 0xaf, 0x1e, 0x76, 0x62, 0x00, 0xe8, 0x3a, 0x80, 0x3a, 0xce, 0xae, 0xba, 0xf2, 0x1d,
+	0xe2, 0xe3, 0x7b, 0x76, 0xcf, 0xb5, 0x79, 0x1e, 0x1a, 0x12, 0x7d, 0x08, 0x48, 0xa7, 0x39, 0x29,
+	0x35, 0x71, 0x5d, 0x87, 0xbc, 0xa9, 0x35, 0xe3, 0x45, 0x27, 0xeb, 0xa2, 0xad, 0xae, 0x2d, 0x97,
+	0xbb, 0xdd, 0x75, 0xe7, 0x15, 0xcd, 0xc0, 0xd6, 0x15, 0xcd, 0x02, 0xcf, 0x2b, 0x9a, 0x01, 0xae,
+	0x2b, 0xda, 0x0a, 0xf0, 0x4d, 0x8a, 0x56, 0x3a, 0xec, 0x7f, 0x2a, 0x5a, 0x0c, 0x17, 0x56, 0x56,
+	0x35, 0x1a, 0x42, 0xc3, 0x2c, 0xe5, 0xbe, 0x57, 0xf2, 0x56, 0x58, 0x60, 0xab, 0x3f, 0xc7, 0x55,
+	0xf5, 0x1c, 0x57, 0xbf, 0x7a, 0xb0, 0xb9, 0xba, 0xd7, 0xd5, 0x07, 0xe5, 0x5e, 0x00, 0xde, 0xae,
+	0xbf, 0xd6, 0x9b, 0x33, 0x40, 0x1f, 0x81, 0x7d, 0x05, 0x2c, 0x5e, 0x4b, 0x0a, 0xd1, 0xb5, 0x08,
+	0x9d, 0x3f, 0xee, 0x1a, 0x13, 0x5b, 0x8c, 0x2b, 0xd0, 0x4d, 0x59, 0x16, 0x4b, 0xc6, 0x27, 0x7a,
+	0x12, 0xf9, 0x7a, 0x12, 0x75, 0xac, 0xec, 0x81, 0x1a, 0x48, 0xeb, 0x93, 0xa8, 0x9d, 0x93, 0xc4,
+	0x1d, 0x40, 0x47, 0xb3, 0x44, 0xc6, 0x63, 0xc2, 0x23, 0x5a, 0xbc, 0xa6, 0x86, 0xab, 0x59, 0xf4,
+	0x4a, 0x59, 0x88, 0x22, 0x87, 0xe0, 0xa7, 0x2a, 0x6c, 0x1c, 0x73, 0xf6, 0x44, 0xcd, 0xff, 0x7d,
+	0x22, 0xc3, 0xa7, 0x6a, 0xf0, 0x0b, 0xfa, 0x7c, 0x46, 0xb3, 0x90, 0xda, 0x8e, 0x2c, 0x78, 0xbd,
+	0xa7, 0x55, 0x04, 0x6e, 0xcb, 0x68, 0x46, 0x6d, 0x23, 0x9a, 0x99, 0x69, 0xed, 0x63, 0x45, 0xa2,
+	0xab, 0xd0, 0xca, 0xed, 0xa1, 0xfd, 0x5a, 0x29, 0x00, 0xeb, 0x0b, 0x17, 0x7a, 0xf5, 0x8e, 0xe1,
+	0x54, 0xb0, 0x19, 0x0f, 0xdd, 0x1a, 0x77, 0x2b, 0x0a, 0x5b, 0x31, 0x2e, 0x0c, 0xd0, 0x08, 0x6a,
+	0x92, 0x44, 0xee, 0x75, 0x36, 0x28, 0x1f, 0x6a, 0x12, 0xd8, 0x1b, 0x93, 0x48, 0x1c, 0x64, 0x92,
+	0x9f, 0x62, 0x6d, 0xbb, 0x73, 0x13, 0xda, 0x85, 0x48, 0xc5, 0xfa, 0x8c, 0x9e, 0xda, 0x0d, 0xa0,
+	0xc8, 0xf2, 0x32, 0x6b, 0xdb, 0xf1, 0x7f, 0xbb, 0x7a, 0xcb, 0x0b, 0x0e, 0xa0, 0x69, 0x4f, 0x5e,
+	0xbb, 0x39, 0x10, 0xd4, 0xe4, 0x69, 0xee, 0x70, 0x9a, 0x56, 0xb2, 0x29, 0x91, 0xc4, 0x6e, 0x2e,
+	0x4d, 0x07, 0x9f, 0x41, 0x4f, 0xcd, 0x74, 0x2e, 0x31, 0x15, 0x39, 0xcb, 0x84, 0xb6, 0x0a, 0xd9,
+	0xd4, 0x9c, 0x56, 0xc7, 0x9a, 0x56, 0x81, 0xa5, 0x22, 0xb2, 0x87, 0x29, 0x72, 0xf4, 0xbd, 0x07,
+	0xf0, 0x70, 0x7c, 0xac, 0xbe, 0xc2, 0x38, 0xa4, 0xe8, 0x36, 0x6c, 0x98, 0x63, 0xdc, 0x25, 0xaf,
+	0xdc, 0xe9, 0xce, 0xc5, 0xa5, 0x05, 0xb2, 0x70, 0x36, 0xf4, 0x6e, 0x78, 0xe8, 0xae, 0x0b, 0xc1,
+	0x55, 0x0a, 0x6d, 0xad, 0x2b, 0xdd, 0x3f, 0x1c, 0xb1, 0x7f, 0xfb, 0xf7, 0x57, 0x03, 0xef, 0xe5,
+	0xab, 0x81, 0xf7, 0xe7, 0xab, 0x81, 0xf7, 0xc3, 0xeb, 0x41, 0xe5, 0xe5, 0xeb, 0x41, 0xe5, 0x8f,
+	0xd7, 0x83, 0x0a, 0x6c, 0x87, 0x2c, 0xdd, 0x93, 0xaa, 0x3f, 0x32, 0xb9, 0x17, 0x91, 0x24, 0x4e,
+	0xa8, 0xfd, 0x29, 0xf9, 0xda, 0xfc, 0xb1, 0x3c, 0x6e, 0x68, 0xee, 0xe3, 0xbf, 0x07, 0x00, 0x0b,
+	0xbf, 0xe5, 0x4e, 0xcc, 0x0c, 0x00, 0x00,
 }
 
 func (m *Metrics) Marshal() (dAtA []byte, err error) {
@@ -932,6 +1440,11 @@ func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Temporality != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.Temporality))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.CustomMetrics) > 0 {
 		for iNdEx := len(m.CustomMetrics) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1028,6 +1541,18 @@ func (m *Bucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Exemplar != nil {
+		{
+			size, err := m.Exemplar.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOtp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Count != 0 {
 		i = encodeVarintOtp(dAtA, i, uint64(m.Count))
 		i--
@@ -1091,7 +1616,7 @@ func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Avg) Marshal() (dAtA []byte, err error) {
+func (m *ExponentialHistogram) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1101,16 +1626,50 @@ func (m *Avg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Avg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExponentialHistogram) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Avg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExponentialHistogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Negative != nil {
+		{
+			size, err := m.Negative.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOtp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Positive != nil {
+		{
+			size, err := m.Positive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOtp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ZeroCount != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.ZeroCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Scale != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.Scale))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Count != 0 {
 		i = encodeVarintOtp(dAtA, i, uint64(m.Count))
 		i--
@@ -1125,7 +1684,7 @@ func (m *Avg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MetricOTP) Marshal() (dAtA []byte, err error) {
+func (m *ExponentialBuckets) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1135,96 +1694,126 @@ func (m *MetricOTP) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MetricOTP) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExponentialBuckets) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MetricOTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExponentialBuckets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Aggregation != 0 {
-		i = encodeVarintOtp(dAtA, i, uint64(m.Aggregation))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.V != nil {
-		{
-			size := m.V.Size()
-			i -= size
-			if _, err := m.V.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if len(m.BucketCounts) > 0 {
+		dAtA6 := make([]byte, len(m.BucketCounts)*10)
+		var j5 int
+		for _, num1 := range m.BucketCounts {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintOtp(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOtp(dAtA, i, uint64(len(m.Name)))
+	if m.Offset != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64((uint32(m.Offset)<<1)^uint32((m.Offset>>31))))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MetricOTP_Value) MarshalTo(dAtA []byte) (int, error) {
+func (m *Avg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *MetricOTP_Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 8
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
-	i--
-	dAtA[i] = 0x11
-	return len(dAtA) - i, nil
-}
-func (m *MetricOTP_Avg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Avg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MetricOTP_Avg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Avg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Avg != nil {
-		{
-			size, err := m.Avg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOtp(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.Count))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x10
+	}
+	if m.Sum != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Sum))))
+		i--
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MetricOTP_Histogram) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Summary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Summary) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MetricOTP_Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Summary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Histogram != nil {
-		{
-			size, err := m.Histogram.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quantiles) > 0 {
+		for iNdEx := len(m.Quantiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quantiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOtp(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintOtp(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.Count))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x10
+	}
+	if m.Sum != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Sum))))
+		i--
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
-func (m *ClientMetricsOTP) Marshal() (dAtA []byte, err error) {
+
+func (m *Quantile) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1234,54 +1823,32 @@ func (m *ClientMetricsOTP) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClientMetricsOTP) MarshalTo(dAtA []byte) (int, error) {
+func (m *Quantile) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClientMetricsOTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Quantile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RpcLabels != nil {
-		{
-			size, err := m.RpcLabels.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOtp(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.RpcClientHandledSeconds != nil {
-		{
-			size, err := m.RpcClientHandledSeconds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOtp(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.RpcClientHandledTotal != 0 {
-		i = encodeVarintOtp(dAtA, i, uint64(m.RpcClientHandledTotal))
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x11
 	}
-	if m.RpcClientStartedTotal != 0 {
-		i = encodeVarintOtp(dAtA, i, uint64(m.RpcClientStartedTotal))
+	if m.Quantile != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Quantile))))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ServerMetricsOTP) Marshal() (dAtA []byte, err error) {
+func (m *Exemplar) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1291,54 +1858,320 @@ func (m *ServerMetricsOTP) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ServerMetricsOTP) MarshalTo(dAtA []byte) (int, error) {
+func (m *Exemplar) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ServerMetricsOTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Exemplar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RpcLabels != nil {
-		{
-			size, err := m.RpcLabels.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOtp(dAtA, i, uint64(size))
+	if m.Sampled {
+		i--
+		if m.Sampled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SpanId) > 0 {
+		i -= len(m.SpanId)
+		copy(dAtA[i:], m.SpanId)
+		i = encodeVarintOtp(dAtA, i, uint64(len(m.SpanId)))
+		i--
 		dAtA[i] = 0x22
 	}
-	if m.RpcServerHandledSeconds != nil {
-		{
-			size, err := m.RpcServerHandledSeconds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOtp(dAtA, i, uint64(size))
-		}
+	if len(m.TraceId) > 0 {
+		i -= len(m.TraceId)
+		copy(dAtA[i:], m.TraceId)
+		i = encodeVarintOtp(dAtA, i, uint64(len(m.TraceId)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.RpcServerHandledTotal != 0 {
-		i = encodeVarintOtp(dAtA, i, uint64(m.RpcServerHandledTotal))
+	if m.TimestampMs != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.TimestampMs))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.RpcServerStartedTotal != 0 {
-		i = encodeVarintOtp(dAtA, i, uint64(m.RpcServerStartedTotal))
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NormalMetricOTP) Marshal() (dAtA []byte, err error) {
+func (m *MetricOTP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricOTP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricOTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.V != nil {
+		{
+			size := m.V.Size()
+			i -= size
+			if _, err := m.V.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Aggregation != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.Aggregation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOtp(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetricOTP_Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricOTP_Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+	i--
+	dAtA[i] = 0x11
+	return len(dAtA) - i, nil
+}
+func (m *MetricOTP_Avg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricOTP_Avg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Avg != nil {
+		{
+			size, err := m.Avg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOtp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MetricOTP_Histogram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricOTP_Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Histogram != nil {
+		{
+			size, err := m.Histogram.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOtp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MetricOTP_ExponentialHistogram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricOTP_ExponentialHistogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExponentialHistogram != nil {
+		{
+			size, err := m.ExponentialHistogram.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOtp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MetricOTP_Summary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricOTP_Summary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Summary != nil {
+		{
+			size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOtp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ClientMetricsOTP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientMetricsOTP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientMetricsOTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartTimestampMs != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.StartTimestampMs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RpcLabels != nil {
+		{
+			size, err := m.RpcLabels.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOtp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RpcClientHandledSeconds != nil {
+		{
+			size, err := m.RpcClientHandledSeconds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOtp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RpcClientHandledTotal != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.RpcClientHandledTotal))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RpcClientStartedTotal != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.RpcClientStartedTotal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServerMetricsOTP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServerMetricsOTP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServerMetricsOTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartTimestampMs != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.StartTimestampMs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RpcLabels != nil {
+		{
+			size, err := m.RpcLabels.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOtp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RpcServerHandledSeconds != nil {
+		{
+			size, err := m.RpcServerHandledSeconds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOtp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RpcServerHandledTotal != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.RpcServerHandledTotal))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RpcServerStartedTotal != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.RpcServerStartedTotal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NormalMetricOTP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1358,6 +2191,11 @@ func (m *NormalMetricOTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StartTimestampMs != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.StartTimestampMs))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Metric != nil {
 		{
 			size, err := m.Metric.MarshalToSizedBuffer(dAtA[:i])
@@ -1393,6 +2231,11 @@ func (m *CustomMetricsOTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StartTimestampMs != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.StartTimestampMs))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.MonitorName) > 0 {
 		i -= len(m.MonitorName)
 		copy(dAtA[i:], m.MonitorName)
@@ -1488,6 +2331,25 @@ func (m *ProfilesBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintOtp(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOtp(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOtp(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.Resource != nil {
 		{
 			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
@@ -1576,6 +2438,41 @@ func (m *Profile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintOtp(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintOtp(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOtp(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOtp(v)
 	base := offset
@@ -1624,6 +2521,9 @@ func (m *Metrics) Size() (n int) {
 			n += 1 + l + sovOtp(uint64(l))
 		}
 	}
+	if m.Temporality != 0 {
+		n += 1 + sovOtp(uint64(m.Temporality))
+	}
 	return n
 }
 
@@ -1640,6 +2540,10 @@ func (m *Bucket) Size() (n int) {
 	if m.Count != 0 {
 		n += 1 + sovOtp(uint64(m.Count))
 	}
+	if m.Exemplar != nil {
+		l = m.Exemplar.Size()
+		n += 1 + l + sovOtp(uint64(l))
+	}
 	return n
 }
 
@@ -1664,6 +2568,54 @@ func (m *Histogram) Size() (n int) {
 	return n
 }
 
+func (m *ExponentialHistogram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != 0 {
+		n += 9
+	}
+	if m.Count != 0 {
+		n += 1 + sovOtp(uint64(m.Count))
+	}
+	if m.Scale != 0 {
+		n += 1 + sovOtp(uint64(m.Scale))
+	}
+	if m.ZeroCount != 0 {
+		n += 1 + sovOtp(uint64(m.ZeroCount))
+	}
+	if m.Positive != nil {
+		l = m.Positive.Size()
+		n += 1 + l + sovOtp(uint64(l))
+	}
+	if m.Negative != nil {
+		l = m.Negative.Size()
+		n += 1 + l + sovOtp(uint64(l))
+	}
+	return n
+}
+
+func (m *ExponentialBuckets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sozOtp(uint64(m.Offset))
+	}
+	if len(m.BucketCounts) > 0 {
+		l = 0
+		for _, e := range m.BucketCounts {
+			l += sovOtp(uint64(e))
+		}
+		n += 1 + sovOtp(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *Avg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1679,6 +2631,68 @@ func (m *Avg) Size() (n int) {
 	return n
 }
 
+func (m *Summary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != 0 {
+		n += 9
+	}
+	if m.Count != 0 {
+		n += 1 + sovOtp(uint64(m.Count))
+	}
+	if len(m.Quantiles) > 0 {
+		for _, e := range m.Quantiles {
+			l = e.Size()
+			n += 1 + l + sovOtp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Quantile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quantile != 0 {
+		n += 9
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *Exemplar) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.TimestampMs != 0 {
+		n += 1 + sovOtp(uint64(m.TimestampMs))
+	}
+	l = len(m.TraceId)
+	if l > 0 {
+		n += 1 + l + sovOtp(uint64(l))
+	}
+	l = len(m.SpanId)
+	if l > 0 {
+		n += 1 + l + sovOtp(uint64(l))
+	}
+	if m.Sampled {
+		n += 2
+	}
+	return n
+}
+
 func (m *MetricOTP) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1731,6 +2745,30 @@ func (m *MetricOTP_Histogram) Size() (n int) {
 	}
 	return n
 }
+func (m *MetricOTP_ExponentialHistogram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExponentialHistogram != nil {
+		l = m.ExponentialHistogram.Size()
+		n += 1 + l + sovOtp(uint64(l))
+	}
+	return n
+}
+func (m *MetricOTP_Summary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Summary != nil {
+		l = m.Summary.Size()
+		n += 1 + l + sovOtp(uint64(l))
+	}
+	return n
+}
 func (m *ClientMetricsOTP) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1751,6 +2789,9 @@ func (m *ClientMetricsOTP) Size() (n int) {
 		l = m.RpcLabels.Size()
 		n += 1 + l + sovOtp(uint64(l))
 	}
+	if m.StartTimestampMs != 0 {
+		n += 1 + sovOtp(uint64(m.StartTimestampMs))
+	}
 	return n
 }
 
@@ -1774,6 +2815,9 @@ func (m *ServerMetricsOTP) Size() (n int) {
 		l = m.RpcLabels.Size()
 		n += 1 + l + sovOtp(uint64(l))
 	}
+	if m.StartTimestampMs != 0 {
+		n += 1 + sovOtp(uint64(m.StartTimestampMs))
+	}
 	return n
 }
 
@@ -1787,6 +2831,9 @@ func (m *NormalMetricOTP) Size() (n int) {
 		l = m.Metric.Size()
 		n += 1 + l + sovOtp(uint64(l))
 	}
+	if m.StartTimestampMs != 0 {
+		n += 1 + sovOtp(uint64(m.StartTimestampMs))
+	}
 	return n
 }
 
@@ -1812,6 +2859,9 @@ func (m *CustomMetricsOTP) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOtp(uint64(l))
 	}
+	if m.StartTimestampMs != 0 {
+		n += 1 + sovOtp(uint64(m.StartTimestampMs))
+	}
 	return n
 }
 
@@ -1855,6 +2905,14 @@ func (m *ProfilesBatch) Size() (n int) {
 		l = m.Resource.Size()
 		n += 1 + l + sovOtp(uint64(l))
 	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOtp(uint64(len(k))) + 1 + len(v) + sovOtp(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOtp(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1879,6 +2937,22 @@ func (m *Profile) Size() (n int) {
 	return n
 }
 
+func (m *ExportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovOtp(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovOtp(uint64(l))
+	}
+	return n
+}
+
 func sovOtp(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2036,10 +3110,466 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if err := m.ServerMetrics[len(m.ServerMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOtp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalMetrics = append(m.NormalMetrics, &NormalMetricOTP{})
+			if err := m.NormalMetrics[len(m.NormalMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOtp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomMetrics = append(m.CustomMetrics, &CustomMetricsOTP{})
+			if err := m.CustomMetrics[len(m.CustomMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Temporality", wireType)
+			}
+			m.Temporality = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Temporality |= MetricsTemporality(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOtp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOtp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOtp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Range = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemplar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOtp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exemplar == nil {
+				m.Exemplar = &Exemplar{}
+			}
+			if err := m.Exemplar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOtp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOtp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Sum = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOtp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &Bucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOtp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExponentialHistogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOtp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExponentialHistogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExponentialHistogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Sum = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			m.Scale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scale |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroCount", wireType)
+			}
+			m.ZeroCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZeroCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NormalMetrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2066,14 +3596,16 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NormalMetrics = append(m.NormalMetrics, &NormalMetricOTP{})
-			if err := m.NormalMetrics[len(m.NormalMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Positive == nil {
+				m.Positive = &ExponentialBuckets{}
+			}
+			if err := m.Positive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomMetrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Negative", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2100,8 +3632,10 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CustomMetrics = append(m.CustomMetrics, &CustomMetricsOTP{})
-			if err := m.CustomMetrics[len(m.CustomMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Negative == nil {
+				m.Negative = &ExponentialBuckets{}
+			}
+			if err := m.Negative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2126,7 +3660,7 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bucket) Unmarshal(dAtA []byte) error {
+func (m *ExponentialBuckets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2149,17 +3683,17 @@ func (m *Bucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExponentialBuckets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExponentialBuckets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOtp
@@ -2169,24 +3703,150 @@ func (m *Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOtp
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.Offset = v
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOtp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BucketCounts = append(m.BucketCounts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOtp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOtp
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOtp
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BucketCounts) == 0 {
+					m.BucketCounts = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOtp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BucketCounts = append(m.BucketCounts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketCounts", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOtp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOtp
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Range = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Avg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOtp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Avg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Avg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Sum = float64(math.Float64frombits(v))
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
@@ -2227,7 +3887,7 @@ func (m *Bucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Histogram) Unmarshal(dAtA []byte) error {
+func (m *Summary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2250,10 +3910,10 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+			return fmt.Errorf("proto: Summary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Summary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2288,7 +3948,7 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2315,8 +3975,8 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buckets = append(m.Buckets, &Bucket{})
-			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Quantiles = append(m.Quantiles, &Quantile{})
+			if err := m.Quantiles[len(m.Quantiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2341,7 +4001,7 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Avg) Unmarshal(dAtA []byte) error {
+func (m *Quantile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2364,15 +4024,15 @@ func (m *Avg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Avg: wiretype end group for non-group")
+			return fmt.Errorf("proto: Quantile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Avg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Quantile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantile", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -2380,12 +4040,171 @@ func (m *Avg) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Sum = float64(math.Float64frombits(v))
+			m.Quantile = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOtp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Exemplar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOtp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Exemplar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Exemplar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOtp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceId = append(m.TraceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TraceId == nil {
+				m.TraceId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOtp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpanId = append(m.SpanId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SpanId == nil {
+				m.SpanId = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sampled", wireType)
 			}
-			m.Count = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOtp
@@ -2395,11 +4214,12 @@ func (m *Avg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Sampled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOtp(dAtA[iNdEx:])
@@ -2582,6 +4402,76 @@ func (m *MetricOTP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExponentialHistogram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOtp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExponentialHistogram{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.V = &MetricOTP_ExponentialHistogram{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOtp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Summary{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.V = &MetricOTP_Summary{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOtp(dAtA[iNdEx:])
@@ -2742,6 +4632,25 @@ func (m *ClientMetricsOTP) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestampMs", wireType)
+			}
+			m.StartTimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOtp(dAtA[iNdEx:])
@@ -2902,6 +4811,25 @@ func (m *ServerMetricsOTP) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestampMs", wireType)
+			}
+			m.StartTimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOtp(dAtA[iNdEx:])
@@ -2988,6 +4916,25 @@ func (m *NormalMetricOTP) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestampMs", wireType)
+			}
+			m.StartTimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOtp(dAtA[iNdEx:])
@@ -3138,6 +5085,25 @@ func (m *CustomMetricsOTP) Unmarshal(dAtA []byte) error {
 			}
 			m.MonitorName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestampMs", wireType)
+			}
+			m.StartTimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOtp(dAtA[iNdEx:])
@@ -3399,6 +5365,133 @@ func (m *ProfilesBatch) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOtp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOtp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOtp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOtp
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOtp
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOtp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOtp
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOtp
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOtp(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOtp
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOtp(dAtA[iNdEx:])
@@ -3568,6 +5661,107 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOtp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOtp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOtp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOtp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOtp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOtp(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
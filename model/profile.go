@@ -33,3 +33,32 @@ const (
 	// GoroutineProfile 收集当前所有 goroutines 的 stack traces
 	GoroutineProfile ProfileType = "goroutine"
 )
+
+const (
+	// ProfileLabelSpanID CPU profile 中关联 span 的 pprof label，值为 span id。
+	ProfileLabelSpanID = "span_id"
+	// ProfileLabelEndpoint CPU profile 中关联接口的 pprof label，值为进程内根 span 的名称。
+	ProfileLabelEndpoint = "trace_endpoint"
+)
+
+const (
+	// ProfileTagCaptureID 按需采集的 ProfilesBatch 标签，值为采集 ID。
+	ProfileTagCaptureID = "capture_id"
+	// ProfileTagTrigger 按需采集的 ProfilesBatch 标签，值为触发方式。
+	ProfileTagTrigger = "trigger"
+	// ProfileTriggerAPI 通过 Go API 触发。
+	ProfileTriggerAPI = "api"
+	// ProfileTriggerHTTP 通过本地 HTTP 管理接口触发。
+	ProfileTriggerHTTP = "http"
+	// ProfileTriggerOCP 通过 ocp 下发配置触发。
+	ProfileTriggerOCP = "ocp"
+)
+
+// ProfileAnomaly profile 异常检测结果，同一调用栈在连续多个采集周期持续增长。
+type ProfileAnomaly struct {
+	Type    ProfileType // goroutine 或 heap
+	Stack   string      // 增长的调用栈，从栈顶到栈底，每行一个函数
+	Growth  int64       // 连续增长期间累计增长的 goroutine 数或 inuse_space 字节数
+	Current int64       // 当前的 goroutine 数或 inuse_space 字节数
+	Periods int         // 连续增长的采集周期数
+}
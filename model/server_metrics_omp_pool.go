@@ -43,5 +43,6 @@ func PutServerMetrics(s *ServerMetrics) {
 	for i := range s.Metrics {
 		s.Metrics[i].Value = 0
 	}
+	s.SpanContext.reset()
 	serverMetricsPool.Put(s)
 }
@@ -55,6 +55,22 @@ type MetricsStats struct {
 	DoubleBufferChangeSlow atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
 	// MaxPointCount 最大单值点（按分钟滑动窗口统计）。
 	MaxPointCount atomic.Int64 `aggregation:"AGGREGATION_MAX"`
+	// SpoolPageTotal 上报失败后写入磁盘缓存的分页数。
+	SpoolPageTotal atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
+	// SpoolReplayPageTotal 从磁盘缓存补发成功的分页数。
+	SpoolReplayPageTotal atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
+	// SpoolEvictPageTotal 磁盘缓存超限或过期被淘汰的分页数。
+	SpoolEvictPageTotal atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
+	// SpoolBytes 磁盘缓存当前占用的字节数。
+	SpoolBytes atomic.Int64 `aggregation:"AGGREGATION_SET"`
+	// OverflowSampleCount 超出时间线预算，折叠到 __overflow__ 时间线的样本数。
+	OverflowSampleCount atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
+	// LabelRuleDropCount 被标签改写规则丢弃的数据条数。
+	LabelRuleDropCount atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
+	// TargetCount 多目标处理器当前代理的目标数。
+	TargetCount atomic.Int64 `aggregation:"AGGREGATION_SET"`
+	// TargetDiscardCount 超出目标数上限或目标点数预算被丢弃的数据条数。
+	TargetDiscardCount atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
 }
 
 // TracesStats 追踪导出器统计。
@@ -73,6 +89,11 @@ type TracesStats struct {
 	WorkflowBreakCounter     atomic.Int64 `aggregation:"AGGREGATION_COUNTER"` // workflow 默认采样触发熔断统计
 	WorkflowPathCounter      atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
 	LimitDropCounter         atomic.Int64 `aggregation:"AGGREGATION_COUNTER"` // 熔断丢弃 span 统计
+	TailBufferSpans          atomic.Int64 `aggregation:"AGGREGATION_SET"`     // 尾部采样当前缓冲的 span 数
+	TailBufferBytes          atomic.Int64 `aggregation:"AGGREGATION_SET"`     // 尾部采样当前缓冲占用的内存（估算值）
+	TailSampledCounter       atomic.Int64 `aggregation:"AGGREGATION_COUNTER"` // 尾部采样命中规则后上报的 span 统计
+	TailEvictCounter         atomic.Int64 `aggregation:"AGGREGATION_COUNTER"` // 尾部采样超出缓冲上限淘汰的 span 统计
+	TailExpireCounter        atomic.Int64 `aggregation:"AGGREGATION_COUNTER"` // 尾部采样超过缓冲时间丢弃的 span 统计
 }
 
 // LogsStats 日志导出器统计。
@@ -105,6 +126,20 @@ type PrometheusPushStats struct {
 	SucceededExportCounter atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
 }
 
+// CollectorStats collector 地址熔断统计，metrics 和 profiles 导出器共用。
+type CollectorStats struct {
+	// CircuitOpenTotal 地址进入熔断状态的次数。
+	CircuitOpenTotal atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
+	// CircuitHalfOpenTotal 熔断地址退避结束后，发送半开探测请求的次数。
+	CircuitHalfOpenTotal atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
+	// CircuitCloseTotal 熔断地址恢复正常的次数。
+	CircuitCloseTotal atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
+	// CircuitRejectTotal 所有地址都处于熔断状态，请求没有实际发送的次数。
+	CircuitRejectTotal atomic.Int64 `aggregation:"AGGREGATION_COUNTER"`
+	// OpenAddrCount 当前处于熔断状态的地址数量。
+	OpenAddrCount atomic.Int64 `aggregation:"AGGREGATION_SET"`
+}
+
 // SelfMonitorStats 监控统计。
 type SelfMonitorStats struct {
 	// MetricsStats 监控处理器统计。
@@ -117,6 +152,8 @@ type SelfMonitorStats struct {
 	ProfilesStats
 	// PrometheusPushStats prometheus push 自监控指标
 	PrometheusPushStats
+	// CollectorStats collector 地址熔断统计。
+	CollectorStats
 }
 
 // GetDeltaMetrics 获取增量自监控数据。
@@ -75,6 +75,30 @@ func TestMetricsStats_GetDeltaMetrics(t *testing.T) {
 				}, {
 					Name: "custom_gauge_MetricsStats_MaxPointCount_max", V: NewOTPValue(1),
 					Aggregation: Aggregation_AGGREGATION_MAX,
+				}, {
+					Name: "custom_counter_MetricsStats_SpoolPageTotal_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_counter_MetricsStats_SpoolReplayPageTotal_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_counter_MetricsStats_SpoolEvictPageTotal_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_gauge_MetricsStats_SpoolBytes_set", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_SET,
+				}, {
+					Name: "custom_counter_MetricsStats_OverflowSampleCount_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_counter_MetricsStats_LabelRuleDropCount_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_gauge_MetricsStats_TargetCount_set", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_SET,
+				}, {
+					Name: "custom_counter_MetricsStats_TargetDiscardCount_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
 				},
 			},
 		}, {
@@ -121,6 +145,21 @@ func TestMetricsStats_GetDeltaMetrics(t *testing.T) {
 				}, {
 					Name: "custom_counter_TracesStats_LimitDropCounter_total", V: NewOTPValue(1),
 					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_gauge_TracesStats_TailBufferSpans_set", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_SET,
+				}, {
+					Name: "custom_gauge_TracesStats_TailBufferBytes_set", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_SET,
+				}, {
+					Name: "custom_counter_TracesStats_TailSampledCounter_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_counter_TracesStats_TailEvictCounter_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_counter_TracesStats_TailExpireCounter_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
 				},
 			},
 		}, {
@@ -189,6 +228,25 @@ func TestMetricsStats_GetDeltaMetrics(t *testing.T) {
 					Aggregation: Aggregation_AGGREGATION_COUNTER,
 				},
 			},
+		}, {
+			MonitorName: "CollectorStats", CustomLabels: []*Label{{"SdkTarget", target}}, Metrics: []*MetricOTP{
+				{
+					Name: "custom_counter_CollectorStats_CircuitOpenTotal_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_counter_CollectorStats_CircuitHalfOpenTotal_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_counter_CollectorStats_CircuitCloseTotal_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_counter_CollectorStats_CircuitRejectTotal_total", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_COUNTER,
+				}, {
+					Name: "custom_gauge_CollectorStats_OpenAddrCount_set", V: NewOTPValue(1),
+					Aggregation: Aggregation_AGGREGATION_SET,
+				},
+			},
 		},
 	}
 	for ii := 0; ii < 3; ii++ {
@@ -228,14 +286,29 @@ func inc(stats *SelfMonitorStats) {
 	stats.ReportHandledRowsTotal.Inc()
 	stats.DoubleBufferChangeSlow.Inc()
 	stats.MaxPointCount.Store(1)
+	stats.SpoolPageTotal.Inc()
+	stats.SpoolReplayPageTotal.Inc()
+	stats.SpoolEvictPageTotal.Inc()
+	stats.SpoolBytes.Store(1)
+	stats.OverflowSampleCount.Inc()
+	stats.LabelRuleDropCount.Inc()
+	stats.TargetCount.Store(1)
+	stats.TargetDiscardCount.Inc()
 	inc64 := func(v *atomic.Int64) {
 		v.Inc()
 	}
 
 	walk(&stats.TracesStats, inc64)
+	stats.TracesStats.TailBufferSpans.Store(1)
+	stats.TracesStats.TailBufferBytes.Store(1)
 	walk(&stats.LogsStats, inc64)
 	walk(&stats.ProfilesStats, inc64)
 	walk(&stats.PrometheusPushStats, inc64)
+	stats.CircuitOpenTotal.Inc()
+	stats.CircuitHalfOpenTotal.Inc()
+	stats.CircuitCloseTotal.Inc()
+	stats.CircuitRejectTotal.Inc()
+	stats.OpenAddrCount.Store(1)
 }
 
 func walk(stats interface{}, cb func(*atomic.Int64)) {
@@ -0,0 +1,55 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// OTPSummary 支持分位值统计的 otp 监控数据，如属性监控、自定义监控。
+type OTPSummary interface {
+	// SetSummary 设置第 i 个数据的分位值，quantiles 与 values 一一对应，（单值监控项 i 填 0）。
+	SetSummary(i int, sum float64, count int64, quantiles, values []float64)
+}
+
+var (
+	_ OTPSummary = (*NormalMetricOTP)(nil)
+	_ OTPSummary = (*CustomMetricsOTP)(nil)
+)
+
+// NewOTPSummary 构造 otp 分位值类型。
+func NewOTPSummary(sum float64, count int64, quantiles, values []float64) *MetricOTP_Summary {
+	s := &MetricOTP_Summary{
+		Summary: &Summary{
+			Sum:   sum,
+			Count: count,
+		},
+	}
+	s.Summary.Quantiles = make([]*Quantile, len(quantiles))
+	for i := range quantiles {
+		s.Summary.Quantiles[i] = &Quantile{Quantile: quantiles[i], Value: values[i]}
+	}
+	return s
+}
+
+// SetSummary 设置第 i 个数据的分位值，（单值监控项 i 填 0）。
+func (n *NormalMetricOTP) SetSummary(i int, sum float64, count int64, quantiles, values []float64) {
+	n.Metric.V = NewOTPSummary(sum, count, quantiles, values)
+}
+
+// SetSummary 设置第 i 个数据的分位值，（单值监控项 i 填 0）。
+func (c *CustomMetricsOTP) SetSummary(i int, sum float64, count int64, quantiles, values []float64) {
+	c.Metrics[i].V = NewOTPSummary(sum, count, quantiles, values)
+}
@@ -50,10 +50,11 @@ type buffer struct {
 	shards     [model.MaxGroup][shardCount]*shard
 	multiCount uberatomic.Int64 // 当前 buffer 的多值点个数。
 	pointCount uberatomic.Int64 // 当前 buffer 的单值点个数。
+	series     *seriesCounter   // 当前 buffer 的时间线预算计数。
 }
 
 func newBuffer() *buffer {
-	b := &buffer{}
+	b := &buffer{series: newSeriesCounter()}
 	for groupID := range b.shards {
 		for shardID := range b.shards[groupID] {
 			b.shards[groupID][shardID] = newShard()
@@ -67,32 +68,48 @@ type aggregator struct {
 	normalLabels           *model.NormalLabels                // 属性标签，启动后唯一确定。
 	windowFunc             func() time.Duration               // 窗口大小配置，函数化方便热更新。
 	bucketFunc             func(name string) point.BucketFunc // 分桶配置。
+	exponentialFunc        point.ExponentialFunc              // 指数直方图配置。
+	summaryFunc            point.SummaryFunc                  // 分位值统计配置。
 	overloadProtectionFunc func() bool                        // 是否过载。
+	temporalityFunc        func() temporalityConfig           // 时间性配置，函数化方便热更新。
 	exporter               components.MetricsExporter         // 导出器。
 	stats                  *model.SelfMonitorStats            // 自监控统计。
 	writer                 *buffer
 	reader                 *buffer
 	mu                     sync.Mutex // 保护 writer 的并发安全。
 	sampler                *sampler
+	limiter                *limiter    // 时间线预算限制器。
+	targets                *targets    // 代理目标注册表，用于目标点数预算及分批导出。
+	cumulative             *cumulative // 累计模式的时间线状态，增量模式为 nil，只在 swapBuffer 协程中访问。
 }
 
 func newAggregator(
 	normalLabels *model.NormalLabels,
 	windowFunc func() time.Duration,
 	bucketFunc func(name string) point.BucketFunc,
+	exponentialFunc point.ExponentialFunc,
+	summaryFunc point.SummaryFunc,
 	overloadProtectionFunc func() bool,
+	temporalityFunc func() temporalityConfig,
 	stats *model.SelfMonitorStats,
 	exporter components.MetricsExporter,
 	sampler *sampler,
+	limiter *limiter,
+	targets *targets,
 ) *aggregator {
 	a := &aggregator{
 		normalLabels:           normalLabels,
 		windowFunc:             windowFunc,
 		bucketFunc:             bucketFunc,
+		exponentialFunc:        exponentialFunc,
+		summaryFunc:            summaryFunc,
 		overloadProtectionFunc: overloadProtectionFunc,
+		temporalityFunc:        temporalityFunc,
 		stats:                  stats,
 		exporter:               exporter,
 		sampler:                sampler,
+		limiter:                limiter,
+		targets:                targets,
 	}
 	a.setWriter(newBuffer())
 	a.setReader(newBuffer())
@@ -151,25 +168,64 @@ func (a *aggregator) flushBuffer(begin time.Time, buffer *buffer) {
 		TimestampMs:  time.Now().UnixMilli(),
 		NormalLabels: a.normalLabels,
 	}
+	out := newOutput(metrics)
+	cfg := a.temporalityFunc()
+	a.switchTemporality(cfg)
 	exportCount := 0
 	for groupID := range buffer.shards {
 		for shardID := range buffer.shards[groupID] {
 			s := buffer.shards[groupID][shardID]
-			exportCount += a.toOTP(s, metrics)
+			exportCount += a.toOTP(s, out)
 		}
 	}
+	if a.cumulative != nil {
+		exportCount += a.cumulative.flush(out, cfg)
+	}
+	buffer.series.writeOverflows(metrics)
+	buffer.series.reset()
 	multiCount := buffer.multiCount.Swap(0)
 	pointCount := buffer.pointCount.Swap(0)
 	a.stats.MultiCount.Add(-multiCount)
 	a.stats.MaxPointCount.Store(int64(maxPointCount.Update(float64(a.stats.PointCount.Load()))))
 	a.stats.PointCount.Add(-pointCount)
 	a.stats.ExportCount.Add(int64(exportCount))
-	a.exporter.Export(metrics)
+	a.export(out)
+	a.targets.expire(time.Now(), cfg.expires, cfg.clear)
+}
+
+// export 导出处理器自身的数据，代理目标的数据分批组装成 MultiTargetMetrics 导出。
+// 导出器没有实现 components.MultiTargetMetricsExporter 时，按目标逐个导出。
+func (a *aggregator) export(out *output) {
+	a.exporter.Export(out.metrics)
+	if len(out.targets) == 0 {
+		return
+	}
+	e, ok := a.exporter.(components.MultiTargetMetricsExporter)
+	if !ok {
+		for _, metrics := range out.targets {
+			a.exporter.Export(metrics)
+		}
+		return
+	}
+	for _, batch := range out.batches(a.targets.getConfig().batchTargets) {
+		e.ExportMultiTarget(batch)
+	}
+}
+
+// switchTemporality 时间性热更新：切换到累计模式时开始累计，切换到增量模式时丢弃累计状态。
+func (a *aggregator) switchTemporality(cfg temporalityConfig) {
+	if !cfg.cumulative {
+		a.cumulative = nil
+		return
+	}
+	if a.cumulative == nil { // 当前 buffer 是最近一个窗口的数据，从窗口开始时间累计。
+		a.cumulative = newCumulative(time.Now().Add(-a.windowFunc()))
+	}
 }
 
-// toOTP 对指标数据进行采样和放大复原，并导出到 OTP 结构中。
+// toOTP 对指标数据进行采样和放大复原，并导出到所属目标的 OTP 结构中。
 // 返回值：最终导出数（注：5 个分桶算导出 5 个点）。
-func (a *aggregator) toOTP(s *shard, metrics *model.Metrics) int {
+func (a *aggregator) toOTP(s *shard, out *output) int {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	exportCount := 0
@@ -180,7 +236,12 @@ func (a *aggregator) toOTP(s *shard, metrics *model.Metrics) int {
 			if factor != 1 { // 采样后数据变少，需要对采样数据根据放大系数进行修改，使采样后的数据贴合原始数据。
 				m.change(factor) // 需要和 toOTPFunc 结合使用，change 之后应立即导出，否则数据不正确。
 			}
-			exportCount += m.toOTPFunc(m, metrics)
+			metrics := out.get(pk.target)
+			if a.cumulative != nil {
+				exportCount += a.cumulative.add(pk, m, metrics)
+			} else {
+				exportCount += m.toOTPFunc(m, metrics)
+			}
 			m.mu.Unlock()
 		}
 		delete(s.multis, pk) // 删除。
@@ -202,7 +263,7 @@ func (a *aggregator) aggregate(
 	a.mu.Lock() // 拿到这把锁，一定没有人在读。
 	defer a.mu.Unlock()
 	b := a.getWriter()
-	s := b.shards[pk.group][pk.labelsHashCode%shardCount]
+	s := b.shards[pk.group][pk.shardIndex()]
 	if m := a.getOrNewMulti(b, s, pk, extractor, rpcLabels, customLabels, monitorName); m != nil { // shard 线程安全
 		m.update(extractor) // multi 线程安全
 	}
@@ -230,23 +291,77 @@ func (a *aggregator) getOrNewMulti(
 		return nil
 	}
 
+	if a.targets.exceeded(b.series, pk) { // 超出代理目标的点数预算，该目标不允许新线创建。
+		a.stats.TargetDiscardCount.Inc()
+		return nil
+	}
+
+	if a.limiter.exceeded(b.series, pk.group, monitorName) { // 超出时间线预算，折叠到 __overflow__ 时间线。
+		return a.getOrNewOverflowMulti(b, pk, extractor, rpcLabels, customLabels, monitorName)
+	}
+
+	m, created := a.newMulti(b, s, pk, extractor, rpcLabels, customLabels, monitorName)
+	if created {
+		a.limiter.add(b.series, pk.group, monitorName)
+	}
+	return m
+}
+
+// getOrNewOverflowMulti 获取 or 创建监控项的 __overflow__ 时间线。
+func (a *aggregator) getOrNewOverflowMulti(
+	b *buffer,
+	pk *PK,
+	extractor model.OMPMetric,
+	rpcLabels *model.RPCLabels,
+	customLabels []model.Label,
+	monitorName string,
+) *multi {
+	b.series.recordOverflow(monitorName, rpcLabels, customLabels)
+	a.stats.OverflowSampleCount.Inc()
+	opk := getPK()
+	defer putPK(opk)
+	overflowPK(pk, monitorName, opk)
+	s := b.shards[opk.group][opk.shardIndex()]
+	s.mu.RLock()
+	m, ok := s.multis[*opk]
+	s.mu.RUnlock()
+	if ok {
+		return m
+	}
+	m, _ = a.newMulti(
+		b, s, opk, extractor, overflowRPCLabels(rpcLabels), overflowCustomLabels(customLabels), monitorName,
+	)
+	return m
+}
+
+// newMulti 创建一个 *multi，已被并发创建时返回已有的 *multi。
+func (a *aggregator) newMulti(
+	b *buffer,
+	s *shard,
+	pk *PK,
+	extractor model.OMPMetric,
+	rpcLabels *model.RPCLabels,
+	customLabels []model.Label,
+	monitorName string,
+) (*multi, bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	m, ok = s.multis[*pk]
+	m, ok := s.multis[*pk]
 	if ok { // 存在，返回，有并发创建。
-		return m
+		return m, false
 	}
 	m = getMulti(pk)
-	m.setPoints(extractor, a.bucketFunc)
+	m.setPoints(extractor, a.bucketFunc, a.exponentialFunc, a.summaryFunc)
 	m.setRPCLabels(rpcLabels)
 	m.setCustomLabels(customLabels)
 	m.toOTPFunc = getMultiToOTPFunc(extractor)
 	m.monitorName = monitorName
 	pk.copyTo(&m.pk)
 	s.multis[m.pk] = m
+	a.targets.add(b.series, pk)
 	b.multiCount.Inc()
 	b.pointCount.Add(int64(m.pk.pointCount))
 	a.stats.MultiCount.Inc()
 	a.stats.PointCount.Add(int64(m.pk.pointCount))
-	return m
+	return m, true
 }
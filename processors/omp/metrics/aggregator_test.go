@@ -46,8 +46,8 @@ func newTestProcessor(sampleMonitors []model.SampleMonitor) *processor {
 		return time.Duration(atomic.LoadInt32(&p.cfg.Processor.WindowSeconds)) * time.Second
 	}
 	p.aggregator = newAggregator(
-		p.normalLabels, windowFunc, bucketFunc, overloadProtectionFunc, p.stats, exporter,
-		p.sampler,
+		p.normalLabels, windowFunc, bucketFunc, p.getExponential, p.getSummary, overloadProtectionFunc,
+		p.getTemporality, p.stats, exporter, p.sampler, p.limiter, p.targets,
 	)
 	return p
 }
@@ -0,0 +1,266 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"galiosight.ai/galio-sdk-go/lib/hash/fnv64a"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+const (
+	// overflowLabelValue 超出时间线预算后，折叠时间线的标签值。
+	overflowLabelValue = "__overflow__"
+	// maxTrackedLabelValues 每个标签键最多记录的标签值个数，避免统计本身引起内存膨胀。
+	maxTrackedLabelValues = 1000
+	// topOffendingLabelKeys 每个监控项上报的问题标签键个数。
+	topOffendingLabelKeys = 3
+	// cardinalityMonitorName 时间线预算自监控的监控项名。
+	cardinalityMonitorName = "GalileoCardinality"
+	// overflowSamplesMetricName 折叠到 __overflow__ 时间线的样本数。
+	overflowSamplesMetricName = "series_overflow_samples"
+	// overflowLabelValuesMetricName 被折叠样本中，某个标签键的不同取值个数。
+	overflowLabelValuesMetricName = "series_overflow_label_values"
+)
+
+// seriesLimits 时间线预算配置，0 表示不限制。
+type seriesLimits struct {
+	groups   [model.MaxGroup]int64 // 分组级别预算。
+	monitors map[string]int64      // 监控项级别预算。
+}
+
+// limiter 时间线预算限制器，配置可热更新。
+type limiter struct {
+	limits atomic.Value // *seriesLimits
+}
+
+func newLimiter(cfg *model.SeriesLimits) *limiter {
+	l := &limiter{}
+	l.updateConfigs(cfg)
+	return l
+}
+
+func (l *limiter) updateConfigs(cfg *model.SeriesLimits) {
+	limits := &seriesLimits{monitors: make(map[string]int64)}
+	if cfg != nil {
+		limits.groups[model.ClientGroup] = cfg.ClientLimit
+		limits.groups[model.ServerGroup] = cfg.ServerLimit
+		limits.groups[model.CustomGroup] = cfg.CustomLimit
+		for i := range cfg.Monitors {
+			if cfg.Monitors[i].Limit > 0 {
+				limits.monitors[cfg.Monitors[i].MonitorName] = cfg.Monitors[i].Limit
+			}
+		}
+	}
+	l.limits.Store(limits)
+}
+
+func (l *limiter) getLimits() *seriesLimits {
+	if l == nil {
+		return nil
+	}
+	limits, _ := l.limits.Load().(*seriesLimits)
+	return limits
+}
+
+// exceeded 判断新建时间线是否超出预算。
+func (l *limiter) exceeded(c *seriesCounter, group model.MetricGroup, monitorName string) bool {
+	limits := l.getLimits()
+	if limits == nil || group == model.NormalGroup { // 属性监控没有标签，不参与预算。
+		return false
+	}
+	if limit := limits.groups[group]; limit > 0 && c.groups[group] >= limit {
+		return true
+	}
+	if limit, ok := limits.monitors[monitorName]; ok && c.monitors[monitorName] >= limit {
+		return true
+	}
+	return false
+}
+
+// add 记录新建了一条时间线，只统计配置了监控项预算的监控项，避免 map 无限增长。
+func (l *limiter) add(c *seriesCounter, group model.MetricGroup, monitorName string) {
+	c.groups[group]++
+	limits := l.getLimits()
+	if limits == nil {
+		return
+	}
+	if _, ok := limits.monitors[monitorName]; ok {
+		c.monitors[monitorName]++
+	}
+}
+
+// seriesCounter 单个 buffer 的时间线计数。
+// 写入发生在 aggregator.aggregate 持有 aggregator.mu 期间，读取发生在 buffer 切换之后，因此无需额外加锁。
+type seriesCounter struct {
+	groups    [model.MaxGroup]int64
+	monitors  map[string]int64
+	overflows map[string]*overflowStat // 监控项名 -> 折叠统计。
+	// targetPoints 代理目标 -> 单值点数，用于目标点数预算。
+	targetPoints map[*target]int64
+}
+
+func newSeriesCounter() *seriesCounter {
+	return &seriesCounter{
+		monitors:     make(map[string]int64),
+		overflows:    make(map[string]*overflowStat),
+		targetPoints: make(map[*target]int64),
+	}
+}
+
+func (c *seriesCounter) reset() {
+	for i := range c.groups {
+		c.groups[i] = 0
+	}
+	for k := range c.monitors {
+		delete(c.monitors, k)
+	}
+	for k := range c.overflows {
+		delete(c.overflows, k)
+	}
+	for k := range c.targetPoints {
+		delete(c.targetPoints, k)
+	}
+}
+
+// overflowStat 监控项折叠统计。
+type overflowStat struct {
+	samples     int64                          // 折叠的样本数。
+	labelValues map[string]map[uint64]struct{} // 标签键 -> 标签值 hash 集合。
+}
+
+// recordOverflow 记录一次折叠，并统计各标签键的取值，用于找出引起时间线膨胀的标签键。
+func (c *seriesCounter) recordOverflow(monitorName string, rpcLabels *model.RPCLabels, customLabels []model.Label) {
+	stat, ok := c.overflows[monitorName]
+	if !ok {
+		stat = &overflowStat{labelValues: make(map[string]map[uint64]struct{})}
+		c.overflows[monitorName] = stat
+	}
+	stat.samples++
+	if rpcLabels != nil {
+		for i := range rpcLabels.Fields {
+			stat.addLabelValue(rpcLabels.Fields[i].Name.String(), rpcLabels.Fields[i].Value)
+		}
+	}
+	for i := range customLabels {
+		stat.addLabelValue(customLabels[i].Name, customLabels[i].Value)
+	}
+}
+
+func (s *overflowStat) addLabelValue(name, value string) {
+	values, ok := s.labelValues[name]
+	if !ok {
+		values = make(map[uint64]struct{})
+		s.labelValues[name] = values
+	}
+	if len(values) >= maxTrackedLabelValues {
+		return
+	}
+	values[fnv64a.Add(fnv64a.New(), value)] = struct{}{}
+}
+
+// labelKeyCount 标签键及其取值个数。
+type labelKeyCount struct {
+	name  string
+	count int
+}
+
+// topLabelKeys 按取值个数从大到小，返回前 n 个标签键。
+func (s *overflowStat) topLabelKeys(n int) []labelKeyCount {
+	keys := make([]labelKeyCount, 0, len(s.labelValues))
+	for name, values := range s.labelValues {
+		keys = append(keys, labelKeyCount{name: name, count: len(values)})
+	}
+	sort.Slice(
+		keys, func(i, j int) bool {
+			if keys[i].count != keys[j].count {
+				return keys[i].count > keys[j].count
+			}
+			return keys[i].name < keys[j].name
+		},
+	)
+	if len(keys) > n {
+		keys = keys[:n]
+	}
+	return keys
+}
+
+// writeOverflows 将各监控项的折叠情况写到自监控数据中。
+func (c *seriesCounter) writeOverflows(metrics *model.Metrics) {
+	if len(c.overflows) == 0 {
+		return
+	}
+	samplesName := model.CustomName(
+		cardinalityMonitorName, overflowSamplesMetricName, model.Aggregation_AGGREGATION_SET,
+	)
+	labelValuesName := model.CustomName(
+		cardinalityMonitorName, overflowLabelValuesMetricName, model.Aggregation_AGGREGATION_SET,
+	)
+	for monitorName, stat := range c.overflows {
+		metrics.AddCustomMetric(
+			cardinalityMonitorName, samplesName, model.Aggregation_AGGREGATION_SET,
+			float64(stat.samples), "monitor", monitorName,
+		)
+		for _, key := range stat.topLabelKeys(topOffendingLabelKeys) {
+			metrics.AddCustomMetric(
+				cardinalityMonitorName, labelValuesName, model.Aggregation_AGGREGATION_SET,
+				float64(key.count), "monitor", monitorName, "label_key", key.name,
+			)
+		}
+	}
+}
+
+// overflowPK 监控项 __overflow__ 时间线的主键，指标名不变，标签替换为 __overflow__。
+func overflowPK(pk *PK, monitorName string, to *PK) {
+	pk.copyTo(to)
+	hashCode := fnv64a.New()
+	hashCode = fnv64a.Add(hashCode, monitorName)
+	hashCode = fnv64a.AddByte(hashCode, sep)
+	hashCode = fnv64a.Add(hashCode, overflowLabelValue)
+	to.labelsHashCode = hashCode
+	to.labelsBytes = len(overflowLabelValue)
+}
+
+// overflowRPCLabels 标签名不变，标签值替换为 __overflow__。
+func overflowRPCLabels(labels *model.RPCLabels) *model.RPCLabels {
+	if labels == nil {
+		return nil
+	}
+	o := &model.RPCLabels{Fields: make([]model.RPCLabels_Field, len(labels.Fields))}
+	for i := range labels.Fields {
+		o.Fields[i].Name = labels.Fields[i].Name
+		o.Fields[i].Value = overflowLabelValue
+	}
+	return o
+}
+
+// overflowCustomLabels 标签名不变，标签值替换为 __overflow__。
+func overflowCustomLabels(labels []model.Label) []model.Label {
+	if len(labels) == 0 {
+		return nil
+	}
+	o := make([]model.Label, len(labels))
+	for i := range labels {
+		o[i].Name = labels[i].Name
+		o[i].Value = overflowLabelValue
+	}
+	return o
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_limiter_exceeded(t *testing.T) {
+	l := newLimiter(
+		&model.SeriesLimits{
+			ClientLimit: 2,
+			Monitors:    []model.MonitorSeriesLimit{{MonitorName: "m1", Limit: 1}},
+		},
+	)
+	c := newSeriesCounter()
+	assert.False(t, l.exceeded(c, model.ClientGroup, model.RPCClient))
+	l.add(c, model.ClientGroup, model.RPCClient)
+	l.add(c, model.ClientGroup, model.RPCClient)
+	assert.True(t, l.exceeded(c, model.ClientGroup, model.RPCClient))
+	assert.False(t, l.exceeded(c, model.ServerGroup, model.RPCServer), "未配置预算，不限制")
+
+	assert.False(t, l.exceeded(c, model.CustomGroup, "m1"))
+	l.add(c, model.CustomGroup, "m1")
+	assert.True(t, l.exceeded(c, model.CustomGroup, "m1"))
+	assert.False(t, l.exceeded(c, model.CustomGroup, "m2"))
+	assert.NotContains(t, c.monitors, model.RPCClient, "未配置监控项预算，不计数")
+
+	c.reset()
+	assert.False(t, l.exceeded(c, model.ClientGroup, model.RPCClient))
+	assert.False(t, l.exceeded(c, model.CustomGroup, "m1"))
+
+	l.updateConfigs(&model.SeriesLimits{})
+	l.add(c, model.ClientGroup, model.RPCClient)
+	l.add(c, model.ClientGroup, model.RPCClient)
+	assert.False(t, l.exceeded(c, model.ClientGroup, model.RPCClient), "热更新后取消预算")
+}
+
+func Test_seriesCounter_writeOverflows(t *testing.T) {
+	c := newSeriesCounter()
+	for i := 0; i < 10; i++ {
+		c.recordOverflow(
+			"m1", nil, []model.Label{
+				{Name: "uid", Value: strconv.Itoa(i)},
+				{Name: "region", Value: strconv.Itoa(i % 2)},
+				{Name: "env", Value: "prod"},
+				{Name: "zone", Value: "z1"},
+			},
+		)
+	}
+	metrics := &model.Metrics{}
+	c.writeOverflows(metrics)
+	require.Len(t, metrics.CustomMetrics, 1+topOffendingLabelKeys)
+	assert.Equal(t, cardinalityMonitorName, metrics.CustomMetrics[0].MonitorName)
+	assert.Equal(t, 10.0, metrics.CustomMetrics[0].Metrics[0].GetValue())
+	assert.Equal(t, "uid", metrics.CustomMetrics[1].CustomLabels[1].Value)
+	assert.Equal(t, 10.0, metrics.CustomMetrics[1].Metrics[0].GetValue())
+	assert.Equal(t, "region", metrics.CustomMetrics[2].CustomLabels[1].Value)
+	assert.Equal(t, 2.0, metrics.CustomMetrics[2].Metrics[0].GetValue())
+	assert.Equal(t, "env", metrics.CustomMetrics[3].CustomLabels[1].Value)
+
+	c.reset()
+	metrics = &model.Metrics{}
+	c.writeOverflows(metrics)
+	assert.Empty(t, metrics.CustomMetrics)
+}
+
+func TestProcessCustomMetrics_Overflow(t *testing.T) {
+	exporter := newExporter()
+	cfg := newProcessorCfg()
+	cfg.Processor.SeriesLimits.Monitors = []model.MonitorSeriesLimit{{MonitorName: "limited", Limit: 2}}
+	processor, err := NewProcessor(cfg, exporter)
+	require.Nil(t, err)
+	for i := 0; i < 5; i++ {
+		processor.ProcessCustomMetrics(
+			&model.CustomMetrics{
+				MonitorName: "limited",
+				Metrics: []model.Metric{
+					{Name: "requests", Aggregation: model.Aggregation_AGGREGATION_SUM, Value: 1},
+				},
+				CustomLabels: []model.Label{{Name: "uid", Value: strconv.Itoa(i)}},
+			},
+		)
+	}
+	time.Sleep(time.Duration(cfg.Processor.WindowSeconds*2) * time.Second)
+	var series, overflow, report int
+	for _, c := range exporter.customs {
+		switch c.MonitorName {
+		case "limited":
+			series++
+			if c.CustomLabels[0].Value == overflowLabelValue {
+				overflow++
+				assert.Equal(t, "uid", c.CustomLabels[0].Name)
+				assert.Equal(t, 3.0, c.Metrics[0].GetValue(), "超出预算的 3 个样本折叠到一条时间线")
+			}
+		case cardinalityMonitorName:
+			report++
+		}
+	}
+	assert.Equal(t, 3, series)
+	assert.Equal(t, 1, overflow)
+	assert.Equal(t, 2, report)
+	assert.Equal(t, int64(3), processor.GetStats().OverflowSampleCount.Load())
+}
@@ -0,0 +1,455 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"time"
+
+	"galiosight.ai/galio-sdk-go/lib/strings"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// temporalityConfig 指标时间性配置。
+type temporalityConfig struct {
+	cumulative bool          // 是否累计值。
+	expires    time.Duration // 累计值时间线、代理目标多久没有数据后过期。
+	clear      time.Duration // 多久检查一次过期时间线、代理目标。
+}
+
+// cumulative 累计模式下跨窗口保存的时间线状态。
+// aggregator.flushBuffer 导出的仍然是窗口增量，cumulative 在导出前把 COUNTER、SUM、HISTOGRAM 累加到时间线状态上，
+// 再把累计值写回导出数据，其他聚合方式（SET、AVG、MAX、MIN、指数直方图、分位值）保持窗口值。
+// 窗口内没有数据的时间线，继续导出上一次的累计值，直到过期，避免后端看到时间线中断。
+// 只在 swapBuffer 协程中访问，不需要加锁。
+type cumulative struct {
+	series        map[PK]*cumulativeSeries
+	scratch       model.Metrics // 单个 multi 导出的临时数据，复用切片。
+	windowStartMs int64         // 当前窗口的开始时间，即上一次导出的时间，作为新时间线的开始时间。
+	lastClear     time.Time
+}
+
+// cumulativeSeries 一条时间线的累计值，与导出数据的结构一致，只保存需要累计的数据点。
+type cumulativeSeries struct {
+	startMs  int64 // 开始累计的时间，毫秒。
+	updateMs int64 // 最近一次有数据的窗口时间，毫秒。
+	client   *model.ClientMetricsOTP
+	server   *model.ServerMetricsOTP
+	normal   *model.NormalMetricOTP
+	custom   *model.CustomMetricsOTP
+}
+
+// newCumulative windowStart 是第一个累计窗口的开始时间。
+func newCumulative(windowStart time.Time) *cumulative {
+	return &cumulative{
+		series:        make(map[PK]*cumulativeSeries),
+		windowStartMs: windowStart.UnixMilli(),
+		lastClear:     windowStart,
+	}
+}
+
+// add 导出一个 multi 的窗口增量，累加到时间线状态，并把累计值追加到 metrics。
+// 返回导出的点数。
+func (c *cumulative) add(pk PK, m *multi, metrics *model.Metrics) int {
+	c.resetScratch()
+	count := m.toOTPFunc(m, &c.scratch)
+	if count == 0 {
+		return 0
+	}
+	s := c.series[pk]
+	for attempt := 0; attempt < 2; attempt++ {
+		if s == nil {
+			s = &cumulativeSeries{startMs: c.windowStartMs}
+			c.series[pk] = s
+		}
+		if s.accumulate(&c.scratch) {
+			break
+		}
+		// 分桶配置或数据点变化（如分桶配置热更新），不能继续累加，重新开始累计。
+		s = nil
+	}
+	s.updateMs = metrics.TimestampMs
+	appendMetrics(metrics, &c.scratch)
+	return count
+}
+
+// flush 导出窗口内没有数据的时间线的累计值到所属目标，并按 clear 间隔清理过期时间线。返回导出的点数。
+// 需要在当前窗口所有 multi 都 add 之后调用。
+func (c *cumulative) flush(out *output, cfg temporalityConfig) int {
+	nowMs := out.metrics.TimestampMs
+	now := time.UnixMilli(nowMs)
+	clear := now.Sub(c.lastClear) >= cfg.clear
+	if clear {
+		c.lastClear = now
+	}
+	count := 0
+	for pk, s := range c.series {
+		if s.updateMs == nowMs {
+			continue
+		}
+		if clear && now.Sub(time.UnixMilli(s.updateMs)) >= cfg.expires {
+			delete(c.series, pk)
+			continue
+		}
+		count += s.appendTo(out.get(pk.target))
+	}
+	c.windowStartMs = nowMs
+	out.setTemporality(model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE)
+	return count
+}
+
+func (c *cumulative) resetScratch() {
+	c.scratch.ClientMetrics = c.scratch.ClientMetrics[:0]
+	c.scratch.ServerMetrics = c.scratch.ServerMetrics[:0]
+	c.scratch.NormalMetrics = c.scratch.NormalMetrics[:0]
+	c.scratch.CustomMetrics = c.scratch.CustomMetrics[:0]
+}
+
+// appendMetrics 把 from 中的数据追加到 to。
+func appendMetrics(to, from *model.Metrics) {
+	to.ClientMetrics = append(to.ClientMetrics, from.ClientMetrics...)
+	to.ServerMetrics = append(to.ServerMetrics, from.ServerMetrics...)
+	to.NormalMetrics = append(to.NormalMetrics, from.NormalMetrics...)
+	to.CustomMetrics = append(to.CustomMetrics, from.CustomMetrics...)
+}
+
+// accumulate 将单个 multi 的窗口增量 d 累加到时间线状态，并把累计值写回 d。
+// 分桶配置或数据点与之前不一致时返回 false，状态及 d 都不修改。
+func (s *cumulativeSeries) accumulate(d *model.Metrics) bool {
+	switch {
+	case len(d.ClientMetrics) > 0:
+		return s.accumulateClient(d.ClientMetrics[0])
+	case len(d.ServerMetrics) > 0:
+		return s.accumulateServer(d.ServerMetrics[0])
+	case len(d.NormalMetrics) > 0:
+		return s.accumulateNormal(d.NormalMetrics[0])
+	case len(d.CustomMetrics) > 0:
+		return s.accumulateCustom(d.CustomMetrics[0])
+	}
+	return true
+}
+
+func (s *cumulativeSeries) accumulateClient(d *model.ClientMetricsOTP) bool {
+	if s.client == nil {
+		s.client = &model.ClientMetricsOTP{RpcLabels: d.RpcLabels}
+	}
+	if !histogramCompatible(s.client.RpcClientHandledSeconds, d.RpcClientHandledSeconds) {
+		return false
+	}
+	s.client.RpcClientStartedTotal += d.RpcClientStartedTotal
+	s.client.RpcClientHandledTotal += d.RpcClientHandledTotal
+	s.client.RpcClientHandledSeconds = accumulateHistogram(s.client.RpcClientHandledSeconds, d.RpcClientHandledSeconds)
+	d.RpcClientStartedTotal = s.client.RpcClientStartedTotal
+	d.RpcClientHandledTotal = s.client.RpcClientHandledTotal
+	d.RpcClientHandledSeconds = cumulativeHistogram(s.client.RpcClientHandledSeconds, d.RpcClientHandledSeconds)
+	d.StartTimestampMs = s.startMs
+	return true
+}
+
+func (s *cumulativeSeries) accumulateServer(d *model.ServerMetricsOTP) bool {
+	if s.server == nil {
+		s.server = &model.ServerMetricsOTP{RpcLabels: d.RpcLabels}
+	}
+	if !histogramCompatible(s.server.RpcServerHandledSeconds, d.RpcServerHandledSeconds) {
+		return false
+	}
+	s.server.RpcServerStartedTotal += d.RpcServerStartedTotal
+	s.server.RpcServerHandledTotal += d.RpcServerHandledTotal
+	s.server.RpcServerHandledSeconds = accumulateHistogram(s.server.RpcServerHandledSeconds, d.RpcServerHandledSeconds)
+	d.RpcServerStartedTotal = s.server.RpcServerStartedTotal
+	d.RpcServerHandledTotal = s.server.RpcServerHandledTotal
+	d.RpcServerHandledSeconds = cumulativeHistogram(s.server.RpcServerHandledSeconds, d.RpcServerHandledSeconds)
+	d.StartTimestampMs = s.startMs
+	return true
+}
+
+func (s *cumulativeSeries) accumulateNormal(d *model.NormalMetricOTP) bool {
+	if s.normal == nil {
+		s.normal = &model.NormalMetricOTP{Metric: &model.MetricOTP{}}
+	}
+	if !metricCompatible(s.normal.Metric, d.Metric) {
+		return false
+	}
+	accumulateMetric(s.normal.Metric, d.Metric)
+	d.StartTimestampMs = s.startMs
+	return true
+}
+
+func (s *cumulativeSeries) accumulateCustom(d *model.CustomMetricsOTP) bool {
+	if s.custom == nil {
+		s.custom = &model.CustomMetricsOTP{
+			Metrics:      make([]*model.MetricOTP, len(d.Metrics)),
+			CustomLabels: d.CustomLabels,
+			MonitorName:  d.MonitorName,
+		}
+		for i := range s.custom.Metrics {
+			s.custom.Metrics[i] = &model.MetricOTP{}
+		}
+	}
+	if len(s.custom.Metrics) != len(d.Metrics) {
+		return false
+	}
+	for i := range d.Metrics {
+		if !metricCompatible(s.custom.Metrics[i], d.Metrics[i]) {
+			return false
+		}
+	}
+	for i := range d.Metrics {
+		accumulateMetric(s.custom.Metrics[i], d.Metrics[i])
+	}
+	d.StartTimestampMs = s.startMs
+	return true
+}
+
+// isCumulative 是否需要跨窗口累计的聚合方式。
+func isCumulative(a model.Aggregation) bool {
+	switch a {
+	case model.Aggregation_AGGREGATION_COUNTER,
+		model.Aggregation_AGGREGATION_SUM,
+		model.Aggregation_AGGREGATION_HISTOGRAM:
+		return true
+	}
+	return false
+}
+
+// metricCompatible 窗口数据 d 能否累加到状态 total 上。窗口内没有数据的点（名字为空）总是可以。
+func metricCompatible(total, d *model.MetricOTP) bool {
+	if d.Name == "" || total.Name == "" {
+		return true
+	}
+	if total.Name != d.Name || total.Aggregation != d.Aggregation {
+		return false
+	}
+	if d.Aggregation == model.Aggregation_AGGREGATION_HISTOGRAM {
+		return histogramCompatible(total.GetHistogram(), d.GetHistogram())
+	}
+	return true
+}
+
+// accumulateMetric 累加 COUNTER、SUM、HISTOGRAM 点，并把累计值写回 d。
+// 窗口内没有数据的点，使用状态中的累计值补齐。
+func accumulateMetric(total, d *model.MetricOTP) {
+	if d.Name == "" { // 窗口内没有数据。
+		if total.Name != "" {
+			copyCumulativeMetric(d, total)
+		}
+		return
+	}
+	if !isCumulative(d.Aggregation) {
+		return
+	}
+	total.Name = d.Name
+	total.Aggregation = d.Aggregation
+	if d.Aggregation == model.Aggregation_AGGREGATION_HISTOGRAM {
+		h := accumulateHistogram(total.GetHistogram(), d.GetHistogram())
+		total.V = &model.MetricOTP_Histogram{Histogram: h}
+		d.V = &model.MetricOTP_Histogram{Histogram: cumulativeHistogram(h, d.GetHistogram())}
+		return
+	}
+	v := total.GetValue() + d.GetValue()
+	total.V = model.NewOTPValue(v)
+	d.V = model.NewOTPValue(v)
+}
+
+// copyCumulativeMetric 复制累计值，直方图不复制 exemplar。
+func copyCumulativeMetric(to, from *model.MetricOTP) {
+	to.Name = from.Name
+	to.Aggregation = from.Aggregation
+	if h := from.GetHistogram(); h != nil {
+		to.V = &model.MetricOTP_Histogram{Histogram: cloneHistogram(h)}
+		return
+	}
+	to.V = model.NewOTPValue(from.GetValue())
+}
+
+// histogramCompatible 窗口直方图 d 能否合并到 total。
+// OMP 只导出非空分桶，相邻窗口的分桶集合可以不同：分桶配置不变时，范围相同的是同一个分桶，范围不同的分桶不重叠；
+// 范围部分重叠说明分桶配置发生了变化，不能合并。
+func histogramCompatible(total, d *model.Histogram) bool {
+	if total == nil || d == nil {
+		return true
+	}
+	i, j := 0, 0
+	for i < len(total.Buckets) && j < len(d.Buckets) {
+		ts, te, err := strings.ParseVMRange(total.Buckets[i].Range)
+		if err != nil {
+			return false
+		}
+		ds, de, err := strings.ParseVMRange(d.Buckets[j].Range)
+		if err != nil {
+			return false
+		}
+		switch {
+		case ts == ds && te == de:
+			i++
+			j++
+		case te <= ds:
+			i++
+		case de <= ts:
+			j++
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// accumulateHistogram 将窗口直方图 d 合并到 total，返回合并后的 total，不保存 exemplar。
+// 分桶按范围取并集，范围相同的分桶计数相加，调用方需要先用 histogramCompatible 检查。
+func accumulateHistogram(total, d *model.Histogram) *model.Histogram {
+	if d == nil {
+		return total
+	}
+	if total == nil {
+		return cloneHistogram(d)
+	}
+	total.Sum += d.Sum
+	total.Count += d.Count
+	buckets := make([]*model.Bucket, 0, len(total.Buckets)+len(d.Buckets))
+	i, j := 0, 0
+	for i < len(total.Buckets) && j < len(d.Buckets) {
+		t, b := total.Buckets[i], d.Buckets[j]
+		switch {
+		case t.Range == b.Range:
+			t.Count += b.Count
+			buckets = append(buckets, t)
+			i++
+			j++
+		case rangeStart(t.Range) < rangeStart(b.Range):
+			buckets = append(buckets, t)
+			i++
+		default:
+			buckets = append(buckets, &model.Bucket{Range: b.Range, Count: b.Count})
+			j++
+		}
+	}
+	buckets = append(buckets, total.Buckets[i:]...)
+	for ; j < len(d.Buckets); j++ {
+		buckets = append(buckets, &model.Bucket{Range: d.Buckets[j].Range, Count: d.Buckets[j].Count})
+	}
+	total.Buckets = buckets
+	return total
+}
+
+// rangeStart 分桶范围的下界。
+func rangeStart(r string) float64 {
+	start, _, _ := strings.ParseVMRange(r)
+	return start
+}
+
+// cumulativeHistogram 返回导出用的累计直方图，保留窗口数据中的 exemplar。
+// 窗口数据 d 的分桶是 total 分桶的子集，且顺序一致。
+func cumulativeHistogram(total, d *model.Histogram) *model.Histogram {
+	if total == nil {
+		return d
+	}
+	h := cloneHistogram(total)
+	if d == nil {
+		return h
+	}
+	j := 0
+	for _, b := range h.Buckets {
+		if j < len(d.Buckets) && b.Range == d.Buckets[j].Range {
+			b.Exemplar = d.Buckets[j].Exemplar
+			j++
+		}
+	}
+	return h
+}
+
+// cloneHistogram 复制直方图，不复制 exemplar。
+func cloneHistogram(h *model.Histogram) *model.Histogram {
+	if h == nil {
+		return nil
+	}
+	c := &model.Histogram{Sum: h.Sum, Count: h.Count, Buckets: make([]*model.Bucket, len(h.Buckets))}
+	for i, b := range h.Buckets {
+		c.Buckets[i] = &model.Bucket{Range: b.Range, Count: b.Count}
+	}
+	return c
+}
+
+// appendTo 导出窗口内没有数据的时间线的累计值，返回导出的点数。
+func (s *cumulativeSeries) appendTo(metrics *model.Metrics) int {
+	switch {
+	case s.client != nil:
+		c := &model.ClientMetricsOTP{
+			RpcClientStartedTotal:   s.client.RpcClientStartedTotal,
+			RpcClientHandledTotal:   s.client.RpcClientHandledTotal,
+			RpcClientHandledSeconds: cloneHistogram(s.client.RpcClientHandledSeconds),
+			RpcLabels:               s.client.RpcLabels,
+			StartTimestampMs:        s.startMs,
+		}
+		metrics.ClientMetrics = append(metrics.ClientMetrics, c)
+		return 2 + histogramPointCount(c.RpcClientHandledSeconds)
+	case s.server != nil:
+		c := &model.ServerMetricsOTP{
+			RpcServerStartedTotal:   s.server.RpcServerStartedTotal,
+			RpcServerHandledTotal:   s.server.RpcServerHandledTotal,
+			RpcServerHandledSeconds: cloneHistogram(s.server.RpcServerHandledSeconds),
+			RpcLabels:               s.server.RpcLabels,
+			StartTimestampMs:        s.startMs,
+		}
+		metrics.ServerMetrics = append(metrics.ServerMetrics, c)
+		return 2 + histogramPointCount(c.RpcServerHandledSeconds)
+	case s.normal != nil:
+		if s.normal.Metric.Name == "" {
+			return 0
+		}
+		m := &model.MetricOTP{}
+		copyCumulativeMetric(m, s.normal.Metric)
+		metrics.NormalMetrics = append(metrics.NormalMetrics, &model.NormalMetricOTP{Metric: m, StartTimestampMs: s.startMs})
+		return metricPointCount(m)
+	case s.custom != nil:
+		c := &model.CustomMetricsOTP{
+			CustomLabels:     s.custom.CustomLabels,
+			MonitorName:      s.custom.MonitorName,
+			StartTimestampMs: s.startMs,
+		}
+		count := 0
+		for _, total := range s.custom.Metrics {
+			if total.Name == "" {
+				continue
+			}
+			m := &model.MetricOTP{}
+			copyCumulativeMetric(m, total)
+			c.Metrics = append(c.Metrics, m)
+			count += metricPointCount(m)
+		}
+		if count > 0 {
+			metrics.CustomMetrics = append(metrics.CustomMetrics, c)
+		}
+		return count
+	}
+	return 0
+}
+
+func metricPointCount(m *model.MetricOTP) int {
+	if h := m.GetHistogram(); h != nil {
+		return histogramPointCount(h)
+	}
+	return 1
+}
+
+// histogramPointCount 与 histogramToOTP 的导出数一致：sum、count 及每个分桶。
+func histogramPointCount(h *model.Histogram) int {
+	if h == nil {
+		return 0
+	}
+	return 2 + len(h.Buckets)
+}
@@ -0,0 +1,261 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/configs"
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/processors/omp/metrics/point"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// metricsRecorder 记录每个窗口导出的数据。
+type metricsRecorder struct {
+	exported []*model.Metrics
+}
+
+func (r *metricsRecorder) Export(metrics *model.Metrics) {
+	r.exported = append(r.exported, metrics)
+}
+
+func (r *metricsRecorder) UpdateConfig(*configs.Metrics) {}
+
+func (r *metricsRecorder) GetStats() *model.SelfMonitorStats {
+	return nil
+}
+
+// flush 手动切换 buffer 并导出，返回导出的数据。
+func (r *metricsRecorder) flush(a *aggregator) *model.Metrics {
+	time.Sleep(2 * time.Millisecond) // 保证每个窗口的导出时间不同。
+	a.flushBuffer(time.Now(), a.bufferChangeAndGetReader())
+	return r.exported[len(r.exported)-1]
+}
+
+func reportCumulativeTest(p *processor, counter, set, histogram float64) {
+	p.ProcessCustomMetrics(&model.CustomMetrics{
+		Metrics: []model.Metric{
+			{Name: "req", Aggregation: model.Aggregation_AGGREGATION_COUNTER, Value: counter},
+			{Name: "conn", Aggregation: model.Aggregation_AGGREGATION_SET, Value: set},
+			{Name: "cost", Aggregation: model.Aggregation_AGGREGATION_HISTOGRAM, Value: histogram},
+		},
+		CustomLabels: []model.Label{{Name: "k1", Value: "v1"}},
+	})
+}
+
+func TestAggregator_Cumulative(t *testing.T) {
+	recorder := &metricsRecorder{}
+	cfg := newProcessorCfg()
+	fixConfig(cfg)
+	p := newProcessor(cfg, recorder)
+	var temporality atomic.Value
+	temporality.Store(temporalityConfig{cumulative: true, expires: time.Hour, clear: time.Hour})
+	p.aggregator = newAggregator(
+		p.normalLabels,
+		func() time.Duration { return time.Hour }, // 不自动切换，由测试手动导出。
+		func(name string) point.BucketFunc { return p.getBucket(name) },
+		p.getExponential,
+		p.getSummary,
+		func() bool { return false },
+		func() temporalityConfig { return temporality.Load().(temporalityConfig) },
+		p.stats, recorder, p.sampler, p.limiter, p.targets,
+	)
+
+	// 第一个窗口。
+	reportCumulativeTest(p, 2, 5, 0.01)
+	m := recorder.flush(p.aggregator)
+	assert.Equal(t, model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE, m.Temporality)
+	require.Len(t, m.CustomMetrics, 1)
+	c := m.CustomMetrics[0]
+	start := c.StartTimestampMs
+	assert.NotZero(t, start)
+	assert.Less(t, start, m.TimestampMs)
+	assert.Equal(t, 2.0, c.Metrics[0].GetValue())
+	assert.Equal(t, 5.0, c.Metrics[1].GetValue())
+	assert.Equal(t, int64(1), c.Metrics[2].GetHistogram().Count)
+
+	// 第二个窗口，counter、histogram 累加，set 仍是窗口值。
+	reportCumulativeTest(p, 3, 1, 0.02)
+	m = recorder.flush(p.aggregator)
+	require.Len(t, m.CustomMetrics, 1)
+	c = m.CustomMetrics[0]
+	assert.Equal(t, start, c.StartTimestampMs)
+	assert.Equal(t, 5.0, c.Metrics[0].GetValue())
+	assert.Equal(t, 1.0, c.Metrics[1].GetValue())
+	assert.Equal(t, int64(2), c.Metrics[2].GetHistogram().Count)
+	assert.InDelta(t, 0.03, c.Metrics[2].GetHistogram().Sum, 1e-9)
+
+	// 第三个窗口没有数据，继续导出累计值，不导出 set。
+	m = recorder.flush(p.aggregator)
+	require.Len(t, m.CustomMetrics, 1)
+	c = m.CustomMetrics[0]
+	assert.Equal(t, start, c.StartTimestampMs)
+	assert.Equal(t, "k1", c.CustomLabels[0].Name)
+	require.Len(t, c.Metrics, 2)
+	assert.Equal(t, 5.0, c.Metrics[0].GetValue())
+	assert.Equal(t, int64(2), c.Metrics[1].GetHistogram().Count)
+
+	// 时间线过期后删除。
+	temporality.Store(temporalityConfig{cumulative: true})
+	m = recorder.flush(p.aggregator)
+	assert.Empty(t, m.CustomMetrics)
+	assert.Empty(t, p.aggregator.cumulative.series)
+
+	// 过期后重新开始累计。
+	reportCumulativeTest(p, 1, 1, 0.01)
+	m = recorder.flush(p.aggregator)
+	require.Len(t, m.CustomMetrics, 1)
+	assert.Equal(t, 1.0, m.CustomMetrics[0].Metrics[0].GetValue())
+	assert.Greater(t, m.CustomMetrics[0].StartTimestampMs, start)
+
+	// 切换回增量模式。
+	temporality.Store(temporalityConfig{})
+	reportCumulativeTest(p, 1, 1, 0.01)
+	m = recorder.flush(p.aggregator)
+	assert.Equal(t, model.MetricsTemporality_METRICS_TEMPORALITY_DELTA, m.Temporality)
+	assert.Nil(t, p.aggregator.cumulative)
+	require.Len(t, m.CustomMetrics, 1)
+	assert.Zero(t, m.CustomMetrics[0].StartTimestampMs)
+	assert.Equal(t, 1.0, m.CustomMetrics[0].Metrics[0].GetValue())
+}
+
+func TestAggregator_CumulativeHistogramBuckets(t *testing.T) {
+	recorder := &metricsRecorder{}
+	cfg := newProcessorCfg()
+	cfg.Exporter.Temporality = model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE
+	fixConfig(cfg)
+	p := newProcessor(cfg, recorder)
+	p.aggregator = newAggregator(
+		p.normalLabels,
+		func() time.Duration { return time.Hour },
+		func(name string) point.BucketFunc { return p.getBucket(name) },
+		p.getExponential,
+		p.getSummary,
+		func() bool { return false },
+		p.getTemporality,
+		p.stats, recorder, p.sampler, p.limiter, p.targets,
+	)
+
+	reportCumulativeTest(p, 2, 5, 0.01)
+	m := recorder.flush(p.aggregator)
+	require.Len(t, m.CustomMetrics, 1)
+	start := m.CustomMetrics[0].StartTimestampMs
+	first := m.CustomMetrics[0].Metrics[2].GetHistogram()
+	require.Len(t, first.Buckets, 1)
+
+	// 第二个窗口落在不同的分桶，分桶取并集，不重新开始累计。
+	reportCumulativeTest(p, 3, 1, 3)
+	m = recorder.flush(p.aggregator)
+	require.Len(t, m.CustomMetrics, 1)
+	c := m.CustomMetrics[0]
+	assert.Equal(t, start, c.StartTimestampMs)
+	assert.Equal(t, 5.0, c.Metrics[0].GetValue())
+	h := c.Metrics[2].GetHistogram()
+	assert.Equal(t, int64(2), h.Count)
+	assert.InDelta(t, 3.01, h.Sum, 1e-9)
+	require.Len(t, h.Buckets, 2)
+	assert.Equal(t, first.Buckets[0].Range, h.Buckets[0].Range)
+	assert.Equal(t, []int64{1, 1}, []int64{h.Buckets[0].Count, h.Buckets[1].Count})
+
+	// 第三个窗口回到第一个分桶，计数累加。
+	reportCumulativeTest(p, 1, 1, 0.01)
+	m = recorder.flush(p.aggregator)
+	require.Len(t, m.CustomMetrics, 1)
+	c = m.CustomMetrics[0]
+	assert.Equal(t, start, c.StartTimestampMs)
+	assert.Equal(t, 6.0, c.Metrics[0].GetValue())
+	h = c.Metrics[2].GetHistogram()
+	require.Len(t, h.Buckets, 2)
+	assert.Equal(t, []int64{2, 1}, []int64{h.Buckets[0].Count, h.Buckets[1].Count})
+}
+
+func Test_histogramCompatible(t *testing.T) {
+	histogram := func(ranges ...string) *model.Histogram {
+		h := &model.Histogram{}
+		for _, r := range ranges {
+			h.Buckets = append(h.Buckets, &model.Bucket{Range: r, Count: 1})
+		}
+		return h
+	}
+	total := histogram("0.000e+00...1.000e-02", "1.000e-01...+Inf")
+	assert.True(t, histogramCompatible(total, nil))
+	assert.True(t, histogramCompatible(total, histogram("0.000e+00...1.000e-02")))
+	assert.True(t, histogramCompatible(total, histogram("1.000e-02...2.000e-02", "1.000e-01...+Inf")))
+	assert.True(t, histogramCompatible(total, histogram("-Inf...0.000e+00")))
+	assert.False(t, histogramCompatible(total, histogram("0.000e+00...2.000e-02")), "分桶配置变化")
+	assert.False(t, histogramCompatible(total, histogram("5.000e-01...+Inf")), "分桶配置变化")
+	assert.False(t, histogramCompatible(total, histogram("invalid")))
+
+	merged := accumulateHistogram(total, histogram("-Inf...0.000e+00", "1.000e-02...2.000e-02", "1.000e-01...+Inf"))
+	ranges := make([]string, 0, len(merged.Buckets))
+	counts := make([]int64, 0, len(merged.Buckets))
+	for _, b := range merged.Buckets {
+		ranges = append(ranges, b.Range)
+		counts = append(counts, b.Count)
+	}
+	assert.Equal(t, []string{
+		"-Inf...0.000e+00", "0.000e+00...1.000e-02", "1.000e-02...2.000e-02", "1.000e-01...+Inf",
+	}, ranges)
+	assert.Equal(t, []int64{1, 1, 1, 2}, counts)
+}
+
+func TestAggregator_CumulativeClient(t *testing.T) {
+	recorder := &metricsRecorder{}
+	cfg := newProcessorCfg()
+	cfg.Exporter.Temporality = model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE
+	fixConfig(cfg)
+	p := newProcessor(cfg, recorder)
+	p.aggregator = newAggregator(
+		p.normalLabels,
+		func() time.Duration { return time.Hour },
+		func(name string) point.BucketFunc { return p.getBucket(name) },
+		p.getExponential,
+		p.getSummary,
+		func() bool { return false },
+		p.getTemporality,
+		p.stats, recorder, p.sampler, p.limiter, p.targets,
+	)
+	report := func() {
+		c := model.GetClientMetrics(1)
+		defer model.PutClientMetrics(c)
+		c.RpcLabels.Fields[0].Name = model.RPCLabels_callee_method
+		c.RpcLabels.Fields[0].Value = "m"
+		c.Metrics[model.ClientMetricStartedTotalPoint].Value = 1
+		c.Metrics[model.ClientMetricHandledTotalPoint].Value = 1
+		c.Metrics[model.ClientMetricHandledSecondsPoint].Value = 0.01
+		p.ProcessClientMetrics(c)
+	}
+	report()
+	recorder.flush(p.aggregator)
+	report()
+	report()
+	m := recorder.flush(p.aggregator)
+	require.Len(t, m.ClientMetrics, 1)
+	assert.Equal(t, int64(3), m.ClientMetrics[0].RpcClientStartedTotal)
+	assert.Equal(t, int64(3), m.ClientMetrics[0].RpcClientHandledSeconds.Count)
+	assert.NotZero(t, m.ClientMetrics[0].StartTimestampMs)
+	m = recorder.flush(p.aggregator)
+	require.Len(t, m.ClientMetrics, 1)
+	assert.Equal(t, int64(3), m.ClientMetrics[0].RpcClientHandledTotal)
+	assert.Equal(t, "m", m.ClientMetrics[0].RpcLabels.Fields[0].Value)
+}
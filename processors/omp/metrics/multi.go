@@ -119,7 +119,12 @@ func (m *multi) resetPoints() {
 	m.points = m.points[:0]
 }
 
-func (m *multi) setPoints(extractor model.OMPMetric, getBucket func(name string) point.BucketFunc) {
+func (m *multi) setPoints(
+	extractor model.OMPMetric,
+	getBucket func(name string) point.BucketFunc,
+	exponentialFunc point.ExponentialFunc,
+	summaryFunc point.SummaryFunc,
+) {
 	count := extractor.PointCount()
 	if cap(m.points) < count {
 		m.points = make([]*point.Point, 0, count)
@@ -129,8 +134,13 @@ func (m *multi) setPoints(extractor model.OMPMetric, getBucket func(name string)
 		name := extractor.PointName(i)
 		aggregation := extractor.PointAggregation(i)
 		m.points[i] = point.Get(aggregation, name)
-		if aggregation == model.Aggregation_AGGREGATION_HISTOGRAM {
+		switch aggregation {
+		case model.Aggregation_AGGREGATION_HISTOGRAM:
 			m.points[i].SetBucket(getBucket(name))
+		case model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM:
+			m.points[i].SetExponential(exponentialFunc)
+		case model.Aggregation_AGGREGATION_SUMMARY:
+			m.points[i].SetSummary(summaryFunc)
 		}
 	}
 }
@@ -185,7 +195,22 @@ func (m *multi) update(extractor model.OMPMetric) {
 			return
 		}
 	}
+	var sc *model.SpanContext
+	if e, ok := extractor.(model.OMPExemplar); ok {
+		sc = e.ExemplarSpanContext()
+	}
+	w, weighted := extractor.(model.OMPWeight)
 	for i := range m.points {
+		if weighted {
+			if n, sum := w.PointWeight(i); n > 0 {
+				m.points[i].UpdateN(extractor.PointValue(i), n, sum)
+				continue
+			}
+		}
+		if sc != nil {
+			m.points[i].UpdateWithExemplar(extractor.PointValue(i), sc)
+			continue
+		}
 		m.points[i].Update(extractor.PointValue(i))
 	}
 	m.updateUnix = times.SecondPrecisionUnix() // 15 ns/op
@@ -35,6 +35,7 @@ type PK struct {
 	pointCount     int               // 数据点数（单值点 1 个，多值点大于 1）。
 	namesHashCode  uint64            // 所有数据点 name 的 hash。
 	namesBytes     int               // 所有数据点 name 的总字节数。
+	target         *target           // 代理目标，nil 表示处理器自身。
 }
 
 func newPK() *PK {
@@ -48,6 +49,7 @@ func (p *PK) reset() {
 	p.pointCount = 0
 	p.namesHashCode = 0
 	p.namesBytes = 0
+	p.target = nil
 }
 
 func (p *PK) copyTo(to *PK) {
@@ -57,6 +59,16 @@ func (p *PK) copyTo(to *PK) {
 	to.pointCount = p.pointCount
 	to.namesHashCode = p.namesHashCode
 	to.namesBytes = p.namesBytes
+	to.target = p.target
+}
+
+// shardIndex 数据分片下标，代理目标的数据按目标打散到不同分片。
+func (p *PK) shardIndex() uint64 {
+	hashCode := p.labelsHashCode
+	if p.target != nil {
+		hashCode ^= p.target.hashCode
+	}
+	return hashCode % shardCount
 }
 
 func (p *PK) set(extractor model.OMPMetric) {
@@ -0,0 +1,230 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package point
+
+import (
+	"math"
+)
+
+const (
+	// DefaultRelativeAccuracy 分位值默认相对误差。
+	DefaultRelativeAccuracy = 0.01
+	// MinRelativeAccuracy 分位值最小相对误差，更小的误差分桶数过多，没有实际意义。
+	MinRelativeAccuracy = 0.0001
+	// maxSketchBins 正数、负数各自的最大分桶数，超出后合并最小的分桶，只影响绝对值最小的分位值精度。
+	maxSketchBins = 2048
+	// minNormalFloat64 最小的规格化正浮点数，更小的非规格化浮点数精度不足，无法保证相对误差。
+	minNormalFloat64 = 0x1p-1022
+)
+
+// ddsketch 可合并的分位值草图（DDSketch），对数分桶，保证分位值的相对误差不超过 relativeAccuracy。
+// 第 index 个分桶范围为 (gamma^(index-1), gamma^index]，gamma = (1+α)/(1-α)。
+type ddsketch struct {
+	positive         sketchStore // 正数分桶。
+	negative         sketchStore // 负数分桶（按绝对值分桶）。
+	relativeAccuracy float64     // 相对误差 α。
+	gamma            float64
+	multiplier       float64 // 1/ln(gamma)，计算分桶 index 使用。
+	zeroCount        int64   // 无法分桶的极小值（含 0）数量。
+}
+
+// sketchStore 从 offset 开始的连续分桶。
+type sketchStore struct {
+	counts []int64
+	offset int32
+}
+
+// setRelativeAccuracy 设置相对误差，同时清空数据。
+func (s *ddsketch) setRelativeAccuracy(relativeAccuracy float64) {
+	if relativeAccuracy <= 0 || relativeAccuracy >= 1 {
+		relativeAccuracy = DefaultRelativeAccuracy
+	}
+	if relativeAccuracy < MinRelativeAccuracy {
+		relativeAccuracy = MinRelativeAccuracy
+	}
+	s.relativeAccuracy = relativeAccuracy
+	s.gamma = (1 + relativeAccuracy) / (1 - relativeAccuracy)
+	s.multiplier = 1 / math.Log(s.gamma)
+	s.clear()
+}
+
+func (s *ddsketch) clear() {
+	s.zeroCount = 0
+	s.positive.clear()
+	s.negative.clear()
+}
+
+// add 记录一个数据，v 不能是 NaN 或 Inf。
+func (s *ddsketch) add(v float64) {
+	abs := math.Abs(v)
+	if abs < minNormalFloat64*s.gamma { // 极小值无法保证相对误差，与 0 一起计数。
+		s.zeroCount++
+		return
+	}
+	if v > 0 {
+		s.positive.add(s.index(abs))
+		return
+	}
+	s.negative.add(s.index(abs))
+}
+
+func (s *ddsketch) index(v float64) int32 {
+	return int32(math.Ceil(math.Log(v) * s.multiplier))
+}
+
+// value 第 index 个分桶的代表值，与分桶内任意值的相对误差不超过 α。
+func (s *ddsketch) value(index int32) float64 {
+	return 2 * math.Pow(s.gamma, float64(index)) / (1 + s.gamma)
+}
+
+func (s *ddsketch) count() int64 {
+	return s.zeroCount + s.positive.count() + s.negative.count()
+}
+
+// quantile 计算分位值，q 取值 [0, 1]，没有数据时返回 0。
+// 按负数（绝对值从大到小）、0、正数（从小到大）的顺序累加，找到排名为 q*(count-1) 的分桶。
+func (s *ddsketch) quantile(q float64) float64 {
+	count := s.count()
+	if count == 0 {
+		return 0
+	}
+	rank := q * float64(count-1)
+	var cumulative int64
+	for i := len(s.negative.counts) - 1; i >= 0; i-- {
+		cumulative += s.negative.counts[i]
+		if float64(cumulative) > rank {
+			return -s.value(s.negative.offset + int32(i))
+		}
+	}
+	cumulative += s.zeroCount
+	if float64(cumulative) > rank {
+		return 0
+	}
+	for i := range s.positive.counts {
+		cumulative += s.positive.counts[i]
+		if float64(cumulative) > rank {
+			return s.value(s.positive.offset + int32(i))
+		}
+	}
+	if n := len(s.positive.counts); n > 0 { // 浮点误差兜底，返回最大值。
+		return s.value(s.positive.offset + int32(n) - 1)
+	}
+	return 0
+}
+
+// merge 合并另一个相同相对误差的草图。
+func (s *ddsketch) merge(o *ddsketch) {
+	s.zeroCount += o.zeroCount
+	s.positive.merge(&o.positive)
+	s.negative.merge(&o.negative)
+}
+
+// change 根据放大系数修改分桶计数。
+func (s *ddsketch) change(factor float64) {
+	s.zeroCount = roundInt64(float64(s.zeroCount) * factor)
+	s.positive.change(factor)
+	s.negative.change(factor)
+}
+
+func (b *sketchStore) add(index int32) {
+	b.addCount(index, 1)
+}
+
+// addCount index 对应的分桶计数 +count，需要时扩展分桶，超出最大分桶数时合并最小的分桶。
+func (b *sketchStore) addCount(index int32, count int64) {
+	if len(b.counts) == 0 {
+		b.counts = append(b.counts, count)
+		b.offset = index
+		return
+	}
+	end := b.offset + int32(len(b.counts)) - 1
+	switch {
+	case index < b.offset:
+		if int64(end)-int64(index) >= maxSketchBins { // 太小，计入最小的分桶。
+			index = end - maxSketchBins + 1
+		}
+		if shift := int(b.offset - index); shift > 0 {
+			b.counts = append(b.counts, make([]int64, shift)...)
+			copy(b.counts[shift:], b.counts)
+			for i := 0; i < shift; i++ {
+				b.counts[i] = 0
+			}
+			b.offset = index
+		}
+	case index > end:
+		if int64(index)-int64(b.offset) >= maxSketchBins {
+			b.collapse(index - maxSketchBins + 1)
+			end = b.offset + int32(len(b.counts)) - 1
+		}
+		b.counts = append(b.counts, make([]int64, int(index-end))...)
+	}
+	b.counts[index-b.offset] += count
+}
+
+// collapse 小于 offset 的分桶合并到 offset 分桶。
+func (b *sketchStore) collapse(offset int32) {
+	if offset <= b.offset {
+		return
+	}
+	shift := int(offset - b.offset)
+	if shift >= len(b.counts) {
+		total := b.count()
+		b.counts = append(b.counts[:0], total)
+		b.offset = offset
+		return
+	}
+	var total int64
+	for i := 0; i <= shift; i++ {
+		total += b.counts[i]
+	}
+	n := copy(b.counts, b.counts[shift:])
+	b.counts = b.counts[:n]
+	b.counts[0] = total
+	b.offset = offset
+}
+
+func (b *sketchStore) merge(o *sketchStore) {
+	for i, c := range o.counts {
+		if c != 0 {
+			b.addCount(o.offset+int32(i), c)
+		}
+	}
+}
+
+func (b *sketchStore) change(factor float64) {
+	for i := range b.counts {
+		b.counts[i] = roundInt64(float64(b.counts[i]) * factor)
+	}
+}
+
+func (b *sketchStore) count() int64 {
+	var total int64
+	for _, c := range b.counts {
+		total += c
+	}
+	return total
+}
+
+func (b *sketchStore) clear() {
+	for i := range b.counts {
+		b.counts[i] = 0
+	}
+	b.counts = b.counts[:0]
+	b.offset = 0
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package point
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ddsketch_quantile(t *testing.T) {
+	s := &ddsketch{}
+	s.setRelativeAccuracy(0.01)
+	assert.Equal(t, 0.0, s.quantile(0.5))
+	for _, v := range []float64{-3, -2, -1, 0, math.SmallestNonzeroFloat64, 1, 2, 3} {
+		s.add(v)
+	}
+	assert.Equal(t, int64(2), s.zeroCount) // 极小值与 0 一起计数。
+	assert.InEpsilon(t, -3, s.quantile(0), 0.01)
+	assert.InEpsilon(t, -1, s.quantile(0.3), 0.01)
+	assert.Equal(t, 0.0, s.quantile(0.5))
+	assert.InEpsilon(t, 3, s.quantile(1), 0.01)
+}
+
+func Test_ddsketch_merge(t *testing.T) {
+	a, b := &ddsketch{}, &ddsketch{}
+	a.setRelativeAccuracy(0.01)
+	b.setRelativeAccuracy(0.01)
+	for i := 1; i <= 100; i++ {
+		a.add(float64(i))
+		b.add(float64(i + 100))
+	}
+	b.add(0)
+	a.merge(b)
+	assert.Equal(t, int64(201), a.count())
+	assert.InEpsilon(t, 100, a.quantile(0.5), 0.01)
+	assert.InEpsilon(t, 200, a.quantile(1), 0.01)
+}
+
+func Test_sketchStore_addCount(t *testing.T) {
+	b := &sketchStore{}
+	b.add(5)
+	b.add(3)
+	b.addCount(7, 2)
+	assert.Equal(t, int32(3), b.offset)
+	assert.Equal(t, []int64{1, 0, 1, 0, 2}, b.counts)
+
+	// 超出最大分桶数，合并最小的分桶。
+	b.add(3 + maxSketchBins)
+	assert.Equal(t, int32(4), b.offset)
+	assert.Len(t, b.counts, maxSketchBins)
+	assert.Equal(t, int64(1), b.counts[0])
+	assert.Equal(t, int64(5), b.count())
+
+	// 比最小的分桶还小太多，计入最小的分桶。
+	b.add(-maxSketchBins)
+	assert.Equal(t, int32(4), b.offset)
+	assert.Equal(t, int64(2), b.counts[0])
+
+	b.collapse(10 + maxSketchBins)
+	assert.Equal(t, []int64{6}, b.counts)
+	assert.Equal(t, int32(10+maxSketchBins), b.offset)
+}
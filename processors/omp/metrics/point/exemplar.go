@@ -0,0 +1,100 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package point
+
+import (
+	"sync/atomic"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// exemplar 分桶样例数据。
+type exemplar struct {
+	value   float64
+	unixMs  int64
+	traceID [16]byte
+	spanID  [8]byte
+	sampled bool
+	set     bool
+}
+
+// UpdateWithExemplar 更新数据，histogram 类型同时为 v 所在的分桶记录 exemplar。
+// 每个窗口每个分桶只保留一个 exemplar：已有的未采样而新的已采样时替换，否则保留先到的。
+// 与 Update 一样，调用方需要保证并发安全。
+func (p *Point) UpdateWithExemplar(v float64, sc *model.SpanContext) {
+	if p == nil {
+		return
+	}
+	p.Update(v)
+	if sc == nil || p.aggregation != model.Aggregation_AGGREGATION_HISTOGRAM || !p.hasBucket() {
+		return
+	}
+	if len(p.exemplars) != len(p.counts) {
+		p.exemplars = make([]exemplar, len(p.counts))
+	}
+	e := &p.exemplars[p.searchBucket(v)]
+	if e.set && (e.sampled || !sc.Sampled) {
+		return
+	}
+	e.value = v
+	e.unixMs = time.Now().UnixMilli()
+	copy(e.traceID[:], sc.TraceId)
+	copy(e.spanID[:], sc.SpanId)
+	e.sampled = sc.Sampled
+	e.set = true
+}
+
+// getAndClearExemplars 按 getAndClearBucket 导出的非 0 分桶顺序返回 exemplar，没有 exemplar 的分桶为 nil。
+// 必须在 getAndClearBucket 之前调用，整个窗口都没有 exemplar 时返回 nil。
+func (p *Point) getAndClearExemplars() []*model.Exemplar {
+	if len(p.exemplars) == 0 || len(p.exemplars) != len(p.counts) {
+		return nil
+	}
+	var exemplars []*model.Exemplar
+	found := false
+	for i := range p.counts {
+		if atomic.LoadInt64(&p.counts[i]) == 0 {
+			continue
+		}
+		var out *model.Exemplar
+		if e := &p.exemplars[i]; e.set {
+			out = &model.Exemplar{
+				Value:       e.value,
+				TimestampMs: e.unixMs,
+				TraceId:     append([]byte(nil), e.traceID[:]...),
+				SpanId:      append([]byte(nil), e.spanID[:]...),
+				Sampled:     e.sampled,
+			}
+			found = true
+		}
+		exemplars = append(exemplars, out)
+	}
+	p.clearExemplars()
+	if !found {
+		return nil
+	}
+	return exemplars
+}
+
+func (p *Point) clearExemplars() {
+	for i := range p.exemplars {
+		p.exemplars[i] = exemplar{}
+	}
+}
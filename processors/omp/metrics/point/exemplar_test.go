@@ -0,0 +1,79 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package point
+
+import (
+	"testing"
+
+	"galiosight.ai/galio-sdk-go/configs"
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoint_UpdateWithExemplar(t *testing.T) {
+	p := Get(model.Aggregation_AGGREGATION_HISTOGRAM, "rpc_server_handled_seconds")
+	defer Put(p)
+	p.SetBucket(func() *configs.Bucket { return configs.NewBucket([]float64{0, 1, 2}) })
+
+	unsampled := &model.SpanContext{TraceId: make([]byte, 16), SpanId: []byte{1, 0, 0, 0, 0, 0, 0, 0}}
+	unsampled.TraceId[0] = 1
+	sampled := &model.SpanContext{TraceId: make([]byte, 16), SpanId: []byte{2, 0, 0, 0, 0, 0, 0, 0}, Sampled: true}
+	sampled.TraceId[0] = 2
+	other := &model.SpanContext{TraceId: make([]byte, 16), SpanId: []byte{3, 0, 0, 0, 0, 0, 0, 0}, Sampled: true}
+	other.TraceId[0] = 3
+
+	p.UpdateWithExemplar(0.5, unsampled) // 桶 0...1：先记录未采样的。
+	p.UpdateWithExemplar(0.6, sampled)   // 桶 0...1：采样的替换未采样的。
+	p.UpdateWithExemplar(0.7, other)     // 桶 0...1：已经有采样的，保留先到的。
+	p.Update(1.5)                        // 桶 1...2：没有 span 上下文。
+	p.UpdateWithExemplar(3, unsampled)   // 桶 2...+Inf：只有未采样的。
+
+	otp := &model.ServerMetricsOTP{}
+	n, err := p.ToOTP(otp, model.ServerMetricHandledSecondsPoint)
+	require.Nil(t, err)
+	assert.Equal(t, 5, n)
+	buckets := otp.RpcServerHandledSeconds.Buckets
+	require.Len(t, buckets, 3)
+	require.NotNil(t, buckets[0].Exemplar)
+	assert.Equal(t, 0.6, buckets[0].Exemplar.Value)
+	assert.Equal(t, sampled.TraceId, buckets[0].Exemplar.TraceId)
+	assert.Equal(t, sampled.SpanId, buckets[0].Exemplar.SpanId)
+	assert.True(t, buckets[0].Exemplar.Sampled)
+	assert.NotZero(t, buckets[0].Exemplar.TimestampMs)
+	assert.Nil(t, buckets[1].Exemplar)
+	require.NotNil(t, buckets[2].Exemplar)
+	assert.False(t, buckets[2].Exemplar.Sampled)
+
+	// 下一个窗口 exemplar 已清空。
+	p.Update(0.5)
+	otp = &model.ServerMetricsOTP{}
+	_, err = p.ToOTP(otp, model.ServerMetricHandledSecondsPoint)
+	require.Nil(t, err)
+	require.Len(t, otp.RpcServerHandledSeconds.Buckets, 1)
+	assert.Nil(t, otp.RpcServerHandledSeconds.Buckets[0].Exemplar)
+}
+
+func TestPoint_UpdateWithExemplar_NotHistogram(t *testing.T) {
+	p := Get(model.Aggregation_AGGREGATION_COUNTER, "rpc_server_handled_total")
+	defer Put(p)
+	p.UpdateWithExemplar(1, &model.SpanContext{TraceId: make([]byte, 16)})
+	assert.Equal(t, int64(1), p.Count())
+	assert.Empty(t, p.exemplars)
+}
@@ -0,0 +1,309 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package point
+
+import (
+	"math"
+
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+const (
+	// ExponentialMaxScale 指数直方图最大 scale，与 OpenTelemetry 一致。
+	ExponentialMaxScale int32 = 20
+	// ExponentialMinScale 指数直方图最小 scale，与 OpenTelemetry 一致。
+	ExponentialMinScale int32 = -10
+	// DefaultExponentialMaxSize 指数直方图默认最大分桶数，与 OpenTelemetry 一致。
+	DefaultExponentialMaxSize int32 = 160
+	// minExponentialMaxSize 最大分桶数的下限，至少 2 个分桶才能通过降低 scale 容纳任意数据。
+	minExponentialMaxSize int32 = 2
+)
+
+// scaleFactors log2(e) * 2^scale，scale > 0 时计算分桶 index 使用。
+var scaleFactors = func() [ExponentialMaxScale + 1]float64 {
+	var factors [ExponentialMaxScale + 1]float64
+	for i := range factors {
+		factors[i] = math.Ldexp(math.Log2E, i)
+	}
+	return factors
+}()
+
+// ExponentialFunc 指数直方图配置获取函数，返回最大分桶数及最大 scale。
+type ExponentialFunc func() (maxSize, maxScale int32)
+
+// exponential 指数直方图数据，分桶边界为 base 的整数次幂，base = 2^(2^-scale)。
+// 每次聚合窗口从 maxScale 开始，分桶数超过 maxSize 时降低 scale，相邻分桶两两合并。
+type exponential struct {
+	cfgFunc   ExponentialFunc   // 配置获取函数，导出后检查配置变化。
+	maxSize   int32             // 正数、负数各自的最大分桶数。
+	maxScale  int32             // 每个窗口的初始 scale。
+	scale     int32             // 当前 scale。
+	zeroCount int64             // 值为 0 的数量。
+	positive  exponentialBucket // 正数分桶。
+	negative  exponentialBucket // 负数分桶（按绝对值分桶）。
+}
+
+// exponentialBucket 从 offset 开始的连续分桶。
+type exponentialBucket struct {
+	offset int32
+	counts []int64
+}
+
+func initExponentialHistogram(p *Point) {
+	p.toOTPFunc = exponentialHistogramToOTP
+	p.updateFunc = updateExponentialHistogram
+	p.changeFunc = changeExponentialHistogram
+	if p.expo == nil {
+		p.expo = &exponential{}
+	}
+	p.expo.setConfig(DefaultExponentialMaxSize, ExponentialMaxScale)
+}
+
+// SetExponential 设置指数直方图配置。
+func (p *Point) SetExponential(f ExponentialFunc) {
+	if f == nil || p.expo == nil {
+		return
+	}
+	p.expo.cfgFunc = f
+	p.expo.setConfig(f())
+}
+
+// setConfig 修正并设置配置，同时清空数据。
+func (e *exponential) setConfig(maxSize, maxScale int32) {
+	if maxSize <= 0 {
+		maxSize = DefaultExponentialMaxSize
+	}
+	if maxSize < minExponentialMaxSize {
+		maxSize = minExponentialMaxSize
+	}
+	if maxScale > ExponentialMaxScale {
+		maxScale = ExponentialMaxScale
+	}
+	if maxScale < ExponentialMinScale {
+		maxScale = ExponentialMinScale
+	}
+	e.maxSize = maxSize
+	e.maxScale = maxScale
+	e.clear()
+}
+
+// clear 清空数据，scale 恢复为 maxScale，保留分桶内存。
+func (e *exponential) clear() {
+	e.scale = e.maxScale
+	e.zeroCount = 0
+	e.positive.clear()
+	e.negative.clear()
+}
+
+func (e *exponential) reset() {
+	e.cfgFunc = nil
+	e.maxSize = 0
+	e.maxScale = 0
+	e.clear()
+}
+
+func updateExponentialHistogram(p *Point, v float64) {
+	if math.IsNaN(v) || math.IsInf(v, 0) { // 无法分桶，直接丢弃。
+		return
+	}
+	p.expo.record(v)
+	p.value += v
+	p.incCount()
+}
+
+// record 记录一个数据，超出最大分桶数时降低 scale。
+func (e *exponential) record(v float64) {
+	abs := math.Abs(v)
+	if abs == 0 {
+		e.zeroCount++
+		return
+	}
+	b := &e.positive
+	if v < 0 {
+		b = &e.negative
+	}
+	index := getIndex(abs, e.scale)
+	if delta := e.scaleChange(b, index); delta > 0 {
+		e.downscale(delta)
+		index = getIndex(abs, e.scale)
+	}
+	b.record(index)
+}
+
+// scaleChange 计算容纳 index 需要降低的 scale。
+func (e *exponential) scaleChange(b *exponentialBucket, index int32) int32 {
+	if len(b.counts) == 0 {
+		return 0
+	}
+	low, high := int64(b.offset), int64(b.offset)+int64(len(b.counts))-1
+	if index < b.offset {
+		low = int64(index)
+	} else if int64(index) > high {
+		high = int64(index)
+	}
+	var delta int32
+	for high-low >= int64(e.maxSize) && e.scale-delta > ExponentialMinScale {
+		low >>= 1
+		high >>= 1
+		delta++
+	}
+	return delta
+}
+
+func (e *exponential) downscale(delta int32) {
+	e.scale -= delta
+	e.positive.downscale(delta)
+	e.negative.downscale(delta)
+}
+
+// getIndex 计算 v 所在分桶的 index，分桶范围为 (base^index, base^(index+1)]。
+func getIndex(v float64, scale int32) int32 {
+	frac, exp := math.Frexp(v) // v = frac * 2^exp，frac 取值 [0.5, 1)。
+	if scale <= 0 {
+		correction := 1
+		if frac == 0.5 { // 2 的整数次幂恰好落在分桶上边界。
+			correction = 2
+		}
+		return int32((exp - correction) >> uint(-scale))
+	}
+	return int32(exp)<<scale + int32(math.Log(frac)*scaleFactors[scale]) - 1
+}
+
+// record index 对应的分桶计数 +1，需要时向两端扩展分桶。
+func (b *exponentialBucket) record(index int32) {
+	if len(b.counts) == 0 {
+		b.counts = append(b.counts, 1)
+		b.offset = index
+		return
+	}
+	end := b.offset + int32(len(b.counts)) - 1
+	switch {
+	case index < b.offset:
+		shift := int(b.offset - index)
+		b.counts = append(b.counts, make([]int64, shift)...)
+		copy(b.counts[shift:], b.counts)
+		for i := 1; i < shift; i++ {
+			b.counts[i] = 0
+		}
+		b.counts[0] = 1
+		b.offset = index
+	case index > end:
+		b.counts = append(b.counts, make([]int64, int(index-end))...)
+		b.counts[index-b.offset] = 1
+	default:
+		b.counts[index-b.offset]++
+	}
+}
+
+// downscale 降低 delta 个 scale，每 2^delta 个相邻分桶合并为一个。
+func (b *exponentialBucket) downscale(delta int32) {
+	if len(b.counts) == 0 {
+		return
+	}
+	steps := int32(1) << uint(delta)
+	offset := b.offset % steps
+	if offset < 0 { // 负数取模修正为正数。
+		offset += steps
+	}
+	for i := 1; i < len(b.counts); i++ {
+		idx := (int32(i) + offset) / steps
+		if (int32(i)+offset)%steps == 0 { // 新分桶的第一个原分桶，覆盖旧值。
+			b.counts[idx] = b.counts[i]
+			continue
+		}
+		b.counts[idx] += b.counts[i]
+	}
+	b.counts = b.counts[:(int32(len(b.counts))-1+offset)/steps+1]
+	b.offset >>= uint(delta)
+}
+
+func (b *exponentialBucket) clear() {
+	for i := range b.counts {
+		b.counts[i] = 0
+	}
+	b.counts = b.counts[:0]
+	b.offset = 0
+}
+
+// toOTP 拷贝分桶数据，没有数据时返回 nil。
+func (b *exponentialBucket) toOTP() *model.ExponentialBuckets {
+	if len(b.counts) == 0 {
+		return nil
+	}
+	counts := make([]int64, len(b.counts))
+	copy(counts, b.counts)
+	return &model.ExponentialBuckets{Offset: b.offset, BucketCounts: counts}
+}
+
+// exponentialHistogramToOTP 如果返回 0，说明没有数据需要上报，与 histogramToOTP 一致。
+// 只有实现了 model.OTPExponentialHistogram 的 otp 指标才能导出指数直方图。
+func exponentialHistogramToOTP(p *Point, injector model.OTPMetric, i int) int {
+	if !hasData(p) {
+		return 0
+	}
+	setter, ok := injector.(model.OTPExponentialHistogram)
+	if !ok {
+		p.getAndClearExponential()
+		return 0
+	}
+	h := p.getAndClearExponential()
+	injector.SetName(i, p.Name())
+	injector.SetAggregation(i, p.Aggregation())
+	setter.SetExponentialHistogram(i, h)
+	// 当前数据导出后，检查配置变化。
+	p.handleExponentialChange()
+	return 2 + len(h.GetPositive().GetBucketCounts()) + len(h.GetNegative().GetBucketCounts())
+}
+
+func (p *Point) getAndClearExponential() *model.ExponentialHistogram {
+	e := p.expo
+	h := &model.ExponentialHistogram{
+		Sum:       p.value,
+		Count:     p.count,
+		Scale:     e.scale,
+		ZeroCount: e.zeroCount,
+		Positive:  e.positive.toOTP(),
+		Negative:  e.negative.toOTP(),
+	}
+	e.clear()
+	p.value = 0
+	p.count = 0
+	return h
+}
+
+func (p *Point) handleExponentialChange() {
+	e := p.expo
+	if e.cfgFunc == nil {
+		return
+	}
+	e.setConfig(e.cfgFunc())
+}
+
+func changeExponentialHistogram(p *Point, factor float64) {
+	e := p.expo
+	for i := range e.positive.counts {
+		e.positive.counts[i] = roundInt64(float64(e.positive.counts[i]) * factor)
+	}
+	for i := range e.negative.counts {
+		e.negative.counts[i] = roundInt64(float64(e.negative.counts[i]) * factor)
+	}
+	e.zeroCount = roundInt64(float64(e.zeroCount) * factor)
+	p.value *= factor
+	p.count = roundInt64(float64(p.count) * factor)
+}
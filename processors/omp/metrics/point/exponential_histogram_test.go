@@ -0,0 +1,147 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package point
+
+import (
+	"math"
+	"testing"
+
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getIndex(t *testing.T) {
+	tests := []struct {
+		name  string
+		v     float64
+		scale int32
+		want  int32
+	}{
+		{name: "scale0_2的整数次幂落在上边界", v: 1, scale: 0, want: -1},
+		{name: "scale0_2", v: 2, scale: 0, want: 0},
+		{name: "scale0_3", v: 3, scale: 0, want: 1},
+		{name: "scale1_2", v: 2, scale: 1, want: 1},
+		{name: "scale1_1.5", v: 1.5, scale: 1, want: 1},
+		{name: "scale1_1.4", v: 1.4, scale: 1, want: 0},
+		{name: "scale-1_4", v: 4, scale: -1, want: 0},
+		{name: "scale-1_5", v: 5, scale: -1, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getIndex(tt.v, tt.scale)
+			assert.Equal(t, tt.want, got)
+			// v 落在 (base^index, base^(index+1)] 内。
+			assert.LessOrEqual(t, tt.v, model.ExponentialUpperBound(tt.scale, got)*(1+1e-9))
+			assert.Greater(t, tt.v, model.ExponentialUpperBound(tt.scale, got-1)*(1-1e-9))
+		})
+	}
+}
+
+func Test_updateExponentialHistogram(t *testing.T) {
+	p := Get(model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM, "Test_updateExponentialHistogram")
+	p.SetExponential(func() (int32, int32) { return 4, 0 })
+	for _, v := range []float64{1, 2, 4, 8, 16, 0, -3, math.NaN(), math.Inf(1)} {
+		p.Update(v)
+	}
+	otp := &model.NormalMetricOTP{Metric: &model.MetricOTP{}}
+	count, err := p.ToOTP(otp, 0)
+	require.Nil(t, err)
+	// 1、2、4、8、16 在 scale 0 下需要 5 个分桶，超出 4 个后降低到 scale -1。
+	assert.Equal(t, &model.NormalMetricOTP{
+		Metric: &model.MetricOTP{
+			Name: "Test_updateExponentialHistogram",
+			V: model.NewOTPExponentialHistogram(&model.ExponentialHistogram{
+				Sum:       28,
+				Count:     7,
+				Scale:     -1,
+				ZeroCount: 1,
+				Positive:  &model.ExponentialBuckets{Offset: -1, BucketCounts: []int64{1, 2, 2}},
+				Negative:  &model.ExponentialBuckets{Offset: 0, BucketCounts: []int64{1}},
+			}),
+			Aggregation: model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM,
+		},
+	}, otp)
+	assert.Equal(t, 6, count)
+
+	// 导出后数据清空，scale 恢复。
+	count, err = p.ToOTP(otp, 0)
+	require.Nil(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, int32(0), p.expo.scale)
+	Put(p)
+}
+
+func Test_exponentialBucket_record(t *testing.T) {
+	b := &exponentialBucket{}
+	for _, index := range []int32{5, 7, 2, 5} {
+		b.record(index)
+	}
+	assert.Equal(t, int32(2), b.offset)
+	assert.Equal(t, []int64{1, 0, 0, 2, 0, 1}, b.counts)
+
+	b.downscale(1) // 2~7 合并为 1~3。
+	assert.Equal(t, int32(1), b.offset)
+	assert.Equal(t, []int64{1, 2, 1}, b.counts)
+
+	b = &exponentialBucket{offset: -3, counts: []int64{1, 1, 1, 1}}
+	b.downscale(2) // -3~0 合并为 -1~0。
+	assert.Equal(t, int32(-1), b.offset)
+	assert.Equal(t, []int64{3, 1}, b.counts)
+}
+
+func Test_changeExponentialHistogram(t *testing.T) {
+	p := Get(model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM, "Test_changeExponentialHistogram")
+	p.Update(0)
+	p.Update(3)
+	p.Update(-3)
+	require.Nil(t, p.Change(2))
+	otp := &model.NormalMetricOTP{Metric: &model.MetricOTP{}}
+	_, err := p.ToOTP(otp, 0)
+	require.Nil(t, err)
+	h := otp.Metric.GetExponentialHistogram()
+	require.NotNil(t, h)
+	assert.Equal(t, int64(6), h.Count)
+	assert.Equal(t, 0.0, h.Sum)
+	assert.Equal(t, int64(2), h.ZeroCount)
+	assert.Equal(t, []int64{2}, h.Positive.BucketCounts)
+	assert.Equal(t, []int64{2}, h.Negative.BucketCounts)
+	assert.Equal(t, ExponentialMaxScale, h.Scale)
+	Put(p)
+}
+
+func Test_handleExponentialChange(t *testing.T) {
+	maxSize, maxScale := int32(160), int32(20)
+	p := Get(model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM, "Test_handleExponentialChange")
+	p.SetExponential(func() (int32, int32) { return maxSize, maxScale })
+	p.Update(1)
+	maxScale = 100 // 超出范围，取最大值。
+	maxSize = 0    // 使用默认值。
+	_, err := p.ToOTP(&model.NormalMetricOTP{Metric: &model.MetricOTP{}}, 0)
+	require.Nil(t, err)
+	assert.Equal(t, ExponentialMaxScale, p.expo.scale)
+	assert.Equal(t, DefaultExponentialMaxSize, p.expo.maxSize)
+
+	maxScale = -3
+	p.Update(1)
+	_, err = p.ToOTP(&model.NormalMetricOTP{Metric: &model.MetricOTP{}}, 0)
+	require.Nil(t, err)
+	assert.Equal(t, int32(-3), p.expo.scale)
+	Put(p)
+}
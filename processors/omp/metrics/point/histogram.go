@@ -50,6 +50,25 @@ func updateHistogram(p *Point, v float64) {
 	p.incCount()
 }
 
+// UpdateN 批量更新 n 次落在 v 所在分桶、总和为 sum 的观测值。
+// 仅 histogram 类型支持批量更新，其他类型逐次更新 v。与 Update 一样，调用方需要保证并发安全。
+func (p *Point) UpdateN(v float64, n uint64, sum float64) {
+	if p == nil || n == 0 {
+		return
+	}
+	if p.aggregation != model.Aggregation_AGGREGATION_HISTOGRAM {
+		for ; n > 0; n-- {
+			p.Update(v)
+		}
+		return
+	}
+	if p.hasBucket() {
+		atomic.AddInt64(&p.counts[p.searchBucket(v)], int64(n))
+	}
+	p.value += sum
+	atomic.AddInt64(&p.count, int64(n))
+}
+
 func (p *Point) updateBucket(v float64) {
 	if !p.hasBucket() {
 		return
@@ -98,10 +117,14 @@ func histogramToOTP(p *Point, injector model.OTPMetric, i int) int {
 	if !hasData(p) {
 		return 0
 	}
+	exemplars := p.getAndClearExemplars()
 	ranges, counts, sum, count := p.getAndClearHistogram()
 	injector.SetName(i, p.Name())
 	injector.SetAggregation(i, p.Aggregation())
 	injector.SetHistogram(i, sum, count, ranges, counts)
+	if setter, ok := injector.(model.OTPExemplar); ok && exemplars != nil {
+		setter.SetExemplars(i, exemplars)
+	}
 	// 当前数据导出后，检查分桶变化。
 	p.handleBucketChange()
 	return 2 + len(ranges)
@@ -152,7 +175,8 @@ func (p *Point) resetHistogram(cfg *configs.Bucket) {
 		p.ranges[i] = start + strings.VMRangeSeparator + end
 		p.counts[i] = 0
 	}
-	p.value = 0 // 重新设置 sum + count。
+	p.exemplars = p.exemplars[:0] // 分桶变化，exemplar 下次更新时按新的分桶重新分配。
+	p.value = 0                   // 重新设置 sum + count。
 	p.count = 0
 }
 
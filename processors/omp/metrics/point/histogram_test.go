@@ -134,6 +134,28 @@ func Test_updateHistogram(t *testing.T) {
 	}
 }
 
+func TestPoint_UpdateN(t *testing.T) {
+	p := get("TestPoint_UpdateN", model.Aggregation_AGGREGATION_HISTOGRAM, []option{initHistogram})
+	p.SetBucket(func() *configs.Bucket { return configs.NewBucket([]float64{0, 0.01, 0.02, 0.05, 0.1}) })
+	p.UpdateN(0.03, 3, 0.1)
+	p.UpdateN(0.03, 0, 1) // 次数为 0，不更新。
+	p.Update(0.2)
+	otp := model.NewNormalMetricsOTP()
+	_, err := p.ToOTP(otp, 0)
+	require.NoError(t, err)
+	want := model.NewOTPHistogram(
+		0.30000000000000004, 4,
+		[]int64{3, 1},
+		[]string{"2.000e-02...5.000e-02", "1.000e-01...+Inf"},
+	)
+	assert.Equal(t, want, otp.Metric.V)
+
+	// 非 histogram 类型逐次更新。
+	c := Get(model.Aggregation_AGGREGATION_COUNTER, "counter")
+	c.UpdateN(2, 3, 6)
+	assert.Equal(t, int64(3), c.Count())
+}
+
 func Test_lowerBound(t *testing.T) {
 	type args struct {
 		array  []float64
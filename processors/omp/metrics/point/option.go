@@ -51,6 +51,12 @@ func newPointConfigs() []config {
 	configs[model.Aggregation_AGGREGATION_HISTOGRAM] = config{
 		options: []option{initHistogram},
 	}
+	configs[model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM] = config{
+		options: []option{initExponentialHistogram},
+	}
+	configs[model.Aggregation_AGGREGATION_SUMMARY] = config{
+		options: []option{initSummary},
+	}
 	configs[model.Aggregation_AGGREGATION_COUNTER] = config{
 		options: []option{initCounter},
 	}
@@ -17,7 +17,7 @@
 // limitations under the License.
 
 // Package point 数据点统一抽象，实现。
-// 实现类型：counter、histogram、avg、set、sum、max、min。
+// 实现类型：counter、histogram、exponential histogram、summary、avg、set、sum、max、min。
 package point
 
 import (
@@ -45,6 +45,10 @@ type Point struct {
 	name          string
 	ranges        []string          // histogram 分桶范围列表，如 1...2、2...4。
 	counts        []int64           // histogram 分桶计数列表，如 10、20。
+	exemplars     []exemplar        // histogram 分桶 exemplar 列表，与 counts 一一对应，没有 span 上下文时为空。
+	expo          *exponential      // 指数直方图数据，仅指数直方图类型使用。
+	sketch        *ddsketch         // 分位值草图，仅分位值类型使用。
+	summaryFunc   SummaryFunc       // 分位值配置，更新函数。
 	counter       int64             // counter。
 	value         float64           // avg、histogram、max、min、set、sum。
 	count         int64             // 计数，数据更新 1 次，+1。
@@ -63,8 +67,10 @@ func get(name string, aggregation model.Aggregation, defaultOpts []option) *Poin
 }
 
 var (
-	pointPool          sync.Pool
-	pointHistogramPool sync.Pool
+	pointPool            sync.Pool
+	pointHistogramPool   sync.Pool
+	pointExponentialPool sync.Pool
+	pointSummaryPool     sync.Pool
 )
 
 func getOrNewPoint(aggregation model.Aggregation) *Point {
@@ -74,6 +80,18 @@ func getOrNewPoint(aggregation model.Aggregation) *Point {
 		}
 		return &Point{}
 	}
+	if aggregation == model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM {
+		if p, ok := pointExponentialPool.Get().(*Point); ok {
+			return p
+		}
+		return &Point{}
+	}
+	if aggregation == model.Aggregation_AGGREGATION_SUMMARY {
+		if p, ok := pointSummaryPool.Get().(*Point); ok {
+			return p
+		}
+		return &Point{}
+	}
 	if p, ok := pointPool.Get().(*Point); ok {
 		return p
 	}
@@ -96,6 +114,15 @@ func (p *Point) reset() {
 		p.ranges[i] = ""
 	}
 	p.ranges = p.ranges[:0]
+	p.clearExemplars()
+	p.exemplars = p.exemplars[:0]
+	if p.expo != nil {
+		p.expo.reset()
+	}
+	if p.sketch != nil {
+		p.sketch.clear()
+	}
+	p.summaryFunc = nil
 	p.updateFunc = nil
 	p.toOTPFunc = nil
 	p.changeFunc = nil
@@ -114,9 +141,14 @@ func Put(p *Point) {
 	}
 	a := p.aggregation
 	p.reset()
-	if a == model.Aggregation_AGGREGATION_HISTOGRAM {
+	switch a {
+	case model.Aggregation_AGGREGATION_HISTOGRAM:
 		pointHistogramPool.Put(p)
-	} else {
+	case model.Aggregation_AGGREGATION_EXPONENTIAL_HISTOGRAM:
+		pointExponentialPool.Put(p)
+	case model.Aggregation_AGGREGATION_SUMMARY:
+		pointSummaryPool.Put(p)
+	default:
 		pointPool.Put(p)
 	}
 }
@@ -0,0 +1,113 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package point
+
+import (
+	"math"
+
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// DefaultQuantiles 默认上报的分位。
+var DefaultQuantiles = []float64{0.5, 0.9, 0.99}
+
+// SummaryFunc 分位值统计配置获取函数，返回相对误差及上报的分位。
+type SummaryFunc func() (relativeAccuracy float64, quantiles []float64)
+
+func initSummary(p *Point) {
+	p.toOTPFunc = summaryToOTP
+	p.updateFunc = updateSummary
+	p.changeFunc = changeSummary
+	if p.sketch == nil {
+		p.sketch = &ddsketch{}
+	}
+	p.sketch.setRelativeAccuracy(DefaultRelativeAccuracy)
+}
+
+// SetSummary 设置分位值统计配置。
+func (p *Point) SetSummary(f SummaryFunc) {
+	if f == nil || p.sketch == nil {
+		return
+	}
+	p.summaryFunc = f
+	relativeAccuracy, _ := f()
+	p.sketch.setRelativeAccuracy(relativeAccuracy)
+}
+
+func updateSummary(p *Point, v float64) {
+	if math.IsNaN(v) || math.IsInf(v, 0) { // 无法分桶，直接丢弃。
+		return
+	}
+	p.sketch.add(v)
+	p.value += v
+	p.incCount()
+}
+
+// summaryToOTP 如果返回 0，说明没有数据需要上报，与 histogramToOTP 一致。
+// 只有实现了 model.OTPSummary 的 otp 指标才能导出分位值。
+func summaryToOTP(p *Point, injector model.OTPMetric, i int) int {
+	if !hasData(p) {
+		return 0
+	}
+	setter, ok := injector.(model.OTPSummary)
+	if !ok {
+		p.getAndClearSummary(nil)
+		return 0
+	}
+	relativeAccuracy, quantiles := p.summaryConfig()
+	values, sum, count := p.getAndClearSummary(quantiles)
+	injector.SetName(i, p.Name())
+	injector.SetAggregation(i, p.Aggregation())
+	setter.SetSummary(i, sum, count, quantiles, values)
+	// 当前数据导出后，检查相对误差变化。
+	if relativeAccuracy != p.sketch.relativeAccuracy {
+		p.sketch.setRelativeAccuracy(relativeAccuracy)
+	}
+	return 2 + len(quantiles)
+}
+
+func (p *Point) summaryConfig() (float64, []float64) {
+	if p.summaryFunc == nil {
+		return p.sketch.relativeAccuracy, DefaultQuantiles
+	}
+	relativeAccuracy, quantiles := p.summaryFunc()
+	if len(quantiles) == 0 {
+		quantiles = DefaultQuantiles
+	}
+	return relativeAccuracy, quantiles
+}
+
+func (p *Point) getAndClearSummary(quantiles []float64) ([]float64, float64, int64) {
+	values := make([]float64, len(quantiles))
+	for i, q := range quantiles {
+		values[i] = p.sketch.quantile(q)
+	}
+	sum := p.value
+	count := p.count
+	p.sketch.clear()
+	p.value = 0
+	p.count = 0
+	return values, sum, count
+}
+
+func changeSummary(p *Point, factor float64) {
+	p.sketch.change(factor)
+	p.value *= factor
+	p.count = roundInt64(float64(p.count) * factor)
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package point
+
+import (
+	"math"
+	"testing"
+
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_updateSummary(t *testing.T) {
+	p := Get(model.Aggregation_AGGREGATION_SUMMARY, "Test_updateSummary")
+	for i := 1; i <= 10000; i++ {
+		p.Update(float64(i))
+	}
+	p.Update(math.NaN())
+	otp := &model.NormalMetricOTP{Metric: &model.MetricOTP{}}
+	count, err := p.ToOTP(otp, 0)
+	require.Nil(t, err)
+	assert.Equal(t, 5, count)
+	assert.Equal(t, "Test_updateSummary", otp.Metric.Name)
+	assert.Equal(t, model.Aggregation_AGGREGATION_SUMMARY, otp.Metric.Aggregation)
+	s := otp.Metric.GetSummary()
+	require.NotNil(t, s)
+	assert.Equal(t, int64(10000), s.Count)
+	assert.Equal(t, 50005000.0, s.Sum)
+	require.Len(t, s.Quantiles, 3)
+	for i, want := range []float64{5000, 9000, 9900} {
+		assert.Equal(t, DefaultQuantiles[i], s.Quantiles[i].Quantile)
+		assert.InEpsilon(t, want, s.Quantiles[i].Value, DefaultRelativeAccuracy)
+	}
+
+	// 导出后数据清空。
+	count, err = p.ToOTP(otp, 0)
+	require.Nil(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, int64(0), p.sketch.count())
+	Put(p)
+}
+
+func Test_changeSummary(t *testing.T) {
+	p := Get(model.Aggregation_AGGREGATION_SUMMARY, "Test_changeSummary")
+	p.SetSummary(func() (float64, []float64) { return 0.02, []float64{0, 0.5, 1} })
+	for _, v := range []float64{-10, 0, 10, 100} {
+		p.Update(v)
+	}
+	require.Nil(t, p.Change(10)) // 采样放大，分位值不变。
+	otp := &model.NormalMetricOTP{Metric: &model.MetricOTP{}}
+	_, err := p.ToOTP(otp, 0)
+	require.Nil(t, err)
+	s := otp.Metric.GetSummary()
+	require.NotNil(t, s)
+	assert.Equal(t, int64(40), s.Count)
+	assert.Equal(t, 1000.0, s.Sum)
+	require.Len(t, s.Quantiles, 3)
+	assert.InEpsilon(t, -10, s.Quantiles[0].Value, 0.02)
+	assert.Equal(t, 0.0, s.Quantiles[1].Value)
+	assert.InEpsilon(t, 100, s.Quantiles[2].Value, 0.02)
+	Put(p)
+}
+
+func Test_summaryToOTP_配置变化(t *testing.T) {
+	relativeAccuracy := 0.01
+	p := Get(model.Aggregation_AGGREGATION_SUMMARY, "Test_summaryToOTP_配置变化")
+	p.SetSummary(func() (float64, []float64) { return relativeAccuracy, nil })
+	p.Update(1)
+	relativeAccuracy = 0.05
+	otp := &model.NormalMetricOTP{Metric: &model.MetricOTP{}}
+	count, err := p.ToOTP(otp, 0)
+	require.Nil(t, err)
+	assert.Equal(t, 2+len(DefaultQuantiles), count) // 没有配置分位时使用默认分位。
+	assert.Equal(t, 0.05, p.sketch.relativeAccuracy)
+
+	// 不支持分位值的 otp 指标不导出，数据清空。
+	p.Update(1)
+	count, err = p.ToOTP(&model.ClientMetricsOTP{}, 0)
+	require.Nil(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, int64(0), p.Count())
+	Put(p)
+}
@@ -52,8 +52,12 @@ type processor struct {
 	aggregator   *aggregator       // 双 bufer 聚合器（常规）。
 	aggregator1s []*aggregatorWrap // 双 bufer 聚合器（秒级，聚合窗口列表：1s，5s，10s）。
 	sampler      *sampler          // 采样器。
+	limiter      *limiter          // 时间线预算限制器。
+	targets      *targets          // 代理目标注册表。
 }
 
+var _ components.MultiTargetMetricsProcessor = (*processor)(nil)
+
 // Watch 更新配置。注意 Resource 是初始化时就确定了的，后面不再发生变化。
 func (p *processor) Watch(readOnlyConfig *ocp.GalileoConfig) {
 	p.UpdateConfig(
@@ -66,13 +70,16 @@ func (p *processor) Watch(readOnlyConfig *ocp.GalileoConfig) {
 }
 
 func newProcessor(cfg *configs.Metrics, exporter components.MetricsExporter) *processor {
-	return &processor{
+	p := &processor{
 		cfg:          cfg,
 		exporter:     exporter,
 		stats:        cfg.Stats,
 		normalLabels: model.ResourceToLabels(&cfg.Resource),
 		sampler:      newSampler(cfg.Processor.SampleMonitors),
+		limiter:      newLimiter(&cfg.Processor.SeriesLimits),
+		targets:      newTargets(&cfg.Processor.MultiTarget, cfg.Stats),
 	}
+	return p
 }
 
 // NewProcessor 构造 omp 协议监控处理器。
@@ -89,12 +96,13 @@ func NewProcessor(cfg *configs.Metrics, exporter components.MetricsExporter) (co
 		return time.Duration(atomic.LoadInt32(&p.cfg.Processor.WindowSeconds)) * time.Second
 	}
 	p.aggregator = newAggregator(
-		p.normalLabels, windowFunc, bucketFunc, overloadProtectionFunc, p.stats, exporter,
-		p.sampler,
+		p.normalLabels, windowFunc, bucketFunc, p.getExponential, p.getSummary, overloadProtectionFunc,
+		p.getTemporality, p.stats, exporter, p.sampler, p.limiter, p.targets,
 	)
 	p.aggregator1s = newAggregatorWraps(
 		[]time.Duration{time.Second, time.Second * 5, time.Second * 10}, // 预留窗口 1s 5s 10s
-		p.normalLabels, bucketFunc, overloadProtectionFunc, p.stats, exporter, p.sampler,
+		p.normalLabels, bucketFunc, p.getExponential, p.getSummary, overloadProtectionFunc, p.getTemporality,
+		p.stats, exporter, p.sampler, p.limiter, p.targets,
 	)
 	go p.reportRuntimes()        // 上报运行时监控。
 	go p.reportGalileoRuntimes() // 上报 galileo runtime 监控
@@ -106,10 +114,15 @@ func newAggregatorWraps(
 	windows []time.Duration,
 	normalLabels *model.NormalLabels,
 	bucketFunc func(name string) point.BucketFunc,
+	exponentialFunc point.ExponentialFunc,
+	summaryFunc point.SummaryFunc,
 	overloadProtectionFunc func() bool,
+	temporalityFunc func() temporalityConfig,
 	stats *model.SelfMonitorStats,
 	exporter components.MetricsExporter,
 	sampler *sampler,
+	limiter *limiter,
+	targets *targets,
 ) []*aggregatorWrap {
 	sort.Slice(
 		windows, func(i, j int) bool {
@@ -123,10 +136,15 @@ func newAggregatorWraps(
 			normalLabels,
 			func() time.Duration { return wrap.window },
 			bucketFunc,
+			exponentialFunc,
+			summaryFunc,
 			overloadProtectionFunc,
+			temporalityFunc,
 			stats,
 			exporter,
 			sampler,
+			limiter,
+			targets,
 		)
 		aggregatorWraps[i] = wrap
 	}
@@ -135,50 +153,105 @@ func newAggregatorWraps(
 
 // ProcessClientMetrics 处理主调监控。
 func (p *processor) ProcessClientMetrics(c *model.ClientMetrics) {
+	p.processClientMetrics(nil, c)
+}
+
+// processClientMetrics 处理目标 t 的主调监控，t 为 nil 表示处理器自身。
+func (p *processor) processClientMetrics(t *target, c *model.ClientMetrics) {
 	p.cfg.GetIgnoreLabels().IgnoreClientLabels(c)
+	if !p.cfg.GetLabelRules().RewriteClientLabels(c) {
+		p.stats.LabelRuleDropCount.Inc()
+		return
+	}
 	pk := getPK()
 	defer putPK(pk)
+	pk.target = t
 	p.getAggregator(model.ClientGroup, model.RPCClient).aggregate(pk, &c.RpcLabels, nil, model.RPCClient, c)
 }
 
 // ProcessServerMetrics 处理被调监控。
 func (p *processor) ProcessServerMetrics(s *model.ServerMetrics) {
+	p.processServerMetrics(nil, s)
+}
+
+// processServerMetrics 处理目标 t 的被调监控，t 为 nil 表示处理器自身。
+func (p *processor) processServerMetrics(t *target, s *model.ServerMetrics) {
 	runtimes.AddRpcServerHandledTotal()
 	p.cfg.GetIgnoreLabels().IgnoreServerLabels(s)
+	if !p.cfg.GetLabelRules().RewriteServerLabels(s) {
+		p.stats.LabelRuleDropCount.Inc()
+		return
+	}
 	pk := getPK()
 	defer putPK(pk)
+	pk.target = t
 	p.getAggregator(model.ServerGroup, model.RPCServer).aggregate(pk, &s.RpcLabels, nil, model.RPCServer, s)
 }
 
 // ProcessNormalMetric 处理属性监控。
 func (p *processor) ProcessNormalMetric(n *model.NormalMetric) {
+	p.processNormalMetric(nil, n)
+}
+
+// processNormalMetric 处理目标 t 的属性监控，t 为 nil 表示处理器自身。
+func (p *processor) processNormalMetric(t *target, n *model.NormalMetric) {
 	pk := getPK()
 	defer putPK(pk)
+	pk.target = t
 	p.getAggregator(model.NormalGroup, model.NormalProperty).aggregate(pk, nil, nil, model.NormalProperty, n)
 }
 
 // ProcessCustomMetrics 处理自定义监控。
 // 会进行维度过载保护，根据配置忽略掉部分 label。
+// 会按标签改写规则改写 label 值，或丢弃整条时间线。
 // 会对中文指标和维度进行编码，转成英文，因为后端不支持中文指标。
 // 会将指标值按聚合策略存储到对应的 hash 桶中，定时上报。
 // 会收集指标的元数据，上报到 OCP 服务，用于数据管理。
 func (p *processor) ProcessCustomMetrics(c *model.CustomMetrics) {
+	p.processCustomMetrics(nil, c)
+}
+
+// processCustomMetrics 处理目标 t 的自定义监控，t 为 nil 表示处理器自身。
+func (p *processor) processCustomMetrics(t *target, c *model.CustomMetrics) {
 	if c.MonitorName == "" {
 		c.MonitorName = "default"
 	}
 	p.cfg.GetIgnoreLabels().IgnoreCustomLabels(c)
+	if !p.cfg.GetLabelRules().RewriteCustomLabels(c) {
+		p.stats.LabelRuleDropCount.Inc()
+		return
+	}
 	if p.cfg.ConvertName {
 		convertName(c)
 	}
-	p.processCustomMetrics(c)
-}
-
-func (p *processor) processCustomMetrics(c *model.CustomMetrics) {
 	pk := getPK()
 	defer putPK(pk)
+	pk.target = t
 	p.getAggregator(model.CustomGroup, c.MonitorName).aggregate(pk, nil, c.CustomLabels, c.MonitorName, c)
 }
 
+// ForResource 返回代理 resource 对应目标的监控处理器。
+// 目标数超出上限时，返回的处理器在注册前丢弃数据，每次上报时重试注册，其他目标过期腾出目标数后恢复上报。
+func (p *processor) ForResource(resource *model.Resource) components.TargetMetricsProcessor {
+	if t := p.targets.get(resource, p.newTargetProcessor); t != nil {
+		return t.processor
+	}
+	return &targetProcessor{processor: p, resource: *resource}
+}
+
+// ForTarget 返回代理目标的监控处理器，resource 中除 target 外的字段使用处理器自身的配置。
+func (p *processor) ForTarget(target string) components.TargetMetricsProcessor {
+	resource := p.cfg.Resource
+	resource.Target = target
+	return p.ForResource(&resource)
+}
+
+func (p *processor) newTargetProcessor(t *target) components.TargetMetricsProcessor {
+	tp := &targetProcessor{processor: p, resource: t.resource}
+	tp.target.Store(t)
+	return tp
+}
+
 // GetStats 获取统计数据。
 func (p *processor) GetStats() *model.SelfMonitorStats {
 	return p.stats
@@ -20,6 +20,7 @@ package metrics
 
 import (
 	"math"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -49,11 +50,15 @@ func fixConfig(cfg *configs.Metrics) {
 	if cfg.Processor.ProcessMetricsSeconds <= 0 {
 		cfg.Processor.ProcessMetricsSeconds = 15 // 默认 15 秒上报一次 go runtime metrics。
 	}
+	fixExponentialHistogram(&cfg.Processor.ExponentialHistogram)
+	fixSummary(&cfg.Processor.Summary)
+	fixMultiTarget(&cfg.Processor.MultiTarget)
 	if cfg.Log == nil {
 		cfg.Log = logs.DefaultWrapper()
 	}
 	cfg.ConvBuckets()
 	cfg.ConvIgnoreLabels()
+	cfg.ConvLabelRules()
 	cfg.ConvSecondGranularitys()
 }
 
@@ -67,7 +72,106 @@ func (p *processor) UpdateConfig(cfg *configs.Metrics) {
 	p.setBuckets(cfg.HistogramBuckets)              // 处理器更新分桶配置。
 	p.setSecondLevels(cfg.SecondGranularitys)       // 秒级监控配置更新。
 	p.setIgnoreLabels(cfg.Processor.LabelIgnores)   // 屏蔽配置热更新。
+	p.setLabelRules(cfg.Processor.LabelRules)       // 标签改写规则热更新。
 	p.sampler.updateConfigs(cfg.Processor.SampleMonitors)
+	p.limiter.updateConfigs(&cfg.Processor.SeriesLimits)  // 时间线预算热更新。
+	p.setExponential(&cfg.Processor.ExponentialHistogram) // 指数直方图配置热更新，下个窗口生效。
+	p.setSummary(&cfg.Processor.Summary)                  // 分位值统计配置热更新，下个窗口生效。
+	p.setTemporality(cfg)                                 // 时间性配置热更新，下个窗口生效。
+	p.targets.updateConfigs(&cfg.Processor.MultiTarget)   // 多目标配置热更新。
+}
+
+// fixMultiTarget 修正多目标配置，目标数及每批目标数使用默认值。
+func fixMultiTarget(cfg *model.MultiTargetConfig) {
+	if cfg.MaxTargets <= 0 {
+		cfg.MaxTargets = defaultMaxTargets
+	}
+	if cfg.BatchTargets <= 0 {
+		cfg.BatchTargets = defaultBatchTargets
+	}
+}
+
+// fixSummary 修正分位值统计配置，去掉超出 [0, 1] 的分位，并从小到大排序去重。
+func fixSummary(cfg *model.SummaryConfig) {
+	if cfg.RelativeAccuracy <= 0 || cfg.RelativeAccuracy >= 1 {
+		cfg.RelativeAccuracy = point.DefaultRelativeAccuracy
+	}
+	if cfg.RelativeAccuracy < point.MinRelativeAccuracy {
+		cfg.RelativeAccuracy = point.MinRelativeAccuracy
+	}
+	quantiles := make([]float64, 0, len(cfg.Quantiles))
+	for _, q := range cfg.Quantiles {
+		if q >= 0 && q <= 1 {
+			quantiles = append(quantiles, q)
+		}
+	}
+	sort.Float64s(quantiles)
+	n := 0
+	for i := range quantiles {
+		if i == 0 || quantiles[i] != quantiles[i-1] {
+			quantiles[n] = quantiles[i]
+			n++
+		}
+	}
+	if n == 0 {
+		quantiles = append(quantiles[:0], point.DefaultQuantiles...)
+		n = len(quantiles)
+	}
+	cfg.Quantiles = quantiles[:n]
+}
+
+func (p *processor) setSummary(cfg *model.SummaryConfig) {
+	p.cfg.Mu.Lock()
+	defer p.cfg.Mu.Unlock()
+	p.cfg.Processor.Summary = *cfg // 整体替换，已经导出的 quantiles 切片不会被修改。
+}
+
+func (p *processor) getSummary() (relativeAccuracy float64, quantiles []float64) {
+	p.cfg.Mu.RLock()
+	defer p.cfg.Mu.RUnlock()
+	return p.cfg.Processor.Summary.RelativeAccuracy, p.cfg.Processor.Summary.Quantiles
+}
+
+func (p *processor) setTemporality(cfg *configs.Metrics) {
+	p.cfg.Mu.Lock()
+	defer p.cfg.Mu.Unlock()
+	p.cfg.Exporter.Temporality = cfg.Exporter.Temporality
+	p.cfg.Processor.ExpiresSeconds = cfg.Processor.ExpiresSeconds
+	p.cfg.Processor.ClearSeconds = cfg.Processor.ClearSeconds
+}
+
+// getTemporality 累计模式下的时间线及代理目标的过期和清理，复用 expires_seconds、clear_seconds 配置。
+func (p *processor) getTemporality() temporalityConfig {
+	p.cfg.Mu.RLock()
+	defer p.cfg.Mu.RUnlock()
+	return temporalityConfig{
+		cumulative: p.cfg.Exporter.Temporality == model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE,
+		expires:    time.Duration(p.cfg.Processor.ExpiresSeconds) * time.Second,
+		clear:      time.Duration(p.cfg.Processor.ClearSeconds) * time.Second,
+	}
+}
+
+// fixExponentialHistogram 修正指数直方图配置，scale 超出范围时取边界值。
+func fixExponentialHistogram(cfg *model.ExponentialHistogramConfig) {
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = point.DefaultExponentialMaxSize
+	}
+	if (cfg.MaxScale == 0 && !cfg.MaxScaleSet) || cfg.MaxScale > point.ExponentialMaxScale {
+		cfg.MaxScale = point.ExponentialMaxScale
+	}
+	if cfg.MaxScale < point.ExponentialMinScale {
+		cfg.MaxScale = point.ExponentialMinScale
+	}
+}
+
+func (p *processor) setExponential(cfg *model.ExponentialHistogramConfig) {
+	atomic.StoreInt32(&p.cfg.Processor.ExponentialHistogram.MaxSize, cfg.MaxSize)
+	atomic.StoreInt32(&p.cfg.Processor.ExponentialHistogram.MaxScale, cfg.MaxScale)
+}
+
+func (p *processor) getExponential() (maxSize, maxScale int32) {
+	return atomic.LoadInt32(&p.cfg.Processor.ExponentialHistogram.MaxSize),
+		atomic.LoadInt32(&p.cfg.Processor.ExponentialHistogram.MaxScale)
 }
 
 func (p *processor) setSecondLevels(cfg *configs.SecondGranularitys) {
@@ -93,6 +197,11 @@ func (p *processor) setIgnoreLabels(labelIgnores []model.LabelIgnore) {
 	p.cfg.ConvIgnoreLabels()
 }
 
+func (p *processor) setLabelRules(labelRules []model.LabelRule) {
+	p.cfg.Processor.LabelRules = labelRules
+	p.cfg.ConvLabelRules()
+}
+
 func (p *processor) setBuckets(buckets map[string]*configs.Bucket) {
 	if buckets == nil {
 		return
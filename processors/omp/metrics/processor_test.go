@@ -31,6 +31,7 @@ import (
 	"galiosight.ai/galio-sdk-go/lib/logs"
 	"galiosight.ai/galio-sdk-go/lib/strings"
 	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/processors/omp/metrics/point"
 	"galiosight.ai/galio-sdk-go/protocols"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -179,6 +180,32 @@ func TestProcessServerMetrics(t *testing.T) {
 	assert.Equal(t, otp.String(), exporter.servers[0].String())
 }
 
+// TestProcessServerMetrics_Exemplar 测试被调监控关联 span 上下文后导出 exemplar。
+func TestProcessServerMetrics_Exemplar(t *testing.T) {
+	exporter := newExporter()
+	cfg := newProcessorCfg()
+	processor, err := NewProcessor(cfg, exporter)
+	assert.Nil(t, err)
+	traceID := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	serverMetrics := model.GetServerMetrics(1)
+	serverMetrics.RpcLabels.Fields[0].Name = model.RPCLabels_callee_method
+	serverMetrics.RpcLabels.Fields[0].Value = "exemplar"
+	serverMetrics.Metrics[model.ServerMetricHandledSecondsPoint].Value = 0.01
+	serverMetrics.SetSpanContext(traceID, []byte{1, 2, 3, 4, 5, 6, 7, 8}, true)
+	processor.ProcessServerMetrics(serverMetrics)
+	model.PutServerMetrics(serverMetrics)
+	// 放回对象池后 span 上下文已清空，不会影响后续上报。
+	assert.Nil(t, serverMetrics.ExemplarSpanContext())
+	time.Sleep(time.Duration(cfg.Processor.WindowSeconds*2) * time.Second)
+	require.Len(t, exporter.servers, 1)
+	buckets := exporter.servers[0].RpcServerHandledSeconds.Buckets
+	require.Len(t, buckets, 1)
+	require.NotNil(t, buckets[0].Exemplar)
+	assert.Equal(t, traceID, buckets[0].Exemplar.TraceId)
+	assert.Equal(t, 0.01, buckets[0].Exemplar.Value)
+	assert.True(t, buckets[0].Exemplar.Sampled)
+}
+
 // TestProcessNormalMetric 测试属性监控处理。
 func TestProcessNormalMetric(t *testing.T) {
 	// 构造处理器。
@@ -271,6 +298,77 @@ func TestProcessCustomMetric(t *testing.T) {
 	assert.Equal(t, otp.String(), exporter.customs[0].String())
 }
 
+// TestProcessCustomMetric_Summary 测试自定义监控分位值统计。
+func TestProcessCustomMetric_Summary(t *testing.T) {
+	exporter := newExporter()
+	cfg := newProcessorCfg()
+	cfg.Processor.Summary = model.SummaryConfig{Quantiles: []float64{0.99, 2, 0.5, 0.5}}
+	processor, err := NewProcessor(cfg, exporter)
+	assert.Nil(t, err)
+	// 非法及重复的分位被去掉，并排序。
+	assert.Equal(t, []float64{0.5, 0.99}, cfg.Processor.Summary.Quantiles)
+	assert.Equal(t, point.DefaultRelativeAccuracy, cfg.Processor.Summary.RelativeAccuracy)
+	for i := 1; i <= 100; i++ {
+		processor.ProcessCustomMetrics(
+			&model.CustomMetrics{
+				Metrics: []model.Metric{
+					{Name: "test_summary_metric", Aggregation: model.Aggregation_AGGREGATION_SUMMARY, Value: float64(i)},
+				},
+				MonitorName: "test_monitor",
+			},
+		)
+	}
+	// 等待数据导出。
+	time.Sleep(time.Duration(cfg.Processor.WindowSeconds*2) * time.Second)
+	require.Len(t, exporter.customs, 1)
+	summary := exporter.customs[0].Metrics[0].GetSummary()
+	require.NotNil(t, summary)
+	assert.Equal(t, int64(100), summary.Count)
+	assert.Equal(t, 5050.0, summary.Sum)
+	require.Len(t, summary.Quantiles, 2)
+	assert.InEpsilon(t, 50, summary.Quantiles[0].Value, 0.01)
+	assert.InEpsilon(t, 99, summary.Quantiles[1].Value, 0.01)
+}
+
+// TestProcessCustomMetric_WeightedHistogram 测试自定义监控批量写入 histogram 观测值。
+func TestProcessCustomMetric_WeightedHistogram(t *testing.T) {
+	exporter := newExporter()
+	cfg := newProcessorCfg()
+	processor, err := NewProcessor(cfg, exporter)
+	assert.Nil(t, err)
+	processor.ProcessCustomMetrics(
+		&model.CustomMetrics{
+			Metrics: []model.Metric{
+				{
+					Name: "test_weighted_metric", Aggregation: model.Aggregation_AGGREGATION_HISTOGRAM,
+					Value: 0.03, Count: 1000, Sum: 31.5,
+				},
+			},
+			MonitorName: "test_monitor",
+		},
+	)
+	processor.ProcessCustomMetrics(
+		&model.CustomMetrics{
+			Metrics: []model.Metric{
+				{Name: "test_weighted_metric", Aggregation: model.Aggregation_AGGREGATION_HISTOGRAM, Value: 0.5},
+			},
+			MonitorName: "test_monitor",
+		},
+	)
+	// 等待数据导出。
+	time.Sleep(time.Duration(cfg.Processor.WindowSeconds*2) * time.Second)
+	require.Len(t, exporter.customs, 1)
+	histogram := exporter.customs[0].Metrics[0].GetHistogram()
+	require.NotNil(t, histogram)
+	assert.Equal(t, int64(1001), histogram.Count)
+	assert.Equal(t, 32.0, histogram.Sum)
+	var count int64
+	for _, b := range histogram.Buckets {
+		count += b.Count
+	}
+	assert.Equal(t, int64(1001), count)
+}
+
 // TestHashCollision 简单测试 hash 碰撞（完备测试需要大量资源）。
 func TestHashCollision(t *testing.T) {
 	// 构造处理器。
@@ -827,3 +925,93 @@ func Test_searchAggregator(t *testing.T) {
 		require.Equalf(t, tt.wantWindow, got.window, "case=%d", i)
 	}
 }
+
+// TestProcessCustomMetrics_LabelRules 测试标签改写规则及热更新。
+func TestProcessCustomMetrics_LabelRules(t *testing.T) {
+	exporter := newExporter()
+	cfg := newProcessorCfg()
+	cfg.Processor.LabelRules = []model.LabelRule{
+		{
+			MonitorName: "rules",
+			Action:      model.LabelRuleAction_LABEL_RULE_ACTION_DROP,
+			Selectors:   []model.LabelMatcher{{Name: "path", Regex: "/health"}},
+		},
+	}
+	p, err := NewProcessor(cfg, exporter)
+	require.Nil(t, err)
+	process := func(path string) {
+		p.ProcessCustomMetrics(
+			&model.CustomMetrics{
+				MonitorName: "rules",
+				Metrics: []model.Metric{
+					{Name: "requests", Aggregation: model.Aggregation_AGGREGATION_SUM, Value: 1},
+				},
+				CustomLabels: []model.Label{{Name: "path", Value: path}},
+			},
+		)
+	}
+	paths := func() []string {
+		var ret []string
+		for _, c := range exporter.customs {
+			if c.MonitorName == "rules" {
+				ret = append(ret, c.CustomLabels[0].Value)
+			}
+		}
+		return ret
+	}
+	process("/health")
+	process("/user/1")
+	time.Sleep(time.Duration(cfg.Processor.WindowSeconds*2) * time.Second)
+	assert.Equal(t, []string{"/user/1"}, paths())
+	assert.Equal(t, int64(1), p.GetStats().LabelRuleDropCount.Load())
+
+	// 规则热更新。
+	processorCfg := cfg.Processor
+	processorCfg.LabelRules = []model.LabelRule{
+		{
+			Action:      model.LabelRuleAction_LABEL_RULE_ACTION_REPLACE,
+			LabelName:   "path",
+			Regex:       `/user/\d+`,
+			Replacement: "/user/:id",
+		},
+	}
+	p.Watch(
+		&ocp.GalileoConfig{
+			Config: model.GetConfigResponse{
+				MetricsConfig: model.MetricsConfig{
+					Enable:    true,
+					Processor: processorCfg,
+					Exporter:  cfg.Exporter,
+				},
+			},
+			Resource: cfg.Resource,
+		},
+	)
+	exporter.customs = nil
+	process("/health")
+	process("/user/2")
+	time.Sleep(time.Duration(cfg.Processor.WindowSeconds*2) * time.Second)
+	assert.ElementsMatch(t, []string{"/health", "/user/:id"}, paths())
+}
+
+func Test_fixExponentialHistogram(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       model.ExponentialHistogramConfig
+		wantScale int32
+	}{
+		{"默认值", model.ExponentialHistogramConfig{}, point.ExponentialMaxScale},
+		{"显式配置 0", model.ExponentialHistogramConfig{MaxScaleSet: true}, 0},
+		{"超出上限", model.ExponentialHistogramConfig{MaxScale: 30}, point.ExponentialMaxScale},
+		{"超出下限", model.ExponentialHistogramConfig{MaxScale: -30, MaxScaleSet: true}, point.ExponentialMinScale},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				fixExponentialHistogram(&tt.cfg)
+				assert.Equal(t, tt.wantScale, tt.cfg.MaxScale)
+				assert.Equal(t, point.DefaultExponentialMaxSize, tt.cfg.MaxSize)
+			},
+		)
+	}
+}
@@ -0,0 +1,504 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package runtimes
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+const (
+	// procSelfCgroup 本进程所属的 cgroup，每行格式为 "hierarchy-ID:controller-list:cgroup-path"。
+	procSelfCgroup = "/proc/self/cgroup"
+	// procSelfMountInfo 本进程可见的挂载点，用于查找 cgroup 层级的挂载目录。
+	procSelfMountInfo = "/proc/self/mountinfo"
+)
+
+// cgroupV1Unlimited cgroup v1 不限制内存时 memory.limit_in_bytes 为接近 int64 最大值的页对齐值。
+const cgroupV1Unlimited = 1 << 62
+
+// errCgroupNotFound 未找到 cgroup v1 或 v2 层级。
+var errCgroupNotFound = errors.New("cgroup not found")
+
+// CgroupStat 容器 cgroup 资源统计，读取失败的统计项为 0。
+type CgroupStat struct {
+	Version  int // cgroup 版本，1 或 2
+	CPU      CgroupCPUStat
+	Memory   CgroupMemoryStat
+	Pressure map[string]*PSIStat // cpu、memory、io 的 PSI，仅 cgroup v2 支持
+}
+
+// CgroupCPUStat cgroup CPU 统计。
+type CgroupCPUStat struct {
+	LimitCores       float64 // CPU 限制的核数，0 表示不限制
+	UsageSeconds     float64 // 累计 CPU 使用时间
+	Periods          uint64  // 累计调度周期数
+	ThrottledPeriods uint64  // 累计被限流的周期数
+	ThrottledSeconds float64 // 累计被限流的时间
+}
+
+// CgroupMemoryStat cgroup 内存统计。
+type CgroupMemoryStat struct {
+	UsageBytes      uint64 // 内存使用，包含 page cache
+	WorkingSetBytes uint64 // 工作集，即 usage - inactive_file，与 kubelet 驱逐及 OOM 判断口径一致
+	LimitBytes      uint64 // 内存限制，0 表示不限制
+	OOMEvents       uint64 // 达到内存限制触发 OOM 的次数
+	OOMKillEvents   uint64 // 被 OOM killer 杀死的进程数
+}
+
+// PSIStat Pressure Stall Information，参考：https://docs.kernel.org/accounting/psi.html
+type PSIStat struct {
+	Some PSILine // 至少有一个任务因资源不足而等待
+	Full PSILine // 所有任务都因资源不足而等待
+}
+
+// PSILine PSI 的一行数据，avg 为百分比。
+type PSILine struct {
+	Avg10  float64
+	Avg60  float64
+	Avg300 float64
+	Total  uint64 // 累计等待时间，单位 us
+}
+
+// cgroupPaths 本进程所属 cgroup 的目录。
+type cgroupPaths struct {
+	unified string            // cgroup v2 目录，v1/v2 混合模式下 unified 层级一般没有控制器，只用于读取 PSI
+	v1      map[string]string // cgroup v1 控制器（cpu、cpuacct、memory 等）到目录的映射
+}
+
+// v2File 返回 cgroup v2 目录下的文件，没有 cgroup v2 时返回空。
+func (p *cgroupPaths) v2File(name string) string {
+	if p.unified == "" {
+		return ""
+	}
+	return filepath.Join(p.unified, name)
+}
+
+// v1File 返回 cgroup v1 控制器目录下的文件，未挂载该控制器时返回空。
+func (p *cgroupPaths) v1File(controller, name string) string {
+	dir, ok := p.v1[controller]
+	if !ok {
+		return ""
+	}
+	return filepath.Join(dir, name)
+}
+
+// cgroupMount cgroup 层级的一个挂载点。
+type cgroupMount struct {
+	root       string // 挂载的 cgroup 在层级中的路径，容器内一般为容器自身的 cgroup
+	mountPoint string
+}
+
+// resolveCgroupPaths 根据 cgroupFile（/proc/self/cgroup）及 mountInfoFile（/proc/self/mountinfo）
+// 找到本进程所属 cgroup 的目录，支持 cgroup v1、v2 及 v1/v2 混合模式。
+func resolveCgroupPaths(cgroupFile, mountInfoFile string) (*cgroupPaths, error) {
+	unifiedMounts, v1Mounts, err := readCgroupMounts(mountInfoFile)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(cgroupFile)
+	if err != nil {
+		return nil, err
+	}
+	paths := &cgroupPaths{v1: make(map[string]string)}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if fields[0] == "0" && fields[1] == "" {
+			paths.unified = cgroupDir(unifiedMounts, fields[2])
+			continue
+		}
+		for _, controller := range strings.Split(fields[1], ",") {
+			if dir := cgroupDir(v1Mounts[controller], fields[2]); dir != "" {
+				paths.v1[controller] = dir
+			}
+		}
+	}
+	if paths.unified == "" && len(paths.v1) == 0 {
+		return nil, errCgroupNotFound
+	}
+	return paths, nil
+}
+
+// readCgroupMounts 读取 mountinfo 中 cgroup2 的挂载点及 cgroup v1 每个控制器的挂载点，每行格式为：
+// 36 35 98:0 /root /mnt/point rw,noatime master:1 - cgroup cgroup rw,cpu,cpuacct
+func readCgroupMounts(mountInfoFile string) ([]cgroupMount, map[string][]cgroupMount, error) {
+	data, err := os.ReadFile(mountInfoFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	var unified []cgroupMount
+	v1 := make(map[string][]cgroupMount)
+	for _, line := range strings.Split(string(data), "\n") {
+		pre, post, ok := strings.Cut(line, " - ")
+		if !ok {
+			continue
+		}
+		fields, fs := strings.Fields(pre), strings.Fields(post)
+		if len(fields) < 5 || len(fs) < 3 {
+			continue
+		}
+		mount := cgroupMount{root: fields[3], mountPoint: fields[4]}
+		switch fs[0] {
+		case "cgroup2":
+			unified = append(unified, mount)
+		case "cgroup":
+			for _, opt := range strings.Split(fs[2], ",") {
+				v1[opt] = append(v1[opt], mount)
+			}
+		}
+	}
+	return unified, v1, nil
+}
+
+// cgroupDir 返回 cgroup 路径对应的目录，优先使用包含该路径的挂载点。
+// 容器开启 cgroup namespace 时 cgroup 路径为 /，挂载点即为容器的 cgroup；
+// 未开启时 cgroup 路径为宿主机上的路径，如 /kubepods/pod1/abc，挂载的 root 一般也是该路径。
+func cgroupDir(mounts []cgroupMount, path string) string {
+	if len(mounts) == 0 {
+		return ""
+	}
+	for _, m := range mounts {
+		if m.root == "/" {
+			return filepath.Join(m.mountPoint, path)
+		}
+		if path == m.root || strings.HasPrefix(path, m.root+"/") {
+			return filepath.Join(m.mountPoint, strings.TrimPrefix(path, m.root))
+		}
+	}
+	return mounts[0].mountPoint
+}
+
+// fixtureCgroupPaths root 为 cgroup v2 挂载点或 cgroup v1 控制器挂载点的父目录。
+func fixtureCgroupPaths(root string) *cgroupPaths {
+	if fileExists(filepath.Join(root, "cgroup.controllers")) {
+		return &cgroupPaths{unified: root}
+	}
+	paths := &cgroupPaths{v1: make(map[string]string)}
+	for _, controller := range []string{"cpu", "cpuacct", "memory"} {
+		if dir := filepath.Join(root, controller); fileExists(dir) {
+			paths.v1[controller] = dir
+		}
+	}
+	return paths
+}
+
+// writeCgroupCPULimit 上报容器 cgroup CPU 限制的核数，不限制时不上报。
+func writeCgroupCPULimit(metrics *model.Metrics) {
+	limit, err := ReadCgroupCPULimit("", "", "")
+	if err != nil || limit <= 0 {
+		return
+	}
+	metrics.AddNormalMetric("go_cgroup_cpu_limit_cores", model.Aggregation_AGGREGATION_SET, limit)
+}
+
+// ReadCgroupCPULimit 读取 cgroup CPU 限制的核数，优先读 cgroup v2，不存在时读 cgroup v1，不限制时返回 0。
+// 文件名为空时使用本进程所属 cgroup 中的文件。
+func ReadCgroupCPULimit(cpuMaxFileName, quotaFileName, periodFileName string) (float64, error) {
+	if cpuMaxFileName == "" || quotaFileName == "" || periodFileName == "" {
+		paths, err := resolveCgroupPaths(procSelfCgroup, procSelfMountInfo)
+		if err != nil {
+			return 0, err
+		}
+		if cpuMaxFileName == "" {
+			cpuMaxFileName = paths.v2File("cpu.max")
+		}
+		if quotaFileName == "" {
+			quotaFileName = paths.v1File("cpu", "cpu.cfs_quota_us")
+		}
+		if periodFileName == "" {
+			periodFileName = paths.v1File("cpu", "cpu.cfs_period_us")
+		}
+	}
+	data, err := os.ReadFile(cpuMaxFileName)
+	if err == nil {
+		return parseCgroupV2CPUMax(string(data))
+	}
+	return readCgroupV1CPULimit(quotaFileName, periodFileName)
+}
+
+// readCgroupV1CPULimit 读取 cgroup v1 CPU 限制的核数，quota 为 -1 表示不限制，返回 0。
+func readCgroupV1CPULimit(quotaFileName, periodFileName string) (float64, error) {
+	quota, err := readNumber(quotaFileName)
+	if err != nil {
+		return 0, err
+	}
+	period, err := readNumber(periodFileName)
+	if err != nil {
+		return 0, err
+	}
+	if quota <= 0 || period <= 0 {
+		return 0, nil
+	}
+	return float64(quota) / float64(period), nil
+}
+
+// writeCgroupMetrics 上报容器 cgroup 资源监控，不在容器中时不上报。
+func writeCgroupMetrics(metrics *model.Metrics) {
+	stat, err := ReadCgroupStat("")
+	if err != nil {
+		return
+	}
+	set := func(name string, value float64) {
+		metrics.AddNormalMetric(name, model.Aggregation_AGGREGATION_SET, value)
+	}
+	set("cgroup_version", float64(stat.Version))
+	if stat.CPU.LimitCores > 0 {
+		set("cgroup_cpu_limit_cores", stat.CPU.LimitCores)
+	}
+	set("cgroup_cpu_usage_seconds_total", stat.CPU.UsageSeconds)
+	set("cgroup_cpu_periods_total", float64(stat.CPU.Periods))
+	set("cgroup_cpu_throttled_periods_total", float64(stat.CPU.ThrottledPeriods))
+	set("cgroup_cpu_throttled_seconds_total", stat.CPU.ThrottledSeconds)
+	set("cgroup_memory_usage_bytes", float64(stat.Memory.UsageBytes))
+	set("cgroup_memory_working_set_bytes", float64(stat.Memory.WorkingSetBytes))
+	if stat.Memory.LimitBytes > 0 {
+		set("cgroup_memory_limit_bytes", float64(stat.Memory.LimitBytes))
+	}
+	set("cgroup_memory_oom_events_total", float64(stat.Memory.OOMEvents))
+	set("cgroup_memory_oom_kill_events_total", float64(stat.Memory.OOMKillEvents))
+	for _, resource := range []string{"cpu", "memory", "io"} {
+		psi, ok := stat.Pressure[resource]
+		if !ok {
+			continue
+		}
+		for kind, line := range map[string]PSILine{"some": psi.Some, "full": psi.Full} {
+			prefix := "cgroup_pressure_" + resource + "_" + kind
+			set(prefix+"_avg10", line.Avg10)
+			set(prefix+"_avg60", line.Avg60)
+			set(prefix+"_seconds_total", float64(line.Total)/1e6)
+		}
+	}
+}
+
+// ReadCgroupStat 读取本进程所属 cgroup 的统计，目录通过 /proc/self/cgroup 及 /proc/self/mountinfo 查找。
+// root 不为空时直接读取 root 下的文件：存在 cgroup.controllers 时按 cgroup v2 读取，否则按 cgroup v1 读取
+// root 下的 cpu、cpuacct、memory 控制器目录。
+// 挂载了 cgroup v1 的 cpu 或 memory 控制器时按 cgroup v1 读取，v1/v2 混合模式下 PSI 从 unified 层级读取。
+func ReadCgroupStat(root string) (*CgroupStat, error) {
+	if root != "" {
+		return readCgroupStat(fixtureCgroupPaths(root))
+	}
+	paths, err := resolveCgroupPaths(procSelfCgroup, procSelfMountInfo)
+	if err != nil {
+		return nil, err
+	}
+	return readCgroupStat(paths)
+}
+
+func readCgroupStat(paths *cgroupPaths) (*CgroupStat, error) {
+	if fileExists(paths.v1File("cpu", "")) || fileExists(paths.v1File("memory", "")) {
+		stat := readCgroupV1(paths)
+		if paths.unified != "" {
+			stat.Pressure = readPressure(paths.unified)
+		}
+		return stat, nil
+	}
+	if fileExists(paths.v2File("cgroup.controllers")) {
+		return readCgroupV2(paths.unified), nil
+	}
+	return nil, errCgroupNotFound
+}
+
+// readPressure 读取 cpu、memory、io 的 PSI，内核未开启 PSI 时为空。
+func readPressure(dir string) map[string]*PSIStat {
+	pressure := make(map[string]*PSIStat)
+	for _, resource := range []string{"cpu", "memory", "io"} {
+		if psi, err := readPSI(filepath.Join(dir, resource+".pressure")); err == nil {
+			pressure[resource] = psi
+		}
+	}
+	return pressure
+}
+
+func readCgroupV2(root string) *CgroupStat {
+	stat := &CgroupStat{Version: 2, Pressure: readPressure(root)}
+	if data, err := os.ReadFile(filepath.Join(root, "cpu.max")); err == nil {
+		stat.CPU.LimitCores, _ = parseCgroupV2CPUMax(string(data))
+	}
+	cpu, _ := readKeyValues(filepath.Join(root, "cpu.stat"))
+	stat.CPU.UsageSeconds = float64(cpu["usage_usec"]) / 1e6
+	stat.CPU.Periods = cpu["nr_periods"]
+	stat.CPU.ThrottledPeriods = cpu["nr_throttled"]
+	stat.CPU.ThrottledSeconds = float64(cpu["throttled_usec"]) / 1e6
+
+	stat.Memory.UsageBytes, _ = readUint(filepath.Join(root, "memory.current"))
+	stat.Memory.LimitBytes, _ = readUint(filepath.Join(root, "memory.max"))
+	memory, _ := readKeyValues(filepath.Join(root, "memory.stat"))
+	stat.Memory.WorkingSetBytes = workingSet(stat.Memory.UsageBytes, memory["inactive_file"])
+	events, _ := readKeyValues(filepath.Join(root, "memory.events"))
+	stat.Memory.OOMEvents = events["oom"]
+	stat.Memory.OOMKillEvents = events["oom_kill"]
+	return stat
+}
+
+func readCgroupV1(paths *cgroupPaths) *CgroupStat {
+	stat := &CgroupStat{Version: 1}
+	stat.CPU.LimitCores, _ = readCgroupV1CPULimit(
+		paths.v1File("cpu", "cpu.cfs_quota_us"), paths.v1File("cpu", "cpu.cfs_period_us"),
+	)
+	if usage, err := readUint(paths.v1File("cpuacct", "cpuacct.usage")); err == nil {
+		stat.CPU.UsageSeconds = float64(usage) / 1e9
+	}
+	cpu, _ := readKeyValues(paths.v1File("cpu", "cpu.stat"))
+	stat.CPU.Periods = cpu["nr_periods"]
+	stat.CPU.ThrottledPeriods = cpu["nr_throttled"]
+	stat.CPU.ThrottledSeconds = float64(cpu["throttled_time"]) / 1e9
+
+	stat.Memory.UsageBytes, _ = readUint(paths.v1File("memory", "memory.usage_in_bytes"))
+	if limit, err := readUint(paths.v1File("memory", "memory.limit_in_bytes")); err == nil &&
+		limit < cgroupV1Unlimited {
+		stat.Memory.LimitBytes = limit
+	}
+	memory, _ := readKeyValues(paths.v1File("memory", "memory.stat"))
+	stat.Memory.WorkingSetBytes = workingSet(stat.Memory.UsageBytes, memory["total_inactive_file"])
+	// cgroup v1 没有 OOM 次数，failcnt 为达到内存限制的次数。
+	stat.Memory.OOMEvents, _ = readUint(paths.v1File("memory", "memory.failcnt"))
+	oom, _ := readKeyValues(paths.v1File("memory", "memory.oom_control"))
+	stat.Memory.OOMKillEvents = oom["oom_kill"]
+	return stat
+}
+
+// workingSet 与 cAdvisor 计算方式一致，usage 小于 inactive_file 时为 0。
+func workingSet(usage, inactiveFile uint64) uint64 {
+	if usage < inactiveFile {
+		return 0
+	}
+	return usage - inactiveFile
+}
+
+// parseCgroupV2CPUMax data：200000 100000，不限制时为 max 100000
+func parseCgroupV2CPUMax(data string) (float64, error) {
+	fields := strings.Fields(data)
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("invalid cpu.max: %q", data)
+	}
+	if fields[0] == "max" {
+		return 0, nil
+	}
+	quota, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	period, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if quota <= 0 || period <= 0 {
+		return 0, nil
+	}
+	return float64(quota) / float64(period), nil
+}
+
+// readUint 读取只有一个数字的文件，值为 max 时返回 0。
+func readUint(fileName string) (uint64, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return 0, err
+	}
+	s := strings.TrimSpace(string(data))
+	if s == "max" {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// readKeyValues 读取每行为 "key value" 的文件，如 cpu.stat、memory.stat、memory.events。
+func readKeyValues(fileName string) (map[string]uint64, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	kvs := make(map[string]uint64)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			kvs[fields[0]] = v
+		}
+	}
+	return kvs, scanner.Err()
+}
+
+// readPSI data：
+// some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+// full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func readPSI(fileName string) (*PSIStat, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	psi := &PSIStat{}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		var l *PSILine
+		switch fields[0] {
+		case "some":
+			l = &psi.Some
+		case "full":
+			l = &psi.Full
+		default:
+			continue
+		}
+		for _, f := range fields[1:] {
+			k, v, ok := strings.Cut(f, "=")
+			if !ok {
+				continue
+			}
+			switch k {
+			case "avg10":
+				l.Avg10, _ = strconv.ParseFloat(v, 64)
+			case "avg60":
+				l.Avg60, _ = strconv.ParseFloat(v, 64)
+			case "avg300":
+				l.Avg300, _ = strconv.ParseFloat(v, 64)
+			case "total":
+				l.Total, _ = strconv.ParseUint(v, 10, 64)
+			}
+		}
+	}
+	return psi, nil
+}
+
+func fileExists(name string) bool {
+	if name == "" {
+		return false
+	}
+	_, err := os.Stat(name)
+	return err == nil
+}
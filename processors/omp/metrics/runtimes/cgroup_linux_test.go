@@ -0,0 +1,191 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package runtimes
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCgroupStat(t *testing.T) {
+	tests := []struct {
+		name       string
+		root       string
+		assertFunc func(*CgroupStat)
+		wantErr    bool
+	}{
+		{
+			name: "cgroup v2",
+			root: "./testdata/cgroup_v2",
+			assertFunc: func(stat *CgroupStat) {
+				assert.Equal(t, 2, stat.Version)
+				assert.Equal(t, CgroupCPUStat{
+					LimitCores: 2, UsageSeconds: 1.5, Periods: 120, ThrottledPeriods: 12, ThrottledSeconds: 0.25,
+				}, stat.CPU)
+				assert.Equal(t, CgroupMemoryStat{
+					UsageBytes: 512 << 20, WorkingSetBytes: 384 << 20, LimitBytes: 1 << 30, OOMEvents: 3, OOMKillEvents: 1,
+				}, stat.Memory)
+				require.Len(t, stat.Pressure, 3)
+				assert.Equal(t, PSILine{Avg10: 1.5, Avg60: 0.8, Avg300: 0.2, Total: 3000000}, stat.Pressure["cpu"].Some)
+				assert.Equal(t, PSILine{Avg10: 1, Avg60: 0.5, Avg300: 0.25, Total: 2000000}, stat.Pressure["io"].Full)
+			},
+		},
+		{
+			name: "cgroup v2 不限制",
+			root: "./testdata/cgroup_v2_unlimited",
+			assertFunc: func(stat *CgroupStat) {
+				assert.Equal(t, 2, stat.Version)
+				assert.Zero(t, stat.CPU.LimitCores)
+				assert.Zero(t, stat.Memory.LimitBytes)
+				assert.Equal(t, uint64(1<<20), stat.Memory.WorkingSetBytes)
+				assert.Empty(t, stat.Pressure)
+			},
+		},
+		{
+			name: "cgroup v1",
+			root: "./testdata/cgroup_v1",
+			assertFunc: func(stat *CgroupStat) {
+				assert.Equal(t, 1, stat.Version)
+				assert.Equal(t, CgroupCPUStat{
+					LimitCores: 1.5, UsageSeconds: 3, Periods: 300, ThrottledPeriods: 30, ThrottledSeconds: 2,
+				}, stat.CPU)
+				assert.Equal(t, CgroupMemoryStat{
+					UsageBytes: 100 << 20, WorkingSetBytes: 80 << 20, OOMEvents: 5, OOMKillEvents: 2,
+				}, stat.Memory)
+				assert.Empty(t, stat.Pressure)
+			},
+		},
+		{
+			name:       "不在容器中",
+			root:       "./testdata/not_exist",
+			assertFunc: func(stat *CgroupStat) {},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCgroupStat(tt.root)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadCgroupStat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			tt.assertFunc(got)
+		})
+	}
+}
+
+func Test_parseCgroupV2CPUMax(t *testing.T) {
+	got, err := parseCgroupV2CPUMax("50000 100000\n")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.5, got)
+	_, err = parseCgroupV2CPUMax("50000")
+	assert.Error(t, err)
+}
+
+func TestReadCgroupCPULimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		cpuMax  string
+		want    float64
+		wantErr bool
+	}{
+		{name: "cgroup v2", cpuMax: "./testdata/cgroup_v2/cpu.max", want: 2},
+		{name: "cgroup v2 不限制", cpuMax: "./testdata/cgroup_v2_unlimited/cpu.max", want: 0},
+		{name: "cgroup v1", cpuMax: "./testdata/not_exist.txt", want: 1.5},
+		{name: "cgroup v2 格式错误", cpuMax: "./testdata/cgroup_v1/cpu/cpu.cfs_quota_us", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCgroupCPULimit(
+				tt.cpuMax, "./testdata/cgroup_v1/cpu/cpu.cfs_quota_us", "./testdata/cgroup_v1/cpu/cpu.cfs_period_us",
+			)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_resolveCgroupPaths(t *testing.T) {
+	tests := []struct {
+		name string
+		want *cgroupPaths
+	}{
+		{
+			name: "v2",
+			want: &cgroupPaths{unified: "/sys/fs/cgroup/kubepods/pod1/abc", v1: map[string]string{}},
+		},
+		{
+			name: "hybrid",
+			want: &cgroupPaths{
+				unified: "/sys/fs/cgroup/unified",
+				v1: map[string]string{
+					"memory":       "/sys/fs/cgroup/memory",
+					"cpu":          "/sys/fs/cgroup/cpu,cpuacct",
+					"cpuacct":      "/sys/fs/cgroup/cpu,cpuacct",
+					"name=systemd": "/sys/fs/cgroup/systemd",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveCgroupPaths(
+				"./testdata/proc_self_cgroup_"+tt.name+".txt", "./testdata/proc_self_mountinfo_"+tt.name+".txt",
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+	_, err := resolveCgroupPaths("./testdata/proc_self_cgroup_v2.txt", "./testdata/proc_self_mountinfo_hybrid.txt")
+	assert.NoError(t, err)
+	_, err = resolveCgroupPaths("./testdata/proc_self_cgroup_v2.txt", "./testdata/proc_self_io.txt")
+	assert.ErrorIs(t, err, errCgroupNotFound)
+}
+
+func Test_readCgroupStat_Hybrid(t *testing.T) {
+	// cpu、memory 使用 cgroup v1，PSI 从 unified 层级读取
+	testdata, err := filepath.Abs("./testdata")
+	require.NoError(t, err)
+	dir := t.TempDir()
+	cgroupFile, mountInfoFile := filepath.Join(dir, "cgroup"), filepath.Join(dir, "mountinfo")
+	require.NoError(t, os.WriteFile(cgroupFile, []byte("4:cpu:/\n5:cpuacct:/\n6:memory:/\n0::/\n"), 0o600))
+	require.NoError(t, os.WriteFile(mountInfoFile, []byte(fmt.Sprintf(
+		"1 0 0:1 / %[1]s/cgroup_v1/cpu rw - cgroup cgroup rw,cpu\n"+
+			"2 0 0:2 / %[1]s/cgroup_v1/cpuacct rw - cgroup cgroup rw,cpuacct\n"+
+			"3 0 0:3 / %[1]s/cgroup_v1/memory rw - cgroup cgroup rw,memory\n"+
+			"4 0 0:4 / %[1]s/cgroup_v2 rw - cgroup2 cgroup2 rw\n",
+		testdata,
+	)), 0o600))
+	paths, err := resolveCgroupPaths(cgroupFile, mountInfoFile)
+	require.NoError(t, err)
+	stat, err := readCgroupStat(paths)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stat.Version)
+	assert.Equal(t, 1.5, stat.CPU.LimitCores)
+	assert.Equal(t, uint64(100<<20), stat.Memory.UsageBytes)
+	assert.Len(t, stat.Pressure, 3)
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !linux
+// +build !linux
+
+package runtimes
+
+import (
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// CgroupStat 容器 cgroup 资源统计，读取失败的统计项为 0。
+type CgroupStat struct {
+	Version  int // cgroup 版本，1 或 2
+	CPU      CgroupCPUStat
+	Memory   CgroupMemoryStat
+	Pressure map[string]*PSIStat // cpu、memory、io 的 PSI，仅 cgroup v2 支持
+}
+
+// CgroupCPUStat cgroup CPU 统计。
+type CgroupCPUStat struct {
+	LimitCores       float64 // CPU 限制的核数，0 表示不限制
+	UsageSeconds     float64 // 累计 CPU 使用时间
+	Periods          uint64  // 累计调度周期数
+	ThrottledPeriods uint64  // 累计被限流的周期数
+	ThrottledSeconds float64 // 累计被限流的时间
+}
+
+// CgroupMemoryStat cgroup 内存统计。
+type CgroupMemoryStat struct {
+	UsageBytes      uint64 // 内存使用，包含 page cache
+	WorkingSetBytes uint64 // 工作集，即 usage - inactive_file，与 kubelet 驱逐及 OOM 判断口径一致
+	LimitBytes      uint64 // 内存限制，0 表示不限制
+	OOMEvents       uint64 // 达到内存限制触发 OOM 的次数
+	OOMKillEvents   uint64 // 被 OOM killer 杀死的进程数
+}
+
+// PSIStat Pressure Stall Information，参考：https://docs.kernel.org/accounting/psi.html
+type PSIStat struct {
+	Some PSILine // 至少有一个任务因资源不足而等待
+	Full PSILine // 所有任务都因资源不足而等待
+}
+
+// PSILine PSI 的一行数据，avg 为百分比。
+type PSILine struct {
+	Avg10  float64
+	Avg60  float64
+	Avg300 float64
+	Total  uint64 // 累计等待时间，单位 us
+}
+
+// writeCgroupCPULimit 非 linux 没有 cgroup，不上报。
+func writeCgroupCPULimit(metrics *model.Metrics) {
+}
+
+// ReadCgroupCPULimit 非 linux 没有 cgroup，返回 0。
+func ReadCgroupCPULimit(cpuMaxFileName, quotaFileName, periodFileName string) (float64, error) {
+	return 0, nil
+}
+
+// writeCgroupMetrics 非 linux 没有 cgroup，不上报。
+func writeCgroupMetrics(metrics *model.Metrics) {
+}
+
+// ReadCgroupStat 读 cgroup 统计。
+func ReadCgroupStat(root string) (*CgroupStat, error) {
+	// TODO 待实现
+	return nil, nil
+}
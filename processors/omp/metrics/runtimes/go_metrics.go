@@ -19,77 +19,239 @@
 package runtimes
 
 import (
+	"math"
 	"runtime"
+	"runtime/debug"
+	"runtime/metrics"
+	"sort"
+	"strings"
+	"sync"
 
 	"galiosight.ai/galio-sdk-go/configs"
+	libstrings "galiosight.ai/galio-sdk-go/lib/strings"
 	"galiosight.ai/galio-sdk-go/model"
-	"galiosight.ai/galio-sdk-go/processors/omp/metrics/point"
 )
 
-// writeGoMetrics 上报逻辑迁移自：https://github.com/VictoriaMetrics/metrics/blob/master/go_metrics.go
+// runtime/metrics 指标名，参考：https://pkg.go.dev/runtime/metrics#hdr-Supported_metrics
+const (
+	rmCgoCalls        = "/cgo/go-to-c-calls:calls"
+	rmCPUGCTotal      = "/cpu/classes/gc/total:cpu-seconds"
+	rmCPUTotal        = "/cpu/classes/total:cpu-seconds"
+	rmGCCycles        = "/gc/cycles/total:gc-cycles"
+	rmGCForcedCycles  = "/gc/cycles/forced:gc-cycles"
+	rmGCGogc          = "/gc/gogc:percent"
+	rmGCGomemlimit    = "/gc/gomemlimit:bytes"
+	rmGCHeapAllocs    = "/gc/heap/allocs:bytes"
+	rmGCHeapAllocsObj = "/gc/heap/allocs:objects"
+	rmGCHeapFreesObj  = "/gc/heap/frees:objects"
+	rmGCHeapTinyObj   = "/gc/heap/tiny/allocs:objects"
+	rmGCHeapGoal      = "/gc/heap/goal:bytes"
+	rmGCHeapObjects   = "/gc/heap/objects:objects"
+	rmGCAllocsBySize  = "/gc/heap/allocs-by-size:bytes"
+	rmGCFreesBySize   = "/gc/heap/frees-by-size:bytes"
+	rmGCPauses        = "/sched/pauses/total/gc:seconds"
+	rmGCPausesLegacy  = "/gc/pauses:seconds" // go1.22 之前的 gc 停顿指标
+	rmGomaxprocs      = "/sched/gomaxprocs:threads"
+	rmGoroutines      = "/sched/goroutines:goroutines"
+	rmSchedLatencies  = "/sched/latencies:seconds"
+
+	rmMemoryClassesPrefix = "/memory/classes/"
+	rmHeapFree            = "/memory/classes/heap/free:bytes"
+	rmHeapObjects         = "/memory/classes/heap/objects:bytes"
+	rmHeapReleased        = "/memory/classes/heap/released:bytes"
+	rmHeapStacks          = "/memory/classes/heap/stacks:bytes"
+	rmHeapUnused          = "/memory/classes/heap/unused:bytes"
+	rmMCacheFree          = "/memory/classes/metadata/mcache/free:bytes"
+	rmMCacheInuse         = "/memory/classes/metadata/mcache/inuse:bytes"
+	rmMSpanFree           = "/memory/classes/metadata/mspan/free:bytes"
+	rmMSpanInuse          = "/memory/classes/metadata/mspan/inuse:bytes"
+	rmMetadataOther       = "/memory/classes/metadata/other:bytes"
+	rmOSStacks            = "/memory/classes/os-stacks:bytes"
+	rmOther               = "/memory/classes/other:bytes"
+	rmProfilingBuckets    = "/memory/classes/profiling/buckets:bytes"
+	rmTotal               = "/memory/classes/total:bytes"
+)
+
+// goRuntime 进程内唯一的 runtime/metrics 读取器。
+var goRuntime = newRuntimeReader()
+
+// writeGoMetrics 从 runtime/metrics 读取运行时指标，不需要 stop the world。
+// 原 runtime.MemStats 的指标名保持不变，换算方式参考：
+// https://github.com/prometheus/client_golang/blob/main/prometheus/go_collector_latest.go
 func writeGoMetrics(metrics *model.Metrics) {
-	// 内存相关上报。
-	var ms runtime.MemStats
-	runtime.ReadMemStats(&ms)
-	writeGoMemStats(metrics, &ms)
-	writeGoGc(metrics, &ms)
+	goRuntime.write(metrics)
+}
+
+// runtimeReader 读取 runtime/metrics，复用 samples 及直方图上次读取的累计值。
+type runtimeReader struct {
+	mu sync.Mutex
+	// samples 当前 Go 版本支持的、需要上报的指标
+	samples []metrics.Sample
+	// index 指标名到 samples 下标
+	index map[string]int
+	// gcPauses 当前 Go 版本的 gc 停顿指标名
+	gcPauses string
+	// histograms runtime/metrics 直方图指标名到 OMP 直方图
+	histograms map[string]*runtimeHistogram
+	// gcStats 复用 Pause 切片，只用于获取最后一次 gc 时间及累计停顿时长
+	gcStats debug.GCStats
+}
+
+func newRuntimeReader() *runtimeReader {
+	r := &runtimeReader{
+		index:      make(map[string]int),
+		histograms: make(map[string]*runtimeHistogram),
+	}
+	wanted := map[string]bool{
+		rmCgoCalls: true, rmCPUGCTotal: true, rmCPUTotal: true, rmGCCycles: true, rmGCForcedCycles: true,
+		rmGCGogc: true, rmGCGomemlimit: true, rmGCHeapAllocs: true, rmGCHeapAllocsObj: true,
+		rmGCHeapFreesObj: true, rmGCHeapTinyObj: true, rmGCHeapGoal: true, rmGCHeapObjects: true,
+		rmGomaxprocs: true, rmGoroutines: true,
+	}
+	supported := make(map[string]bool)
+	for _, d := range metrics.All() {
+		supported[d.Name] = true
+		if wanted[d.Name] || strings.HasPrefix(d.Name, rmMemoryClassesPrefix) {
+			r.add(d.Name)
+		}
+	}
+	r.gcPauses = rmGCPauses
+	if !supported[rmGCPauses] {
+		r.gcPauses = rmGCPausesLegacy
+	}
+	for name, h := range map[string]*runtimeHistogram{
+		r.gcPauses:       newRuntimeHistogram("go_gc_pause_seconds", gcBucket),
+		rmSchedLatencies: newRuntimeHistogram("go_sched_latencies_seconds", schedLatencyBucket),
+		rmGCAllocsBySize: newRuntimeHistogram("go_gc_heap_allocs_by_size_bytes", sizeBucket),
+		rmGCFreesBySize:  newRuntimeHistogram("go_gc_heap_frees_by_size_bytes", sizeBucket),
+	} {
+		if supported[name] {
+			r.add(name)
+			r.histograms[name] = h
+		}
+	}
+	return r
+}
+
+func (r *runtimeReader) add(name string) {
+	r.index[name] = len(r.samples)
+	r.samples = append(r.samples, metrics.Sample{Name: name})
+}
+
+// value 返回标量指标的值，当前 Go 版本不支持时返回 0。
+func (r *runtimeReader) value(name string) float64 {
+	i, ok := r.index[name]
+	if !ok {
+		return 0
+	}
+	v := r.samples[i].Value
+	switch v.Kind() {
+	case metrics.KindUint64:
+		return float64(v.Uint64())
+	case metrics.KindFloat64:
+		return v.Float64()
+	default:
+		return 0
+	}
+}
+
+func (r *runtimeReader) write(m *model.Metrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	metrics.Read(r.samples)
+	r.writeGoMemStats(m)
+	r.writeGoGc(m)
+	r.writeMemoryClasses(m)
 	// 进程、线程、cgo、核数相关上报。
-	metrics.AddNormalMetric("go_cgo_calls_count", model.Aggregation_AGGREGATION_SET, float64(runtime.NumCgoCall()))
-	metrics.AddNormalMetric("go_cpu_count", model.Aggregation_AGGREGATION_SET, float64(runtime.NumCPU()))
-	metrics.AddNormalMetric("go_gomaxprocs", model.Aggregation_AGGREGATION_SET, float64(runtime.GOMAXPROCS(0)))
-	metrics.AddNormalMetric("go_goroutines", model.Aggregation_AGGREGATION_SET, float64(runtime.NumGoroutine()))
+	m.AddNormalMetric("go_cgo_calls_count", model.Aggregation_AGGREGATION_SET, r.value(rmCgoCalls))
+	m.AddNormalMetric("go_cpu_count", model.Aggregation_AGGREGATION_SET, float64(runtime.NumCPU()))
+	m.AddNormalMetric("go_gomaxprocs", model.Aggregation_AGGREGATION_SET, r.value(rmGomaxprocs))
+	m.AddNormalMetric("go_goroutines", model.Aggregation_AGGREGATION_SET, r.value(rmGoroutines))
 	numThread, _ := runtime.ThreadCreateProfile(nil)
-	metrics.AddNormalMetric("go_threads", model.Aggregation_AGGREGATION_SET, float64(numThread))
+	m.AddNormalMetric("go_threads", model.Aggregation_AGGREGATION_SET, float64(numThread))
+	writeCgroupCPULimit(m)
+	// 调度延迟、gc 停顿、按大小的内存分配直方图。
+	for _, s := range r.samples {
+		if h, ok := r.histograms[s.Name]; ok && s.Value.Kind() == metrics.KindFloat64Histogram {
+			h.write(m, s.Value.Float64Histogram())
+		}
+	}
 	// 兼容伽利略页面补充上报。
-	writeGcHistogram(metrics, &ms)
-	metrics.AddNormalMetric("process_cpu_cores", model.Aggregation_AGGREGATION_SET, float64(runtime.NumCPU()))
-	metrics.AddNormalMetric("go_max_process_num", model.Aggregation_AGGREGATION_SET, float64(runtime.GOMAXPROCS(0)))
+	m.AddNormalMetric("process_cpu_cores", model.Aggregation_AGGREGATION_SET, float64(runtime.NumCPU()))
+	m.AddNormalMetric("go_max_process_num", model.Aggregation_AGGREGATION_SET, r.value(rmGomaxprocs))
 }
 
-// writeGoMemStats go 内存统计上报。
-func writeGoMemStats(metrics *model.Metrics, ms *runtime.MemStats) {
-	metrics.AddNormalMetric("go_memstats_alloc_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.Alloc))
-	metrics.AddNormalMetric("go_memstats_alloc_bytes_total", model.Aggregation_AGGREGATION_SET, float64(ms.TotalAlloc))
-	metrics.AddNormalMetric(
-		"go_memstats_buck_hash_sys_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.BuckHashSys),
-	)
-	metrics.AddNormalMetric("go_memstats_frees_total", model.Aggregation_AGGREGATION_SET, float64(ms.Frees))
-	metrics.AddNormalMetric("go_memstats_heap_alloc_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.HeapAlloc))
-	metrics.AddNormalMetric("go_memstats_heap_idle_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.HeapIdle))
-	metrics.AddNormalMetric("go_memstats_heap_inuse_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.HeapInuse))
-	metrics.AddNormalMetric("go_memstats_heap_objects", model.Aggregation_AGGREGATION_SET, float64(ms.HeapObjects))
-	metrics.AddNormalMetric(
-		"go_memstats_heap_released_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.HeapReleased),
-	)
-	metrics.AddNormalMetric("go_memstats_heap_sys_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.HeapSys))
-	metrics.AddNormalMetric("go_memstats_lookups_total", model.Aggregation_AGGREGATION_SET, float64(ms.Lookups))
-	metrics.AddNormalMetric("go_memstats_mallocs_total", model.Aggregation_AGGREGATION_SET, float64(ms.Mallocs))
-	metrics.AddNormalMetric(
-		"go_memstats_mcache_inuse_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.MCacheInuse),
-	)
-	metrics.AddNormalMetric("go_memstats_mcache_sys_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.MCacheSys))
-	metrics.AddNormalMetric("go_memstats_mspan_inuse_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.MSpanInuse))
-	metrics.AddNormalMetric("go_memstats_mspan_sys_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.MSpanSys))
-	metrics.AddNormalMetric("go_memstats_other_sys_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.OtherSys))
-	metrics.AddNormalMetric("go_memstats_stack_inuse_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.StackInuse))
-	metrics.AddNormalMetric("go_memstats_stack_sys_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.StackSys))
-	metrics.AddNormalMetric("go_memstats_sys_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.Sys))
+// writeGoMemStats 按 runtime.MemStats 的字段含义上报内存统计。
+func (r *runtimeReader) writeGoMemStats(m *model.Metrics) {
+	v := r.value
+	heapObjects := v(rmHeapObjects)
+	heapIdle := v(rmHeapFree) + v(rmHeapReleased)
+	heapInuse := heapObjects + v(rmHeapUnused)
+	tinyAllocs := v(rmGCHeapTinyObj)
+	set := func(name string, value float64) {
+		m.AddNormalMetric(name, model.Aggregation_AGGREGATION_SET, value)
+	}
+	set("go_memstats_alloc_bytes", heapObjects)
+	set("go_memstats_alloc_bytes_total", v(rmGCHeapAllocs))
+	set("go_memstats_buck_hash_sys_bytes", v(rmProfilingBuckets))
+	set("go_memstats_frees_total", v(rmGCHeapFreesObj)+tinyAllocs)
+	set("go_memstats_heap_alloc_bytes", heapObjects)
+	set("go_memstats_heap_idle_bytes", heapIdle)
+	set("go_memstats_heap_inuse_bytes", heapInuse)
+	set("go_memstats_heap_objects", v(rmGCHeapObjects))
+	set("go_memstats_heap_released_bytes", v(rmHeapReleased))
+	set("go_memstats_heap_sys_bytes", heapIdle+heapInuse)
+	// runtime 已不再统计 lookups，MemStats.Lookups 恒为 0。
+	set("go_memstats_lookups_total", 0)
+	set("go_memstats_mallocs_total", v(rmGCHeapAllocsObj)+tinyAllocs)
+	set("go_memstats_mcache_inuse_bytes", v(rmMCacheInuse))
+	set("go_memstats_mcache_sys_bytes", v(rmMCacheInuse)+v(rmMCacheFree))
+	set("go_memstats_mspan_inuse_bytes", v(rmMSpanInuse))
+	set("go_memstats_mspan_sys_bytes", v(rmMSpanInuse)+v(rmMSpanFree))
+	set("go_memstats_other_sys_bytes", v(rmOther))
+	set("go_memstats_stack_inuse_bytes", v(rmHeapStacks))
+	set("go_memstats_stack_sys_bytes", v(rmHeapStacks)+v(rmOSStacks))
+	set("go_memstats_sys_bytes", v(rmTotal))
 }
 
 // writeGoGc 上报 go gc 统计。
-func writeGoGc(metrics *model.Metrics, ms *runtime.MemStats) {
-	metrics.AddNormalMetric("go_memstats_gc_cpu_fraction", model.Aggregation_AGGREGATION_SET, ms.GCCPUFraction)
-	metrics.AddNormalMetric("go_memstats_gc_sys_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.GCSys))
-	metrics.AddNormalMetric(
-		"go_memstats_last_gc_time_seconds", model.Aggregation_AGGREGATION_SET, float64(ms.LastGC)/1e9,
-	)
-	metrics.AddNormalMetric("go_memstats_next_gc_bytes", model.Aggregation_AGGREGATION_SET, float64(ms.NextGC))
+func (r *runtimeReader) writeGoGc(m *model.Metrics) {
+	var gcCPUFraction float64
+	if total := r.value(rmCPUTotal); total > 0 {
+		gcCPUFraction = r.value(rmCPUGCTotal) / total
+	}
+	// debug.ReadGCStats 只加锁不 stop the world，用于获取 runtime/metrics 未提供的最后一次 gc 时间。
+	debug.ReadGCStats(&r.gcStats)
+	set := func(name string, value float64) {
+		m.AddNormalMetric(name, model.Aggregation_AGGREGATION_SET, value)
+	}
+	set("go_memstats_gc_cpu_fraction", gcCPUFraction)
+	set("go_memstats_gc_sys_bytes", r.value(rmMetadataOther))
+	set("go_memstats_last_gc_time_seconds", float64(r.gcStats.LastGC.UnixNano())/1e9)
+	set("go_memstats_next_gc_bytes", r.value(rmGCHeapGoal))
+	set("go_gc_duration_seconds_sum", r.gcStats.PauseTotal.Seconds())
+	set("go_gc_duration_seconds_count", r.value(rmGCCycles))
+	set("go_gc_forced_count", r.value(rmGCForcedCycles))
+	if _, ok := r.index[rmGCGogc]; ok {
+		set("go_gc_gogc_percent", r.value(rmGCGogc))
+		set("go_gc_gomemlimit_bytes", r.value(rmGCGomemlimit))
+	}
+}
 
-	metrics.AddNormalMetric(
-		"go_gc_duration_seconds_sum", model.Aggregation_AGGREGATION_SET, float64(ms.PauseTotalNs)/1e9,
-	)
-	metrics.AddNormalMetric("go_gc_duration_seconds_count", model.Aggregation_AGGREGATION_SET, float64(ms.NumGC))
-	metrics.AddNormalMetric("go_gc_forced_count", model.Aggregation_AGGREGATION_SET, float64(ms.NumForcedGC))
+// writeMemoryClasses 上报 /memory/classes/ 下的所有内存分类，
+// 如：/memory/classes/heap/objects:bytes 上报为 go_memory_classes_heap_objects_bytes。
+func (r *runtimeReader) writeMemoryClasses(m *model.Metrics) {
+	for _, s := range r.samples {
+		if strings.HasPrefix(s.Name, rmMemoryClassesPrefix) {
+			m.AddNormalMetric(runtimeMetricName(s.Name), model.Aggregation_AGGREGATION_SET, r.value(s.Name))
+		}
+	}
+}
+
+// runtimeMetricName runtime/metrics 指标名转换成 OMP 指标名，规则与 prometheus client_golang 一致。
+func runtimeMetricName(name string) string {
+	path, unit, _ := strings.Cut(name, ":")
+	return "go" + strings.NewReplacer("/", "_", "-", "_").Replace(path+"_"+unit)
 }
 
 const (
@@ -113,9 +275,8 @@ func writeServerQPS(metrics *model.Metrics) {
 }
 
 var (
-	// lastGcNum 上次 gc num
-	lastGcNum = -1
 	// gcBucket 默认 gc 耗时分桶。
+	// 注：某些服务对 gc 比较敏感，所以细化了些，一个容器多几根时间线不会引起数据膨胀。
 	gcBucket = configs.NewBucket(
 		[]float64{
 			0,
@@ -138,40 +299,117 @@ var (
 			1,      // 1 s
 		},
 	)
-	// gcHistogram gc 耗时 point。
-	// 分桶参考：
-	// 注：某些服务对 gc 比较敏感，所以细化了些，一个容器多几根时间线不会引起数据膨胀。
-	gcHistogram = newGCHistogram()
+	// schedLatencyBucket goroutine 从就绪到运行的调度延迟分桶，通常在微秒级。
+	schedLatencyBucket = configs.NewBucket(
+		[]float64{
+			0,
+			0.000001, // 1 us
+			0.000005, // 5 us
+			0.00001,  // 10 us
+			0.00005,  // 50 us
+			0.0001,   // 100 us
+			0.0005,   // 500 us
+			0.001,    // 1 ms
+			0.005,    // 5 ms
+			0.01,     // 10 ms
+			0.05,     // 50 ms
+			0.1,      // 100 ms
+			0.5,      // 500 ms
+			1,        // 1 s
+		},
+	)
+	// sizeBucket 内存分配大小分桶，32KB 以上为大对象。
+	sizeBucket = configs.NewBucket(
+		[]float64{0, 8, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384, 32768},
+	)
 )
 
-func newGCHistogram() *point.Point {
-	p := point.Get(
-		model.Aggregation_AGGREGATION_HISTOGRAM, "go_gc_pause_seconds",
-	)
-	p.SetBucket(func() *configs.Bucket { return gcBucket })
-	return p
+// runtimeHistogram 将 runtime/metrics 的累计直方图转换成 OMP 直方图，每次上报与上次读取之间的增量。
+// runtime 的分桶很细，按分桶中值归入 bucket 的分桶，sum 同样按分桶中值估算。
+type runtimeHistogram struct {
+	name   string
+	bucket *configs.Bucket
+	// ranges 与 bucket.Values 一一对应的 vmrange
+	ranges []string
+	// last 上次读取的累计值
+	last []uint64
 }
 
-// writeGcHistogram 上报 gc 耗时 histogram。
-// 区别于 writeGoGc，精准上报每一次 gc 的情况。
-// 注：如果一个服务的 go gc 特别频繁，存在漏报数据的情况。如：writeGcHistogram 20 秒执行一次，期间 gc 超过 256 次，会发生漏报。
-func writeGcHistogram(metrics *model.Metrics, ms *runtime.MemStats) {
-	for i := lastGcNum + 1; i <= int(ms.NumGC); i++ {
-		pauseNs := ms.PauseNs[(i+255)%256]
-		pauseS := float64(pauseNs) / 1e9 // ns -> s
-		gcHistogram.Update(pauseS)
+func newRuntimeHistogram(name string, bucket *configs.Bucket) *runtimeHistogram {
+	h := &runtimeHistogram{name: name, bucket: bucket, ranges: make([]string, len(bucket.Values))}
+	for i, v := range bucket.Values {
+		end := libstrings.VMRangeMax
+		if i+1 < len(bucket.Values) {
+			end = libstrings.VMRangeFloatToString(bucket.Values[i+1])
+		}
+		h.ranges[i] = libstrings.VMRangeFloatToString(v) + libstrings.VMRangeSeparator + end
 	}
-	lastGcNum = int(ms.NumGC)
+	return h
+}
 
-	otp := model.NewNormalMetricsOTP()
-	// 当且仅当 p.toOTPFunc 为 nil 时会返回 err，此处不可能有 err。
-	cnt, _ := gcHistogram.ToOTP(otp, 0)
-	// 如果此时间段没有 gc，就没有数据，会命中这个分支。
-	// 此时调用方需要判断，避免上报空数据。
-	// 否则会导致后台收到一个指标名为空的数据，带来干扰。
-	// 请参阅 histogramToOTP 的实现。
-	if cnt == 0 {
+// write 上报增量直方图，没有增量时不上报，避免后台收到空数据。
+func (h *runtimeHistogram) write(m *model.Metrics, hist *metrics.Float64Histogram) {
+	if len(h.last) != len(hist.Counts) {
+		h.last = make([]uint64, len(hist.Counts))
+	}
+	counts := make([]int64, len(h.bucket.Values))
+	var (
+		sum   float64
+		count int64
+	)
+	for i, c := range hist.Counts {
+		d := c - h.last[i]
+		h.last[i] = c
+		if d == 0 {
+			continue
+		}
+		v := bucketMid(hist.Buckets[i], hist.Buckets[i+1])
+		counts[searchBucket(h.bucket.Values, v)] += int64(d)
+		sum += v * float64(d)
+		count += int64(d)
+	}
+	if count == 0 {
 		return
 	}
-	metrics.NormalMetrics = append(metrics.NormalMetrics, otp)
+	var (
+		ranges  []string
+		nonZero []int64
+	)
+	for i, c := range counts {
+		if c != 0 {
+			ranges = append(ranges, h.ranges[i])
+			nonZero = append(nonZero, c)
+		}
+	}
+	otp := model.NewNormalMetricsOTP()
+	otp.SetName(0, h.name)
+	otp.SetAggregation(0, model.Aggregation_AGGREGATION_HISTOGRAM)
+	otp.SetHistogram(0, sum, count, ranges, nonZero)
+	m.NormalMetrics = append(m.NormalMetrics, otp)
+}
+
+// bucketMid 返回 runtime 分桶 [lo, hi) 的中值，有一边为无穷时取另一边。
+func bucketMid(lo, hi float64) float64 {
+	switch {
+	case math.IsInf(lo, -1) && math.IsInf(hi, 1):
+		return 0
+	case math.IsInf(lo, -1):
+		return hi
+	case math.IsInf(hi, 1):
+		return lo
+	default:
+		return (lo + hi) / 2
+	}
+}
+
+// searchBucket 返回 v 所在的分桶下标，即 values[i] <= v < values[i+1]，比最小的桶还小时归入第一个桶。
+func searchBucket(values []float64, v float64) int {
+	i := sort.SearchFloat64s(values, v)
+	if i < len(values) && values[i] == v {
+		return i
+	}
+	if i == 0 {
+		return 0
+	}
+	return i - 1
 }
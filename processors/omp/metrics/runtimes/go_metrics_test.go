@@ -0,0 +1,99 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimes
+
+import (
+	"math"
+	"runtime"
+	"runtime/metrics"
+	"testing"
+
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func normalMetrics(m *model.Metrics) map[string]*model.NormalMetricOTP {
+	out := make(map[string]*model.NormalMetricOTP, len(m.NormalMetrics))
+	for _, n := range m.NormalMetrics {
+		out[n.Metric.Name] = n
+	}
+	return out
+}
+
+func TestWriteGoMetrics(t *testing.T) {
+	runtime.GC()
+	m := &model.Metrics{}
+	writeGoMetrics(m)
+	got := normalMetrics(m)
+	// 原 runtime.MemStats 的指标名保持兼容
+	for _, name := range []string{
+		"go_memstats_alloc_bytes", "go_memstats_heap_sys_bytes", "go_memstats_sys_bytes",
+		"go_memstats_mallocs_total", "go_memstats_next_gc_bytes", "go_memstats_last_gc_time_seconds",
+		"go_gc_duration_seconds_count", "go_gomaxprocs", "go_goroutines", "go_threads",
+		"process_cpu_cores", "go_max_process_num", "go_memory_classes_heap_objects_bytes",
+		"go_memory_classes_total_bytes", "go_gc_gogc_percent", "go_gc_pause_seconds",
+		"go_sched_latencies_seconds", "go_gc_heap_allocs_by_size_bytes",
+	} {
+		assert.Contains(t, got, name)
+	}
+	assert.EqualValues(t, runtime.GOMAXPROCS(0), got["go_gomaxprocs"].Metric.GetValue())
+	assert.Greater(t, got["go_memstats_sys_bytes"].Metric.GetValue(), float64(0))
+	assert.Greater(t, got["go_gc_duration_seconds_count"].Metric.GetValue(), float64(0))
+	assert.Equal(t, model.Aggregation_AGGREGATION_HISTOGRAM, got["go_gc_pause_seconds"].Metric.Aggregation)
+	assert.NotZero(t, got["go_gc_pause_seconds"].Metric.GetHistogram().Count)
+}
+
+func Test_runtimeHistogram(t *testing.T) {
+	h := newRuntimeHistogram("test_seconds", gcBucket)
+	hist := &metrics.Float64Histogram{
+		Buckets: []float64{math.Inf(-1), 0.0001, 0.0002, 0.002, math.Inf(1)},
+		Counts:  []uint64{1, 2, 3, 4},
+	}
+	m := &model.Metrics{}
+	h.write(m, hist)
+	require.Len(t, m.NormalMetrics, 1)
+	got := m.NormalMetrics[0].Metric.GetHistogram()
+	assert.EqualValues(t, 10, got.Count)
+	assert.InDelta(t, 0.0001+2*0.00015+3*0.0011+4*0.002, got.Sum, 1e-9)
+	buckets := map[string]int64{}
+	for _, b := range got.Buckets {
+		buckets[b.Range] = b.Count
+	}
+	assert.Equal(t, map[string]int64{
+		"1.000e-04...3.000e-04": 3,
+		"1.000e-03...3.000e-03": 7,
+	}, buckets)
+
+	// 只上报增量
+	hist.Counts = []uint64{1, 2, 3, 6}
+	m = &model.Metrics{}
+	h.write(m, hist)
+	require.Len(t, m.NormalMetrics, 1)
+	assert.EqualValues(t, 2, m.NormalMetrics[0].Metric.GetHistogram().Count)
+
+	m = &model.Metrics{}
+	h.write(m, hist)
+	assert.Empty(t, m.NormalMetrics)
+}
+
+func Test_runtimeMetricName(t *testing.T) {
+	assert.Equal(t, "go_memory_classes_heap_objects_bytes", runtimeMetricName("/memory/classes/heap/objects:bytes"))
+	assert.Equal(t, "go_memory_classes_os_stacks_bytes", runtimeMetricName("/memory/classes/os-stacks:bytes"))
+}
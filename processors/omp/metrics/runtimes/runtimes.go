@@ -27,6 +27,7 @@ import (
 func Write(metrics *model.Metrics) {
 	writeGoMetrics(metrics)
 	writeProcessMetrics(metrics)
+	writeCgroupMetrics(metrics)
 	writeFDMetrics(metrics)
 	writePidCount(metrics)
 	writeDiskUsage(metrics, "/")
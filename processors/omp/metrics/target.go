@@ -0,0 +1,300 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/components"
+	"galiosight.ai/galio-sdk-go/lib/hash/fnv64a"
+	"galiosight.ai/galio-sdk-go/lib/times"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+const (
+	defaultMaxTargets   = 1000 // 默认最多代理的目标数。
+	defaultBatchTargets = 100  // 默认每个 MultiTargetMetrics 包含的目标数。
+)
+
+// target 多目标处理器代理上报的逻辑服务，按 resource 区分，expires_seconds 内没有数据时过期。
+// PK 中保存的是 *target，注册表中同一个 resource 只有一个 *target，过期后再上报时重新创建。
+type target struct {
+	name         string                            // resource.target，用于匹配点数预算配置。
+	resource     model.Resource                    // 创建目标的处理器时使用。
+	hashCode     uint64                            // 所有属性标签值的 hash。
+	normalLabels *model.NormalLabels               // 导出时使用的属性标签。
+	processor    components.TargetMetricsProcessor // 该目标的监控处理器，避免每次调用都创建。
+	updateUnix   atomic.Int64                      // 最近一次上报数据的时间，秒。
+	expired      atomic.Bool                       // 是否已从注册表中过期删除。
+}
+
+// targetConfig 多目标配置，目标数及点数预算为 0 表示不限制。
+type targetConfig struct {
+	maxTargets   int
+	pointLimit   int64
+	pointLimits  map[string]int64
+	batchTargets int
+}
+
+// targets 代理目标注册表，目标数受 max_targets 限制，配置可热更新。
+type targets struct {
+	cfg       atomic.Value // *targetConfig
+	stats     *model.SelfMonitorStats
+	mu        sync.RWMutex // 保护 targets、lastClear 并发读写。
+	targets   map[uint64]*target
+	lastClear time.Time // 最近一次清理过期目标的时间。
+}
+
+func newTargets(cfg *model.MultiTargetConfig, stats *model.SelfMonitorStats) *targets {
+	t := &targets{
+		stats:     stats,
+		targets:   make(map[uint64]*target),
+		lastClear: time.Now(),
+	}
+	t.updateConfigs(cfg)
+	return t
+}
+
+func (t *targets) updateConfigs(cfg *model.MultiTargetConfig) {
+	c := &targetConfig{
+		maxTargets:   int(cfg.MaxTargets),
+		pointLimit:   cfg.PointLimit,
+		pointLimits:  make(map[string]int64, len(cfg.TargetPointLimits)),
+		batchTargets: int(cfg.BatchTargets),
+	}
+	for i := range cfg.TargetPointLimits {
+		c.pointLimits[cfg.TargetPointLimits[i].Target] = cfg.TargetPointLimits[i].PointLimit
+	}
+	t.cfg.Store(c)
+}
+
+func (t *targets) getConfig() *targetConfig {
+	c, _ := t.cfg.Load().(*targetConfig)
+	return c
+}
+
+// get 获取 or 创建 resource 对应的目标，超出目标数上限时返回 nil。
+// newProcessor 用于创建目标的监控处理器。
+func (t *targets) get(
+	r *model.Resource, newProcessor func(*target) components.TargetMetricsProcessor,
+) *target {
+	hashCode := hashResource(r)
+	maxTargets := t.getConfig().maxTargets
+	t.mu.RLock()
+	tg, ok := t.targets[hashCode]
+	full := maxTargets > 0 && len(t.targets) >= maxTargets
+	t.mu.RUnlock()
+	if ok {
+		return tg
+	}
+	if full { // 未注册的处理器每次上报都会重试，避免目标数已满时加写锁。
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if tg, ok = t.targets[hashCode]; ok { // 并发创建。
+		return tg
+	}
+	if maxTargets > 0 && len(t.targets) >= maxTargets {
+		return nil
+	}
+	tg = &target{
+		name:         r.Target,
+		resource:     *r,
+		hashCode:     hashCode,
+		normalLabels: model.ResourceToLabels(r),
+	}
+	tg.updateUnix.Store(times.SecondPrecisionUnix())
+	tg.processor = newProcessor(tg)
+	t.targets[hashCode] = tg
+	t.stats.TargetCount.Store(int64(len(t.targets)))
+	return tg
+}
+
+// expire 按 clear 间隔清理 expires 内没有上报数据的目标。
+// 过期目标的处理器仍然可用，再上报时重新创建目标，不受已过期目标的影响；
+// 腾出的目标数也可供超出上限时获取的处理器注册。
+func (t *targets) expire(now time.Time, expires, clear time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if now.Sub(t.lastClear) < clear {
+		return
+	}
+	t.lastClear = now
+	for hashCode, tg := range t.targets {
+		if now.Sub(time.Unix(tg.updateUnix.Load(), 0)) < expires {
+			continue
+		}
+		tg.expired.Store(true)
+		delete(t.targets, hashCode)
+	}
+	t.stats.TargetCount.Store(int64(len(t.targets)))
+}
+
+// exceeded 判断目标新建时间线后是否超出点数预算，处理器自身的数据不受限制。
+func (t *targets) exceeded(c *seriesCounter, pk *PK) bool {
+	if pk.target == nil {
+		return false
+	}
+	cfg := t.getConfig()
+	limit, ok := cfg.pointLimits[pk.target.name]
+	if !ok {
+		limit = cfg.pointLimit
+	}
+	return limit > 0 && c.targetPoints[pk.target]+int64(pk.pointCount) > limit
+}
+
+// add 记录目标新建了一条时间线。
+func (t *targets) add(c *seriesCounter, pk *PK) {
+	if pk.target != nil {
+		c.targetPoints[pk.target] += int64(pk.pointCount)
+	}
+}
+
+// hashResource 计算 resource 转换成属性标签后的 hash，字段与 model.ResourceToLabels 保持一致。
+func hashResource(r *model.Resource) uint64 {
+	hashCode := fnv64a.New()
+	for _, v := range [...]string{
+		r.Target, r.Namespace, r.EnvName, r.Region, r.Instance, r.Node, r.ContainerName, r.Version, r.City,
+		r.SdkName, r.ReleaseVersion,
+	} {
+		hashCode = fnv64a.Add(hashCode, v)
+		hashCode = fnv64a.AddByte(hashCode, sep)
+	}
+	return hashCode
+}
+
+// output 一个窗口的导出数据，代理目标的数据按目标拆分到各自的 *model.Metrics。
+type output struct {
+	metrics *model.Metrics            // 处理器自身的数据。
+	targets map[uint64]*model.Metrics // 代理目标的数据，按 resource hash 区分，过期重建的目标与原目标合并。
+}
+
+func newOutput(metrics *model.Metrics) *output {
+	return &output{metrics: metrics}
+}
+
+// get 获取目标的导出数据，nil 表示处理器自身。
+func (o *output) get(t *target) *model.Metrics {
+	if t == nil {
+		return o.metrics
+	}
+	if o.targets == nil {
+		o.targets = make(map[uint64]*model.Metrics)
+	}
+	m, ok := o.targets[t.hashCode]
+	if !ok {
+		m = &model.Metrics{
+			TimestampMs:  o.metrics.TimestampMs,
+			NormalLabels: t.normalLabels,
+		}
+		o.targets[t.hashCode] = m
+	}
+	return m
+}
+
+// setTemporality 设置所有导出数据的时间性。
+func (o *output) setTemporality(temporality model.MetricsTemporality) {
+	o.metrics.Temporality = temporality
+	for _, m := range o.targets {
+		m.Temporality = temporality
+	}
+}
+
+// batches 将代理目标的数据按 batchTargets 分批组装成 MultiTargetMetrics。
+func (o *output) batches(batchTargets int) []*model.MultiTargetMetrics {
+	if len(o.targets) == 0 {
+		return nil
+	}
+	if batchTargets <= 0 {
+		batchTargets = defaultBatchTargets
+	}
+	var batches []*model.MultiTargetMetrics
+	var batch *model.MultiTargetMetrics
+	for _, m := range o.targets {
+		if batch == nil || len(batch.Metrics) >= batchTargets {
+			batch = &model.MultiTargetMetrics{}
+			batches = append(batches, batch)
+		}
+		batch.Metrics = append(batch.Metrics, m)
+	}
+	return batches
+}
+
+// targetProcessor 代理目标的监控处理器，与所属处理器共用聚合分片、配置及上报链路。
+// 只能上报数据，不暴露所属处理器的配置更新等方法。
+type targetProcessor struct {
+	processor *processor
+	resource  model.Resource         // 目标未注册或已过期时，用于重新注册。
+	target    atomic.Pointer[target] // nil 表示超出目标数上限未注册，每次上报时重试，注册前丢弃数据。
+}
+
+var _ components.TargetMetricsProcessor = (*targetProcessor)(nil)
+
+// GetStats 获取所属处理器的统计数据。
+func (t *targetProcessor) GetStats() *model.SelfMonitorStats {
+	return t.processor.stats
+}
+
+// ProcessClientMetrics 处理目标的主调监控。
+func (t *targetProcessor) ProcessClientMetrics(c *model.ClientMetrics) {
+	if tg := t.live(); tg != nil {
+		t.processor.processClientMetrics(tg, c)
+	}
+}
+
+// ProcessServerMetrics 处理目标的被调监控。
+func (t *targetProcessor) ProcessServerMetrics(s *model.ServerMetrics) {
+	if tg := t.live(); tg != nil {
+		t.processor.processServerMetrics(tg, s)
+	}
+}
+
+// ProcessNormalMetric 处理目标的属性监控。
+func (t *targetProcessor) ProcessNormalMetric(n *model.NormalMetric) {
+	if tg := t.live(); tg != nil {
+		t.processor.processNormalMetric(tg, n)
+	}
+}
+
+// ProcessCustomMetrics 处理目标的自定义监控。
+func (t *targetProcessor) ProcessCustomMetrics(c *model.CustomMetrics) {
+	if tg := t.live(); tg != nil {
+		t.processor.processCustomMetrics(tg, c)
+	}
+}
+
+// live 返回注册表中有效的目标并记录上报时间，目标未注册或已过期时重新注册，返回 nil 表示丢弃数据。
+func (t *targetProcessor) live() *target {
+	p := t.processor
+	tg := t.target.Load()
+	if tg == nil || tg.expired.Load() {
+		if tg = p.targets.get(&t.resource, p.newTargetProcessor); tg != nil {
+			t.target.Store(tg)
+		}
+	}
+	if tg == nil {
+		p.stats.TargetDiscardCount.Inc()
+		return nil
+	}
+	tg.updateUnix.Store(times.SecondPrecisionUnix())
+	return tg
+}
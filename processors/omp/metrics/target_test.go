@@ -0,0 +1,256 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/components"
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/processors/omp/metrics/point"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// multiTargetRecorder 记录每个窗口导出的数据，包括多目标数据。
+type multiTargetRecorder struct {
+	metricsRecorder
+	batches []*model.MultiTargetMetrics
+}
+
+func (r *multiTargetRecorder) ExportMultiTarget(metrics *model.MultiTargetMetrics) {
+	r.batches = append(r.batches, metrics)
+}
+
+// newManualProcessor 构造手动导出的处理器，聚合窗口足够大，由测试调用 flush 导出。
+func newManualProcessor(t *testing.T, cfg *model.MultiTargetConfig, recorder *multiTargetRecorder) *processor {
+	t.Helper()
+	c := newProcessorCfg()
+	c.Processor.MultiTarget = *cfg
+	fixConfig(c)
+	p := newProcessor(c, recorder)
+	p.aggregator = newAggregator(
+		p.normalLabels,
+		func() time.Duration { return time.Hour },
+		func(name string) point.BucketFunc { return p.getBucket(name) },
+		p.getExponential,
+		p.getSummary,
+		func() bool { return false },
+		p.getTemporality,
+		p.stats, recorder, p.sampler, p.limiter, p.targets,
+	)
+	return p
+}
+
+func reportTargetTest(p interface{ ProcessCustomMetrics(*model.CustomMetrics) }, uid string) {
+	p.ProcessCustomMetrics(
+		&model.CustomMetrics{
+			MonitorName:  "proxy",
+			Metrics:      []model.Metric{{Name: "req", Aggregation: model.Aggregation_AGGREGATION_SUM, Value: 1}},
+			CustomLabels: []model.Label{{Name: "uid", Value: uid}},
+		},
+	)
+}
+
+func targetOf(m *model.Metrics) string {
+	return m.NormalLabels.Fields[model.NormalLabels_target].Value
+}
+
+func TestProcessor_ForResource(t *testing.T) {
+	recorder := &multiTargetRecorder{}
+	p := newManualProcessor(t, &model.MultiTargetConfig{BatchTargets: 1}, recorder)
+	resource := p.cfg.Resource
+	resource.Target = "PCG-123.a"
+	a := p.ForResource(&resource)
+	assert.Same(t, a, p.ForTarget("PCG-123.a"), "同一目标复用处理器")
+	_, ok := a.(components.MetricsProcessor)
+	assert.False(t, ok, "目标处理器不能更新所属处理器的配置")
+	b := p.ForTarget("PCG-123.b")
+
+	reportTargetTest(p, "1")
+	reportTargetTest(a, "1")
+	reportTargetTest(a, "1")
+	reportTargetTest(b, "1")
+	recorder.flush(p.aggregator)
+
+	self := recorder.exported[0]
+	require.Len(t, self.CustomMetrics, 1)
+	assert.Equal(t, 1.0, self.CustomMetrics[0].Metrics[0].GetValue())
+	require.Len(t, recorder.batches, 2, "每批 1 个目标")
+	values := make(map[string]float64)
+	for _, batch := range recorder.batches {
+		require.Len(t, batch.Metrics, 1)
+		m := batch.Metrics[0]
+		assert.Equal(t, self.TimestampMs, m.TimestampMs)
+		require.Len(t, m.CustomMetrics, 1)
+		values[targetOf(m)] = m.CustomMetrics[0].Metrics[0].GetValue()
+	}
+	assert.Equal(t, map[string]float64{"PCG-123.a": 2, "PCG-123.b": 1}, values)
+	assert.Equal(t, int64(2), p.stats.TargetCount.Load())
+}
+
+func TestProcessor_TargetPointLimit(t *testing.T) {
+	recorder := &multiTargetRecorder{}
+	p := newManualProcessor(
+		t, &model.MultiTargetConfig{
+			PointLimit:        2,
+			TargetPointLimits: []model.TargetPointLimit{{Target: "big", PointLimit: 4}},
+		}, recorder,
+	)
+	small, big := p.ForTarget("small"), p.ForTarget("big")
+	for i := 0; i < 5; i++ {
+		reportTargetTest(p, strconv.Itoa(i))
+		reportTargetTest(small, strconv.Itoa(i))
+		reportTargetTest(big, strconv.Itoa(i))
+	}
+	reportTargetTest(small, "0") // 已有时间线继续聚合。
+	recorder.flush(p.aggregator)
+
+	assert.Len(t, recorder.exported[0].CustomMetrics, 5, "处理器自身不受目标预算限制")
+	require.Len(t, recorder.batches, 1)
+	series := make(map[string]int)
+	for _, m := range recorder.batches[0].Metrics {
+		series[targetOf(m)] = len(m.CustomMetrics)
+	}
+	assert.Equal(t, map[string]int{"small": 2, "big": 4}, series)
+	assert.Equal(t, int64(3+1), p.stats.TargetDiscardCount.Load())
+
+	// 预算按窗口计算，热更新后生效。
+	p.targets.updateConfigs(&model.MultiTargetConfig{})
+	for i := 0; i < 5; i++ {
+		reportTargetTest(small, strconv.Itoa(i))
+	}
+	recorder.flush(p.aggregator)
+	require.Len(t, recorder.batches, 2)
+	assert.Len(t, recorder.batches[1].Metrics[0].CustomMetrics, 5)
+}
+
+func TestProcessor_MaxTargets(t *testing.T) {
+	recorder := &multiTargetRecorder{}
+	p := newManualProcessor(t, &model.MultiTargetConfig{MaxTargets: 1}, recorder)
+	a := p.ForTarget("a")
+	discard := p.ForTarget("b")
+	assert.NotSame(t, a, discard)
+	reportTargetTest(discard, "1")
+	discard.ProcessNormalMetric(&model.NormalMetric{Metric: model.Metric{Name: "n", Value: 1}})
+	assert.Equal(t, int64(2), p.stats.TargetDiscardCount.Load())
+	assert.Equal(t, int64(1), p.stats.TargetCount.Load())
+}
+
+func TestProcessor_TargetExpire(t *testing.T) {
+	recorder := &multiTargetRecorder{}
+	p := newManualProcessor(t, &model.MultiTargetConfig{MaxTargets: 1}, recorder)
+	a := p.ForTarget("a")
+	reportTargetTest(a, "1")
+	recorder.flush(p.aggregator)
+	now := time.Now()
+	p.targets.expire(now, time.Hour, time.Hour)
+	assert.Equal(t, int64(1), p.stats.TargetCount.Load(), "未到清理间隔")
+	p.targets.expire(now.Add(time.Hour), time.Hour, time.Hour)
+	assert.Equal(t, int64(0), p.stats.TargetCount.Load(), "超过 expires 没有数据的目标过期")
+
+	// 过期后腾出目标数，新目标可以创建，过期目标的处理器受目标数限制。
+	b := p.ForTarget("b")
+	assert.NotNil(t, b.(*targetProcessor).target.Load(), "b 注册成功")
+	reportTargetTest(a, "1")
+	assert.Equal(t, int64(1), p.stats.TargetDiscardCount.Load())
+
+	// 再次腾出目标数后，过期目标的处理器重新创建目标。
+	p.targets.expire(now.Add(3*time.Hour), time.Hour, time.Hour)
+	reportTargetTest(a, "1")
+	reportTargetTest(a, "2")
+	assert.Equal(t, int64(1), p.stats.TargetCount.Load())
+	assert.NotSame(t, a, p.ForTarget("a"), "重新创建的目标使用新的处理器")
+	recorder.flush(p.aggregator)
+	require.Len(t, recorder.batches, 2)
+	require.Len(t, recorder.batches[1].Metrics, 1)
+	m := recorder.batches[1].Metrics[0]
+	assert.Equal(t, "a", targetOf(m))
+	assert.Len(t, m.CustomMetrics, 2)
+}
+
+func TestProcessor_TargetRecover(t *testing.T) {
+	recorder := &multiTargetRecorder{}
+	p := newManualProcessor(t, &model.MultiTargetConfig{MaxTargets: 1}, recorder)
+	a := p.ForTarget("a")
+	reportTargetTest(a, "1")
+	// 目标数已满时获取并缓存的处理器，注册前丢弃数据。
+	b := p.ForTarget("b")
+	reportTargetTest(b, "1")
+	assert.Equal(t, int64(1), p.stats.TargetDiscardCount.Load())
+	recorder.flush(p.aggregator)
+	require.Len(t, recorder.batches, 1)
+	require.Len(t, recorder.batches[0].Metrics, 1)
+	assert.Equal(t, "a", targetOf(recorder.batches[0].Metrics[0]))
+
+	// a 过期腾出目标数后，缓存的 b 恢复上报。
+	p.targets.expire(time.Now().Add(time.Hour), time.Hour, time.Hour)
+	reportTargetTest(b, "1")
+	reportTargetTest(b, "2")
+	assert.Equal(t, int64(1), p.stats.TargetDiscardCount.Load())
+	assert.Equal(t, int64(1), p.stats.TargetCount.Load())
+	assert.Same(t, p.ForTarget("b").(*targetProcessor).target.Load(), b.(*targetProcessor).target.Load())
+	recorder.flush(p.aggregator)
+	require.Len(t, recorder.batches, 2)
+	require.Len(t, recorder.batches[1].Metrics, 1)
+	m := recorder.batches[1].Metrics[0]
+	assert.Equal(t, "b", targetOf(m))
+	assert.Len(t, m.CustomMetrics, 2)
+}
+
+func TestAggregator_ExportTargetsWithoutMultiTargetExporter(t *testing.T) {
+	recorder := &metricsRecorder{}
+	cfg := newProcessorCfg()
+	fixConfig(cfg)
+	p := newProcessor(cfg, recorder)
+	p.aggregator = newAggregator(
+		p.normalLabels,
+		func() time.Duration { return time.Hour },
+		func(name string) point.BucketFunc { return p.getBucket(name) },
+		p.getExponential,
+		p.getSummary,
+		func() bool { return false },
+		p.getTemporality,
+		p.stats, recorder, p.sampler, p.limiter, p.targets,
+	)
+	reportTargetTest(p.ForTarget("a"), "1")
+	reportTargetTest(p.ForTarget("b"), "1")
+	recorder.flush(p.aggregator)
+	require.Len(t, recorder.exported, 3, "导出器不支持多目标时，按目标逐个导出")
+	assert.ElementsMatch(t, []string{"a", "b"}, []string{targetOf(recorder.exported[1]), targetOf(recorder.exported[2])})
+}
+
+func TestAggregator_CumulativeTargets(t *testing.T) {
+	recorder := &multiTargetRecorder{}
+	p := newManualProcessor(t, &model.MultiTargetConfig{}, recorder)
+	p.cfg.Exporter.Temporality = model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE
+	p.setTemporality(p.cfg)
+	a := p.ForTarget("a")
+	reportTargetTest(a, "1")
+	recorder.flush(p.aggregator)
+	recorder.flush(p.aggregator) // 没有数据的窗口，继续导出目标的累计值。
+	require.Len(t, recorder.batches, 2)
+	m := recorder.batches[1].Metrics[0]
+	assert.Equal(t, "a", targetOf(m))
+	assert.Equal(t, model.MetricsTemporality_METRICS_TEMPORALITY_CUMULATIVE, m.Temporality)
+	require.Len(t, m.CustomMetrics, 1)
+	assert.Equal(t, 1.0, m.CustomMetrics[0].Metrics[0].GetValue())
+}
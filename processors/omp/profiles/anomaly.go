@@ -0,0 +1,190 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiles
+
+import (
+	"fmt"
+	"strings"
+
+	pprofile "github.com/google/pprof/profile"
+
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/processors/omp/profiles/delta"
+)
+
+// Defaults for model.ProfilesAnomalyConfig.
+const (
+	defaultGrowthPeriods   = 3
+	defaultGoroutineGrowth = 100
+	defaultHeapGrowthBytes = 64 * 1024 * 1024
+)
+
+// anomalyValueTypes 异常检测关注的 sample 类型
+var anomalyValueTypes = map[model.ProfileType]delta.DeltaValueType{
+	model.GoroutineProfile: {Type: "goroutine", Unit: "count"},
+	model.HeapProfile:      {Type: "inuse_space", Unit: "bytes"},
+}
+
+// anomalyDetector 比较相邻采集周期的 profile，找出持续增长的调用栈：
+// goroutine profile 按调用栈比较 goroutine 数，heap profile 按分配位置比较 inuse_space。
+// 每种 profile 类型只在各自的采集协程中使用，不需要加锁。
+type anomalyDetector struct {
+	trackers map[model.ProfileType]*growthTracker
+}
+
+// growthTracker 跟踪一种 profile 类型每个调用栈的增长趋势
+type growthTracker struct {
+	valueType delta.DeltaValueType
+	profiler  delta.Profiler
+	started   bool // 第一次没有可比较的数据，只记录
+	trends    map[string]*growthTrend
+}
+
+type growthTrend struct {
+	periods int
+	growth  int64
+}
+
+func newAnomalyDetector() *anomalyDetector {
+	d := &anomalyDetector{trackers: map[model.ProfileType]*growthTracker{}}
+	for pt, vt := range anomalyValueTypes {
+		d.trackers[pt] = &growthTracker{
+			valueType: vt,
+			profiler:  delta.NewSimpleProfiler([]delta.DeltaValueType{vt}),
+			trends:    map[string]*growthTrend{},
+		}
+	}
+	return d
+}
+
+// observe 处理一个采集周期的 profile 数据，返回达到阈值的异常
+func (d *anomalyDetector) observe(
+	pt model.ProfileType, data []byte, cfg *model.ProfilesAnomalyConfig,
+) ([]*model.ProfileAnomaly, error) {
+	t, ok := d.trackers[pt]
+	if !ok {
+		return nil, nil
+	}
+	threshold := cfg.GoroutineGrowth
+	if threshold <= 0 {
+		threshold = defaultGoroutineGrowth
+	}
+	if pt == model.HeapProfile {
+		threshold = cfg.HeapGrowthBytes
+		if threshold <= 0 {
+			threshold = defaultHeapGrowthBytes
+		}
+	}
+	periods := int(cfg.GrowthPeriods)
+	if periods <= 0 {
+		periods = defaultGrowthPeriods
+	}
+	return t.observe(pt, data, periods, threshold)
+}
+
+func (t *growthTracker) observe(
+	pt model.ProfileType, data []byte, periods int, threshold int64,
+) ([]*model.ProfileAnomaly, error) {
+	current, err := t.stackValues(data)
+	if err != nil {
+		return nil, err
+	}
+	deltaData, err := t.profiler.Delta(data)
+	if err != nil {
+		return nil, err
+	}
+	if !t.started {
+		t.started = true
+		return nil, nil
+	}
+	growth, err := t.stackValues(deltaData)
+	if err != nil {
+		return nil, err
+	}
+	var anomalies []*model.ProfileAnomaly
+	for stack := range t.trends {
+		if growth[stack] <= 0 {
+			delete(t.trends, stack) // 没有持续增长，重新计算
+		}
+	}
+	for stack, v := range growth {
+		if v <= 0 {
+			continue
+		}
+		trend, ok := t.trends[stack]
+		if !ok {
+			trend = &growthTrend{}
+			t.trends[stack] = trend
+		}
+		trend.periods++
+		trend.growth += v
+		if trend.periods >= periods && trend.growth >= threshold {
+			anomalies = append(anomalies, &model.ProfileAnomaly{
+				Type:    pt,
+				Stack:   stack,
+				Growth:  trend.growth,
+				Current: current[stack],
+				Periods: trend.periods,
+			})
+			// 已上报，重新计算，避免每个周期重复上报
+			delete(t.trends, stack)
+		}
+	}
+	return anomalies, nil
+}
+
+// stackValues 按调用栈汇总关注的 sample 值
+func (t *growthTracker) stackValues(data []byte) (map[string]int64, error) {
+	prof, err := pprofile.ParseData(data)
+	if err != nil {
+		return nil, fmt.Errorf("anomaly prof parse: %v", err)
+	}
+	index := -1
+	for i, st := range prof.SampleType {
+		if st.Type == t.valueType.Type && st.Unit == t.valueType.Unit {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("anomaly prof sample type %s/%s not found", t.valueType.Type, t.valueType.Unit)
+	}
+	values := map[string]int64{}
+	for _, s := range prof.Sample {
+		values[stackString(s)] += s.Value[index]
+	}
+	return values, nil
+}
+
+// stackString 调用栈转换为字符串，从栈顶到栈底，每行一个函数
+func stackString(s *pprofile.Sample) string {
+	var b strings.Builder
+	for _, loc := range s.Location {
+		for _, line := range loc.Line {
+			if line.Function == nil {
+				continue
+			}
+			if b.Len() > 0 {
+				b.WriteByte('\n')
+			}
+			b.WriteString(line.Function.Name)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiles
+
+import (
+	"bytes"
+	"testing"
+
+	pprofile "github.com/google/pprof/profile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// countProfile 构造 profile，stacks 为调用栈（栈顶函数名）到 sample 值的映射
+func countProfile(t *testing.T, st *pprofile.ValueType, stacks map[string]int64) []byte {
+	prof := &pprofile.Profile{SampleType: []*pprofile.ValueType{st}}
+	for name, v := range stacks {
+		fn := &pprofile.Function{ID: uint64(len(prof.Function) + 1), Name: name}
+		root := &pprofile.Function{ID: uint64(len(prof.Function) + 2), Name: "main.main"}
+		loc := &pprofile.Location{
+			ID:   uint64(len(prof.Location) + 1),
+			Line: []pprofile.Line{{Function: fn}, {Function: root}},
+		}
+		prof.Function = append(prof.Function, fn, root)
+		prof.Location = append(prof.Location, loc)
+		prof.Sample = append(prof.Sample, &pprofile.Sample{Location: []*pprofile.Location{loc}, Value: []int64{v}})
+	}
+	var buf bytes.Buffer
+	require.Nil(t, prof.Write(&buf))
+	return buf.Bytes()
+}
+
+var goroutineType = &pprofile.ValueType{Type: "goroutine", Unit: "count"}
+
+func TestAnomalyDetector_Goroutine(t *testing.T) {
+	d := newAnomalyDetector()
+	cfg := &model.ProfilesAnomalyConfig{GrowthPeriods: 2, GoroutineGrowth: 50}
+	periods := []map[string]int64{
+		{"leak": 10, "stable": 5, "spike": 1},
+		{"leak": 40, "stable": 5, "spike": 100},
+		{"leak": 70, "stable": 5, "spike": 1},
+		{"leak": 80, "stable": 5, "spike": 1},
+	}
+	var got []*model.ProfileAnomaly
+	for _, stacks := range periods {
+		anomalies, err := d.observe(model.GoroutineProfile, countProfile(t, goroutineType, stacks), cfg)
+		require.Nil(t, err)
+		got = append(got, anomalies...)
+	}
+	// leak 连续 2 个周期累计增长 60，spike 只增长 1 个周期
+	require.Len(t, got, 1)
+	assert.Equal(t, &model.ProfileAnomaly{
+		Type:    model.GoroutineProfile,
+		Stack:   "leak\nmain.main",
+		Growth:  60,
+		Current: 70,
+		Periods: 2,
+	}, got[0])
+}
+
+func TestAnomalyDetector_Heap(t *testing.T) {
+	d := newAnomalyDetector()
+	inuse := &pprofile.ValueType{Type: "inuse_space", Unit: "bytes"}
+	cfg := &model.ProfilesAnomalyConfig{GrowthPeriods: 1, HeapGrowthBytes: 1024}
+	_, err := d.observe(model.HeapProfile, countProfile(t, inuse, map[string]int64{"alloc": 1024}), cfg)
+	require.Nil(t, err)
+	anomalies, err := d.observe(model.HeapProfile, countProfile(t, inuse, map[string]int64{"alloc": 4096}), cfg)
+	require.Nil(t, err)
+	require.Len(t, anomalies, 1)
+	assert.Equal(t, int64(3072), anomalies[0].Growth)
+
+	// 类型不匹配、不支持的 profile 类型
+	_, err = d.observe(model.HeapProfile, countProfile(t, goroutineType, map[string]int64{"alloc": 1}), cfg)
+	assert.NotNil(t, err)
+	anomalies, err = d.observe(model.CPUProfile, nil, cfg)
+	assert.Nil(t, err)
+	assert.Empty(t, anomalies)
+}
+
+func TestProcessor_detectAnomaly(t *testing.T) {
+	cfg := newTestCfg([]string{"goroutine"})
+	cfg.Processor.Anomaly = model.ProfilesAnomalyConfig{Enable: true, GrowthPeriods: 1, GoroutineGrowth: 1}
+	var got []*model.ProfileAnomaly
+	cfg.AnomalyHandler = func(a *model.ProfileAnomaly) {
+		got = append(got, a)
+	}
+	p := newProcessor(cfg, newFakeExporter())
+	p.detectAnomaly(model.GoroutineProfile, countProfile(t, goroutineType, map[string]int64{"leak": 1}))
+	p.detectAnomaly(model.GoroutineProfile, countProfile(t, goroutineType, map[string]int64{"leak": 2}))
+	p.detectAnomaly(model.GoroutineProfile, []byte("invalid"))
+	require.Len(t, got, 1)
+	assert.Equal(t, int64(1), got[0].Growth)
+
+	p.cfg.Processor.Anomaly.Enable = false
+	p.detectAnomaly(model.GoroutineProfile, countProfile(t, goroutineType, map[string]int64{"leak": 10}))
+	assert.Len(t, got, 1)
+}
@@ -0,0 +1,286 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiles
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/errs"
+	"galiosight.ai/galio-sdk-go/model"
+	"galiosight.ai/galio-sdk-go/processors/omp/profiles/delta"
+)
+
+const (
+	// defaultCaptureSeconds 按需采集默认时长
+	defaultCaptureSeconds = 10
+	// maxCaptureSeconds 按需采集最长时长
+	maxCaptureSeconds = 300
+	// defaultCPUProfileRate runtime.StartCPUProfile 默认的 cpu profile rate
+	defaultCPUProfileRate = 100
+	// maxCaptureCPUProfileRate 按需采集最大的 cpu profile rate
+	maxCaptureCPUProfileRate = 1000
+	// defaultMutexProfileFraction 未配置 mutex_profile_fraction 时按需采集使用的采样比例，与默认配置一致
+	defaultMutexProfileFraction = 10
+	// defaultBlockProfileRate 未配置 block_profile_rate 时按需采集使用的采样率（纳秒），与默认配置一致
+	defaultBlockProfileRate = 100000000
+)
+
+type captureTriggerKey struct{}
+
+// withCaptureTrigger 在 ctx 中设置按需采集的触发方式。
+func withCaptureTrigger(ctx context.Context, trigger string) context.Context {
+	return context.WithValue(ctx, captureTriggerKey{}, trigger)
+}
+
+// captureTrigger 从 ctx 中获取触发方式，默认为 Go API 触发。
+func captureTrigger(ctx context.Context) string {
+	if t, ok := ctx.Value(captureTriggerKey{}).(string); ok {
+		return t
+	}
+	return model.ProfileTriggerAPI
+}
+
+// Capture 按需采集一次 profile，采集期间抢占周期采集的 CPU profiling，周期采集的调度不受影响。
+// 同一时间只允许一个按需采集，采集结果带上 capture_id、trigger 标签导出，并返回给调用方。
+func (p *processor) Capture(ctx context.Context, req *model.ProfileCapture) (*model.ProfilesBatch, error) {
+	return p.capture(ctx, req, captureTrigger(ctx))
+}
+
+// watchCapture ocp 下发新的采集 ID 且未过期时，异步触发一次按需采集。
+func (p *processor) watchCapture(c model.ProfileCapture) {
+	if c.Id == "" {
+		return
+	}
+	configMutex.Lock()
+	if c.Id == p.captureID {
+		configMutex.Unlock()
+		return
+	}
+	p.captureID = c.Id
+	log := p.cfg.Log
+	configMutex.Unlock()
+	if c.ExpireAt <= time.Now().Unix() {
+		log.Infof("[galileo]profiles processor capture %s expired at %d, skipping", c.Id, c.ExpireAt)
+		return
+	}
+	go func() {
+		if _, err := p.capture(context.Background(), &c, model.ProfileTriggerOCP); err != nil {
+			log.Errorf("[galileo]profiles processor capture %s failed: %v", c.Id, err)
+		}
+	}()
+}
+
+func (p *processor) capture(
+	ctx context.Context, req *model.ProfileCapture, trigger string,
+) (*model.ProfilesBatch, error) {
+	c, err := fixCapture(req, trigger)
+	if err != nil {
+		return nil, err
+	}
+	if !p.captureMu.TryLock() {
+		return nil, errs.ErrProfileCaptureBusy
+	}
+	defer p.captureMu.Unlock()
+
+	configMutex.Lock()
+	cfg := p.cfg
+	periodic := make(map[model.ProfileType]bool, len(p.enabledProfiles))
+	for t := range p.enabledProfiles {
+		periodic[t] = true
+	}
+	configMutex.Unlock()
+
+	cfg.Log.Infof("[galileo]profiles processor capture %s started|trigger=%s|req=%+v", c.Id, trigger, c)
+	batch := &model.ProfilesBatch{
+		Start:    time.Now().Unix(),
+		Resource: &cfg.Resource,
+		Tags: map[string]string{
+			model.ProfileTagCaptureID: c.Id,
+			model.ProfileTagTrigger:   trigger,
+		},
+	}
+
+	var (
+		cpu     bytes.Buffer
+		stopCPU func()
+		deltas  = make(map[model.ProfileType]delta.Profiler)
+	)
+	for _, pt := range c.ProfileTypes {
+		t := model.ProfileType(pt)
+		switch t {
+		case model.CPUProfile:
+			if stopCPU, err = p.startCaptureCPU(c.CpuProfileRate, &cpu); err != nil {
+				return nil, fmt.Errorf("start cpu profile: %w", err)
+			}
+			defer func() {
+				if stopCPU != nil {
+					stopCPU()
+				}
+			}()
+		case model.MutexProfile:
+			if !periodic[t] {
+				prev := runtime.SetMutexProfileFraction(captureRate(cfg.Processor.MutexProfileFraction, defaultMutexProfileFraction))
+				defer runtime.SetMutexProfileFraction(prev)
+			}
+		case model.BlockProfile:
+			if !periodic[t] {
+				runtime.SetBlockProfileRate(captureRate(cfg.Processor.BlockProfileRate, defaultBlockProfileRate))
+				defer runtime.SetBlockProfileRate(0)
+			}
+		}
+		// 累计类型的 profile 先取基线，采集结束时只导出采集期间的增量
+		if d := profileCollectors[t].DeltaValues; len(d) > 0 {
+			deltas[t] = delta.NewFastProfiler(d)
+			if _, err := p.captureSnapshot(t, deltas[t]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-p.closed:
+		return nil, errs.ErrProfilesDisabled
+	case <-time.After(time.Duration(c.DurationSeconds) * time.Second):
+	}
+
+	for _, pt := range c.ProfileTypes {
+		t := model.ProfileType(pt)
+		collector := profileCollectors[t]
+		prof := &model.Profile{Name: collector.Filename, Type: pt}
+		if t == model.CPUProfile {
+			stopCPU()
+			stopCPU = nil
+			prof.Data = cpu.Bytes()
+			if !cfg.Processor.EnableLinkTrace {
+				if prof.Data, err = removeTraceLinkLabels(prof.Data); err != nil {
+					return nil, err
+				}
+			}
+		} else {
+			if prof.Data, err = p.captureSnapshot(t, deltas[t]); err != nil {
+				return nil, err
+			}
+			if deltas[t] != nil {
+				prof.Name = "delta-" + prof.Name
+			}
+		}
+		addProfile(batch, prof)
+	}
+	batch.End = time.Now().Unix()
+	p.exporter.Export(batch)
+	cfg.Log.Infof("[galileo]profiles processor capture %s finished, %d profiles", c.Id, len(batch.Profiles))
+	return batch, nil
+}
+
+// captureRate 按需采集期间的 mutex/block 采样率，未配置（0 表示关闭）时使用默认值，否则采集不到数据。
+func captureRate(rate int32, def int) int {
+	if rate <= 0 {
+		return def
+	}
+	return int(rate)
+}
+
+// startCaptureCPU 抢占周期采集的 CPU profiling 后开始采集，返回停止采集的函数。
+func (p *processor) startCaptureCPU(rate int32, w io.Writer) (func(), error) {
+	select {
+	case p.cpuPreempt <- struct{}{}:
+	default:
+	}
+	p.cpuMu.Lock()
+	// 周期采集未在 CPU profiling 时，抢占信号不会被消费，清掉以免截断下一次周期采集
+	select {
+	case <-p.cpuPreempt:
+	default:
+	}
+	if rate != defaultCPUProfileRate {
+		// 需在 pprof.StartCPUProfile 前调用才能生效
+		runtime.SetCPUProfileRate(int(rate))
+	}
+	if err := pprof.StartCPUProfile(w); err != nil {
+		p.cpuMu.Unlock()
+		return nil, err
+	}
+	return func() {
+		pprof.StopCPUProfile()
+		p.cpuMu.Unlock()
+	}, nil
+}
+
+// captureSnapshot 获取 profile 快照，dp 不为空时返回与上一次快照的增量。
+func (p *processor) captureSnapshot(t model.ProfileType, dp delta.Profiler) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := p.lookupProfile(profileCollectors[t].Name, &buf, 0); err != nil {
+		return nil, fmt.Errorf("lookup %s profile: %w", t, err)
+	}
+	if dp == nil {
+		return buf.Bytes(), nil
+	}
+	data, err := dp.Delta(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("delta %s profile: %w", t, err)
+	}
+	return data, nil
+}
+
+// fixCapture 校验按需采集请求并填充默认值。
+func fixCapture(req *model.ProfileCapture, trigger string) (*model.ProfileCapture, error) {
+	c := &model.ProfileCapture{
+		Id:              req.Id,
+		DurationSeconds: req.DurationSeconds,
+		CpuProfileRate:  req.CpuProfileRate,
+		ExpireAt:        req.ExpireAt,
+	}
+	seen := make(map[string]bool, len(req.ProfileTypes))
+	for _, pt := range req.ProfileTypes {
+		if _, ok := profileCollectors[model.ProfileType(pt)]; !ok {
+			return nil, fmt.Errorf("profile type %q not supported: %w", pt, errs.ErrConfigInvalid)
+		}
+		if !seen[pt] {
+			seen[pt] = true
+			c.ProfileTypes = append(c.ProfileTypes, pt)
+		}
+	}
+	if len(c.ProfileTypes) == 0 {
+		c.ProfileTypes = []string{string(model.CPUProfile)}
+	}
+	if c.DurationSeconds <= 0 {
+		c.DurationSeconds = defaultCaptureSeconds
+	}
+	if c.DurationSeconds > maxCaptureSeconds {
+		c.DurationSeconds = maxCaptureSeconds
+	}
+	if c.CpuProfileRate <= 0 {
+		c.CpuProfileRate = defaultCPUProfileRate
+	}
+	if c.CpuProfileRate > maxCaptureCPUProfileRate {
+		c.CpuProfileRate = maxCaptureCPUProfileRate
+	}
+	if c.Id == "" {
+		c.Id = fmt.Sprintf("%s-%d", trigger, time.Now().UnixNano())
+	}
+	return c, nil
+}
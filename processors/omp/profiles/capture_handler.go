@@ -0,0 +1,129 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiles
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"galiosight.ai/galio-sdk-go/components"
+	"galiosight.ai/galio-sdk-go/errs"
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// captureHandler 本地 HTTP 管理接口，触发按需采集。
+type captureHandler struct {
+	processor func() components.ProfilesProcessor
+}
+
+// captureResponse 按需采集的响应，profile 数据通过导出器上报，这里只返回摘要。
+type captureResponse struct {
+	CaptureID string            `json:"capture_id"`
+	Start     int64             `json:"start"`
+	End       int64             `json:"end"`
+	Profiles  []captureProfile  `json:"profiles"`
+	Tags      map[string]string `json:"tags"`
+}
+
+type captureProfile struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Size int    `json:"size"`
+}
+
+// NewCaptureHandler 创建按需采集的 http.Handler，每次请求时通过 processor 获取性能数据处理器。
+// 支持的 query 参数：
+//   - id：采集 ID，默认自动生成
+//   - types：采集的 profile 类型，逗号分隔，默认 cpu
+//   - seconds：采集时长，默认 10s
+//   - rate：CPU profile 采样频率（Hz），默认 100
+//
+// 请求会阻塞到采集结束，返回 JSON 格式的采集摘要。
+func NewCaptureHandler(processor func() components.ProfilesProcessor) http.Handler {
+	return &captureHandler{processor: processor}
+}
+
+// ServeHTTP 实现 http.Handler。
+func (h *captureHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := parseCaptureRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := withCaptureTrigger(r.Context(), model.ProfileTriggerHTTP)
+	batch, err := h.processor().Capture(ctx, req)
+	if err != nil {
+		http.Error(w, err.Error(), captureStatusCode(err))
+		return
+	}
+	resp := &captureResponse{
+		CaptureID: batch.Tags[model.ProfileTagCaptureID],
+		Start:     batch.Start,
+		End:       batch.End,
+		Tags:      batch.Tags,
+	}
+	for _, p := range batch.Profiles {
+		resp.Profiles = append(resp.Profiles, captureProfile{Name: p.Name, Type: p.Type, Size: len(p.Data)})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func parseCaptureRequest(r *http.Request) (*model.ProfileCapture, error) {
+	q := r.URL.Query()
+	req := &model.ProfileCapture{Id: q.Get("id")}
+	for _, v := range q["types"] {
+		for _, t := range strings.Split(v, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				req.ProfileTypes = append(req.ProfileTypes, t)
+			}
+		}
+	}
+	if v := q.Get("seconds"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, errors.New("invalid seconds: " + v)
+		}
+		req.DurationSeconds = int32(n)
+	}
+	if v := q.Get("rate"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, errors.New("invalid rate: " + v)
+		}
+		req.CpuProfileRate = int32(n)
+	}
+	return req, nil
+}
+
+func captureStatusCode(err error) int {
+	switch {
+	case errors.Is(err, errs.ErrConfigInvalid):
+		return http.StatusBadRequest
+	case errors.Is(err, errs.ErrProfileCaptureBusy):
+		return http.StatusConflict
+	case errors.Is(err, errs.ErrProfilesDisabled):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
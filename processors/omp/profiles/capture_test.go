@@ -0,0 +1,219 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiles
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"galiosight.ai/galio-sdk-go/components"
+	"galiosight.ai/galio-sdk-go/configs/ocp"
+	"galiosight.ai/galio-sdk-go/errs"
+	"galiosight.ai/galio-sdk-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessor_Capture(t *testing.T) {
+	exporter := newFakeExporter()
+	p := newProcessor(newTestCfg([]string{"cpu"}), exporter)
+	batch, err := p.Capture(context.Background(), &model.ProfileCapture{
+		Id:              "incident-1",
+		ProfileTypes:    []string{"cpu", "goroutine", "heap"},
+		DurationSeconds: 1,
+		CpuProfileRate:  500,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		model.ProfileTagCaptureID: "incident-1",
+		model.ProfileTagTrigger:   model.ProfileTriggerAPI,
+	}, batch.Tags)
+	require.Len(t, batch.Profiles, 3)
+	assert.Equal(t, "cpu.pprof", batch.Profiles[0].Name)
+	assert.Equal(t, "goroutine.pprof", batch.Profiles[1].Name)
+	assert.Equal(t, "delta-heap.pprof", batch.Profiles[2].Name)
+	for _, prof := range batch.Profiles {
+		assert.True(t, isGzipData(prof.Data), prof.Name)
+	}
+	assert.Equal(t, []*model.ProfilesBatch{batch}, exporter.GetProfileData())
+}
+
+func TestProcessor_CapturePreemptsPeriodic(t *testing.T) {
+	exporter := newFakeExporter()
+	cfg := newTestCfg([]string{"cpu"})
+	cfg.Processor.PeriodSeconds = 3
+	cfg.Processor.CpuDurationSeconds = 3
+	p := newProcessor(cfg, exporter)
+	p.run()
+	defer p.stop()
+	// 等待周期采集开始 CPU profiling
+	time.Sleep(200 * time.Millisecond)
+
+	start := time.Now()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := p.Capture(context.Background(), &model.ProfileCapture{DurationSeconds: 1})
+		assert.NoError(t, err)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	_, err := p.Capture(context.Background(), &model.ProfileCapture{DurationSeconds: 1})
+	assert.ErrorIs(t, err, errs.ErrProfileCaptureBusy)
+	<-done
+	// 抢占周期采集，不需要等待周期采集结束
+	assert.Less(t, time.Since(start), 2*time.Second)
+	data := exporter.GetProfileData()
+	require.Len(t, data, 1)
+	assert.Contains(t, data[0].Tags[model.ProfileTagCaptureID], model.ProfileTriggerAPI+"-")
+}
+
+func TestProcessor_CaptureCanceled(t *testing.T) {
+	exporter := newFakeExporter()
+	p := newProcessor(newTestCfg([]string{"cpu"}), exporter)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := p.Capture(ctx, &model.ProfileCapture{ProfileTypes: []string{"cpu", "mutex"}})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Empty(t, exporter.GetProfileData())
+	// CPU profiling 已停止，可以再次采集
+	_, err = p.Capture(context.Background(), &model.ProfileCapture{DurationSeconds: 1})
+	assert.NoError(t, err)
+}
+
+func TestProcessor_PeriodicSkipsCPUDuringCapture(t *testing.T) {
+	p := newProcessor(newTestCfg([]string{"cpu"}), newFakeExporter())
+	// 模拟按需采集正在进行 CPU profiling
+	p.cpuMu.Lock()
+	start := time.Now()
+	_, err := profileCollectors[model.CPUProfile].Collect(p)
+	assert.ErrorIs(t, err, errs.ErrProfileCaptureBusy)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	p.cpuMu.Unlock()
+}
+
+func Test_captureRate(t *testing.T) {
+	assert.Equal(t, defaultBlockProfileRate, captureRate(0, defaultBlockProfileRate))
+	assert.Equal(t, defaultMutexProfileFraction, captureRate(-1, defaultMutexProfileFraction))
+	assert.Equal(t, 5, captureRate(5, defaultMutexProfileFraction))
+}
+
+func Test_fixCapture(t *testing.T) {
+	c, err := fixCapture(&model.ProfileCapture{
+		ProfileTypes:    []string{"goroutine", "goroutine"},
+		DurationSeconds: 3600,
+		CpuProfileRate:  5000,
+	}, model.ProfileTriggerHTTP)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"goroutine"}, c.ProfileTypes)
+	assert.Equal(t, int32(maxCaptureSeconds), c.DurationSeconds)
+	assert.Equal(t, int32(maxCaptureCPUProfileRate), c.CpuProfileRate)
+	assert.Contains(t, c.Id, model.ProfileTriggerHTTP+"-")
+
+	c, err = fixCapture(&model.ProfileCapture{Id: "x"}, model.ProfileTriggerAPI)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cpu"}, c.ProfileTypes)
+	assert.Equal(t, int32(defaultCaptureSeconds), c.DurationSeconds)
+	assert.Equal(t, int32(defaultCPUProfileRate), c.CpuProfileRate)
+	assert.Equal(t, "x", c.Id)
+
+	_, err = fixCapture(&model.ProfileCapture{ProfileTypes: []string{"threadcreate"}}, model.ProfileTriggerAPI)
+	assert.ErrorIs(t, err, errs.ErrConfigInvalid)
+}
+
+func TestProcessor_WatchCapture(t *testing.T) {
+	exporter := newFakeExporter()
+	cfg := newTestCfg([]string{"cpu"})
+	p := newProcessor(cfg, exporter)
+	p.run()
+	defer p.stop()
+	stopCh := p.stopCh
+	watch := func(c model.ProfileCapture) {
+		processor := cfg.Processor
+		processor.Capture = c
+		p.Watch(&ocp.GalileoConfig{
+			Config: model.GetConfigResponse{
+				ProfilesConfig: model.ProfilesConfig{Processor: processor, Exporter: cfg.Exporter},
+			},
+		})
+	}
+	captured := func() []*model.ProfilesBatch {
+		var batches []*model.ProfilesBatch
+		for _, b := range exporter.GetProfileData() {
+			if b.Tags != nil {
+				batches = append(batches, b)
+			}
+		}
+		return batches
+	}
+
+	watch(model.ProfileCapture{Id: "expired", ExpireAt: time.Now().Add(-time.Minute).Unix()})
+	c := model.ProfileCapture{
+		Id:              "ocp-1",
+		ProfileTypes:    []string{"goroutine"},
+		DurationSeconds: 1,
+		ExpireAt:        time.Now().Add(time.Minute).Unix(),
+	}
+	watch(c)
+	// 相同的 id 只触发一次
+	watch(c)
+	assert.Eventually(t, func() bool { return len(captured()) > 0 }, 3*time.Second, 100*time.Millisecond)
+	time.Sleep(200 * time.Millisecond)
+	batches := captured()
+	require.Len(t, batches, 1)
+	assert.Equal(t, "ocp-1", batches[0].Tags[model.ProfileTagCaptureID])
+	assert.Equal(t, model.ProfileTriggerOCP, batches[0].Tags[model.ProfileTagTrigger])
+	// 只变更按需采集配置，不重启周期采集
+	assert.Equal(t, stopCh, p.stopCh)
+}
+
+func TestCaptureHandler(t *testing.T) {
+	exporter := newFakeExporter()
+	p := newProcessor(newTestCfg([]string{"cpu"}), exporter)
+	h := NewCaptureHandler(func() components.ProfilesProcessor { return p })
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?id=h1&types=goroutine,%20heap&seconds=1", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp captureResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "h1", resp.CaptureID)
+	assert.Equal(t, model.ProfileTriggerHTTP, resp.Tags[model.ProfileTagTrigger])
+	require.Len(t, resp.Profiles, 2)
+	assert.Equal(t, "goroutine", resp.Profiles[0].Type)
+	assert.Equal(t, "heap", resp.Profiles[1].Type)
+	assert.NotZero(t, resp.Profiles[0].Size)
+
+	for target, code := range map[string]int{
+		"/?seconds=x":          http.StatusBadRequest,
+		"/?types=threadcreate": http.StatusBadRequest,
+	} {
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+		assert.Equal(t, code, w.Code, target)
+	}
+
+	noop := NewCaptureHandler(func() components.ProfilesProcessor { return components.NoopProfilersProcessor{} })
+	w = httptest.NewRecorder()
+	noop.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
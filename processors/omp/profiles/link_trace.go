@@ -0,0 +1,93 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiles
+
+import (
+	"bytes"
+	"fmt"
+
+	pprofile "github.com/google/pprof/profile"
+
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// removeTraceLinkLabels 未开启 profile 关联 trace 时，删除 CPU profile 中关联 span 的 pprof label。
+// span id 每个请求都不同，保留会导致相同调用栈的 sample 无法合并，profile 体积膨胀。
+func removeTraceLinkLabels(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	prof, err := pprofile.ParseData(data)
+	if err != nil {
+		return nil, fmt.Errorf("cpu prof parse: %v", err)
+	}
+	var found bool
+	for _, s := range prof.Sample {
+		if len(s.Label[model.ProfileLabelSpanID]) > 0 || len(s.Label[model.ProfileLabelEndpoint]) > 0 {
+			delete(s.Label, model.ProfileLabelSpanID)
+			delete(s.Label, model.ProfileLabelEndpoint)
+			found = true
+		}
+	}
+	if !found {
+		return data, nil
+	}
+	return writeProfile(prof.Compact())
+}
+
+// FilterCPUProfile 过滤 CPU profile，只保留指定 span id 及接口的 sample，用于展示单个请求的火焰图。
+// 需要同时开启 traces 的 enable_profile 及 profiles 的 enable_link_trace。
+// spanID、endpoint 为空时不作为过滤条件，都为空时返回原数据。
+func FilterCPUProfile(data []byte, spanID, endpoint string) ([]byte, error) {
+	if spanID == "" && endpoint == "" {
+		return data, nil
+	}
+	prof, err := pprofile.ParseData(data)
+	if err != nil {
+		return nil, fmt.Errorf("cpu prof parse: %v", err)
+	}
+	samples := prof.Sample[:0]
+	for _, s := range prof.Sample {
+		if matchLabel(s, model.ProfileLabelSpanID, spanID) && matchLabel(s, model.ProfileLabelEndpoint, endpoint) {
+			samples = append(samples, s)
+		}
+	}
+	prof.Sample = samples
+	return writeProfile(prof.Compact())
+}
+
+func matchLabel(s *pprofile.Sample, key, value string) bool {
+	if value == "" {
+		return true
+	}
+	for _, v := range s.Label[key] {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func writeProfile(prof *pprofile.Profile) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := prof.Write(&buf); err != nil {
+		return nil, fmt.Errorf("cpu prof write: %v", err)
+	}
+	return buf.Bytes(), nil
+}
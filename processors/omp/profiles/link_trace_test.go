@@ -0,0 +1,107 @@
+// Copyright 2021 Tencent Galileo Authors
+//
+// Copyright 2021 Tencent OpenTelemetry Oteam
+//
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiles
+
+import (
+	"bytes"
+	"testing"
+
+	pprofile "github.com/google/pprof/profile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"galiosight.ai/galio-sdk-go/model"
+)
+
+// linkTraceProfile 构造带 span label 的 CPU profile
+func linkTraceProfile(t *testing.T) []byte {
+	fn := &pprofile.Function{ID: 1, Name: "main.handle"}
+	loc := &pprofile.Location{ID: 1, Line: []pprofile.Line{{Function: fn}}}
+	sample := func(value int64, spanID, endpoint string) *pprofile.Sample {
+		return &pprofile.Sample{
+			Location: []*pprofile.Location{loc},
+			Value:    []int64{value},
+			Label: map[string][]string{
+				model.ProfileLabelSpanID:   {spanID},
+				model.ProfileLabelEndpoint: {endpoint},
+				"user":                     {"u1"},
+			},
+		}
+	}
+	prof := &pprofile.Profile{
+		SampleType: []*pprofile.ValueType{{Type: "cpu", Unit: "nanoseconds"}},
+		Sample: []*pprofile.Sample{
+			sample(10, "s1", "/hello"),
+			sample(20, "s2", "/hello"),
+			sample(30, "s3", "/world"),
+		},
+		Location: []*pprofile.Location{loc},
+		Function: []*pprofile.Function{fn},
+	}
+	var buf bytes.Buffer
+	require.Nil(t, prof.Write(&buf))
+	return buf.Bytes()
+}
+
+func sampleValues(t *testing.T, data []byte) []int64 {
+	prof, err := pprofile.ParseData(data)
+	require.Nil(t, err)
+	var values []int64
+	for _, s := range prof.Sample {
+		values = append(values, s.Value[0])
+	}
+	return values
+}
+
+func TestFilterCPUProfile(t *testing.T) {
+	data := linkTraceProfile(t)
+	got, err := FilterCPUProfile(data, "s2", "")
+	require.Nil(t, err)
+	assert.Equal(t, []int64{20}, sampleValues(t, got))
+
+	got, err = FilterCPUProfile(data, "", "/hello")
+	require.Nil(t, err)
+	assert.ElementsMatch(t, []int64{10, 20}, sampleValues(t, got))
+
+	got, err = FilterCPUProfile(data, "s3", "/hello")
+	require.Nil(t, err)
+	assert.Empty(t, sampleValues(t, got))
+
+	got, err = FilterCPUProfile(data, "", "")
+	require.Nil(t, err)
+	assert.Equal(t, data, got)
+
+	_, err = FilterCPUProfile([]byte("invalid"), "s1", "")
+	assert.NotNil(t, err)
+}
+
+func Test_removeTraceLinkLabels(t *testing.T) {
+	got, err := removeTraceLinkLabels(linkTraceProfile(t))
+	require.Nil(t, err)
+	prof, err := pprofile.ParseData(got)
+	require.Nil(t, err)
+	// 删除 span label 后，相同调用栈、相同用户 label 的 sample 合并
+	require.Len(t, prof.Sample, 1)
+	assert.Equal(t, []int64{60}, prof.Sample[0].Value)
+	assert.Equal(t, map[string][]string{"user": {"u1"}}, prof.Sample[0].Label)
+
+	got, err = removeTraceLinkLabels(nil)
+	require.Nil(t, err)
+	assert.Empty(t, got)
+}
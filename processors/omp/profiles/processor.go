@@ -62,6 +62,21 @@ type processor struct {
 
 	// stats 自监控状态。
 	stats *model.SelfMonitorStats
+	// anomaly 异常检测，配置热更新时保留历史数据。
+	anomaly *anomalyDetector
+	// anomalyHandler 处理异常检测结果。
+	anomalyHandler func(*model.ProfileAnomaly)
+
+	// cpuMu 同一时间只能有一个 CPU profiling，周期采集与按需采集通过 cpuMu 互斥
+	cpuMu sync.Mutex
+	// cpuPreempt 按需采集通知周期采集提前结束 CPU profiling
+	cpuPreempt chan struct{}
+	// captureMu 同一时间只允许一个按需采集
+	captureMu sync.Mutex
+	// captureID ocp 下发的最近一次采集 ID，由 configMutex 保护
+	captureID string
+	// closed Shutdown 时关闭，中断进行中的按需采集
+	closed chan struct{}
 }
 
 var _ components.ProfilesProcessor = (*processor)(nil)
@@ -84,6 +99,7 @@ func (p *processor) Start() {
 func (p *processor) Shutdown() {
 	p.stopOnce.Do(
 		func() {
+			close(p.closed)
 			if p.exporter != nil {
 				p.exporter.Shutdown()
 			}
@@ -101,6 +117,10 @@ func newProcessor(cfg *configs.Profiles, exporter components.ProfilesExporter) *
 		stopCh:          make(chan struct{}),
 		deltas:          make(map[model.ProfileType]delta.Profiler),
 		enabledProfiles: make(map[model.ProfileType]bool),
+		anomaly:         newAnomalyDetector(),
+		anomalyHandler:  cfg.AnomalyHandler,
+		cpuPreempt:      make(chan struct{}, 1),
+		closed:          make(chan struct{}),
 	}
 	p.addProfileTypes(cfg.Processor.ProfileTypes)
 	return p
@@ -157,6 +177,17 @@ func (p *processor) interruptibleSleep(d time.Duration) {
 	}
 }
 
+// cpuSleep CPU profiling 期间休眠一段时间 d，被 p.stopCh 或按需采集中断，按需采集中断时返回 true
+func (p *processor) cpuSleep(d time.Duration) bool {
+	select {
+	case <-p.stopCh:
+	case <-p.cpuPreempt:
+		return true
+	case <-time.After(d):
+	}
+	return false
+}
+
 func (p *processor) lookupProfile(name string, w io.Writer, debug int) error {
 	prof := pprof.Lookup(name)
 	if prof == nil {
@@ -252,6 +283,7 @@ func (p *processor) collectProfile(pt model.ProfileType) ([]*model.Profile, erro
 	if err != nil {
 		return nil, err
 	}
+	p.detectAnomaly(pt, data)
 	filename := t.Filename
 	if p.cfg.Processor.EnableDeltaProfiles && len(t.DeltaValues) > 0 {
 		filename = "delta-" + filename
@@ -268,3 +300,25 @@ func (p *processor) collectProfile(pt model.ProfileType) ([]*model.Profile, erro
 func addProfile(b *model.ProfilesBatch, p *model.Profile) {
 	b.Profiles = append(b.Profiles, p)
 }
+
+// detectAnomaly 开启异常检测时，比较相邻周期的 profile，持续增长超过阈值时上报。
+func (p *processor) detectAnomaly(pt model.ProfileType, data []byte) {
+	cfg := &p.cfg.Processor.Anomaly
+	if !cfg.Enable {
+		return
+	}
+	anomalies, err := p.anomaly.observe(pt, data, cfg)
+	if err != nil {
+		p.cfg.Log.Errorf("[galileo]profiles processor detect %s anomaly failed: %v", pt, err)
+		return
+	}
+	for _, a := range anomalies {
+		p.cfg.Log.Infof(
+			"[galileo]profiles processor %s anomaly: growth=%d, current=%d, periods=%d, stack=\n%s",
+			a.Type, a.Growth, a.Current, a.Periods, a.Stack,
+		)
+		if p.anomalyHandler != nil {
+			p.anomalyHandler(a)
+		}
+	}
+}
@@ -51,6 +51,7 @@ func fixConfig(cfg *configs.Profiles) {
 
 // Watch 观察配置变化
 func (p *processor) Watch(readOnlyConfig *ocp.GalileoConfig) {
+	p.watchCapture(readOnlyConfig.Config.ProfilesConfig.Processor.Capture)
 	p.UpdateConfig(
 		profiles.NewConfig(
 			&p.cfg.Resource,
@@ -64,7 +65,9 @@ func (p *processor) Watch(readOnlyConfig *ocp.GalileoConfig) {
 func (p *processor) UpdateConfig(cfg *configs.Profiles) {
 	configMutex.Lock()
 	defer configMutex.Unlock()
-	if sameAsYaml(p.cfg.Processor, cfg.Processor) && sameAsYaml(p.cfg.Exporter, cfg.Exporter) {
+	// 按需采集由 watchCapture 处理，不需要重启周期采集
+	if sameAsYaml(withoutCapture(p.cfg.Processor), withoutCapture(cfg.Processor)) &&
+		sameAsYaml(p.cfg.Exporter, cfg.Exporter) {
 		return
 	}
 	p.stop()
@@ -79,6 +82,9 @@ func (p *processor) UpdateConfig(cfg *configs.Profiles) {
 func (p *processor) reloadConfig(cfg *configs.Profiles) {
 	p.cfg = cfg
 	p.stats = cfg.Stats
+	if cfg.AnomalyHandler != nil {
+		p.anomalyHandler = cfg.AnomalyHandler
+	}
 	p.stopCh = make(chan struct{})
 	p.resetProfileTypes(cfg)
 
@@ -94,6 +100,11 @@ func (p *processor) resetProfileTypes(cfg *configs.Profiles) {
 	p.addProfileTypes(cfg.Processor.ProfileTypes)
 }
 
+func withoutCapture(m model.ProfilesProcessor) model.ProfilesProcessor {
+	m.Capture = model.ProfileCapture{}
+	return m
+}
+
 func sameAsYaml(old, new interface{}) bool {
 	return toYaml(old) == toYaml(new)
 }
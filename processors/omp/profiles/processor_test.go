@@ -20,6 +20,7 @@ package profiles
 
 import (
 	"bytes"
+	"sync"
 	"testing"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 )
 
 type fakeExporter struct {
+	mu   sync.Mutex
 	data []*model.ProfilesBatch
 }
 
@@ -38,6 +40,8 @@ func (f *fakeExporter) Run(_ chan struct{}) {
 }
 
 func (f *fakeExporter) Export(batch *model.ProfilesBatch) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	f.data = append(f.data, batch)
 }
 
@@ -45,11 +49,15 @@ func (f *fakeExporter) UpdateConfig(_ *configs.Profiles) {
 }
 
 func (f *fakeExporter) Shutdown() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	f.data = nil
 }
 
 func (f *fakeExporter) GetProfileData() []*model.ProfilesBatch {
-	return f.data
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*model.ProfilesBatch(nil), f.data...)
 }
 
 func newFakeExporter() *fakeExporter {
@@ -26,6 +26,7 @@ import (
 	"runtime/pprof"
 	"time"
 
+	"galiosight.ai/galio-sdk-go/errs"
 	"galiosight.ai/galio-sdk-go/model"
 	"galiosight.ai/galio-sdk-go/processors/omp/profiles/delta"
 )
@@ -72,6 +73,11 @@ var profileCollectors = map[model.ProfileType]profileCollector{
 			var buf bytes.Buffer
 			// 在上一个采集周期结束后，启动 CPU Profiling
 			p.interruptibleSleep(time.Duration(p.cfg.Processor.PeriodSeconds-p.cfg.Processor.CpuDurationSeconds) * time.Second)
+			// 按需采集进行中时跳过本周期的 CPU profile，不阻塞周期采集上报及 stop
+			if !p.cpuMu.TryLock() {
+				return nil, errs.ErrProfileCaptureBusy
+			}
+			end := time.Now().Add(time.Duration(p.cfg.Processor.CpuDurationSeconds) * time.Second)
 			// 如果 CpuProfileRate 为默认值（100 Hz），则不需要再设置 cpu profile rate
 			if p.cfg.Processor.CpuProfileRate != 0 && p.cfg.Processor.CpuProfileRate != hz {
 				// 需在 pprof.StartCPUProfile 前调用才能生效
@@ -79,14 +85,20 @@ var profileCollectors = map[model.ProfileType]profileCollector{
 			}
 
 			if err := pprof.StartCPUProfile(&buf); err != nil {
+				p.cpuMu.Unlock()
 				return nil, err
 			}
-			p.interruptibleSleep(time.Duration(p.cfg.Processor.CpuDurationSeconds) * time.Second)
-
-			// 通过 pendingProfiles.Wait() 等待其他类型 profiles 完成收集,
-			// CPU profiles 最后完成收集, 以保证收集完整的 profiles 数据
-			p.pendingProfiles.Wait()
+			// 被按需采集抢占时提前结束，本周期的 CPU profile 只包含抢占前的数据
+			if !p.cpuSleep(time.Until(end)) {
+				// 通过 pendingProfiles.Wait() 等待其他类型 profiles 完成收集,
+				// CPU profiles 最后完成收集, 以保证收集完整的 profiles 数据
+				p.pendingProfiles.Wait()
+			}
 			pprof.StopCPUProfile()
+			p.cpuMu.Unlock()
+			if !p.cfg.Processor.EnableLinkTrace {
+				return removeTraceLinkLabels(buf.Bytes())
+			}
 			return buf.Bytes(), nil
 		},
 	},
@@ -27,4 +27,6 @@ const (
 	OTP string = "otp"
 	// OMP Observability Meta Protocol。
 	OMP string = "omp"
+	// PrometheusRemoteWrite Prometheus remote write v1 协议。
+	PrometheusRemoteWrite string = "prometheus_remote_write"
 )
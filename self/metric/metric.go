@@ -104,6 +104,7 @@ func Init(
 					},
 				),
 				otphttp.WithMaxRetryCount(2),
+				otphttp.WithStats(selfMonitor.Stats),
 			)
 			selfMonitor.log = log
 			n := selfMetricNormalLabels(resource)